@@ -0,0 +1,9 @@
+// Package audit implements an opt-in audit trail of resource operations,
+// which enables change-audit requirements, such as recording who changed
+// which infrastructure and whether it succeeded, without per-provider code.
+//
+// When a Sink is configured with the providerserver.ServeOpts type AuditSink
+// field, the framework writes one Record for each resource Create, Read,
+// Update, and Delete operation. Static labels, such as an environment or team
+// name, can be included in every Record with the AuditLabels field.
+package audit
@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"time"
+)
+
+// Action is the kind of resource operation of a Record.
+type Action string
+
+const (
+	// ActionCreate is a resource Create operation.
+	ActionCreate Action = "create"
+
+	// ActionRead is a resource Read operation, such as when Terraform
+	// refreshes a resource.
+	ActionRead Action = "read"
+
+	// ActionUpdate is a resource Update operation.
+	ActionUpdate Action = "update"
+
+	// ActionDelete is a resource Delete operation.
+	ActionDelete Action = "delete"
+)
+
+// Outcome is the result of the resource operation of a Record.
+type Outcome string
+
+const (
+	// OutcomeSuccess is a resource operation without error diagnostics.
+	OutcomeSuccess Outcome = "success"
+
+	// OutcomeFailure is a resource operation with error diagnostics.
+	OutcomeFailure Outcome = "failure"
+)
+
+// Record is a single audit record of a resource operation.
+type Record struct {
+	// Time is when the resource operation started.
+	Time time.Time `json:"time"`
+
+	// ResourceType is the resource type name, such as examplecloud_thing.
+	ResourceType string `json:"resource_type"`
+
+	// Action is the kind of resource operation.
+	Action Action `json:"action"`
+
+	// Labels are the caller-supplied labels, such as an environment or team
+	// name. The map must not be modified.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Outcome is the result of the resource operation.
+	Outcome Outcome `json:"outcome"`
+
+	// Duration is the time taken by the resource operation, including
+	// resource Configure method calls.
+	Duration time.Duration `json:"duration_ns"`
+}
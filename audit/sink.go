@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Sink receives audit records. Sinks are called synchronously after each
+// resource operation, so they should return quickly and must be safe for
+// concurrent use. Returned errors are logged and do not affect the resource
+// operation.
+type Sink interface {
+	Write(context.Context, Record) error
+}
+
+var _ Sink = SinkFunc(nil)
+
+// SinkFunc is a function which implements Sink, such as a callback which
+// sends records to a remote audit system.
+type SinkFunc func(context.Context, Record) error
+
+// Write calls the function with the record.
+func (f SinkFunc) Write(ctx context.Context, record Record) error {
+	return f(ctx, record)
+}
+
+var _ Sink = &FileSink{}
+
+// FileSink is a Sink which appends each record to a file as a line of JSON.
+// Create a FileSink with the NewFileSink function.
+type FileSink struct {
+	file  *os.File
+	mutex sync.Mutex
+}
+
+// NewFileSink returns a FileSink which appends records to the file at the
+// given path. The file is created with owner-only permissions if it does not
+// exist. Call the Close method when the sink is no longer used.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit file: %w", err)
+	}
+
+	return &FileSink{file: file}, nil
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.file.Close()
+}
+
+// Write appends the record to the file as a line of JSON.
+func (s *FileSink) Write(_ context.Context, record Record) error {
+	line, err := json.Marshal(record)
+
+	if err != nil {
+		return fmt.Errorf("unable to encode audit record: %w", err)
+	}
+
+	line = append(line, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("unable to write audit record: %w", err)
+	}
+
+	return nil
+}
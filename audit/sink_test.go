@@ -0,0 +1,145 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/audit"
+)
+
+func TestSinkFuncWrite(t *testing.T) {
+	t.Parallel()
+
+	var got audit.Record
+
+	sink := audit.SinkFunc(func(_ context.Context, record audit.Record) error {
+		got = record
+
+		return errors.New("test error")
+	})
+
+	record := audit.Record{
+		Action:       audit.ActionCreate,
+		Outcome:      audit.OutcomeSuccess,
+		ResourceType: "test_resource",
+	}
+
+	err := sink.Write(context.Background(), record)
+
+	if err == nil || err.Error() != "test error" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(got, record); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestFileSinkWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := audit.NewFileSink(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	records := []audit.Record{
+		{
+			Action:       audit.ActionCreate,
+			Duration:     time.Second,
+			Labels:       map[string]string{"environment": "test"},
+			Outcome:      audit.OutcomeSuccess,
+			ResourceType: "test_resource",
+			Time:         time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			Action:       audit.ActionDelete,
+			Duration:     time.Millisecond,
+			Outcome:      audit.OutcomeFailure,
+			ResourceType: "test_resource",
+			Time:         time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC),
+		},
+	}
+
+	for _, record := range records {
+		if err := sink.Write(context.Background(), record); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Reopening the file appends records.
+	sink, err = audit.NewFileSink(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := sink.Write(context.Background(), records[0]); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer file.Close()
+
+	var lines []string
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	expected := []string{
+		`{"time":"2026-01-02T03:04:05Z","resource_type":"test_resource","action":"create","labels":{"environment":"test"},"outcome":"success","duration_ns":1000000000}`,
+		`{"time":"2026-01-02T03:04:06Z","resource_type":"test_resource","action":"delete","outcome":"failure","duration_ns":1000000}`,
+		`{"time":"2026-01-02T03:04:05Z","resource_type":"test_resource","action":"create","labels":{"environment":"test"},"outcome":"success","duration_ns":1000000000}`,
+	}
+
+	if diff := cmp.Diff(lines, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var got audit.Record
+
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, records[0]); diff != "" {
+		t.Errorf("unexpected round trip difference: %s", diff)
+	}
+}
+
+func TestNewFileSink_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := audit.NewFileSink(filepath.Join(t.TempDir(), "missing", "audit.log"))
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
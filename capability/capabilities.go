@@ -0,0 +1,94 @@
+package capability
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Capabilities describes the versions and features supported by the remote
+// system a provider is configured against. The zero-value contains no
+// versions or features.
+type Capabilities struct {
+	features map[string]struct{}
+	versions map[string]Version
+}
+
+// NewCapabilities returns an empty Capabilities.
+func NewCapabilities() *Capabilities {
+	return &Capabilities{
+		features: make(map[string]struct{}),
+		versions: make(map[string]Version),
+	}
+}
+
+// SetFeature marks the named feature as supported.
+func (c *Capabilities) SetFeature(name string) {
+	if c.features == nil {
+		c.features = make(map[string]struct{})
+	}
+
+	c.features[name] = struct{}{}
+}
+
+// SetVersion sets the version of the named component, such as "api". An
+// error is returned if the version cannot be parsed.
+func (c *Capabilities) SetVersion(component string, version string) error {
+	v, err := ParseVersion(version)
+
+	if err != nil {
+		return fmt.Errorf("invalid %s version: %w", component, err)
+	}
+
+	if c.versions == nil {
+		c.versions = make(map[string]Version)
+	}
+
+	c.versions[component] = v
+
+	return nil
+}
+
+// HasFeature returns true if the named feature is supported.
+func (c *Capabilities) HasFeature(name string) bool {
+	if c == nil {
+		return false
+	}
+
+	_, ok := c.features[name]
+
+	return ok
+}
+
+// Version returns the version of the named component and whether it was
+// set.
+func (c *Capabilities) Version(component string) (Version, bool) {
+	if c == nil {
+		return Version{}, false
+	}
+
+	v, ok := c.versions[component]
+
+	return v, ok
+}
+
+// String returns a human-readable representation of the Capabilities.
+func (c *Capabilities) String() string {
+	if c == nil {
+		return "<none>"
+	}
+
+	parts := make([]string, 0, len(c.versions)+len(c.features))
+
+	for component, version := range c.versions {
+		parts = append(parts, component+"="+version.String())
+	}
+
+	for feature := range c.features {
+		parts = append(parts, feature)
+	}
+
+	sort.Strings(parts)
+
+	return "[" + strings.Join(parts, ", ") + "]"
+}
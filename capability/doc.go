@@ -0,0 +1,12 @@
+// Package capability implements remote system capability functionality,
+// which enables providers to declare that resource and data source
+// attributes require a minimum remote API version or a supported feature.
+//
+// Providers collect Capabilities, such as by querying a version endpoint,
+// in the provider Configure method and set them in the ConfigureResponse.
+// Resources and data sources declare AttributeRequirement for attributes,
+// which the framework checks during planning and reading. When an attribute
+// is configured with a known value and its requirements are not satisfied,
+// the framework returns an error diagnostic instead of calling the remote
+// system.
+package capability
@@ -0,0 +1,83 @@
+package capability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Requirement is a condition on the remote system Capabilities.
+type Requirement interface {
+	// Description should describe the requirement in plain text, for
+	// example "api version >= 2.1". It is used in diagnostics.
+	Description(context.Context) string
+
+	// Satisfied should return true if the Capabilities meet the requirement.
+	Satisfied(context.Context, *Capabilities) bool
+}
+
+// AttributeRequirement declares Requirement which must be satisfied when
+// attributes matching the path expression are configured with a known,
+// non-null value.
+type AttributeRequirement struct {
+	// PathExpression matches the attributes with requirements.
+	PathExpression path.Expression
+
+	// Requirements must all be satisfied when a matching attribute is
+	// configured.
+	Requirements []Requirement
+}
+
+// MinimumVersion returns a Requirement that the named component version is
+// greater than or equal to the given version. The version must be parsable
+// by ParseVersion, otherwise this function will panic. A component without
+// a version in Capabilities does not satisfy the Requirement.
+func MinimumVersion(component string, version string) Requirement {
+	return minimumVersionRequirement{
+		component: component,
+		minimum:   MustParseVersion(version),
+	}
+}
+
+var _ Requirement = minimumVersionRequirement{}
+
+type minimumVersionRequirement struct {
+	component string
+	minimum   Version
+}
+
+func (r minimumVersionRequirement) Description(_ context.Context) string {
+	return fmt.Sprintf("%s version >= %s", r.component, r.minimum)
+}
+
+func (r minimumVersionRequirement) Satisfied(_ context.Context, c *Capabilities) bool {
+	v, ok := c.Version(r.component)
+
+	if !ok {
+		return false
+	}
+
+	return v.Compare(r.minimum) >= 0
+}
+
+// Feature returns a Requirement that the named feature is supported.
+func Feature(name string) Requirement {
+	return featureRequirement{
+		name: name,
+	}
+}
+
+var _ Requirement = featureRequirement{}
+
+type featureRequirement struct {
+	name string
+}
+
+func (r featureRequirement) Description(_ context.Context) string {
+	return fmt.Sprintf("%s feature support", r.name)
+}
+
+func (r featureRequirement) Satisfied(_ context.Context, c *Capabilities) bool {
+	return c.HasFeature(r.name)
+}
@@ -0,0 +1,113 @@
+package capability_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
+)
+
+func TestFeatureSatisfied(t *testing.T) {
+	t.Parallel()
+
+	testCapabilities := capability.NewCapabilities()
+	testCapabilities.SetFeature("tags")
+
+	testCases := map[string]struct {
+		capabilities *capability.Capabilities
+		feature      string
+		expected     bool
+	}{
+		"nil": {
+			capabilities: nil,
+			feature:      "tags",
+			expected:     false,
+		},
+		"missing": {
+			capabilities: testCapabilities,
+			feature:      "labels",
+			expected:     false,
+		},
+		"present": {
+			capabilities: testCapabilities,
+			feature:      "tags",
+			expected:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := capability.Feature(testCase.feature).Satisfied(context.Background(), testCase.capabilities)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestMinimumVersionSatisfied(t *testing.T) {
+	t.Parallel()
+
+	testCapabilities := capability.NewCapabilities()
+
+	if err := testCapabilities.SetVersion("api", "v2.1.0"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		capabilities *capability.Capabilities
+		component    string
+		minimum      string
+		expected     bool
+	}{
+		"nil": {
+			capabilities: nil,
+			component:    "api",
+			minimum:      "1.0",
+			expected:     false,
+		},
+		"missing-component": {
+			capabilities: testCapabilities,
+			component:    "storage",
+			minimum:      "1.0",
+			expected:     false,
+		},
+		"equal": {
+			capabilities: testCapabilities,
+			component:    "api",
+			minimum:      "2.1",
+			expected:     true,
+		},
+		"greater": {
+			capabilities: testCapabilities,
+			component:    "api",
+			minimum:      "2.0.5",
+			expected:     true,
+		},
+		"less": {
+			capabilities: testCapabilities,
+			component:    "api",
+			minimum:      "2.2",
+			expected:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := capability.MinimumVersion(testCase.component, testCase.minimum).Satisfied(context.Background(), testCase.capabilities)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package capability
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a dotted numeric version, such as 1.2.3. Any leading "v" and
+// any pre-release or build suffix beginning with "-" or "+" are ignored
+// during parsing.
+type Version struct {
+	segments []int
+}
+
+// ParseVersion returns the Version of the given string or an error if it is
+// not a dotted numeric version.
+func ParseVersion(s string) (Version, error) {
+	raw := strings.TrimPrefix(strings.TrimSpace(s), "v")
+
+	if i := strings.IndexAny(raw, "-+"); i >= 0 {
+		raw = raw[:i]
+	}
+
+	if raw == "" {
+		return Version{}, fmt.Errorf("%q is not a dotted numeric version", s)
+	}
+
+	parts := strings.Split(raw, ".")
+	segments := make([]int, 0, len(parts))
+
+	for _, part := range parts {
+		segment, err := strconv.Atoi(part)
+
+		if err != nil || segment < 0 {
+			return Version{}, fmt.Errorf("%q is not a dotted numeric version", s)
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return Version{segments: segments}, nil
+}
+
+// MustParseVersion returns the Version of the given string, panicking if it
+// cannot be parsed. This is intended for static declarations.
+func MustParseVersion(s string) Version {
+	v, err := ParseVersion(s)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Compare returns -1, 0, or 1 if the Version is less than, equal to, or
+// greater than the other Version. Missing segments are treated as zero, so
+// 1.2 is equal to 1.2.0.
+func (v Version) Compare(o Version) int {
+	length := len(v.segments)
+
+	if len(o.segments) > length {
+		length = len(o.segments)
+	}
+
+	for i := 0; i < length; i++ {
+		var a, b int
+
+		if i < len(v.segments) {
+			a = v.segments[i]
+		}
+
+		if i < len(o.segments) {
+			b = o.segments[i]
+		}
+
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// String returns the dotted representation of the Version.
+func (v Version) String() string {
+	parts := make([]string, 0, len(v.segments))
+
+	for _, segment := range v.segments {
+		parts = append(parts, strconv.Itoa(segment))
+	}
+
+	return strings.Join(parts, ".")
+}
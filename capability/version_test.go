@@ -0,0 +1,131 @@
+package capability_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    string
+		expectError bool
+	}{
+		"empty": {
+			input:       "",
+			expectError: true,
+		},
+		"major": {
+			input:    "2",
+			expected: "2",
+		},
+		"major-minor-patch": {
+			input:    "1.2.3",
+			expected: "1.2.3",
+		},
+		"prefix": {
+			input:    "v1.2.3",
+			expected: "1.2.3",
+		},
+		"prerelease": {
+			input:    "1.2.3-beta1",
+			expected: "1.2.3",
+		},
+		"build": {
+			input:    "1.2.3+abc",
+			expected: "1.2.3",
+		},
+		"invalid-segment": {
+			input:       "1.x",
+			expectError: true,
+		},
+		"negative-segment": {
+			input:       "1.-2",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := capability.ParseVersion(testCase.input)
+
+			if err != nil {
+				if !testCase.expectError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if testCase.expectError {
+				t.Fatalf("expected error, got: %s", got)
+			}
+
+			if got.String() != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		version  string
+		other    string
+		expected int
+	}{
+		"equal": {
+			version:  "1.2.3",
+			other:    "1.2.3",
+			expected: 0,
+		},
+		"equal-missing-segments": {
+			version:  "1.2",
+			other:    "1.2.0",
+			expected: 0,
+		},
+		"less-major": {
+			version:  "1.9.9",
+			other:    "2.0.0",
+			expected: -1,
+		},
+		"less-minor-numeric": {
+			version:  "1.2",
+			other:    "1.10",
+			expected: -1,
+		},
+		"greater-patch": {
+			version:  "1.2.4",
+			other:    "1.2.3",
+			expected: 1,
+		},
+		"greater-extra-segment": {
+			version:  "1.2.0.1",
+			other:    "1.2",
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := capability.MustParseVersion(testCase.version).Compare(capability.MustParseVersion(testCase.other))
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package datasource
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
@@ -29,3 +31,18 @@ type ConfigureResponse struct {
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
 }
+
+// NewUnexpectedProviderDataTypeDiagnostic returns the standard error
+// diagnostic for when ConfigureRequest.ProviderData is not the type expected
+// by the DataSource implementation. The expected argument should be a value of the
+// expected type, such as a nil pointer, since only its type is used.
+//
+// ConfigureRequest.ProviderData should be checked for nil before calling this
+// function, as it is not set when Terraform validates configuration without
+// calling the ConfigureProvider RPC.
+func NewUnexpectedProviderDataTypeDiagnostic(expected any, providerData any) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unexpected Data Source Configure Type",
+		fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", expected, providerData),
+	)
+}
@@ -2,6 +2,8 @@ package datasource
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
 )
 
 // DataSource represents an instance of a data source type. This is the core
@@ -10,6 +12,8 @@ import (
 // Data sources can optionally implement these additional concepts:
 //
 //   - Configure: Include provider-level data or clients.
+//   - Attribute Requirements: DataSourceWithAttributeRequirements
+//   - Read Caching: DataSourceWithReadCache
 //   - Validation: Schema-based or entire configuration
 //     via DataSourceWithConfigValidators or DataSourceWithValidateConfig.
 type DataSource interface {
@@ -26,6 +30,21 @@ type DataSource interface {
 	Read(context.Context, ReadRequest, *ReadResponse)
 }
 
+// DataSourceWithAttributeRequirements is an interface type that extends
+// DataSource to include declarative remote system requirements for
+// attributes, such as a minimum API version.
+//
+// Requirements are checked before reading against the Capabilities set in
+// the provider Configure method. Attributes configured with a known, non-null
+// value that do not meet their requirements return an error diagnostic. If
+// the provider does not set Capabilities, requirements are not checked.
+type DataSourceWithAttributeRequirements interface {
+	DataSource
+
+	// AttributeRequirements returns a list of attribute requirements.
+	AttributeRequirements(context.Context) []capability.AttributeRequirement
+}
+
 // DataSourceWithConfigure is an interface type that extends DataSource to
 // include a method which the framework will automatically call so provider
 // developers have the opportunity to setup any necessary provider-level data
@@ -57,6 +76,21 @@ type DataSourceWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// DataSourceWithReadCache is an interface type that extends DataSource to
+// cache Read results for the lifetime of the provider process. When the
+// returned ReadCache contains a result for an identical configuration, the
+// framework returns it instead of calling Read.
+//
+// This is only appropriate for data sources which represent static facts.
+// The ReadCache method is called after Configure, if implemented.
+type DataSourceWithReadCache interface {
+	DataSource
+
+	// ReadCache returns the cache for the data source type. Returning nil
+	// disables caching for the request.
+	ReadCache(context.Context) *ReadCache
+}
+
 // DataSourceWithValidateConfig is an interface type that extends DataSource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
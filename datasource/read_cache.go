@@ -0,0 +1,63 @@
+package datasource
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ReadCache is an opt-in cache of data source Read results for the lifetime
+// of the provider process. It is intended for data sources which represent
+// static facts, such as the identity of the current API caller, so identical
+// reads across the plan and apply phases in the same provider process do not
+// call the remote system again.
+//
+// Results are keyed by the configuration and provider_meta configuration of
+// each read. Only reads which return no diagnostics are cached.
+//
+// The zero value is ready to use. The same *ReadCache must be returned from
+// every DataSourceWithReadCache instance of a data source type, which is
+// typically accomplished by storing it in the provider-level data or client
+// passed to the Configure method. Call Invalidate to remove all cached
+// results, such as after a resource changes the underlying data.
+type ReadCache struct {
+	mutex  sync.Mutex
+	states map[string]tftypes.Value
+}
+
+// Get returns a copy of the cached state for the given key, if found. This
+// method is called by the framework and is not typically needed in provider
+// logic.
+func (c *ReadCache) Get(key string) (tftypes.Value, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	state, ok := c.states[key]
+
+	if !ok {
+		return tftypes.Value{}, false
+	}
+
+	return state.Copy(), true
+}
+
+// Invalidate removes all cached results.
+func (c *ReadCache) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.states = nil
+}
+
+// Set caches a copy of the given state for the given key. This method is
+// called by the framework and is not typically needed in provider logic.
+func (c *ReadCache) Set(key string, state tftypes.Value) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.states == nil {
+		c.states = make(map[string]tftypes.Value)
+	}
+
+	c.states[key] = state.Copy()
+}
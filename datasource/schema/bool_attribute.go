@@ -13,6 +13,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                             = BoolAttribute{}
+	_ fwschema.AttributeWithNonSensitive    = BoolAttribute{}
 	_ fwxschema.AttributeWithBoolValidators = BoolAttribute{}
 )
 
@@ -57,6 +58,13 @@ type BoolAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -168,6 +176,11 @@ func (a BoolAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a BoolAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a BoolAttribute) IsOptional() bool {
 	return a.Optional
@@ -13,6 +13,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                = Float64Attribute{}
+	_ fwschema.AttributeWithNonSensitive       = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64Validators = Float64Attribute{}
 )
 
@@ -60,6 +61,13 @@ type Float64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -171,6 +179,11 @@ func (a Float64Attribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a Float64Attribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a Float64Attribute) IsOptional() bool {
 	return a.Optional
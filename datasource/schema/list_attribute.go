@@ -15,6 +15,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                    = ListAttribute{}
+	_ fwschema.AttributeWithNonSensitive           = ListAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
 )
@@ -71,6 +72,13 @@ type ListAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -179,6 +187,11 @@ func (a ListAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a ListAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a ListAttribute) IsOptional() bool {
 	return a.Optional
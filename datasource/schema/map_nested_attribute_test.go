@@ -541,6 +541,46 @@ func TestMapNestedAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestMapNestedAttributeMapKeyValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.MapNestedAttribute
+		expected  []validator.String
+	}{
+		"no-validators": {
+			attribute: schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"testattr": schema.StringAttribute{},
+					},
+				},
+			},
+			expected: nil,
+		},
+		"validators": {
+			attribute: schema.MapNestedAttribute{
+				KeyValidators: []validator.String{},
+			},
+			expected: []validator.String{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.MapKeyValidators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapNestedAttributeMapNestedValidators(t *testing.T) {
 	t.Parallel()
 
@@ -392,7 +392,7 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := tc.schema.AttributeAtTerraformPath(context.Background(), tc.path)
+			got, err := tc.schema.AttributeAtTerraformPath(context.Background(), tc.path) //nolint:staticcheck // Testing deprecated method
 
 			if err != nil {
 				if tc.expectedErr == "" {
@@ -419,6 +419,78 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected path.Expressions
+	}{
+		"no-attributes": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"nested-sets": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+					"test_set_nested": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_set_nested": schema.SetNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"test_leaf": schema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"test_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"test_block_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("test_attribute"),
+				path.MatchRoot("test_block").AtAnySetValue().AtName("test_block_attribute"),
+				path.MatchRoot("test_set_nested"),
+				path.MatchRoot("test_set_nested").AtAnySetValue().AtName("test_set_nested"),
+				path.MatchRoot("test_set_nested").AtAnySetValue().AtName("test_set_nested").AtAnySetValue().AtName("test_leaf"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.AttributePaths(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -1010,7 +1082,9 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1053,7 +1127,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1086,8 +1162,11 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"depends_on": schema.BoolAttribute{},
+							"depends_on": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1098,7 +1177,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"connection": schema.BoolAttribute{},
+							"connection": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1107,7 +1188,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-and-blocks-using-reserved-field-names": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 				Blocks: map[string]schema.Block{
 					"connection": schema.ListNestedBlock{},
@@ -1133,7 +1216,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"^": schema.StringAttribute{},
+					"^": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1166,8 +1251,11 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1187,7 +1275,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1209,7 +1299,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 						Blocks: map[string]schema.Block{
 							"^": schema.SingleNestedBlock{
 								Attributes: map[string]schema.Attribute{
-									"!": schema.BoolAttribute{},
+									"!": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -1240,6 +1332,207 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-missing-behavior": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is missing the Computed, Optional, or Required field. "+
+						"One of these fields is required to describe how the attribute is configured.",
+				),
+			},
+		},
+		"attribute-required-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" sets the Required field with the Computed or Optional field. "+
+						"Required attributes cannot also be Computed or Optional.",
+				),
+			},
+		},
+		"attribute-required-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" sets the Required field with the Computed or Optional field. "+
+						"Required attributes cannot also be Computed or Optional.",
+				),
+			},
+		},
+		"nested-block-attribute-missing-behavior": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_nested_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"test": schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"list_nested_block.test\" is missing the Computed, Optional, or Required field. "+
+						"One of these fields is required to describe how the attribute is configured.",
+				),
+			},
+		},
+		"attribute-and-block-same-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"test": schema.SingleNestedBlock{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is used as both an attribute and block name. "+
+						"Attribute and block names must be unique at each level of the schema.",
+				),
+			},
+		},
+		"nested-nested-nested-block-attribute-and-block-same-name": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_nested_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"single_nested_block": schema.SingleNestedBlock{
+									Blocks: map[string]schema.Block{
+										"set_nested_block": schema.SetNestedBlock{
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"id": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"id": schema.SingleNestedBlock{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"list_nested_block.single_nested_block.set_nested_block.id\" is used as both an attribute and block name. "+
+						"Attribute and block names must be unique at each level of the schema.",
+				),
+			},
+		},
+		"nested-block-attribute-using-reserved-meta-argument-name": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_nested_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"count": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Reserved Nested Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"count\" at schema path \"list_nested_block.count\" is a reserved meta-argument name within blocks. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+			},
+		},
+		"set-nested-block-computed-only-attributes": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"set_nested_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Block Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"set_nested_block\" is a set nested block which only contains Computed attributes that are not Optional or Required. "+
+						"Set elements are identified by their values, so at least one attribute or block must be configurable.",
+				),
+			},
+		},
+		"set-nested-block-optional-computed-attributes": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"set_nested_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed: true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"attribute-with-validate-attribute-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -1262,6 +1555,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"list_nested_attribute": schema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"test": schema.ListAttribute{
@@ -1352,3 +1646,92 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaWalk(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected []string
+	}{
+		"no-attributes": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"nested-sets": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+					"test_set_nested": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_set_nested": schema.SetNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"test_leaf": schema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"test_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"test_block_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"test_attribute",
+				"test_block (block)",
+				"test_block[Value(*)].test_block_attribute",
+				"test_set_nested",
+				"test_set_nested[Value(*)].test_set_nested",
+				"test_set_nested[Value(*)].test_set_nested[Value(*)].test_leaf",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := testCase.schema.Walk(context.Background(), func(p path.Expression, a schema.Attribute, b schema.Block) diag.Diagnostics {
+				if b != nil {
+					got = append(got, p.String()+" (block)")
+
+					return nil
+				}
+
+				got = append(got, p.String())
+
+				return nil
+			})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
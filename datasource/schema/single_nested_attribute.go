@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                         = SingleNestedAttribute{}
+	_ fwschema.AttributeWithNonSensitive      = SingleNestedAttribute{}
 	_ fwxschema.AttributeWithObjectValidators = SingleNestedAttribute{}
 )
 
@@ -72,8 +73,18 @@ type SingleNestedAttribute struct {
 	// in CLI output. Sensitive does not impact how values are stored, and
 	// practitioners are encouraged to store their state as if the entire
 	// file is sensitive.
+	//
+	// Setting it to true also marks all nested attributes as sensitive,
+	// unless they set NonSensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -220,6 +231,11 @@ func (a SingleNestedAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a SingleNestedAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a SingleNestedAttribute) IsOptional() bool {
 	return a.Optional
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/displayhint"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -13,6 +14,8 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                               = StringAttribute{}
+	_ fwschema.AttributeWithDisplayHint       = StringAttribute{}
+	_ fwschema.AttributeWithNonSensitive      = StringAttribute{}
 	_ fwxschema.AttributeWithStringValidators = StringAttribute{}
 )
 
@@ -57,6 +60,13 @@ type StringAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -105,6 +115,12 @@ type StringAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DisplayHint describes how tooling, such as documentation generators
+	// and debug renderers, should present the attribute value, such as
+	// displayhint.JSON for JSON documents. It is not sent to Terraform and
+	// does not affect plans or state.
+	DisplayHint displayhint.Hint
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -144,6 +160,11 @@ func (a StringAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetDisplayHint returns the DisplayHint field value.
+func (a StringAttribute) GetDisplayHint() displayhint.Hint {
+	return a.DisplayHint
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a StringAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -163,6 +184,11 @@ func (a StringAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a StringAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a StringAttribute) IsOptional() bool {
 	return a.Optional
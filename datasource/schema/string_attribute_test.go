@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/schema/displayhint"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -183,6 +184,40 @@ func TestStringAttributeGetDescription(t *testing.T) {
 	}
 }
 
+func TestStringAttributeGetDisplayHint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  displayhint.Hint
+	}{
+		"no-display-hint": {
+			attribute: schema.StringAttribute{},
+			expected:  displayhint.None,
+		},
+		"display-hint": {
+			attribute: schema.StringAttribute{
+				DisplayHint: displayhint.JSON,
+			},
+			expected: displayhint.JSON,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDisplayHint()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStringAttributeGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,310 @@
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// SyntheticID returns a stable identifier for a data source which has no
+// natural remote system identifier, based on the given significant values,
+// such as the configured filter arguments. This replaces the
+// strconv.Itoa(hashcode.String(...)) pattern of terraform-plugin-sdk.
+//
+// The identifier is the lowercase hexadecimal SHA-256 hash of an encoding of
+// each value which includes its Terraform type and the boundaries between
+// values, so it only changes when the values change. For example, the string
+// values "ab" and "c" have a different identifier than "a" and "bc", and a
+// null value has a different identifier than an empty string. The order of
+// values is significant, however the order of map elements, set elements, and
+// object attributes is not.
+//
+// An error diagnostic is returned for unknown values, which cannot be
+// identified.
+func SyntheticID(ctx context.Context, values ...attr.Value) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	h := sha256.New()
+
+	for i, value := range values {
+		if value == nil {
+			diags.AddError(
+				"Invalid Synthetic ID Value",
+				fmt.Sprintf("Value %d is missing. This is always an issue in the provider and should be reported to the provider developers.", i),
+			)
+
+			return "", diags
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddError(
+				"Invalid Synthetic ID Value",
+				fmt.Sprintf("Value %d could not be converted to a Terraform value. This is always an issue in the provider and should be reported to the provider developers.\n\n", i)+
+					"Error: "+err.Error(),
+			)
+
+			return "", diags
+		}
+
+		if !tfValue.IsFullyKnown() {
+			diags.AddError(
+				"Invalid Synthetic ID Value",
+				fmt.Sprintf("Value %d is or contains an unknown value, which cannot be used to create an identifier. ", i)+
+					"This is always an issue in the provider and should be reported to the provider developers.",
+			)
+
+			return "", diags
+		}
+
+		typeSignature, err := syntheticIDTypeSignature(tfValue.Type())
+
+		if err != nil {
+			diags.AddError(
+				"Invalid Synthetic ID Value",
+				fmt.Sprintf("The type of value %d could not be encoded. This is always an issue in the provider and should be reported to the provider developers.\n\n", i)+
+					"Error: "+err.Error(),
+			)
+
+			return "", diags
+		}
+
+		var encoded bytes.Buffer
+
+		err = writeSyntheticIDValue(&encoded, tfValue)
+
+		if err != nil {
+			diags.AddError(
+				"Invalid Synthetic ID Value",
+				fmt.Sprintf("Value %d could not be encoded. This is always an issue in the provider and should be reported to the provider developers.\n\n", i)+
+					"Error: "+err.Error(),
+			)
+
+			return "", diags
+		}
+
+		writeLengthPrefixed(h, []byte(typeSignature))
+		writeLengthPrefixed(h, encoded.Bytes())
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), diags
+}
+
+// writeLengthPrefixed writes the length of the data followed by the data, so
+// the boundaries between values are part of the encoding. Neither
+// bytes.Buffer nor hash.Hash Write return errors.
+func writeLengthPrefixed(w io.Writer, data []byte) {
+	writeCount(w, len(data))
+
+	_, _ = w.Write(data)
+}
+
+// writeSyntheticIDValue writes a canonical encoding of the known value. Map
+// elements and object attributes are written in key order and set elements
+// in encoding order, so the encoding does not depend on element ordering.
+func writeSyntheticIDValue(w *bytes.Buffer, value tftypes.Value) error {
+	if value.IsNull() {
+		w.WriteByte(0)
+
+		return nil
+	}
+
+	w.WriteByte(1)
+
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			return err
+		}
+
+		if b {
+			w.WriteByte(1)
+		} else {
+			w.WriteByte(0)
+		}
+
+		return nil
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+
+		if err := value.As(&n); err != nil {
+			return err
+		}
+
+		writeLengthPrefixed(w, []byte(n.Text('g', -1)))
+
+		return nil
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return err
+		}
+
+		writeLengthPrefixed(w, []byte(s))
+
+		return nil
+	}
+
+	switch typ.(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+
+		encodedElements := make([][]byte, 0, len(elements))
+
+		for _, element := range elements {
+			var encodedElement bytes.Buffer
+
+			if err := writeSyntheticIDValue(&encodedElement, element); err != nil {
+				return err
+			}
+
+			encodedElements = append(encodedElements, encodedElement.Bytes())
+		}
+
+		if typ.Is(tftypes.Set{}) {
+			sort.Slice(encodedElements, func(i, j int) bool {
+				return bytes.Compare(encodedElements[i], encodedElements[j]) < 0
+			})
+		}
+
+		writeCount(w, len(encodedElements))
+
+		for _, encodedElement := range encodedElements {
+			writeLengthPrefixed(w, encodedElement)
+		}
+
+		return nil
+	case tftypes.Map, tftypes.Object:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		writeCount(w, len(keys))
+
+		for _, key := range keys {
+			var encodedElement bytes.Buffer
+
+			if err := writeSyntheticIDValue(&encodedElement, elements[key]); err != nil {
+				return err
+			}
+
+			writeLengthPrefixed(w, []byte(key))
+			writeLengthPrefixed(w, encodedElement.Bytes())
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// writeCount writes the number of collection elements.
+func writeCount(w io.Writer, count int) {
+	var b [8]byte
+
+	binary.BigEndian.PutUint64(b[:], uint64(count))
+
+	_, _ = w.Write(b[:])
+}
+
+// syntheticIDTypeSignature returns a stable string representation of the
+// Terraform type, so identifiers do not change with terraform-plugin-go
+// type formatting.
+func syntheticIDTypeSignature(typ tftypes.Type) (string, error) {
+	switch {
+	case typ.Is(tftypes.Bool):
+		return "bool", nil
+	case typ.Is(tftypes.DynamicPseudoType):
+		return "dynamic", nil
+	case typ.Is(tftypes.Number):
+		return "number", nil
+	case typ.Is(tftypes.String):
+		return "string", nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.List:
+		element, err := syntheticIDTypeSignature(t.ElementType)
+
+		return "list(" + element + ")", err
+	case tftypes.Map:
+		element, err := syntheticIDTypeSignature(t.ElementType)
+
+		return "map(" + element + ")", err
+	case tftypes.Set:
+		element, err := syntheticIDTypeSignature(t.ElementType)
+
+		return "set(" + element + ")", err
+	case tftypes.Object:
+		names := make([]string, 0, len(t.AttributeTypes))
+
+		for name := range t.AttributeTypes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		attributes := make([]string, 0, len(names))
+
+		for _, name := range names {
+			attribute, err := syntheticIDTypeSignature(t.AttributeTypes[name])
+
+			if err != nil {
+				return "", err
+			}
+
+			attributes = append(attributes, strconv.Quote(name)+"="+attribute)
+		}
+
+		return "object(" + strings.Join(attributes, ",") + ")", nil
+	case tftypes.Tuple:
+		elements := make([]string, 0, len(t.ElementTypes))
+
+		for _, elementType := range t.ElementTypes {
+			element, err := syntheticIDTypeSignature(elementType)
+
+			if err != nil {
+				return "", err
+			}
+
+			elements = append(elements, element)
+		}
+
+		return "tuple(" + strings.Join(elements, ",") + ")", nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", typ)
+	}
+}
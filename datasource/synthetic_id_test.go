@@ -0,0 +1,194 @@
+package datasource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSyntheticID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values        []attr.Value
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"no-values": {
+			values:   nil,
+			expected: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		"string": {
+			values: []attr.Value{
+				types.StringValue("test"),
+			},
+			expected: "e6070d21da554d897742de5718535e03292d3937782dd81039b9b79f46000b10",
+		},
+		"multiple": {
+			values: []attr.Value{
+				types.StringValue("test"),
+				types.Int64Value(123),
+				types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("a"),
+				}),
+			},
+			expected: "d1c3c6092e3db10f564d627b78172a6d90b179c7bb512bc47e7858aae9160628",
+		},
+		"nil": {
+			values: []attr.Value{
+				types.StringValue("test"),
+				nil,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Synthetic ID Value",
+					"Value 1 is missing. This is always an issue in the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"unknown": {
+			values: []attr.Value{
+				types.StringUnknown(),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Synthetic ID Value",
+					"Value 0 is or contains an unknown value, which cannot be used to create an identifier. "+
+						"This is always an issue in the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"unknown-element": {
+			values: []attr.Value{
+				types.ListValueMust(types.StringType, []attr.Value{
+					types.StringUnknown(),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Synthetic ID Value",
+					"Value 0 is or contains an unknown value, which cannot be used to create an identifier. "+
+						"This is always an issue in the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := datasource.SyntheticID(context.Background(), testCase.values...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSyntheticIDCollisions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values      []attr.Value
+		otherValues []attr.Value
+		expectEqual bool
+	}{
+		"boundaries": {
+			values:      []attr.Value{types.StringValue("ab"), types.StringValue("c")},
+			otherValues: []attr.Value{types.StringValue("a"), types.StringValue("bc")},
+		},
+		"order": {
+			values:      []attr.Value{types.StringValue("a"), types.StringValue("b")},
+			otherValues: []attr.Value{types.StringValue("b"), types.StringValue("a")},
+		},
+		"null-empty": {
+			values:      []attr.Value{types.StringNull()},
+			otherValues: []attr.Value{types.StringValue("")},
+		},
+		"string-number": {
+			values:      []attr.Value{types.StringValue("1")},
+			otherValues: []attr.Value{types.Int64Value(1)},
+		},
+		"list-set": {
+			values: []attr.Value{
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			},
+			otherValues: []attr.Value{
+				types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			},
+		},
+		"map-element-order": {
+			values: []attr.Value{
+				types.MapValueMust(types.StringType, map[string]attr.Value{
+					"a": types.StringValue("1"),
+					"b": types.StringValue("2"),
+				}),
+			},
+			otherValues: []attr.Value{
+				types.MapValueMust(types.StringType, map[string]attr.Value{
+					"b": types.StringValue("2"),
+					"a": types.StringValue("1"),
+				}),
+			},
+			expectEqual: true,
+		},
+		"set-element-order": {
+			values: []attr.Value{
+				types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("a"),
+					types.StringValue("b"),
+				}),
+			},
+			otherValues: []attr.Value{
+				types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("b"),
+					types.StringValue("a"),
+				}),
+			},
+			expectEqual: true,
+		},
+		"equal": {
+			values:      []attr.Value{types.StringValue("a"), types.BoolValue(true)},
+			otherValues: []attr.Value{types.StringValue("a"), types.BoolValue(true)},
+			expectEqual: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := datasource.SyntheticID(context.Background(), testCase.values...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			other, diags := datasource.SyntheticID(context.Background(), testCase.otherValues...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			if (got == other) != testCase.expectEqual {
+				t.Errorf("expected equal %t, got %s and %s", testCase.expectEqual, got, other)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// TypedDataSource is the Read logic of a data source which embeds Typed.
+// The ConfigT type is the data model of the configuration and the ResultT
+// type is the data model of the state, such as structs with tfsdk field tags.
+// The same type can be used for both when the configuration and state data
+// models are equivalent.
+type TypedDataSource[ConfigT any, ResultT any] interface {
+	// TypedRead is called when the provider must read data source values in
+	// order to update state.
+	TypedRead(context.Context, TypedReadRequest[ConfigT], *TypedReadResponse[ResultT])
+}
+
+// Typed implements the DataSource interface Read method by reading the
+// configuration into the ConfigT data model, calling the TypedDataSource
+// TypedRead method, and writing the returned ResultT data model into the
+// state. This removes the need to call the Get and Set methods of request and
+// response data.
+//
+// Embed Typed in the data source type, which must also implement the
+// Metadata and Schema methods of the DataSource interface, and set it with
+// NewTyped. Optional interfaces, such as DataSourceWithConfigure, are
+// implemented on the data source type as usual. For example:
+//
+//	type ThingDataSource struct {
+//		datasource.Typed[ThingDataSourceConfig, ThingDataSourceModel]
+//	}
+//
+//	func NewThingDataSource() datasource.DataSource {
+//		d := &ThingDataSource{}
+//		d.Typed = datasource.NewTyped[ThingDataSourceConfig, ThingDataSourceModel](d)
+//
+//		return d
+//	}
+//
+// The ResultT data model must include every attribute and block in the
+// schema, including those which are configurable.
+type Typed[ConfigT any, ResultT any] struct {
+	dataSource TypedDataSource[ConfigT, ResultT]
+}
+
+// NewTyped returns a Typed which calls the given TypedDataSource method.
+func NewTyped[ConfigT any, ResultT any](d TypedDataSource[ConfigT, ResultT]) Typed[ConfigT, ResultT] {
+	return Typed[ConfigT, ResultT]{
+		dataSource: d,
+	}
+}
+
+// Read reads the ReadRequest configuration into the ConfigT data model,
+// calls the TypedDataSource TypedRead method, and sets the ReadResponse State
+// from the returned ResultT data model if there are no errors.
+func (t Typed[ConfigT, ResultT]) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	if t.dataSource == nil {
+		resp.Diagnostics.AddError(
+			"Missing Typed Data Source Implementation",
+			"The data source embeds datasource.Typed without setting it with datasource.NewTyped, so there is no implementation to call. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	typedReq := TypedReadRequest[ConfigT]{
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &typedReq.Config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var typedResp TypedReadResponse[ResultT]
+
+	t.dataSource.TypedRead(ctx, typedReq, &typedResp)
+
+	resp.Diagnostics.Append(typedResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, typedResp.State)...)
+}
+
+// TypedReadRequest represents a request for the provider to read a data
+// source, with the configuration read into the ConfigT data model. An
+// instance of this request struct is supplied as an argument to the
+// TypedDataSource TypedRead method.
+type TypedReadRequest[ConfigT any] struct {
+	// Config is the configuration the user supplied for the data source.
+	Config ConfigT
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+}
+
+// TypedReadResponse represents a response to a TypedReadRequest. An
+// instance of this response struct is supplied as an argument to the
+// TypedDataSource TypedRead method.
+type TypedReadResponse[ResultT any] struct {
+	// State is the state of the data source following the Read operation.
+	State ResultT
+
+	// Diagnostics report errors or warnings related to reading the data
+	// source. If any errors are present, the State is not saved.
+	Diagnostics diag.Diagnostics
+}
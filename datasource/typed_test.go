@@ -0,0 +1,162 @@
+package datasource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testTypedConfig struct {
+	Name types.String `tfsdk:"name"`
+	ID   types.String `tfsdk:"id"`
+}
+
+type testTypedResult struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type testTypedDataSource struct {
+	datasource.Typed[testTypedConfig, testTypedResult]
+
+	readMethod func(context.Context, datasource.TypedReadRequest[testTypedConfig], *datasource.TypedReadResponse[testTypedResult])
+}
+
+func newTestTypedDataSource(d *testTypedDataSource) *testTypedDataSource {
+	d.Typed = datasource.NewTyped[testTypedConfig, testTypedResult](d)
+
+	return d
+}
+
+func (d *testTypedDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "test_data_source"
+}
+
+func (d *testTypedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = testTypedSchema
+}
+
+func (d *testTypedDataSource) TypedRead(ctx context.Context, req datasource.TypedReadRequest[testTypedConfig], resp *datasource.TypedReadResponse[testTypedResult]) {
+	d.readMethod(ctx, req, resp)
+}
+
+var (
+	_ datasource.DataSource                                        = &testTypedDataSource{}
+	_ datasource.TypedDataSource[testTypedConfig, testTypedResult] = &testTypedDataSource{}
+
+	testTypedSchema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testTypedType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+)
+
+func TestTypedRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dataSource    datasource.DataSource
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"state": {
+			dataSource: newTestTypedDataSource(&testTypedDataSource{
+				readMethod: func(_ context.Context, req datasource.TypedReadRequest[testTypedConfig], resp *datasource.TypedReadResponse[testTypedResult]) {
+					resp.State.ID = types.StringValue("test-id")
+					resp.State.Name = req.Config.Name
+				},
+			}),
+			expected: tftypes.NewValue(testTypedType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "test-name"),
+			}),
+		},
+		"state-unset": {
+			dataSource: newTestTypedDataSource(&testTypedDataSource{
+				readMethod: func(_ context.Context, _ datasource.TypedReadRequest[testTypedConfig], _ *datasource.TypedReadResponse[testTypedResult]) {
+				},
+			}),
+			expected: tftypes.NewValue(testTypedType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"diagnostics": {
+			dataSource: newTestTypedDataSource(&testTypedDataSource{
+				readMethod: func(_ context.Context, _ datasource.TypedReadRequest[testTypedConfig], resp *datasource.TypedReadResponse[testTypedResult]) {
+					resp.State.ID = types.StringValue("test-id")
+					resp.Diagnostics.AddError("error summary", "error detail")
+				},
+			}),
+			expected: tftypes.NewValue(testTypedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"missing-NewTyped": {
+			dataSource: &testTypedDataSource{},
+			expected:   tftypes.NewValue(testTypedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Typed Data Source Implementation",
+					"The data source embeds datasource.Typed without setting it with datasource.NewTyped, so there is no implementation to call. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testTypedType, map[string]tftypes.Value{
+						"id":   tftypes.NewValue(tftypes.String, nil),
+						"name": tftypes.NewValue(tftypes.String, "test-name"),
+					}),
+					Schema: testTypedSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testTypedType, nil),
+					Schema: testTypedSchema,
+				},
+			}
+
+			testCase.dataSource.Read(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package defaultmetadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults is the provider-level default key/value metadata.
+type Defaults map[string]string
+
+// Merge returns the resource-level values merged with the Defaults. The
+// values must be a map of strings. Resource-level values take precedence and
+// a warning diagnostic is returned at the given path for each key which
+// collides with a default of a different value.
+//
+// Unknown values return an unknown map, as the merged keys cannot be
+// determined. Unknown elements are preserved. Null values with no Defaults
+// return a null map.
+func (d Defaults) Merge(ctx context.Context, valuesPath path.Path, values types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if values.IsUnknown() {
+		return types.MapUnknown(types.StringType), diags
+	}
+
+	if values.IsNull() && len(d) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+
+	merged := make(map[string]attr.Value, len(d)+len(values.Elements()))
+
+	for key, value := range d {
+		merged[key] = types.StringValue(value)
+	}
+
+	for key, element := range values.Elements() {
+		value, ok := element.(types.String)
+
+		if !ok {
+			diags.AddAttributeError(
+				valuesPath.AtMapKey(key),
+				"Invalid Metadata Value",
+				"An unexpected error occurred while merging default metadata. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Expected string element, got: %T", element),
+			)
+
+			continue
+		}
+
+		defaultValue, ok := d[key]
+
+		if ok && !value.IsNull() && !value.IsUnknown() && value.ValueString() != defaultValue {
+			diags.AddAttributeWarning(
+				valuesPath.AtMapKey(key),
+				"Conflicting Default Metadata",
+				fmt.Sprintf("The resource value for key %q overrides the provider default value. ", key)+
+					"Remove the key from either the provider default metadata or the resource configuration to remove this warning.",
+			)
+		}
+
+		if value.IsNull() {
+			continue
+		}
+
+		merged[key] = value
+	}
+
+	if diags.HasError() {
+		return types.MapUnknown(types.StringType), diags
+	}
+
+	result, mapDiags := types.MapValue(types.StringType, merged)
+
+	diags.Append(mapDiags...)
+
+	return result, diags
+}
+
+// ModifyPlan is a helper function to set the merged resource-level and
+// default values into the plan. The valuesPath must be an Optional map of
+// strings attribute and the mergedPath must be a Computed map of strings
+// attribute. This should be called within the resource ModifyPlan method.
+//
+// Resource destruction is skipped.
+func ModifyPlan(ctx context.Context, defaults Defaults, valuesPath path.Path, mergedPath path.Path, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var values types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, valuesPath, &values)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merged, diags := defaults.Merge(ctx, valuesPath, values)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, mergedPath, merged)...)
+}
@@ -0,0 +1,199 @@
+package defaultmetadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/defaultmetadata"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDefaultsMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		defaults      defaultmetadata.Defaults
+		values        types.Map
+		expected      types.Map
+		expectedDiags diag.Diagnostics
+	}{
+		"no-defaults-null-values": {
+			defaults: nil,
+			values:   types.MapNull(types.StringType),
+			expected: types.MapNull(types.StringType),
+		},
+		"defaults-null-values": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			values:   types.MapNull(types.StringType),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+		},
+		"defaults-unknown-values": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			values:   types.MapUnknown(types.StringType),
+			expected: types.MapUnknown(types.StringType),
+		},
+		"defaults-unknown-element": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			values: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"name": types.StringUnknown(),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("prod"),
+				"name": types.StringUnknown(),
+			}),
+		},
+		"defaults-values": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			values: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"name": types.StringValue("test"),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("prod"),
+				"name": types.StringValue("test"),
+			}),
+		},
+		"defaults-values-equal": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			values: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+		},
+		"defaults-values-conflict": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			values: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("dev"),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("dev"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("tags").AtMapKey("env"),
+					"Conflicting Default Metadata",
+					"The resource value for key \"env\" overrides the provider default value. "+
+						"Remove the key from either the provider default metadata or the resource configuration to remove this warning.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.defaults.Merge(context.Background(), path.Root("tags"), testCase.values)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+	testMapType := tftypes.Map{ElementType: tftypes.String}
+
+	testConfigValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"tags": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		"tags_all": tftypes.NewValue(testMapType, nil),
+	})
+
+	testCases := map[string]struct {
+		defaults defaultmetadata.Defaults
+		plan     tftypes.Value
+		expected tftypes.Value
+	}{
+		"create": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			plan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				}),
+				"tags_all": tftypes.NewValue(testMapType, tftypes.UnknownValue),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				}),
+				"tags_all": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"env":  tftypes.NewValue(tftypes.String, "prod"),
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				}),
+			}),
+		},
+		"destroy": {
+			defaults: defaultmetadata.Defaults{"env": "prod"},
+			plan:     tftypes.NewValue(testType, nil),
+			expected: tftypes.NewValue(testType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue,
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testCase.plan,
+					Schema: testSchema,
+				},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			defaultmetadata.ModifyPlan(context.Background(), testCase.defaults, path.Root("tags"), path.Root("tags_all"), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if diff := cmp.Diff(resp.Plan.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
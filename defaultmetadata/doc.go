@@ -0,0 +1,17 @@
+// Package defaultmetadata implements provider-level default key/value
+// metadata functionality, such as default tags or labels, which are merged
+// into the metadata of resources that opt in.
+//
+// Providers add the block returned by ProviderSchemaBlock to the provider
+// schema and read its configured Defaults with DefaultsFromConfig in the
+// provider Configure method. The Defaults are then passed to resources, such
+// as via the ResourceData field of the ConfigureResponse. Resources opt in by
+// declaring an Optional map of strings for resource-level values and a
+// Computed map of strings for the merged values, then calling ModifyPlan
+// within their ModifyPlan method.
+//
+// Resource-level values take precedence over provider defaults. When a
+// resource-level value collides with a provider default of the same key, a
+// warning diagnostic is returned so practitioners can remove the redundant
+// configuration.
+package defaultmetadata
@@ -0,0 +1,92 @@
+package defaultmetadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProviderSchemaBlock returns a provider schema block containing a single
+// Optional map of strings attribute with the given name, for example a
+// "default_tags" block with a "tags" attribute. The block is typically added
+// to the provider schema Blocks with a name describing the metadata. The
+// description is set on the block.
+func ProviderSchemaBlock(attributeName string, description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			attributeName: schema.MapAttribute{
+				Description: "Key/value metadata applied to all supporting resources.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Description: description,
+	}
+}
+
+// DefaultsFromConfig returns the Defaults configured in the provider schema
+// block created by ProviderSchemaBlock. The blockName and attributeName
+// must match the names used in the provider schema. A null block or
+// attribute returns empty Defaults.
+//
+// An error diagnostic is returned if the block contains unknown values, as
+// the merged resource values cannot be determined.
+func DefaultsFromConfig(ctx context.Context, config tfsdk.Config, blockName string, attributeName string) (Defaults, diag.Diagnostics) {
+	var values types.Map
+
+	attributePath := path.Root(blockName).AtName(attributeName)
+
+	diags := config.GetAttribute(ctx, attributePath, &values)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if values.IsNull() {
+		return Defaults{}, diags
+	}
+
+	if values.IsUnknown() {
+		diags.AddAttributeError(
+			attributePath,
+			"Unknown Default Metadata",
+			"The provider default metadata contains unknown values, which prevents determining resource metadata. "+
+				"Ensure the provider configuration does not depend on values which are only known after apply.",
+		)
+
+		return nil, diags
+	}
+
+	result := make(Defaults, len(values.Elements()))
+
+	for key, element := range values.Elements() {
+		value, ok := element.(types.String)
+
+		if !ok || value.IsUnknown() {
+			diags.AddAttributeError(
+				attributePath.AtMapKey(key),
+				"Unknown Default Metadata",
+				"The provider default metadata contains unknown values, which prevents determining resource metadata. "+
+					"Ensure the provider configuration does not depend on values which are only known after apply.",
+			)
+
+			continue
+		}
+
+		if value.IsNull() {
+			continue
+		}
+
+		result[key] = value.ValueString()
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,86 @@
+package defaultmetadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/defaultmetadata"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDefaultsFromConfig(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"default_tags": defaultmetadata.ProviderSchemaBlock("tags", "Default tags for all resources."),
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"tags": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+
+	testCases := map[string]struct {
+		tags          tftypes.Value
+		expected      defaultmetadata.Defaults
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			tags:     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			expected: defaultmetadata.Defaults{},
+		},
+		"unknown": {
+			tags: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("default_tags").AtName("tags"),
+					"Unknown Default Metadata",
+					"The provider default metadata contains unknown values, which prevents determining resource metadata. "+
+						"Ensure the provider configuration does not depend on values which are only known after apply.",
+				),
+			},
+		},
+		"known": {
+			tags: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"env": tftypes.NewValue(tftypes.String, "prod"),
+			}),
+			expected: defaultmetadata.Defaults{"env": "prod"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"default_tags": tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+						"tags": testCase.tags,
+					}),
+				}),
+				Schema: testSchema,
+			}
+
+			got, diags := defaultmetadata.DefaultsFromConfig(context.Background(), config, "default_tags", "tags")
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
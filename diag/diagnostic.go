@@ -48,3 +48,30 @@ type DiagnosticWithPath interface {
 	// supporting implementations such as Terraform CLI commands.
 	Path() path.Path
 }
+
+// DiagnosticWithCode is a diagnostic with a stable, machine-readable code,
+// such as EXAMPLE_QUOTA_EXCEEDED, which enables tooling to identify the
+// diagnostic without depending on the summary or detail prose.
+//
+// The protocol has no dedicated field for codes, so the framework appends
+// the code to the detail sent to Terraform in a trailer, such as:
+//
+//	Error code: EXAMPLE_QUOTA_EXCEEDED
+type DiagnosticWithCode interface {
+	Diagnostic
+
+	// Code returns the machine-readable code, if any.
+	Code() string
+}
+
+// DiagnosticWithError is a diagnostic which preserves an underlying Go error,
+// such as an API client error, so errors.Is and errors.As can be used
+// against it via the Unwrap method.
+//
+// The error is not sent to Terraform, only the summary and detail.
+type DiagnosticWithError interface {
+	Diagnostic
+
+	// Unwrap returns the underlying Go error, if any.
+	Unwrap() error
+}
@@ -31,6 +31,12 @@ func (diags *Diagnostics) AddWarning(summary string, detail string) {
 }
 
 // Append adds non-empty and non-duplicate diagnostics to the collection.
+// Duplicates are determined by the (Diagnostic).Equal() method, which for
+// the built-in diagnostics compares severity, summary, detail, and path.
+//
+// Deduplication only applies to diagnostics added via this method. Combining
+// collections with the Go built-in append() preserves any repeated
+// diagnostics.
 func (diags *Diagnostics) Append(in ...Diagnostic) {
 	for _, diag := range in {
 		if diag == nil {
@@ -60,6 +66,19 @@ func (diags Diagnostics) Contains(in Diagnostic) bool {
 	return false
 }
 
+// ContainsSummary returns true if the collection contains a Diagnostic with
+// the given summary, such as one of the Summary constants for framework
+// generated diagnostics.
+func (diags Diagnostics) ContainsSummary(summary string) bool {
+	for _, diag := range diags {
+		if diag.Summary() == summary {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Equal returns true if all given diagnostics are equivalent in order and
 // content, based on the underlying (Diagnostic).Equal() method of each.
 func (diags Diagnostics) Equal(other Diagnostics) bool {
@@ -122,3 +141,29 @@ func (diags Diagnostics) Warnings() Diagnostics {
 
 	return dd
 }
+
+// WrappedErrors returns the underlying Go errors of all the Diagnostic in
+// Diagnostics that implement DiagnosticWithError, such as those created with
+// NewErrorDiagnosticWithError. Diagnostics without an underlying error are
+// skipped.
+func (diags Diagnostics) WrappedErrors() []error {
+	var errs []error
+
+	for _, d := range diags {
+		diagWithError, ok := d.(DiagnosticWithError)
+
+		if !ok {
+			continue
+		}
+
+		err := diagWithError.Unwrap()
+
+		if err == nil {
+			continue
+		}
+
+		errs = append(errs, err)
+	}
+
+	return errs
+}
@@ -1,9 +1,11 @@
 package diag_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -472,6 +474,58 @@ func TestDiagnosticsContains(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsContainsSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		summary  string
+		expected bool
+	}{
+		"matching": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+			},
+			summary:  "two summary",
+			expected: true,
+		},
+		"matching-attribute-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), diag.SummaryValueConversionError, "one detail"),
+			},
+			summary:  diag.SummaryValueConversionError,
+			expected: true,
+		},
+		"nil-diagnostics": {
+			diags:    nil,
+			summary:  "one summary",
+			expected: false,
+		},
+		"different-summary": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+			},
+			summary:  "different summary",
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diags.ContainsSummary(tc.summary)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -830,3 +884,52 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestDiagnosticsWrappedErrors(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test error")
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected []error
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"no-wrapped-errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+			expected: nil,
+		},
+		"wrapped-errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewErrorDiagnosticWithError("Error Summary", testErr),
+				diag.WithPath(path.Root("test"), diag.NewErrorDiagnosticWithError("Other Summary", testErr)),
+				diag.NewErrorDiagnosticWithError("Nil Summary", nil),
+			},
+			expected: []error{
+				testErr,
+				testErr,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.WrappedErrors()
+
+			if diff := cmp.Diff(test.expected, got, cmpopts.EquateErrors()); diff != "" {
+				t.Fatalf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package diag
+
+var _ DiagnosticWithError = ErrorWithErrorDiagnostic{}
+
+// ErrorWithErrorDiagnostic is a generic diagnostic with error severity, which
+// preserves an underlying Go error. The error is available via the Unwrap
+// method, so errors.Is and errors.As can be used against it, while the
+// error string is used as the diagnostic detail.
+type ErrorWithErrorDiagnostic struct {
+	ErrorDiagnostic
+
+	err error
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d ErrorWithErrorDiagnostic) Equal(other Diagnostic) bool {
+	ed, ok := other.(ErrorWithErrorDiagnostic)
+
+	if !ok {
+		return false
+	}
+
+	if !ed.ErrorDiagnostic.Equal(d.ErrorDiagnostic) {
+		return false
+	}
+
+	if d.err == nil || ed.err == nil {
+		return d.err == ed.err
+	}
+
+	return ed.err.Error() == d.err.Error()
+}
+
+// Unwrap returns the underlying Go error.
+func (d ErrorWithErrorDiagnostic) Unwrap() error {
+	return d.err
+}
+
+// NewErrorDiagnosticWithError returns a new error severity diagnostic with
+// the given summary, which preserves the given error. The diagnostic detail
+// is the error string.
+func NewErrorDiagnosticWithError(summary string, err error) ErrorWithErrorDiagnostic {
+	var detail string
+
+	if err != nil {
+		detail = err.Error()
+	}
+
+	return ErrorWithErrorDiagnostic{
+		ErrorDiagnostic: NewErrorDiagnostic(summary, detail),
+		err:             err,
+	}
+}
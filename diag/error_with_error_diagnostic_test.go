@@ -0,0 +1,125 @@
+package diag_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var errTest = errors.New("test error")
+
+type testError struct {
+	code int
+}
+
+func (e testError) Error() string {
+	return fmt.Sprintf("test error code %d", e.code)
+}
+
+func TestErrorWithErrorDiagnosticEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.ErrorWithErrorDiagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", errTest),
+			other:    diag.NewErrorDiagnosticWithError("test summary", errTest),
+			expected: true,
+		},
+		"matching-nil-error": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", nil),
+			other:    diag.NewErrorDiagnosticWithError("test summary", nil),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", errTest),
+			other:    nil,
+			expected: false,
+		},
+		"different-error": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", errTest),
+			other:    diag.NewErrorDiagnosticWithError("test summary", errors.New("different error")),
+			expected: false,
+		},
+		"different-error-nil": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", errTest),
+			other:    diag.NewErrorDiagnosticWithError("test summary", nil),
+			expected: false,
+		},
+		"different-summary": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", errTest),
+			other:    diag.NewErrorDiagnosticWithError("different summary", errTest),
+			expected: false,
+		},
+		"different-type": {
+			diag:     diag.NewErrorDiagnosticWithError("test summary", errTest),
+			other:    diag.NewErrorDiagnostic("test summary", errTest.Error()),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestErrorWithErrorDiagnosticUnwrap(t *testing.T) {
+	t.Parallel()
+
+	wrappedErr := fmt.Errorf("unable to read thing: %w", testError{code: 404})
+
+	testCases := map[string]struct {
+		diag diag.Diagnostic
+	}{
+		"diagnostic": {
+			diag: diag.NewErrorDiagnosticWithError("test summary", wrappedErr),
+		},
+		"WithPath": {
+			diag: diag.WithPath(path.Root("test"), diag.NewErrorDiagnosticWithError("test summary", wrappedErr)),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.diag.Detail() != wrappedErr.Error() {
+				t.Errorf("unexpected detail: %s", tc.diag.Detail())
+			}
+
+			diagWithError, ok := tc.diag.(diag.DiagnosticWithError)
+
+			if !ok {
+				t.Fatalf("expected diag.DiagnosticWithError, got: %T", tc.diag)
+			}
+
+			err := diagWithError.Unwrap()
+
+			if !errors.Is(err, testError{code: 404}) {
+				t.Errorf("expected errors.Is to match testError, got: %s", err)
+			}
+
+			var target testError
+
+			if !errors.As(err, &target) || target.code != 404 {
+				t.Errorf("expected errors.As to match testError code 404, got: %#v", target)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package diag
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+var (
+	// formatNumberPlainMin is the smallest absolute value, other than zero,
+	// which FormatNumber formats without an exponent.
+	formatNumberPlainMin, _, _ = big.ParseFloat("1e-20", 10, 512, big.ToNearestEven)
+
+	// formatNumberPlainMax is the absolute value at which FormatNumber
+	// starts formatting with an exponent.
+	formatNumberPlainMax = new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(40), nil))
+)
+
+// FormatNumber returns the number formatted as the framework formats numbers
+// in diagnostics. Numbers are formatted in plain decimal notation, such as
+// 9007199254740993 rather than 9.007199254740993e+15, so large identifiers
+// are not confusing to practitioners. Integers include every digit, while
+// fractional numbers use the fewest digits necessary to represent the value
+// at its precision. The format does not depend
+// on the system locale, so there are no digit grouping separators and the
+// decimal separator is always a period.
+//
+// Numbers with an absolute value less than 1e-20 or greater than or equal to
+// 1e40 use exponent notation, such as 1e-400, to prevent excessively long
+// messages. Infinite values are formatted as +Inf or -Inf and a nil number is
+// formatted as <nil>.
+func FormatNumber(n *big.Float) string {
+	if n == nil {
+		return "<nil>"
+	}
+
+	if n.IsInf() || n.Sign() == 0 {
+		return n.Text('f', -1)
+	}
+
+	abs := new(big.Float).Abs(n)
+
+	if abs.Cmp(formatNumberPlainMin) < 0 || abs.Cmp(formatNumberPlainMax) >= 0 {
+		return n.Text('g', -1)
+	}
+
+	// Shortest decimal representations of large integers can end with
+	// zeros instead of the actual digits, such as 9223372036854776000 for
+	// 9223372036854775808, which is confusing for identifiers.
+	if n.IsInt() {
+		i, _ := n.Int(nil)
+
+		return i.String()
+	}
+
+	return n.Text('f', -1)
+}
+
+// FormatFloat64 returns the float64 formatted as the framework formats
+// numbers in diagnostics. Refer to FormatNumber for details about the format.
+// NaN values are formatted as NaN.
+func FormatFloat64(f float64) string {
+	if math.IsNaN(f) {
+		return "NaN"
+	}
+
+	return FormatNumber(big.NewFloat(f))
+}
+
+// FormatInt64 returns the int64 formatted as the framework formats numbers
+// in diagnostics, such as -1234. Refer to FormatNumber for details about the
+// format.
+func FormatInt64(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
@@ -0,0 +1,179 @@
+package diag_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+
+	testMustParseFloat := func(s string) *big.Float {
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", s, err)
+		}
+
+		return f
+	}
+
+	testCases := map[string]struct {
+		number   *big.Float
+		expected string
+	}{
+		"nil": {
+			number:   nil,
+			expected: "<nil>",
+		},
+		"zero": {
+			number:   big.NewFloat(0),
+			expected: "0",
+		},
+		"integer": {
+			number:   big.NewFloat(123),
+			expected: "123",
+		},
+		"integer-negative": {
+			number:   big.NewFloat(-123),
+			expected: "-123",
+		},
+		"integer-large": {
+			number:   testMustParseFloat("9007199254740993"),
+			expected: "9007199254740993",
+		},
+		"integer-larger-than-int64": {
+			number:   testMustParseFloat("123456789012345678901234567890"),
+			expected: "123456789012345678901234567890",
+		},
+		"integer-float64-precision": {
+			number:   big.NewFloat(math.MaxInt64),
+			expected: "9223372036854775808",
+		},
+		"fraction": {
+			number:   testMustParseFloat("1234.5678"),
+			expected: "1234.5678",
+		},
+		"fraction-small": {
+			number:   testMustParseFloat("0.000000123"),
+			expected: "0.000000123",
+		},
+		"exponent-large": {
+			number:   testMustParseFloat("1e40"),
+			expected: "1e+40",
+		},
+		"exponent-small": {
+			number:   testMustParseFloat("1e-400"),
+			expected: "1e-400",
+		},
+		"infinity": {
+			number:   big.NewFloat(math.Inf(1)),
+			expected: "+Inf",
+		},
+		"infinity-negative": {
+			number:   big.NewFloat(math.Inf(-1)),
+			expected: "-Inf",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.FormatNumber(testCase.number)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		f        float64
+		expected string
+	}{
+		"zero": {
+			f:        0,
+			expected: "0",
+		},
+		"fraction": {
+			f:        1.5,
+			expected: "1.5",
+		},
+		"fraction-shortest": {
+			f:        0.1,
+			expected: "0.1",
+		},
+		"integer-large": {
+			f:        9007199254740992,
+			expected: "9007199254740992",
+		},
+		"exponent": {
+			f:        math.MaxFloat64,
+			expected: "1.7976931348623157e+308",
+		},
+		"nan": {
+			f:        math.NaN(),
+			expected: "NaN",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.FormatFloat64(testCase.f)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		i        int64
+		expected string
+	}{
+		"zero": {
+			i:        0,
+			expected: "0",
+		},
+		"max": {
+			i:        math.MaxInt64,
+			expected: "9223372036854775807",
+		},
+		"min": {
+			i:        math.MinInt64,
+			expected: "-9223372036854775808",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.FormatInt64(testCase.i)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
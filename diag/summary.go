@@ -0,0 +1,90 @@
+package diag
+
+// Summaries of diagnostics which are generated by the framework. These are
+// intended for provider testing and middleware logic that must identify
+// framework diagnostics without depending on detail prose, which may change
+// between releases.
+const (
+	// SummaryAttributeNotSupported is the summary of error diagnostics for
+	// configured attributes whose requirements are not supported by the
+	// remote system capabilities.
+	SummaryAttributeNotSupported = "Attribute Not Supported"
+
+	// SummaryDataSourceTypeNotFound is the summary of error diagnostics for
+	// requests with a data source type name not defined by the provider.
+	SummaryDataSourceTypeNotFound = "Data Source Type Not Found"
+
+	// SummaryDuplicateDataSourceTypeDefined is the summary of error
+	// diagnostics for multiple data sources returning the same type name.
+	SummaryDuplicateDataSourceTypeDefined = "Duplicate Data Source Type Defined"
+
+	// SummaryDuplicateResourceTypeDefined is the summary of error diagnostics
+	// for multiple resources returning the same type name.
+	SummaryDuplicateResourceTypeDefined = "Duplicate Resource Type Defined"
+
+	// SummaryDuplicateSetElement is the summary of error diagnostics for set
+	// values which contain duplicate elements.
+	SummaryDuplicateSetElement = "Duplicate Set Element"
+
+	// SummaryInvalidAttributeBlockName is the summary of error diagnostics
+	// for schema attribute or block names which are not valid.
+	SummaryInvalidAttributeBlockName = "Invalid Attribute/Block Name"
+
+	// SummaryInvalidAttributeImplementation is the summary of error
+	// diagnostics for schema attribute definitions which cannot be used.
+	SummaryInvalidAttributeImplementation = "Invalid Attribute Implementation"
+
+	// SummaryInvalidBlockImplementation is the summary of error diagnostics
+	// for schema block definitions which cannot be used.
+	SummaryInvalidBlockImplementation = "Invalid Block Implementation"
+
+	// SummaryInvalidConfigurationForReadOnlyAttribute is the summary of
+	// error diagnostics for configuration values of computed only
+	// attributes.
+	SummaryInvalidConfigurationForReadOnlyAttribute = "Invalid Configuration for Read-Only Attribute"
+
+	// SummaryMissingConfigurationForRequiredAttribute is the summary of
+	// error diagnostics for missing configuration values of required
+	// attributes.
+	SummaryMissingConfigurationForRequiredAttribute = "Missing Configuration for Required Attribute"
+
+	// SummaryReservedNestedAttributeBlockName is the summary of error
+	// diagnostics for nested schema attribute or block names which are
+	// reserved by Terraform.
+	SummaryReservedNestedAttributeBlockName = "Reserved Nested Attribute/Block Name"
+
+	// SummaryReservedRootAttributeBlockName is the summary of error
+	// diagnostics for root schema attribute or block names which are
+	// reserved by Terraform.
+	SummaryReservedRootAttributeBlockName = "Reserved Root Attribute/Block Name"
+
+	// SummaryResourceImportNotImplemented is the summary of error diagnostics
+	// for import requests of resources which do not implement import.
+	SummaryResourceImportNotImplemented = "Resource Import Not Implemented"
+
+	// SummaryResourceTypeNotFound is the summary of error diagnostics for
+	// requests with a resource type name not defined by the provider.
+	SummaryResourceTypeNotFound = "Resource Type Not Found"
+
+	// SummaryUnableToConvertConfiguration is the summary of error diagnostics
+	// for configuration data which could not be converted from the protocol.
+	SummaryUnableToConvertConfiguration = "Unable to Convert Configuration"
+
+	// SummaryUnableToConvertPlan is the summary of error diagnostics for plan
+	// data which could not be converted from the protocol.
+	SummaryUnableToConvertPlan = "Unable to Convert Plan"
+
+	// SummaryUnableToConvertProviderMetaConfiguration is the summary of error
+	// diagnostics for provider_meta configuration data which could not be
+	// converted from the protocol.
+	SummaryUnableToConvertProviderMetaConfiguration = "Unable to Convert Provider Meta Configuration"
+
+	// SummaryUnableToConvertState is the summary of error diagnostics for
+	// state data which could not be converted from the protocol.
+	SummaryUnableToConvertState = "Unable to Convert State"
+
+	// SummaryValueConversionError is the summary of error diagnostics for
+	// values which could not be converted between framework types, Go types,
+	// and Terraform types.
+	SummaryValueConversionError = "Value Conversion Error"
+)
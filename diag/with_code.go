@@ -0,0 +1,52 @@
+package diag
+
+var _ DiagnosticWithCode = withCode{}
+
+// withCode wraps a diagnostic with a machine-readable code.
+type withCode struct {
+	Diagnostic
+
+	code string
+}
+
+// Code returns the diagnostic code.
+func (d withCode) Code() string {
+	return d.code
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withCode) Equal(other Diagnostic) bool {
+	o, ok := other.(withCode)
+
+	if !ok {
+		return false
+	}
+
+	if d.Code() != o.Code() {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// NewErrorDiagnosticWithCode returns a new error severity diagnostic with the
+// given code, summary, and detail.
+func NewErrorDiagnosticWithCode(code string, summary string, detail string) DiagnosticWithCode {
+	return withCode{
+		Diagnostic: NewErrorDiagnostic(summary, detail),
+		code:       code,
+	}
+}
+
+// NewWarningDiagnosticWithCode returns a new warning severity diagnostic with
+// the given code, summary, and detail.
+func NewWarningDiagnosticWithCode(code string, summary string, detail string) DiagnosticWithCode {
+	return withCode{
+		Diagnostic: NewWarningDiagnostic(summary, detail),
+		code:       code,
+	}
+}
@@ -0,0 +1,105 @@
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithCodeCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		expected string
+	}{
+		"error": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			expected: "TEST_CODE",
+		},
+		"warning": {
+			diag:     diag.NewWarningDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			expected: "TEST_CODE",
+		},
+		"WithPath": {
+			diag:     diag.WithPath(path.Root("test"), diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail")),
+			expected: "TEST_CODE",
+		},
+		"WithPath-no-code": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diagWithCode, ok := tc.diag.(diag.DiagnosticWithCode)
+
+			if !ok {
+				t.Fatalf("expected diag.DiagnosticWithCode, got: %T", tc.diag)
+			}
+
+			if got := diagWithCode.Code(); got != tc.expected {
+				t.Errorf("expected code %q, got: %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestWithCodeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.DiagnosticWithCode
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			other:    nil,
+			expected: false,
+		},
+		"different-code": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnosticWithCode("OTHER_CODE", "test summary", "test detail"),
+			expected: false,
+		},
+		"different-detail": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "different detail"),
+			expected: false,
+		},
+		"different-severity": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			other:    diag.NewWarningDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			expected: false,
+		},
+		"different-type": {
+			diag:     diag.NewErrorDiagnosticWithCode("TEST_CODE", "test summary", "test detail"),
+			other:    diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
@@ -13,6 +13,18 @@ type withPath struct {
 	path path.Path
 }
 
+// Code returns the machine-readable code of the wrapped diagnostic, if it
+// implements DiagnosticWithCode.
+func (d withPath) Code() string {
+	diagWithCode, ok := d.Diagnostic.(DiagnosticWithCode)
+
+	if !ok {
+		return ""
+	}
+
+	return diagWithCode.Code()
+}
+
 // Equal returns true if the other diagnostic is wholly equivalent.
 func (d withPath) Equal(other Diagnostic) bool {
 	o, ok := other.(withPath)
@@ -32,6 +44,18 @@ func (d withPath) Equal(other Diagnostic) bool {
 	return d.Diagnostic.Equal(o.Diagnostic)
 }
 
+// Unwrap returns the underlying Go error of the wrapped diagnostic, if it
+// implements DiagnosticWithError.
+func (d withPath) Unwrap() error {
+	diagWithError, ok := d.Diagnostic.(DiagnosticWithError)
+
+	if !ok {
+		return nil
+	}
+
+	return diagWithError.Unwrap()
+}
+
 // Path returns the diagnostic path.
 func (d withPath) Path() path.Path {
 	return d.path
@@ -0,0 +1,106 @@
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		diag     diag.Diagnostic
+		expected diag.DiagnosticWithPath
+	}{
+		"diagnostic": {
+			path:     path.Root("test"),
+			diag:     diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+		},
+		"diagnosticwithpath-overwrite": {
+			path:     path.Root("test"),
+			diag:     diag.NewAttributeWarningDiagnostic(path.Root("other"), "test summary", "test detail"),
+			expected: diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithPath(tc.path, tc.diag)
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("Unexpected response: got: %#v, wanted: %#v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestWithPathEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.DiagnosticWithPath
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    nil,
+			expected: false,
+		},
+		"different-detail": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "different detail"),
+			expected: false,
+		},
+		"different-path": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("different"), "test summary", "test detail"),
+			expected: false,
+		},
+		"different-severity": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: false,
+		},
+		"different-summary": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "different summary", "test detail"),
+			expected: false,
+		},
+		"different-type": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: false,
+		},
+		"nil-diagnostic": {
+			diag:     diag.WithPath(path.Root("test"), nil),
+			other:    diag.WithPath(path.Root("test"), nil),
+			expected: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
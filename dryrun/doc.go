@@ -0,0 +1,16 @@
+// Package dryrun implements a context-propagated flag which signals that the
+// current operation will not persist changes, such as during configuration
+// validation and planning. Shared provider business logic libraries can
+// check the flag to uniformly guard side-effecting calls, such as creating
+// remote objects or writing local files, instead of each data source and
+// resource passing booleans through to them.
+//
+// The framework sets the flag on the context of all provider-defined logic
+// called while handling the ValidateProviderConfig,
+// ValidateDataSourceConfig, ValidateResourceConfig, and PlanResourceChange
+// RPCs, such as resource ValidateConfig and ModifyPlan methods, plan
+// modifiers, and validators. It is not set while handling other RPCs, such
+// as ApplyResourceChange or ReadResource, even if Terraform is planning,
+// since refreshing state and applying changes are expected to call remote
+// systems.
+package dryrun
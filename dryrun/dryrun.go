@@ -0,0 +1,24 @@
+package dryrun
+
+import (
+	"context"
+)
+
+// contextKey is the context key of the dry run flag.
+type contextKey struct{}
+
+// IsDryRun returns true if the current operation will not persist changes,
+// such as during configuration validation and planning. Side-effecting
+// calls should be skipped when this returns true.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, ok := ctx.Value(contextKey{}).(bool)
+
+	return ok && dryRun
+}
+
+// NewContext returns a context with the dry run flag set. The framework
+// calls this automatically, however it is exported so provider unit testing
+// can verify dry run handling of shared business logic.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
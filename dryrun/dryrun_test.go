@@ -0,0 +1,49 @@
+package dryrun_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
+)
+
+func TestIsDryRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected bool
+	}{
+		"background": {
+			ctx:      context.Background(),
+			expected: false,
+		},
+		"new-context": {
+			ctx:      dryrun.NewContext(context.Background()),
+			expected: true,
+		},
+		"new-context-child": {
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(dryrun.NewContext(context.Background()))
+				cancel()
+
+				return ctx
+			}(),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := dryrun.IsDryRun(testCase.ctx)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
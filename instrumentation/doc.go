@@ -0,0 +1,12 @@
+// Package instrumentation implements opt-in hooks around framework RPC
+// handling and provider-defined method calls, which enables profiling where
+// time is spent, such as schema retrieval, plan modifiers, or remote API
+// calls in resource Read methods, without modifying the provider.
+//
+// When an Instrumentation is configured with the providerserver.ServeOpts
+// type Instrumentation field, the framework calls BeforeProviderMethod and
+// AfterProviderMethod around the handling of each RPC and around each
+// provider-defined method call within the RPC, such as Schema, Create, Read,
+// ModifyPlan, and validators. The Logging type is a bundled implementation
+// which emits timing logs.
+package instrumentation
@@ -0,0 +1,40 @@
+package instrumentation
+
+import (
+	"context"
+	"time"
+)
+
+// Instrumentation receives callbacks around framework RPC handling and
+// provider-defined method calls. Callbacks are called synchronously and
+// concurrently across RPCs, so they should return quickly and must be safe
+// for concurrent use.
+type Instrumentation interface {
+	// BeforeProviderMethod is called before the RPC handling or
+	// provider-defined method call. The returned context is used for the
+	// call and passed to AfterProviderMethod, which enables tracing
+	// implementations to propagate a span. Return the given context if
+	// there is nothing to propagate.
+	BeforeProviderMethod(ctx context.Context, method Method) context.Context
+
+	// AfterProviderMethod is called after the RPC handling or
+	// provider-defined method call with the elapsed time of the call.
+	AfterProviderMethod(ctx context.Context, method Method, elapsed time.Duration)
+}
+
+// Method describes RPC handling or a provider-defined method call.
+type Method struct {
+	// RPC is the name of the RPC being handled, such as
+	// "PlanResourceChange".
+	RPC string
+
+	// Name is the description of the provider-defined method being called,
+	// such as "Resource ModifyPlan" or "validator.String". It is empty for
+	// the handling of the RPC itself, which includes all provider-defined
+	// method calls of the RPC.
+	Name string
+
+	// TypeName is the data source or resource type name of the RPC, such as
+	// "examplecloud_thing". It is empty for provider RPCs.
+	TypeName string
+}
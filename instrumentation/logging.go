@@ -0,0 +1,40 @@
+package instrumentation
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+var _ Instrumentation = Logging{}
+
+// Logging is an Instrumentation which emits a framework subsystem DEBUG log
+// with the elapsed time after each RPC and provider-defined method call.
+// The logs include the "tf_provider_method" and
+// "tf_provider_method_duration_ms" fields, in addition to the RPC and type
+// name fields of all framework logs.
+type Logging struct{}
+
+// BeforeProviderMethod returns the given context.
+func (l Logging) BeforeProviderMethod(ctx context.Context, _ Method) context.Context {
+	return ctx
+}
+
+// AfterProviderMethod emits a timing log.
+func (l Logging) AfterProviderMethod(ctx context.Context, method Method, elapsed time.Duration) {
+	name := method.Name
+
+	if name == "" {
+		name = method.RPC
+	}
+
+	logging.FrameworkDebug(
+		ctx,
+		"Provider method timing",
+		map[string]interface{}{
+			logging.KeyProviderMethod:           name,
+			logging.KeyProviderMethodDurationMs: elapsed.Milliseconds(),
+		},
+	)
+}
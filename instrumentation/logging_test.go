@@ -0,0 +1,86 @@
+package instrumentation_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/instrumentation"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+func TestLoggingAfterProviderMethod(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method   instrumentation.Method
+		elapsed  time.Duration
+		expected []map[string]interface{}
+	}{
+		"rpc": {
+			method: instrumentation.Method{
+				RPC:      "ReadResource",
+				TypeName: "test_resource",
+			},
+			elapsed: 1500 * time.Millisecond,
+			expected: []map[string]interface{}{
+				{
+					"@level":                         "debug",
+					"@message":                       "Provider method timing",
+					"@module":                        "sdk.framework",
+					"tf_provider_method":             "ReadResource",
+					"tf_provider_method_duration_ms": float64(1500),
+				},
+			},
+		},
+		"provider-method": {
+			method: instrumentation.Method{
+				Name:     "Resource Read",
+				RPC:      "ReadResource",
+				TypeName: "test_resource",
+			},
+			elapsed: 20 * time.Millisecond,
+			expected: []map[string]interface{}{
+				{
+					"@level":                         "debug",
+					"@message":                       "Provider method timing",
+					"@module":                        "sdk.framework",
+					"tf_provider_method":             "Resource Read",
+					"tf_provider_method_duration_ms": float64(20),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			i := instrumentation.Logging{}
+
+			ctx = i.BeforeProviderMethod(ctx, testCase.method)
+			i.AfterProviderMethod(ctx, testCase.method, testCase.elapsed)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package interfacecheck
+
+import (
+	"fmt"
+	"go/format"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwinterface"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// DataSourceAssertions returns Go source code with compile-time assertions
+// for datasource.DataSource and each optional data source interface which
+// the data source implements, such as:
+//
+//	var (
+//		_ datasource.DataSource              = (*ThingDataSource)(nil)
+//		_ datasource.DataSourceWithConfigure = (*ThingDataSource)(nil)
+//	)
+//
+// Adding the assertions to the data source implementation ensures that
+// changing a method signature fails compilation rather than silently
+// disabling the method.
+func DataSourceAssertions(d datasource.DataSource) string {
+	report := fwinterface.Detect(d, fwinterface.DataSourceInterfaces)
+
+	return assertions(d, append([]string{"datasource.DataSource"}, report.Implemented...))
+}
+
+// ResourceAssertions returns Go source code with compile-time assertions for
+// resource.Resource and each optional resource interface which the resource
+// implements, such as:
+//
+//	var (
+//		_ resource.Resource                = (*ThingResource)(nil)
+//		_ resource.ResourceWithImportState = (*ThingResource)(nil)
+//	)
+//
+// Adding the assertions to the resource implementation ensures that changing
+// a method signature fails compilation rather than silently disabling the
+// method.
+func ResourceAssertions(r resource.Resource) string {
+	report := fwinterface.Detect(r, fwinterface.ResourceInterfaces)
+
+	return assertions(r, append([]string{"resource.Resource"}, report.Implemented...))
+}
+
+// assertions returns a formatted variable declaration which asserts that the
+// value type implements each of the interface names.
+func assertions(value any, interfaceNames []string) string {
+	valueType := reflect.TypeOf(value)
+
+	// Typed nil pointers are used for pointer types to prevent allocations
+	// and the need for knowing the fields of the type.
+	expression := fmt.Sprintf("%s{}", valueType.Name())
+
+	if valueType.Kind() == reflect.Pointer {
+		expression = fmt.Sprintf("(*%s)(nil)", valueType.Elem().Name())
+	}
+
+	var b strings.Builder
+
+	b.WriteString("var (\n")
+
+	for _, interfaceName := range interfaceNames {
+		fmt.Fprintf(&b, "_ %s = %s\n", interfaceName, expression)
+	}
+
+	b.WriteString(")\n")
+
+	formatted, err := format.Source([]byte(b.String()))
+
+	// The unformatted source is still valid, so only the formatting is lost.
+	if err != nil {
+		return b.String()
+	}
+
+	return string(formatted)
+}
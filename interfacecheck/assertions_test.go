@@ -0,0 +1,86 @@
+package interfacecheck_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/interfacecheck"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDataSourceAssertions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dataSource datasource.DataSource
+		expected   string
+	}{
+		"base": {
+			dataSource: &testprovider.DataSource{},
+			expected: "var (\n" +
+				"\t_ datasource.DataSource = (*DataSource)(nil)\n" +
+				")\n",
+		},
+		"optional-interfaces": {
+			dataSource: &testprovider.DataSourceWithConfigure{},
+			expected: "var (\n" +
+				"\t_ datasource.DataSource              = (*DataSourceWithConfigure)(nil)\n" +
+				"\t_ datasource.DataSourceWithConfigure = (*DataSourceWithConfigure)(nil)\n" +
+				")\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := interfacecheck.DataSourceAssertions(testCase.dataSource)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAssertions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		expected string
+	}{
+		"base": {
+			resource: &testprovider.Resource{},
+			expected: "var (\n" +
+				"\t_ resource.Resource = (*Resource)(nil)\n" +
+				")\n",
+		},
+		"optional-interfaces": {
+			resource: &testprovider.ResourceWithImportState{},
+			expected: "var (\n" +
+				"\t_ resource.Resource                = (*ResourceWithImportState)(nil)\n" +
+				"\t_ resource.ResourceWithImportState = (*ResourceWithImportState)(nil)\n" +
+				")\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := interfacecheck.ResourceAssertions(testCase.resource)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
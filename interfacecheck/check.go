@@ -0,0 +1,81 @@
+package interfacecheck
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwinterface"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// TestingT is the subset of the testing.T methods used by the checks, which
+// enables usage outside the standard library testing package.
+type TestingT interface {
+	Errorf(format string, args ...any)
+	Helper()
+}
+
+// DataSource fails the test for each method of the data source which has the
+// name of an optional data source interface method, but does not implement
+// the interface.
+func DataSource(t TestingT, d datasource.DataSource) {
+	t.Helper()
+
+	report := fwinterface.Detect(d, fwinterface.DataSourceInterfaces)
+
+	for _, mismatch := range report.Mismatched {
+		t.Errorf("%T: %s", d, mismatch)
+	}
+}
+
+// Resource fails the test for each method of the resource which has the name
+// of an optional resource interface method, but does not implement the
+// interface.
+func Resource(t TestingT, r resource.Resource) {
+	t.Helper()
+
+	report := fwinterface.Detect(r, fwinterface.ResourceInterfaces)
+
+	for _, mismatch := range report.Mismatched {
+		t.Errorf("%T: %s", r, mismatch)
+	}
+}
+
+// Provider fails the test for each method of the provider data sources and
+// resources which has the name of an optional interface method, but does not
+// implement the interface. Failures include the data source or resource type
+// name.
+func Provider(ctx context.Context, t TestingT, p provider.Provider) {
+	t.Helper()
+
+	metadataResp := &provider.MetadataResponse{}
+
+	p.Metadata(ctx, provider.MetadataRequest{}, metadataResp)
+
+	for _, dataSourceFunc := range p.DataSources(ctx) {
+		d := dataSourceFunc()
+		resp := &datasource.MetadataResponse{}
+
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: metadataResp.TypeName}, resp)
+
+		report := fwinterface.Detect(d, fwinterface.DataSourceInterfaces)
+
+		for _, mismatch := range report.Mismatched {
+			t.Errorf("%s data source (%T): %s", resp.TypeName, d, mismatch)
+		}
+	}
+
+	for _, resourceFunc := range p.Resources(ctx) {
+		r := resourceFunc()
+		resp := &resource.MetadataResponse{}
+
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: metadataResp.TypeName}, resp)
+
+		report := fwinterface.Detect(r, fwinterface.ResourceInterfaces)
+
+		for _, mismatch := range report.Mismatched {
+			t.Errorf("%s resource (%T): %s", resp.TypeName, r, mismatch)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package interfacecheck_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/interfacecheck"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// testingT records errors instead of failing the test.
+type testingT struct {
+	errors []string
+}
+
+func (t *testingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *testingT) Helper() {}
+
+// testDataSourceConfigureMismatch has a Configure method with the response
+// parameter incorrectly declared as a non-pointer.
+type testDataSourceConfigureMismatch struct {
+	testprovider.DataSource
+}
+
+func (d *testDataSourceConfigureMismatch) Configure(_ context.Context, _ datasource.ConfigureRequest, _ datasource.ConfigureResponse) {
+}
+
+// testResourceImportStateMismatch has an ImportState method with the request
+// parameter incorrectly declared as a pointer.
+type testResourceImportStateMismatch struct {
+	testprovider.Resource
+}
+
+func (r *testResourceImportStateMismatch) ImportState(_ context.Context, _ *resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+}
+
+func TestDataSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dataSource datasource.DataSource
+		expected   []string
+	}{
+		"implemented": {
+			dataSource: &testprovider.DataSourceWithConfigure{},
+		},
+		"mismatched": {
+			dataSource: &testDataSourceConfigureMismatch{},
+			expected: []string{
+				"*interfacecheck_test.testDataSourceConfigureMismatch: Configure method does not implement datasource.DataSourceWithConfigure: " +
+					"expected signature func(context.Context, datasource.ConfigureRequest, *datasource.ConfigureResponse), " +
+					"got: func(context.Context, datasource.ConfigureRequest, datasource.ConfigureResponse)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &testingT{}
+
+			interfacecheck.DataSource(got, testCase.dataSource)
+
+			if diff := cmp.Diff(got.errors, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		expected []string
+	}{
+		"implemented": {
+			resource: &testprovider.ResourceWithImportState{},
+		},
+		"mismatched": {
+			resource: &testResourceImportStateMismatch{},
+			expected: []string{
+				"*interfacecheck_test.testResourceImportStateMismatch: ImportState method does not implement resource.ResourceWithImportState: " +
+					"expected signature func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse), " +
+					"got: func(context.Context, *resource.ImportStateRequest, *resource.ImportStateResponse)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &testingT{}
+
+			interfacecheck.Resource(got, testCase.resource)
+
+			if diff := cmp.Diff(got.errors, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+
+	p := &testprovider.Provider{
+		MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+			resp.TypeName = "test"
+		},
+		DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+			return []func() datasource.DataSource{
+				func() datasource.DataSource {
+					return &testDataSourceConfigureMismatch{
+						DataSource: testprovider.DataSource{
+							MetadataMethod: func(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+								resp.TypeName = req.ProviderTypeName + "_data_source"
+							},
+						},
+					}
+				},
+			}
+		},
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testResourceImportStateMismatch{
+						Resource: testprovider.Resource{
+							MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = req.ProviderTypeName + "_resource"
+							},
+						},
+					}
+				},
+			}
+		},
+	}
+
+	got := &testingT{}
+
+	interfacecheck.Provider(context.Background(), got, p)
+
+	expected := []string{
+		"test_data_source data source (*interfacecheck_test.testDataSourceConfigureMismatch): Configure method does not implement datasource.DataSourceWithConfigure: " +
+			"expected signature func(context.Context, datasource.ConfigureRequest, *datasource.ConfigureResponse), " +
+			"got: func(context.Context, datasource.ConfigureRequest, datasource.ConfigureResponse)",
+		"test_resource resource (*interfacecheck_test.testResourceImportStateMismatch): ImportState method does not implement resource.ResourceWithImportState: " +
+			"expected signature func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse), " +
+			"got: func(context.Context, *resource.ImportStateRequest, *resource.ImportStateResponse)",
+	}
+
+	if diff := cmp.Diff(got.errors, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,19 @@
+// Package interfacecheck implements checks and generators which prevent data
+// source and resource methods from being silently ignored by the framework.
+//
+// The framework detects optional interfaces, such as
+// resource.ResourceWithImportState, with type assertions. A method which
+// almost matches an optional interface method, such as one with a mistyped
+// parameter or a pointer receiver on a non-pointer value, compiles
+// successfully but is never called. The Provider, DataSource, and Resource
+// functions fail a test for each of these methods, while the
+// DataSourceAssertions and ResourceAssertions functions generate
+// compile-time interface assertions for implementation source files.
+//
+// For example, a provider can verify all of its data sources and resources
+// in a unit test:
+//
+//	func TestProviderInterfaces(t *testing.T) {
+//		interfacecheck.Provider(context.Background(), t, New())
+//	}
+package interfacecheck
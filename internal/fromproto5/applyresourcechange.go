@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -24,13 +25,12 @@ func ApplyResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.ApplyReso
 	// Panic prevention here to simplify the calling implementations.
 	// This should not happen, but just in case.
 	if resourceSchema == nil {
-		diags.AddError(
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
 			"Missing Resource Schema",
-			"An unexpected error was encountered when handling the request. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Missing schema.",
-		)
+			"handling the request",
+			"Missing schema.",
+		))
 
 		return nil, diags
 	}
@@ -38,6 +38,7 @@ func ApplyResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.ApplyReso
 	fw := &fwserver.ApplyResourceChangeRequest{
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, resourceSchema)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -22,13 +23,12 @@ func Config(ctx context.Context, proto5DynamicValue *tfprotov5.DynamicValue, sch
 	// Panic prevention here to simplify the calling implementations.
 	// This should not happen, but just in case.
 	if schema == nil {
-		diags.AddError(
-			"Unable to Convert Configuration",
-			"An unexpected error was encountered when converting the configuration from the protocol type. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Missing schema.",
-		)
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
+			diag.SummaryUnableToConvertConfiguration,
+			"converting the configuration from the protocol type",
+			"Missing schema.",
+		))
 
 		return nil, diags
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -32,13 +33,12 @@ func DynamicValue(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fw
 	proto5Value, err := proto5.Unmarshal(schema.Type().TerraformType(ctx))
 
 	if err != nil {
-		diags.AddError(
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
 			"Unable to Convert "+description.Title(),
-			"An unexpected error was encountered when converting the "+description.String()+" from the protocol type. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Unable to unmarshal DynamicValue: "+err.Error(),
-		)
+			"converting the "+description.String()+" from the protocol type",
+			"Unable to unmarshal DynamicValue: "+err.Error(),
+		))
 
 		return *data, diags
 	}
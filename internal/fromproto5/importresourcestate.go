@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,13 +25,12 @@ func ImportResourceStateRequest(ctx context.Context, proto5 *tfprotov5.ImportRes
 	// Panic prevention here to simplify the calling implementations.
 	// This should not happen, but just in case.
 	if resourceSchema == nil {
-		diags.AddError(
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
 			"Unable to Create Empty State",
-			"An unexpected error was encountered when creating the empty state. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Missing schema.",
-		)
+			"creating the empty state",
+			"Missing schema.",
+		))
 
 		return nil, diags
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -35,12 +36,12 @@ func ProviderMeta(ctx context.Context, proto5DynamicValue *tfprotov5.DynamicValu
 	proto5Value, err := proto5DynamicValue.Unmarshal(schema.Type().TerraformType(ctx))
 
 	if err != nil {
-		diags.AddError(
-			"Unable to Convert Provider Meta Configuration",
-			"An unexpected error was encountered when converting the provider meta configuration from the protocol type. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+err.Error(),
-		)
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
+			diag.SummaryUnableToConvertProviderMetaConfiguration,
+			"converting the provider meta configuration from the protocol type",
+			err.Error(),
+		))
 
 		return nil, diags
 	}
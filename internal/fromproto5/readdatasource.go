@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -22,13 +23,12 @@ func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSource
 	// Panic prevention here to simplify the calling implementations.
 	// This should not happen, but just in case.
 	if dataSourceSchema == nil {
-		diags.AddError(
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
 			"Missing DataSource Schema",
-			"An unexpected error was encountered when handling the request. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Missing schema.",
-		)
+			"handling the request",
+			"Missing schema.",
+		))
 
 		return nil, diags
 	}
@@ -36,6 +36,7 @@ func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSource
 	fw := &fwserver.ReadDataSourceRequest{
 		DataSource:       dataSource,
 		DataSourceSchema: dataSourceSchema,
+		TypeName:         proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, dataSourceSchema)
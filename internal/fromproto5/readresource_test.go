@@ -169,6 +169,14 @@ func TestReadResourceRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov5.ReadResourceRequest{
+				TypeName: "test_resource",
+			},
+			expected: &fwserver.ReadResourceRequest{
+				TypeName: "test_resource",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -23,6 +23,7 @@ func ValidateDataSourceConfigRequest(ctx context.Context, proto5 *tfprotov5.Vali
 
 	fw.Config = config
 	fw.DataSource = dataSource
+	fw.TypeName = proto5.TypeName
 
 	return fw, diags
 }
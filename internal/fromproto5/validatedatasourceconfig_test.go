@@ -85,6 +85,14 @@ func TestValidateDataSourceConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov5.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
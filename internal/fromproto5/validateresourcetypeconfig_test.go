@@ -85,6 +85,14 @@ func TestValidateResourceTypeConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov5.ValidateResourceTypeConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -244,6 +244,16 @@ func TestApplyResourceChangeRequest(t *testing.T) {
 				ResourceSchema: testFwSchema,
 			},
 		},
+		"typename": {
+			input: &tfprotov6.ApplyResourceChangeRequest{
+				TypeName: "test_resource",
+			},
+			resourceSchema: testFwSchema,
+			expected: &fwserver.ApplyResourceChangeRequest{
+				ResourceSchema: testFwSchema,
+				TypeName:       "test_resource",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -8,8 +8,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -113,3 +115,57 @@ func TestProviderMeta(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderMeta_CustomType(t *testing.T) {
+	t.Parallel()
+
+	testProto6Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto6DynamicValue, err := tfprotov6.NewDynamicValue(testProto6Type, tftypes.NewValue(testProto6Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+	}
+
+	testFwSchema := metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"test_attribute": metaschema.StringAttribute{
+				CustomType: testtypes.StringType{},
+				Required:   true,
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	providerMeta, diags := fromproto6.ProviderMeta(ctx, &testProto6DynamicValue, testFwSchema)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var got struct {
+		TestAttribute testtypes.String `tfsdk:"test_attribute"`
+	}
+
+	diags = providerMeta.Get(ctx, &got)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected Get diagnostics: %v", diags)
+	}
+
+	expected := testtypes.String{
+		InternalString: types.StringValue("test-value"),
+		CreatedBy:      testtypes.StringType{},
+	}
+
+	if diff := cmp.Diff(got.TestAttribute, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -23,6 +23,7 @@ func ReadResourceRequest(ctx context.Context, proto6 *tfprotov6.ReadResourceRequ
 
 	fw := &fwserver.ReadResourceRequest{
 		Resource: resource,
+		TypeName: proto6.TypeName,
 	}
 
 	currentState, currentStateDiags := State(ctx, proto6.CurrentState, resourceSchema)
@@ -85,6 +85,14 @@ func TestValidateDataSourceConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov6.ValidateDataResourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -23,6 +23,7 @@ func ValidateResourceConfigRequest(ctx context.Context, proto6 *tfprotov6.Valida
 
 	fw.Config = config
 	fw.Resource = resource
+	fw.TypeName = proto6.TypeName
 
 	return fw, diags
 }
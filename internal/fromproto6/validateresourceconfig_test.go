@@ -85,6 +85,14 @@ func TestValidateResourceConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,79 @@
+package fwdiag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// detailSuffixKey is the context key of the detail suffix.
+type detailSuffixKey struct{}
+
+// WithDetailSuffix returns a context containing the suffix, which is appended
+// to framework-generated error diagnostics created with the context. An empty
+// suffix returns the context unmodified.
+func WithDetailSuffix(ctx context.Context, suffix string) context.Context {
+	if suffix == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, detailSuffixKey{}, suffix)
+}
+
+// ReportableErrorDetail returns the detail of a framework-generated error
+// diagnostic which should be reported to the provider developers, with any
+// detail suffix from the context appended. Prefer the other diagnostic
+// functions in this package when the detail matches their prose.
+func ReportableErrorDetail(ctx context.Context, detail string) string {
+	suffix, ok := ctx.Value(detailSuffixKey{}).(string)
+
+	if !ok || suffix == "" {
+		return detail
+	}
+
+	return detail + "\n\n" + suffix
+}
+
+// FrameworkErrorDiag returns an error diagnostic for an unexpected issue in
+// the framework while performing the operation, such as "converting the
+// configuration from the protocol type". The details, such as an underlying
+// error message, are included after the boilerplate prose.
+func FrameworkErrorDiag(ctx context.Context, summary string, operation string, details string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		summary,
+		ReportableErrorDetail(
+			ctx,
+			"An unexpected error was encountered when "+operation+". "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+
+				details,
+		),
+	)
+}
+
+// ProviderErrorDiag returns an error diagnostic for an issue in the provider
+// implementation, such as an invalid response from a provider-defined method.
+// The problem is a sentence describing the issue. Any details, such as
+// remediation steps, are included after the boilerplate prose.
+func ProviderErrorDiag(ctx context.Context, summary string, problem string, details string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(summary, providerErrorDetail(ctx, problem, details))
+}
+
+// ProviderAttributeErrorDiag is the same as ProviderErrorDiag, except the
+// diagnostic is associated with the attribute path.
+func ProviderAttributeErrorDiag(ctx context.Context, attributePath path.Path, summary string, problem string, details string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(attributePath, summary, providerErrorDetail(ctx, problem, details))
+}
+
+// providerErrorDetail returns the detail of ProviderErrorDiag and
+// ProviderAttributeErrorDiag.
+func providerErrorDetail(ctx context.Context, problem string, details string) string {
+	detail := problem + " This is always an issue with the provider and should be reported to the provider developers."
+
+	if details != "" {
+		detail += "\n\n" + details
+	}
+
+	return ReportableErrorDetail(ctx, detail)
+}
@@ -0,0 +1,144 @@
+package fwdiag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+)
+
+func TestReportableErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suffix   string
+		detail   string
+		expected string
+	}{
+		"no-suffix": {
+			detail:   "test detail",
+			expected: "test detail",
+		},
+		"suffix": {
+			suffix:   "Report this issue at: https://example.com/issues",
+			detail:   "test detail",
+			expected: "test detail\n\nReport this issue at: https://example.com/issues",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := fwdiag.WithDetailSuffix(context.Background(), testCase.suffix)
+
+			got := fwdiag.ReportableErrorDetail(ctx, testCase.detail)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFrameworkErrorDiag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suffix   string
+		expected diag.Diagnostic
+	}{
+		"no-suffix": {
+			expected: diag.NewErrorDiagnostic(
+				"Unable to Convert Configuration",
+				"An unexpected error was encountered when converting the configuration from the protocol type. "+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+					"Please report this to the provider developer:\n\n"+
+					"test details",
+			),
+		},
+		"suffix": {
+			suffix: "Report this issue at: https://example.com/issues",
+			expected: diag.NewErrorDiagnostic(
+				"Unable to Convert Configuration",
+				"An unexpected error was encountered when converting the configuration from the protocol type. "+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+					"Please report this to the provider developer:\n\n"+
+					"test details\n\n"+
+					"Report this issue at: https://example.com/issues",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := fwdiag.WithDetailSuffix(context.Background(), testCase.suffix)
+
+			got := fwdiag.FrameworkErrorDiag(ctx, "Unable to Convert Configuration", "converting the configuration from the protocol type", "test details")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderErrorDiag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suffix   string
+		details  string
+		expected diag.Diagnostic
+	}{
+		"details": {
+			details: "test details",
+			expected: diag.NewErrorDiagnostic(
+				"Test Summary",
+				"Test problem. This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"test details",
+			),
+		},
+		"no-details": {
+			expected: diag.NewErrorDiagnostic(
+				"Test Summary",
+				"Test problem. This is always an issue with the provider and should be reported to the provider developers.",
+			),
+		},
+		"suffix": {
+			suffix:  "Report this issue at: https://example.com/issues",
+			details: "test details",
+			expected: diag.NewErrorDiagnostic(
+				"Test Summary",
+				"Test problem. This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"test details\n\n"+
+					"Report this issue at: https://example.com/issues",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := fwdiag.WithDetailSuffix(context.Background(), testCase.suffix)
+
+			got := fwdiag.ProviderErrorDiag(ctx, "Test Summary", "Test problem.", testCase.details)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package fwdiag contains framework internal helpers for creating error
+// diagnostics which should be reported to the provider developers.
+package fwdiag
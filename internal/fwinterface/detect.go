@@ -0,0 +1,90 @@
+package fwinterface
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Report is the result of optional interface detection.
+type Report struct {
+	// Implemented are the names of the implemented optional interfaces.
+	Implemented []string
+
+	// Mismatched are the methods which have the name of an optional
+	// interface method, but do not implement the interface.
+	Mismatched []Mismatch
+}
+
+// Mismatch is a method which has the name of an optional interface method,
+// but does not implement the interface, so it is never called.
+type Mismatch struct {
+	// Interface is the name of the optional interface.
+	Interface string
+
+	// Method is the method name.
+	Method string
+
+	// Reason describes why the method does not implement the interface.
+	Reason string
+}
+
+// String returns a human readable description of the mismatch.
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s method does not implement %s: %s", m.Method, m.Interface, m.Reason)
+}
+
+// Detect returns which of the optional interfaces are implemented by the
+// value, along with any mismatched methods.
+func Detect(value any, interfaces []Interface) Report {
+	var report Report
+
+	if value == nil {
+		return report
+	}
+
+	valueType := reflect.TypeOf(value)
+
+	for _, iface := range interfaces {
+		if valueType.Implements(iface.Type) {
+			report.Implemented = append(report.Implemented, iface.Name)
+
+			continue
+		}
+
+		expectedMethod, ok := iface.Type.MethodByName(iface.Method)
+
+		if !ok {
+			continue
+		}
+
+		if valueType.Kind() != reflect.Pointer && reflect.PointerTo(valueType).Implements(iface.Type) {
+			report.Mismatched = append(report.Mismatched, Mismatch{
+				Interface: iface.Name,
+				Method:    iface.Method,
+				Reason:    fmt.Sprintf("the method has a pointer receiver, but %s is not a pointer", valueType),
+			})
+
+			continue
+		}
+
+		method := reflect.ValueOf(value).MethodByName(iface.Method)
+
+		// Methods with a pointer receiver are not in the method set of a
+		// non-pointer value.
+		if !method.IsValid() && valueType.Kind() != reflect.Pointer {
+			method = reflect.New(valueType).MethodByName(iface.Method)
+		}
+
+		if !method.IsValid() {
+			continue
+		}
+
+		report.Mismatched = append(report.Mismatched, Mismatch{
+			Interface: iface.Name,
+			Method:    iface.Method,
+			Reason:    fmt.Sprintf("expected signature %s, got: %s", expectedMethod.Type, method.Type()),
+		})
+	}
+
+	return report
+}
@@ -0,0 +1,180 @@
+package fwinterface_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwinterface"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// testResourceImportState implements resource.ResourceWithImportState.
+type testResourceImportState struct {
+	testprovider.Resource
+}
+
+func (r *testResourceImportState) ImportState(_ context.Context, _ resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+}
+
+// testResourceImportStateMismatch has an ImportState method with the
+// request parameter incorrectly declared as a pointer.
+type testResourceImportStateMismatch struct {
+	testprovider.Resource
+}
+
+func (r *testResourceImportStateMismatch) ImportState(_ context.Context, _ *resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+}
+
+// testResourceValue implements resource.Resource with a value receiver and
+// resource.ResourceWithModifyPlan with a pointer receiver.
+type testResourceValue struct{}
+
+func (r testResourceValue) Metadata(_ context.Context, _ resource.MetadataRequest, _ *resource.MetadataResponse) {
+}
+
+func (r testResourceValue) Schema(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+}
+
+func (r testResourceValue) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r testResourceValue) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r testResourceValue) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r testResourceValue) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *testResourceValue) ModifyPlan(_ context.Context, _ resource.ModifyPlanRequest, _ *resource.ModifyPlanResponse) {
+}
+
+// testDataSourceConfigValidatorsMismatch has a ConfigValidators method with
+// an incorrect return type.
+type testDataSourceConfigValidatorsMismatch struct {
+	testprovider.DataSource
+}
+
+func (d *testDataSourceConfigValidatorsMismatch) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return nil
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value      any
+		interfaces []fwinterface.Interface
+		expected   fwinterface.Report
+	}{
+		"nil": {
+			value:      nil,
+			interfaces: fwinterface.ResourceInterfaces,
+			expected:   fwinterface.Report{},
+		},
+		"datasource-none": {
+			value:      &testprovider.DataSource{},
+			interfaces: fwinterface.DataSourceInterfaces,
+			expected:   fwinterface.Report{},
+		},
+		"datasource-configure": {
+			value:      &testprovider.DataSourceWithConfigure{},
+			interfaces: fwinterface.DataSourceInterfaces,
+			expected: fwinterface.Report{
+				Implemented: []string{"datasource.DataSourceWithConfigure"},
+			},
+		},
+		"datasource-signature-mismatch": {
+			value:      &testDataSourceConfigValidatorsMismatch{},
+			interfaces: fwinterface.DataSourceInterfaces,
+			expected: fwinterface.Report{
+				Mismatched: []fwinterface.Mismatch{
+					{
+						Interface: "datasource.DataSourceWithConfigValidators",
+						Method:    "ConfigValidators",
+						Reason:    "expected signature func(context.Context) []datasource.ConfigValidator, got: func(context.Context) []resource.ConfigValidator",
+					},
+				},
+			},
+		},
+		"resource-none": {
+			value:      &testprovider.Resource{},
+			interfaces: fwinterface.ResourceInterfaces,
+			expected:   fwinterface.Report{},
+		},
+		"resource-importstate": {
+			value:      &testResourceImportState{},
+			interfaces: fwinterface.ResourceInterfaces,
+			expected: fwinterface.Report{
+				Implemented: []string{"resource.ResourceWithImportState"},
+			},
+		},
+		"resource-signature-mismatch": {
+			value:      &testResourceImportStateMismatch{},
+			interfaces: fwinterface.ResourceInterfaces,
+			expected: fwinterface.Report{
+				Mismatched: []fwinterface.Mismatch{
+					{
+						Interface: "resource.ResourceWithImportState",
+						Method:    "ImportState",
+						Reason:    "expected signature func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse), got: func(context.Context, *resource.ImportStateRequest, *resource.ImportStateResponse)",
+					},
+				},
+			},
+		},
+		"resource-pointer-receiver-mismatch": {
+			value:      testResourceValue{},
+			interfaces: fwinterface.ResourceInterfaces,
+			expected: fwinterface.Report{
+				Mismatched: []fwinterface.Mismatch{
+					{
+						Interface: "resource.ResourceWithModifyPlan",
+						Method:    "ModifyPlan",
+						Reason:    "the method has a pointer receiver, but fwinterface_test.testResourceValue is not a pointer",
+					},
+				},
+			},
+		},
+		"resource-pointer-receiver": {
+			value:      &testResourceValue{},
+			interfaces: fwinterface.ResourceInterfaces,
+			expected: fwinterface.Report{
+				Implemented: []string{"resource.ResourceWithModifyPlan"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwinterface.Detect(testCase.value, testCase.interfaces)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMismatchString(t *testing.T) {
+	t.Parallel()
+
+	mismatch := fwinterface.Mismatch{
+		Interface: "resource.ResourceWithImportState",
+		Method:    "ImportState",
+		Reason:    "test reason",
+	}
+
+	expected := "ImportState method does not implement resource.ResourceWithImportState: test reason"
+
+	if got := mismatch.String(); got != expected {
+		t.Errorf("expected %q, got: %q", expected, got)
+	}
+}
@@ -0,0 +1,8 @@
+// Package fwinterface implements detection of the optional interfaces which
+// data sources and resources can implement, such as
+// resource.ResourceWithImportState.
+//
+// The framework detects optional interfaces with type assertions, so a method
+// with a mistyped signature or a pointer receiver on a non-pointer value is
+// silently ignored. Detection reports these near misses as mismatches.
+package fwinterface
@@ -0,0 +1,116 @@
+package fwinterface
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Interface is an optional interface which is detected with a type
+// assertion.
+type Interface struct {
+	// Name is the package qualified interface name, such as
+	// resource.ResourceWithImportState.
+	Name string
+
+	// Method is the name of the method which distinguishes the interface
+	// from the base data source or resource interface, such as ImportState.
+	Method string
+
+	// Type is the interface type.
+	Type reflect.Type
+}
+
+// DataSourceInterfaces are all optional data source interfaces.
+var DataSourceInterfaces = []Interface{
+	{
+		Name:   "datasource.DataSourceWithAttributeRequirements",
+		Method: "AttributeRequirements",
+		Type:   reflect.TypeOf((*datasource.DataSourceWithAttributeRequirements)(nil)).Elem(),
+	},
+	{
+		Name:   "datasource.DataSourceWithConfigValidators",
+		Method: "ConfigValidators",
+		Type:   reflect.TypeOf((*datasource.DataSourceWithConfigValidators)(nil)).Elem(),
+	},
+	{
+		Name:   "datasource.DataSourceWithConfigure",
+		Method: "Configure",
+		Type:   reflect.TypeOf((*datasource.DataSourceWithConfigure)(nil)).Elem(),
+	},
+	{
+		Name:   "datasource.DataSourceWithReadCache",
+		Method: "ReadCache",
+		Type:   reflect.TypeOf((*datasource.DataSourceWithReadCache)(nil)).Elem(),
+	},
+	{
+		Name:   "datasource.DataSourceWithValidateConfig",
+		Method: "ValidateConfig",
+		Type:   reflect.TypeOf((*datasource.DataSourceWithValidateConfig)(nil)).Elem(),
+	},
+}
+
+// ResourceInterfaces are all optional resource interfaces.
+var ResourceInterfaces = []Interface{
+	{
+		Name:   "resource.ResourceWithAttributeRequirements",
+		Method: "AttributeRequirements",
+		Type:   reflect.TypeOf((*resource.ResourceWithAttributeRequirements)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithCheckPreconditions",
+		Method: "CheckPreconditions",
+		Type:   reflect.TypeOf((*resource.ResourceWithCheckPreconditions)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithConfigValidators",
+		Method: "ConfigValidators",
+		Type:   reflect.TypeOf((*resource.ResourceWithConfigValidators)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithConfigure",
+		Method: "Configure",
+		Type:   reflect.TypeOf((*resource.ResourceWithConfigure)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithImportState",
+		Method: "ImportState",
+		Type:   reflect.TypeOf((*resource.ResourceWithImportState)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithLegacyStateNormalization",
+		Method: "LegacyStateNormalizationPaths",
+		Type:   reflect.TypeOf((*resource.ResourceWithLegacyStateNormalization)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithModifyPlan",
+		Method: "ModifyPlan",
+		Type:   reflect.TypeOf((*resource.ResourceWithModifyPlan)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithNonComputedStateWrites",
+		Method: "AllowNonComputedStateWrites",
+		Type:   reflect.TypeOf((*resource.ResourceWithNonComputedStateWrites)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithPlanImpact",
+		Method: "PlanImpact",
+		Type:   reflect.TypeOf((*resource.ResourceWithPlanImpact)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithUndefinedStateAttributes",
+		Method: "UndefinedStateAttributes",
+		Type:   reflect.TypeOf((*resource.ResourceWithUndefinedStateAttributes)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithUpgradeState",
+		Method: "UpgradeState",
+		Type:   reflect.TypeOf((*resource.ResourceWithUpgradeState)(nil)).Elem(),
+	},
+	{
+		Name:   "resource.ResourceWithValidateConfig",
+		Method: "ValidateConfig",
+		Type:   reflect.TypeOf((*resource.ResourceWithValidateConfig)(nil)).Elem(),
+	},
+}
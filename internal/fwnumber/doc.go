@@ -0,0 +1,4 @@
+// Package fwnumber implements the lossless number conversions shared by the
+// framework types and reflection, so a Number value is converted into an
+// int64 or float64 the same way regardless of how it is accessed.
+package fwnumber
@@ -0,0 +1,81 @@
+package fwnumber
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Int64 returns the value as an int64. An error is returned if the value is
+// not an integer or is outside the int64 range, rather than truncating it.
+func Int64(value *big.Float) (int64, error) {
+	if !value.IsInt() {
+		return 0, fmt.Errorf("Value %s is not an integer.", diag.FormatNumber(value))
+	}
+
+	i, accuracy := value.Int64()
+
+	if accuracy != big.Exact {
+		return 0, fmt.Errorf("Value %s cannot be represented as a 64-bit integer.", diag.FormatNumber(value))
+	}
+
+	return i, nil
+}
+
+// Float64 returns the value as a float64. An error is returned if the value
+// overflows, underflows, or loses precision as a float64. Refer to the
+// Float64PrecisionLoss function for which conversions lose precision.
+//
+// Error messages format the value with at most 17 significant digits, which
+// is enough to identify any float64, rather than every digit of values such
+// as 1/3 which Terraform sends with 512 bits of precision.
+func Float64(value *big.Float) (float64, error) {
+	f, accuracy := value.Float64()
+
+	// Underflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float64
+	if f == 0 && accuracy != big.Exact {
+		return 0, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", value.Text('g', 17))
+	}
+
+	// Overflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float64
+	if math.IsInf(f, 0) {
+		return 0, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", value.Text('g', 17))
+	}
+
+	if Float64PrecisionLoss(value, f) {
+		return 0, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point without losing precision.", value.Text('g', 17))
+	}
+
+	return f, nil
+}
+
+// Float64PrecisionLoss returns true if the value is an integer for which the
+// shortest decimal representation of the float64 conversion is a different
+// number, such as integers greater than 2^53. Integers such as 1e100 are
+// lossless since they round-trip through their float64 representation.
+// Fractional values are always rounded to the nearest float64, since
+// Terraform sends numbers such as 0.1 or the result of 1/3 with more
+// precision than a float64 can hold. Values outside the float64 range are
+// handled by Float64.
+func Float64PrecisionLoss(value *big.Float, f float64) bool {
+	if !value.IsInt() {
+		return false
+	}
+
+	if _, accuracy := value.Float64(); accuracy == big.Exact {
+		return false
+	}
+
+	roundTrip, _, err := big.ParseFloat(strconv.FormatFloat(f, 'g', -1, 64), 10, value.Prec(), value.Mode())
+
+	if err != nil {
+		return true
+	}
+
+	return roundTrip.Cmp(value) != 0
+}
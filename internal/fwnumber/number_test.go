@@ -0,0 +1,158 @@
+package fwnumber_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwnumber"
+)
+
+func testMustParseFloat(s string) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+func TestInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       *big.Float
+		expected    int64
+		expectedErr string
+	}{
+		"integer": {
+			value:    testMustParseFloat("123"),
+			expected: 123,
+		},
+		"negative-zero": {
+			value:    testMustParseFloat("-0"),
+			expected: 0,
+		},
+		"2^63": {
+			value:       testMustParseFloat("9223372036854775808"),
+			expectedErr: "Value 9223372036854775808 cannot be represented as a 64-bit integer.",
+		},
+		"1e100": {
+			value:       testMustParseFloat("1e100"),
+			expectedErr: "Value 1e+100 cannot be represented as a 64-bit integer.",
+		},
+		"fractional": {
+			value:       testMustParseFloat("1.5"),
+			expectedErr: "Value 1.5 is not an integer.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fwnumber.Int64(testCase.value)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedErr); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       *big.Float
+		expected    float64
+		expectedErr string
+	}{
+		"exact": {
+			value:    testMustParseFloat("1.5"),
+			expected: 1.5,
+		},
+		"decimal": {
+			value:    testMustParseFloat("0.1"),
+			expected: 0.1,
+		},
+		"decimal-division": {
+			value:    new(big.Float).SetPrec(512).Quo(big.NewFloat(1), big.NewFloat(3)),
+			expected: 1.0 / 3.0,
+		},
+		"decimal-sum": {
+			value:    new(big.Float).SetPrec(512).Add(testMustParseFloat("0.1"), testMustParseFloat("0.2")),
+			expected: 0.3,
+		},
+		"1e100": {
+			value:    testMustParseFloat("1e100"),
+			expected: 1e100,
+		},
+		"fractional-large": {
+			value:    testMustParseFloat("9007199254740993.5"),
+			expected: 9007199254740994,
+		},
+		"precision-loss": {
+			value:       testMustParseFloat("9007199254740993"),
+			expectedErr: "Value 9007199254740993 cannot be represented as a 64-bit floating point without losing precision.",
+		},
+		"overflow": {
+			value:       testMustParseFloat("1e400"),
+			expectedErr: "Value 1e+400 cannot be represented as a 64-bit floating point.",
+		},
+		"underflow": {
+			value:       testMustParseFloat("1e-400"),
+			expectedErr: "Value 1e-400 cannot be represented as a 64-bit floating point.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fwnumber.Float64(testCase.value)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedErr); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -98,5 +98,9 @@ func AttributesEqual(a, b Attribute) bool {
 		return false
 	}
 
+	if attributeNonSensitive(a) != attributeNonSensitive(b) {
+		return false
+	}
+
 	return true
 }
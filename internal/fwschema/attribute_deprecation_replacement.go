@@ -0,0 +1,17 @@
+package fwschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeWithDeprecationReplacement is an optional interface on Attribute
+// which enables deprecation warnings to include the path of the replacement
+// attribute.
+type AttributeWithDeprecationReplacement interface {
+	Attribute
+
+	// GetDeprecationReplacement should return the path expression of the
+	// attribute which replaces this deprecated attribute. Relative
+	// expressions are resolved from the attribute path.
+	GetDeprecationReplacement() path.Expression
+}
@@ -0,0 +1,14 @@
+package fwschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/displayhint"
+)
+
+// AttributeWithDisplayHint is an optional interface on Attribute which
+// enables tooling to present the attribute value readably.
+type AttributeWithDisplayHint interface {
+	Attribute
+
+	// GetDisplayHint should return the display hint of the attribute.
+	GetDisplayHint() displayhint.Hint
+}
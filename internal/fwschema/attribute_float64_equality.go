@@ -0,0 +1,18 @@
+package fwschema
+
+// AttributeWithFloat64Equality is an optional interface on Attribute which
+// enables Float64 values to be considered equal when they are within a
+// tolerance, such as when a remote system rounds values.
+type AttributeWithFloat64Equality interface {
+	Attribute
+
+	// GetEqualityEpsilon should return the maximum absolute difference
+	// between two values which are considered equal. Zero disables this
+	// comparison.
+	GetEqualityEpsilon() float64
+
+	// GetEqualitySignificantDigits should return the number of significant
+	// digits which must match for two values to be considered equal. Zero
+	// disables this comparison.
+	GetEqualitySignificantDigits() int
+}
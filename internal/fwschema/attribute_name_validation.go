@@ -45,6 +45,23 @@ var ReservedResourceAttributeNames = []string{
 	"provisioner",
 }
 
+// ReservedNestedBlockAttributeNames contains the list of attribute and block
+// names which should not be included within provider-defined nested blocks
+// since they match Terraform meta-argument names and cause confusing
+// configuration errors, such as within dynamic blocks.
+var ReservedNestedBlockAttributeNames = []string{
+	// Reference: https://developer.hashicorp.com/terraform/language/meta-arguments/count
+	"count",
+	// Reference: https://developer.hashicorp.com/terraform/language/meta-arguments/depends_on
+	"depends_on",
+	// Reference: https://developer.hashicorp.com/terraform/language/meta-arguments/for_each
+	"for_each",
+	// Reference: https://developer.hashicorp.com/terraform/language/meta-arguments/lifecycle
+	"lifecycle",
+	// Reference: https://developer.hashicorp.com/terraform/language/meta-arguments/resource-provider
+	"provider",
+}
+
 // ValidAttributeNameRegex contains the regular expression to validate
 // attribute names, which are considered [identifiers] in the Terraform
 // configuration language.
@@ -76,7 +93,7 @@ func IsReservedProviderAttributeName(name string, attributePath path.Path) diag.
 			// in this context. Diagnostic paths are intended to be mapped to
 			// actual data, while this path information must be synthesized.
 			diags.AddError(
-				"Reserved Root Attribute/Block Name",
+				diag.SummaryReservedRootAttributeBlockName,
 				"When validating the provider schema, an implementation issue was found. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
 					fmt.Sprintf("%q is a reserved root attribute/block name. ", name)+
@@ -109,7 +126,7 @@ func IsReservedResourceAttributeName(name string, attributePath path.Path) diag.
 			// in this context. Diagnostic paths are intended to be mapped to
 			// actual data, while this path information must be synthesized.
 			diags.AddError(
-				"Reserved Root Attribute/Block Name",
+				diag.SummaryReservedRootAttributeBlockName,
 				"When validating the resource or data source schema, an implementation issue was found. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
 					fmt.Sprintf("%q is a reserved root attribute/block name. ", name)+
@@ -123,6 +140,39 @@ func IsReservedResourceAttributeName(name string, attributePath path.Path) diag.
 	return diags
 }
 
+// IsReservedNestedBlockAttributeName returns an error diagnostic if the given
+// attribute or block name, nested within a block, is in
+// ReservedNestedBlockAttributeNames. Root attribute paths are automatically
+// skipped without error.
+func IsReservedNestedBlockAttributeName(name string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Root names are handled by IsReservedProviderAttributeName and
+	// IsReservedResourceAttributeName.
+	if len(attributePath.Steps()) < 2 {
+		return diags
+	}
+
+	for _, reservedName := range ReservedNestedBlockAttributeNames {
+		if name == reservedName {
+			// The diagnostic path is intentionally omitted as it is invalid
+			// in this context. Diagnostic paths are intended to be mapped to
+			// actual data, while this path information must be synthesized.
+			diags.AddError(
+				diag.SummaryReservedNestedAttributeBlockName,
+				"When validating the schema, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("%q at schema path %q is a reserved meta-argument name within blocks. ", name, attributePath)+
+					"This is to prevent practitioners from needing special Terraform configuration syntax.",
+			)
+
+			break
+		}
+	}
+
+	return diags
+}
+
 // IsValidAttributeName returns an error diagnostic if the given
 // attribute path has an invalid attribute name according to
 // ValidAttributeNameRegex. Non-AttributeName paths are automatically skipped
@@ -148,7 +198,7 @@ func IsValidAttributeName(name string, attributePath path.Path) diag.Diagnostics
 	// context. Diagnostic paths are intended to be mapped to actual data,
 	// while this path information must be synthesized.
 	diags.AddError(
-		"Invalid Attribute/Block Name",
+		diag.SummaryInvalidAttributeBlockName,
 		"When validating the schema, an implementation issue was found. "+
 			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
 			fmt.Sprintf("%q at schema path %q is an invalid attribute/block name. ", name, attributePath)+
@@ -9,6 +9,77 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+func TestIsReservedNestedBlockAttributeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		attributePath path.Path
+		expected      diag.Diagnostics
+	}{
+		"empty-path": {
+			name:          "",
+			attributePath: path.Empty(),
+			expected:      nil,
+		},
+		"root-attribute-name": {
+			name:          "count",
+			attributePath: path.Root("count"),
+			expected:      nil,
+		},
+		"connection": {
+			name:          "connection",
+			attributePath: path.Root("test").AtName("connection"),
+			expected:      nil,
+		},
+		"count": {
+			name:          "count",
+			attributePath: path.Root("test").AtName("count"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Reserved Nested Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"count\" at schema path \"test.count\" is a reserved meta-argument name within blocks. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+			},
+		},
+		"lifecycle-deeply-nested": {
+			name:          "lifecycle",
+			attributePath: path.Root("test1").AtName("test2").AtName("lifecycle"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Reserved Nested Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"lifecycle\" at schema path \"test1.test2.lifecycle\" is a reserved meta-argument name within blocks. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+			},
+		},
+		"other": {
+			name:          "other",
+			attributePath: path.Root("test").AtName("other"),
+			expected:      nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.IsReservedNestedBlockAttributeName(testCase.name, testCase.attributePath)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestIsReservedProviderAttributeName(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,99 @@
+package fwschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeWithNonSensitive is an optional interface on Attribute which
+// enables an attribute to opt out of inheriting Sensitive from a parent
+// nested attribute.
+type AttributeWithNonSensitive interface {
+	Attribute
+
+	// IsNonSensitive should return true if the attribute must not inherit
+	// Sensitive from a parent nested attribute.
+	IsNonSensitive() bool
+}
+
+// AttributeSensitive returns true if the attribute is sensitive, given
+// whether its parent nested attribute is sensitive. Attributes inherit
+// Sensitive from the parent, unless they implement AttributeWithNonSensitive
+// and opt out.
+func AttributeSensitive(a Attribute, parentSensitive bool) bool {
+	if a.IsSensitive() {
+		return true
+	}
+
+	if !parentSensitive {
+		return false
+	}
+
+	return !attributeNonSensitive(a)
+}
+
+// AttributeSensitiveValue returns true if the entire value of the attribute
+// is sensitive, given whether its parent nested attribute is sensitive. This
+// is the same as AttributeSensitive, except it returns false for nested
+// attributes containing a nested attribute which opts out of inheriting
+// Sensitive, since only the other nested attribute values are sensitive.
+func AttributeSensitiveValue(a Attribute, parentSensitive bool) bool {
+	return AttributeSensitive(a, parentSensitive) && !hasNonSensitiveNestedAttribute(a)
+}
+
+// SchemaSensitiveAtTerraformPath returns true if the entire value at the
+// path is sensitive, including Sensitive inherited from parent nested
+// attributes. Paths which are not attributes, such as nested attribute
+// objects, return false.
+func SchemaSensitiveAtTerraformPath(ctx context.Context, s Schema, p *tftypes.AttributePath) bool {
+	var attribute Attribute
+
+	sensitive := false
+	steps := p.Steps()
+
+	for i := range steps {
+		stepAttribute, err := s.AttributeAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:i+1]))
+
+		if err != nil {
+			attribute = nil
+
+			continue
+		}
+
+		attribute = stepAttribute
+		sensitive = AttributeSensitive(attribute, sensitive)
+	}
+
+	if attribute == nil {
+		return false
+	}
+
+	return sensitive && !hasNonSensitiveNestedAttribute(attribute)
+}
+
+// attributeNonSensitive returns true if the attribute implements
+// AttributeWithNonSensitive and opts out of inheriting Sensitive.
+func attributeNonSensitive(a Attribute) bool {
+	nonSensitiveAttribute, ok := a.(AttributeWithNonSensitive)
+
+	return ok && nonSensitiveAttribute.IsNonSensitive()
+}
+
+// hasNonSensitiveNestedAttribute returns true if the attribute has any
+// nested attributes, at any depth, which opt out of inheriting Sensitive.
+func hasNonSensitiveNestedAttribute(a Attribute) bool {
+	nestedAttribute, ok := a.(NestedAttribute)
+
+	if !ok {
+		return false
+	}
+
+	for _, nestedAttr := range nestedAttribute.GetNestedObject().GetAttributes() {
+		if attributeNonSensitive(nestedAttr) || hasNonSensitiveNestedAttribute(nestedAttr) {
+			return true
+		}
+	}
+
+	return false
+}
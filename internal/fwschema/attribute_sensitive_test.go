@@ -0,0 +1,99 @@
+package fwschema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestSchemaSensitiveAtTerraformPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"credentials": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"password": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"token": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:     true,
+						NonSensitive: true,
+					},
+					"value": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path     *tftypes.AttributePath
+		expected bool
+	}{
+		"not-sensitive": {
+			path:     tftypes.NewAttributePath().WithAttributeName("name"),
+			expected: false,
+		},
+		"sensitive": {
+			path:     tftypes.NewAttributePath().WithAttributeName("credentials"),
+			expected: true,
+		},
+		"sensitive-parent-nested-object": {
+			path:     tftypes.NewAttributePath().WithAttributeName("credentials").WithElementKeyInt(0),
+			expected: false,
+		},
+		"sensitive-parent": {
+			path:     tftypes.NewAttributePath().WithAttributeName("credentials").WithElementKeyInt(0).WithAttributeName("password"),
+			expected: true,
+		},
+		"sensitive-non-sensitive-descendant": {
+			path:     tftypes.NewAttributePath().WithAttributeName("token"),
+			expected: false,
+		},
+		"sensitive-parent-non-sensitive": {
+			path:     tftypes.NewAttributePath().WithAttributeName("token").WithAttributeName("id"),
+			expected: false,
+		},
+		"sensitive-parent-non-sensitive-sibling": {
+			path:     tftypes.NewAttributePath().WithAttributeName("token").WithAttributeName("value"),
+			expected: true,
+		},
+		"missing": {
+			path:     tftypes.NewAttributePath().WithAttributeName("missing"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SchemaSensitiveAtTerraformPath(context.Background(), testSchema, testCase.path)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -27,6 +27,8 @@ type AttributeWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - Checks whether one of Computed, Optional, or Required is set and
+//     that Required is not combined with Computed or Optional
 //   - If the given Attribute implements the
 //     AttributeWithValidateImplementation interface, calls the method
 //   - If the given Attribute implements the NestedAttribute interface,
@@ -36,6 +38,13 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	switch {
+	case attribute.IsRequired() && (attribute.IsComputed() || attribute.IsOptional()):
+		diags.Append(AttributeConflictingBehaviorDiag(req.Path))
+	case !attribute.IsRequired() && !attribute.IsComputed() && !attribute.IsOptional():
+		diags.Append(AttributeMissingBehaviorDiag(req.Path))
+	}
+
 	if attributeWithValidateImplementation, ok := attribute.(AttributeWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
@@ -57,8 +66,11 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 	}
 
 	nestingMode := nestedAttribute.GetNestingMode()
+	nestedAttributes := nestedObject.GetAttributes()
+
+	for _, nestedAttributeName := range SortedNames(nestedAttributes) {
+		nestedAttribute := nestedAttributes[nestedAttributeName]
 
-	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
 		var nestedAttributePath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
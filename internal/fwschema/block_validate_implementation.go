@@ -27,6 +27,10 @@ type BlockWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - Checks whether nested attribute and block names are reserved
+//     meta-argument names or are used by both an attribute and a block
+//   - Checks whether set nested blocks contain a configurable attribute or
+//     block to identify elements
 //   - If the given Block implements the BlockWithValidateImplementation
 //     interface, calls the method
 //   - Recursively calls this function on nested attributes and blocks
@@ -51,8 +55,28 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 	}
 
 	nestingMode := block.GetNestingMode()
+	nestedAttributes := nestedObject.GetAttributes()
+	nestedBlocks := nestedObject.GetBlocks()
+
+	if nestingMode == BlockNestingModeSet && len(nestedAttributes) > 0 && len(nestedBlocks) == 0 {
+		var configurable bool
+
+		for _, nestedAttribute := range nestedAttributes {
+			if nestedAttribute.IsOptional() || nestedAttribute.IsRequired() {
+				configurable = true
+
+				break
+			}
+		}
+
+		if !configurable {
+			diags.Append(SetBlockComputedOnlyAttributesDiag(req.Path))
+		}
+	}
+
+	for _, nestedAttributeName := range SortedNames(nestedAttributes) {
+		nestedAttribute := nestedAttributes[nestedAttributeName]
 
-	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
 		var nestedAttributePath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
@@ -82,10 +106,13 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 			Path: nestedAttributePath,
 		}
 
+		diags.Append(IsReservedNestedBlockAttributeName(nestedReq.Name, nestedReq.Path)...)
 		diags.Append(ValidateAttributeImplementation(ctx, nestedAttribute, nestedReq)...)
 	}
 
-	for nestedBlockName, nestedBlock := range nestedObject.GetBlocks() {
+	for _, nestedBlockName := range SortedNames(nestedBlocks) {
+		nestedBlock := nestedBlocks[nestedBlockName]
+
 		var nestedBlockPath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
@@ -115,6 +142,11 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 			Path: nestedBlockPath,
 		}
 
+		if _, ok := nestedAttributes[nestedBlockName]; ok {
+			diags.Append(AttributeBlockNameConflictDiag(nestedReq.Path))
+		}
+
+		diags.Append(IsReservedNestedBlockAttributeName(nestedReq.Name, nestedReq.Path)...)
 		diags.Append(ValidateBlockImplementation(ctx, nestedBlock, nestedReq)...)
 	}
 
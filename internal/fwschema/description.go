@@ -0,0 +1,80 @@
+package fwschema
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// markdownCodeFencePattern matches fenced code block delimiter lines.
+	markdownCodeFencePattern = regexp.MustCompile("(?m)^[ \t]*(```|~~~).*$\n?")
+
+	// markdownEmphasisPattern matches bold, italic, and strikethrough
+	// emphasis delimiters. Single underscores are not matched as they are
+	// common in attribute names.
+	markdownEmphasisPattern = regexp.MustCompile(`\*\*|__|~~|\*`)
+
+	// markdownHeadingPattern matches heading and block quote line prefixes.
+	markdownHeadingPattern = regexp.MustCompile(`(?m)^[ \t]*(#{1,6}|>)[ \t]+`)
+
+	// markdownImagePattern matches images, such as ![alt](url).
+	markdownImagePattern = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+
+	// markdownInlineCodePattern matches inline code, such as `code`.
+	markdownInlineCodePattern = regexp.MustCompile("`([^`]*)`")
+
+	// markdownLinkPattern matches inline links, such as [text](url).
+	markdownLinkPattern = regexp.MustCompile(`\[([^\]]*)\]\(([^)]*)\)`)
+)
+
+// ResolveDescriptions returns the plain text and Markdown descriptions,
+// deriving either one from the other when only one is set. Plain text
+// descriptions are passed through as Markdown and Markdown descriptions are
+// stripped of common formatting to become plain text. If both are set, they
+// are returned as-is.
+func ResolveDescriptions(description string, markdownDescription string) (string, string) {
+	switch {
+	case description == "" && markdownDescription != "":
+		return MarkdownToPlainText(markdownDescription), markdownDescription
+	case description != "" && markdownDescription == "":
+		return description, description
+	default:
+		return description, markdownDescription
+	}
+}
+
+// MarkdownToPlainText returns the given Markdown with common formatting, such
+// as emphasis, headings, inline code, and links, removed. Link destinations
+// are preserved in parentheses after the link text, since they often contain
+// relevant information. This is not a full Markdown parser and is only
+// intended for typical schema descriptions.
+func MarkdownToPlainText(markdown string) string {
+	// Inline code content is preserved verbatim, so it is extracted before
+	// other formatting is removed and restored afterwards.
+	var codeSpans []string
+
+	result := markdownCodeFencePattern.ReplaceAllString(markdown, "")
+	result = markdownInlineCodePattern.ReplaceAllStringFunc(result, func(match string) string {
+		codeSpans = append(codeSpans, markdownInlineCodePattern.FindStringSubmatch(match)[1])
+
+		return "\x00"
+	})
+	result = markdownImagePattern.ReplaceAllString(result, "$1")
+	result = markdownLinkPattern.ReplaceAllStringFunc(result, func(match string) string {
+		submatches := markdownLinkPattern.FindStringSubmatch(match)
+
+		if submatches[1] == "" || submatches[1] == submatches[2] {
+			return submatches[2]
+		}
+
+		return submatches[1] + " (" + submatches[2] + ")"
+	})
+	result = markdownHeadingPattern.ReplaceAllString(result, "")
+	result = markdownEmphasisPattern.ReplaceAllString(result, "")
+
+	for _, codeSpan := range codeSpans {
+		result = strings.Replace(result, "\x00", codeSpan, 1)
+	}
+
+	return strings.TrimSpace(result)
+}
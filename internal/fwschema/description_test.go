@@ -0,0 +1,119 @@
+package fwschema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+func TestResolveDescriptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		description                 string
+		markdownDescription         string
+		expectedDescription         string
+		expectedMarkdownDescription string
+	}{
+		"empty": {},
+		"description": {
+			description:                 "test *description*",
+			expectedDescription:         "test *description*",
+			expectedMarkdownDescription: "test *description*",
+		},
+		"markdowndescription": {
+			markdownDescription:         "test *description*",
+			expectedDescription:         "test description",
+			expectedMarkdownDescription: "test *description*",
+		},
+		"both": {
+			description:                 "test plain description",
+			markdownDescription:         "test markdown description",
+			expectedDescription:         "test plain description",
+			expectedMarkdownDescription: "test markdown description",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDescription, gotMarkdownDescription := fwschema.ResolveDescriptions(testCase.description, testCase.markdownDescription)
+
+			if diff := cmp.Diff(gotDescription, testCase.expectedDescription); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotMarkdownDescription, testCase.expectedMarkdownDescription); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMarkdownToPlainText(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		markdown string
+		expected string
+	}{
+		"plain": {
+			markdown: "Name of the thing.",
+			expected: "Name of the thing.",
+		},
+		"block-quote": {
+			markdown: "> Note that this is deprecated.",
+			expected: "Note that this is deprecated.",
+		},
+		"code-fence": {
+			markdown: "Example:\n\n```hcl\nname = \"test\"\n```",
+			expected: "Example:\n\nname = \"test\"",
+		},
+		"emphasis": {
+			markdown: "One of **bold**, *italic*, __bold__, or ~~strikethrough~~.",
+			expected: "One of bold, italic, bold, or strikethrough.",
+		},
+		"heading": {
+			markdown: "## Details\nMore information.",
+			expected: "Details\nMore information.",
+		},
+		"image": {
+			markdown: "![diagram](https://example.com/diagram.png)",
+			expected: "diagram",
+		},
+		"inline-code": {
+			markdown: "Defaults to `**value**` in `snake_case`.",
+			expected: "Defaults to **value** in snake_case.",
+		},
+		"link": {
+			markdown: "Refer to the [documentation](https://example.com).",
+			expected: "Refer to the documentation (https://example.com).",
+		},
+		"link-text-is-destination": {
+			markdown: "Refer to [https://example.com](https://example.com).",
+			expected: "Refer to https://example.com.",
+		},
+		"underscore": {
+			markdown: "Overrides the some_attribute value.",
+			expected: "Overrides the some_attribute value.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.MarkdownToPlainText(testCase.markdown)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -16,7 +16,7 @@ func AttributeMissingAttributeTypesDiag(attributePath path.Path) diag.Diagnostic
 	// context. Diagnostic paths are intended to be mapped to actual data,
 	// while this path information must be synthesized.
 	return diag.NewErrorDiagnostic(
-		"Invalid Attribute Implementation",
+		diag.SummaryInvalidAttributeImplementation,
 		"When validating the schema, an implementation issue was found. "+
 			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
 			fmt.Sprintf("%q is missing the AttributeTypes or CustomType field on an object Attribute. ", attributePath)+
@@ -33,10 +33,79 @@ func AttributeMissingElementTypeDiag(attributePath path.Path) diag.Diagnostic {
 	// context. Diagnostic paths are intended to be mapped to actual data,
 	// while this path information must be synthesized.
 	return diag.NewErrorDiagnostic(
-		"Invalid Attribute Implementation",
+		diag.SummaryInvalidAttributeImplementation,
 		"When validating the schema, an implementation issue was found. "+
 			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
 			fmt.Sprintf("%q is missing the CustomType or ElementType field on a collection Attribute. ", attributePath)+
 			"One of these fields is required to prevent other unexpected errors or panics.",
 	)
 }
+
+// AttributeConflictingBehaviorDiag returns an error diagnostic to provider
+// developers about setting the Required field alongside the Computed or
+// Optional fields on an Attribute implementation. Terraform rejects these
+// schemas with errors which are difficult to trace back to the attribute.
+func AttributeConflictingBehaviorDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		diag.SummaryInvalidAttributeImplementation,
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q sets the Required field with the Computed or Optional field. ", attributePath)+
+			"Required attributes cannot also be Computed or Optional.",
+	)
+}
+
+// AttributeMissingBehaviorDiag returns an error diagnostic to provider
+// developers about missing all of the Computed, Optional, and Required fields
+// on an Attribute implementation. Terraform rejects these schemas with errors
+// which are difficult to trace back to the attribute.
+func AttributeMissingBehaviorDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		diag.SummaryInvalidAttributeImplementation,
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is missing the Computed, Optional, or Required field. ", attributePath)+
+			"One of these fields is required to describe how the attribute is configured.",
+	)
+}
+
+// AttributeBlockNameConflictDiag returns an error diagnostic to provider
+// developers about an attribute and block using the same name at the same
+// level of the schema. Terraform rejects these schemas with errors which are
+// difficult to trace back to the attribute or block.
+func AttributeBlockNameConflictDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		diag.SummaryInvalidAttributeBlockName,
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is used as both an attribute and block name. ", attributePath)+
+			"Attribute and block names must be unique at each level of the schema.",
+	)
+}
+
+// SetBlockComputedOnlyAttributesDiag returns an error diagnostic to provider
+// developers about a set nested block which only contains Computed
+// attributes that are not configurable. Set elements are identified by
+// their values, so elements without any configurable values cannot be
+// correlated between the configuration, plan, and state.
+func SetBlockComputedOnlyAttributesDiag(blockPath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		diag.SummaryInvalidBlockImplementation,
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is a set nested block which only contains Computed attributes that are not Optional or Required. ", blockPath)+
+			"Set elements are identified by their values, so at least one attribute or block must be configurable.",
+	)
+}
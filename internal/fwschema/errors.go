@@ -12,4 +12,10 @@ var (
 	// ErrPathIsBlock is used with AttributeAtPath is called on a path is a
 	// block, not an attribute. Use blockAtPath on the path instead.
 	ErrPathIsBlock = errors.New("path leads to block, not an attribute")
+
+	// ErrPathIsNestedObject is used with AttributeAtPath is called on a path
+	// that is the object of a nested attribute or block, such as a list
+	// element, not an attribute. Attributes within the object are returned
+	// by extending the path with the attribute name.
+	ErrPathIsNestedObject = errors.New("path leads to nested attribute or block object, not an attribute")
 )
@@ -50,6 +50,16 @@ type AttributeWithMapValidators interface {
 	MapValidators() []validator.Map
 }
 
+// AttributeWithMapKeyValidators is an optional interface on Attribute which
+// enables Map key validation support.
+type AttributeWithMapKeyValidators interface {
+	fwschema.Attribute
+
+	// MapKeyValidators should return a list of String validators, which are
+	// run against each map key.
+	MapKeyValidators() []validator.String
+}
+
 // AttributeWithNumberValidators is an optional interface on Attribute which
 // enables Number validation support.
 type AttributeWithNumberValidators interface {
@@ -139,11 +139,11 @@ func SchemaAttributeAtTerraformPath(ctx context.Context, s Schema, p *tftypes.At
 	case Block:
 		return nil, ErrPathIsBlock
 	case NestedAttributeObject:
-		return nil, ErrPathInsideAtomicAttribute
+		return nil, ErrPathIsNestedObject
 	case NestedBlockObject:
-		return nil, ErrPathInsideAtomicAttribute
+		return nil, ErrPathIsNestedObject
 	case UnderlyingAttributes:
-		return nil, ErrPathInsideAtomicAttribute
+		return nil, ErrPathIsNestedObject
 	default:
 		return nil, fmt.Errorf("got unexpected type %T", rawType)
 	}
@@ -0,0 +1,152 @@
+package fwschema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// WalkFunc is the function signature called for each attribute and block
+// visited by SchemaWalk. Exactly one of the Attribute or Block parameters is
+// non-nil, which distinguishes attributes from blocks.
+//
+// The path expression is the full schema path of the attribute or block.
+// Elements of list, map, and set nesting are represented by the
+// AtAnyListIndex, AtAnyMapKey, and AtAnySetValue expression steps, since
+// schemas have no concrete element data.
+//
+// Returning error diagnostics stops the walk.
+type WalkFunc func(path.Expression, Attribute, Block) diag.Diagnostics
+
+// SchemaWalk is a helper function which calls the given WalkFunc for every
+// attribute and block in the Schema, depth-first. Parents are visited before
+// their nested attributes and blocks and each level is visited in name order.
+func SchemaWalk(ctx context.Context, s Schema, fn WalkFunc) diag.Diagnostics {
+	_, diags := walkAttributesAndBlocks(ctx, path.MatchRoot, s.GetAttributes(), s.GetBlocks(), fn)
+
+	return diags
+}
+
+// SchemaAttributePaths returns the path expressions of all attributes in the
+// Schema, including those nested under attributes and blocks, in SchemaWalk
+// order. Block paths are not included.
+func SchemaAttributePaths(ctx context.Context, s Schema) path.Expressions {
+	var result path.Expressions
+
+	_ = SchemaWalk(ctx, s, func(p path.Expression, a Attribute, _ Block) diag.Diagnostics {
+		if a != nil {
+			result = append(result, p)
+		}
+
+		return nil
+	})
+
+	return result
+}
+
+// walkAttributesAndBlocks visits the given attributes and blocks in name
+// order, using atName to build the path expression of each. The returned
+// boolean is false if the walk should be stopped.
+func walkAttributesAndBlocks(ctx context.Context, atName func(string) path.Expression, attributes map[string]Attribute, blocks map[string]Block, fn WalkFunc) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(attributes)+len(blocks))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		var cont bool
+		var walkDiags diag.Diagnostics
+
+		if attribute, ok := attributes[name]; ok {
+			cont, walkDiags = walkAttribute(ctx, atName(name), attribute, fn)
+		} else {
+			cont, walkDiags = walkBlock(ctx, atName(name), blocks[name], fn)
+		}
+
+		diags.Append(walkDiags...)
+
+		if !cont {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// walkAttribute visits the attribute and any nested attributes.
+func walkAttribute(ctx context.Context, p path.Expression, attribute Attribute, fn WalkFunc) (bool, diag.Diagnostics) {
+	diags := fn(p, attribute, nil)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	nestedAttribute, ok := attribute.(NestedAttribute)
+
+	if !ok {
+		return true, diags
+	}
+
+	var objectPath path.Expression
+
+	switch nestingMode := nestedAttribute.GetNestingMode(); nestingMode {
+	case NestingModeList:
+		objectPath = p.AtAnyListIndex()
+	case NestingModeMap:
+		objectPath = p.AtAnyMapKey()
+	case NestingModeSet:
+		objectPath = p.AtAnySetValue()
+	case NestingModeSingle:
+		objectPath = p
+	default:
+		panic(fmt.Sprintf("unhandled NestingMode: %T", nestingMode))
+	}
+
+	cont, walkDiags := walkAttributesAndBlocks(ctx, objectPath.AtName, nestedAttribute.GetNestedObject().GetAttributes(), nil, fn)
+
+	diags.Append(walkDiags...)
+
+	return cont, diags
+}
+
+// walkBlock visits the block and any nested attributes and blocks.
+func walkBlock(ctx context.Context, p path.Expression, block Block, fn WalkFunc) (bool, diag.Diagnostics) {
+	diags := fn(p, nil, block)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var objectPath path.Expression
+
+	switch nestingMode := block.GetNestingMode(); nestingMode {
+	case BlockNestingModeList:
+		objectPath = p.AtAnyListIndex()
+	case BlockNestingModeSet:
+		objectPath = p.AtAnySetValue()
+	case BlockNestingModeSingle:
+		objectPath = p
+	default:
+		panic(fmt.Sprintf("unhandled BlockNestingMode: %T", nestingMode))
+	}
+
+	nestedObject := block.GetNestedObject()
+
+	cont, walkDiags := walkAttributesAndBlocks(ctx, objectPath.AtName, nestedObject.GetAttributes(), nestedObject.GetBlocks(), fn)
+
+	diags.Append(walkDiags...)
+
+	return cont, diags
+}
@@ -0,0 +1,237 @@
+package fwschema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSchemaWalkSchema contains nested sets of objects under both an
+// attribute and a block, along with other nesting modes.
+var testSchemaWalkSchema = testschema.Schema{
+	Attributes: map[string]fwschema.Attribute{
+		"b_attribute": testschema.Attribute{
+			Optional: true,
+			Type:     types.StringType,
+		},
+		"a_set_nested": testschema.NestedAttribute{
+			NestedObject: testschema.NestedAttributeObject{
+				Attributes: map[string]fwschema.Attribute{
+					"inner_set_nested": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"leaf": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSet,
+						Optional:    true,
+					},
+					"inner_attribute": testschema.Attribute{
+						Optional: true,
+						Type:     types.BoolType,
+					},
+				},
+			},
+			NestingMode: fwschema.NestingModeSet,
+			Optional:    true,
+		},
+		"c_map_nested": testschema.NestedAttribute{
+			NestedObject: testschema.NestedAttributeObject{
+				Attributes: map[string]fwschema.Attribute{
+					"leaf": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			NestingMode: fwschema.NestingModeMap,
+			Optional:    true,
+		},
+	},
+	Blocks: map[string]fwschema.Block{
+		"d_set_block": testschema.Block{
+			NestedObject: testschema.NestedBlockObject{
+				Attributes: map[string]fwschema.Attribute{
+					"leaf": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"single_block": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"leaf": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeSingle,
+					},
+				},
+			},
+			NestingMode: fwschema.BlockNestingModeSet,
+		},
+	},
+}
+
+type testSchemaWalkVisit struct {
+	Path    string
+	IsBlock bool
+}
+
+func TestSchemaWalk(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema         fwschema.Schema
+		walkDiags      map[string]diag.Diagnostics
+		expectedVisits []testSchemaWalkVisit
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty": {
+			schema:         testschema.Schema{},
+			expectedVisits: nil,
+		},
+		"nested-sets": {
+			schema: testSchemaWalkSchema,
+			expectedVisits: []testSchemaWalkVisit{
+				{Path: "a_set_nested"},
+				{Path: "a_set_nested[Value(*)].inner_attribute"},
+				{Path: "a_set_nested[Value(*)].inner_set_nested"},
+				{Path: "a_set_nested[Value(*)].inner_set_nested[Value(*)].leaf"},
+				{Path: "b_attribute"},
+				{Path: "c_map_nested"},
+				{Path: `c_map_nested["*"].leaf`},
+				{Path: "d_set_block", IsBlock: true},
+				{Path: "d_set_block[Value(*)].leaf"},
+				{Path: "d_set_block[Value(*)].single_block", IsBlock: true},
+				{Path: "d_set_block[Value(*)].single_block.leaf"},
+			},
+		},
+		"warning-continues": {
+			schema: testSchemaWalkSchema,
+			walkDiags: map[string]diag.Diagnostics{
+				`c_map_nested["*"].leaf`: {
+					diag.NewWarningDiagnostic("test summary", "test detail"),
+				},
+			},
+			expectedVisits: []testSchemaWalkVisit{
+				{Path: "a_set_nested"},
+				{Path: "a_set_nested[Value(*)].inner_attribute"},
+				{Path: "a_set_nested[Value(*)].inner_set_nested"},
+				{Path: "a_set_nested[Value(*)].inner_set_nested[Value(*)].leaf"},
+				{Path: "b_attribute"},
+				{Path: "c_map_nested"},
+				{Path: `c_map_nested["*"].leaf`},
+				{Path: "d_set_block", IsBlock: true},
+				{Path: "d_set_block[Value(*)].leaf"},
+				{Path: "d_set_block[Value(*)].single_block", IsBlock: true},
+				{Path: "d_set_block[Value(*)].single_block.leaf"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+		},
+		"error-stops": {
+			schema: testSchemaWalkSchema,
+			walkDiags: map[string]diag.Diagnostics{
+				"a_set_nested[Value(*)].inner_set_nested": {
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+			expectedVisits: []testSchemaWalkVisit{
+				{Path: "a_set_nested"},
+				{Path: "a_set_nested[Value(*)].inner_attribute"},
+				{Path: "a_set_nested[Value(*)].inner_set_nested"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotVisits []testSchemaWalkVisit
+
+			gotDiags := fwschema.SchemaWalk(context.Background(), testCase.schema, func(p path.Expression, a fwschema.Attribute, b fwschema.Block) diag.Diagnostics {
+				if (a == nil) == (b == nil) {
+					t.Fatalf("expected exactly one of attribute or block for %s", p)
+				}
+
+				gotVisits = append(gotVisits, testSchemaWalkVisit{
+					Path:    p.String(),
+					IsBlock: b != nil,
+				})
+
+				return testCase.walkDiags[p.String()]
+			})
+
+			if diff := cmp.Diff(gotVisits, testCase.expectedVisits); diff != "" {
+				t.Errorf("unexpected visits difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   fwschema.Schema
+		expected path.Expressions
+	}{
+		"empty": {
+			schema:   testschema.Schema{},
+			expected: nil,
+		},
+		"nested-sets": {
+			schema: testSchemaWalkSchema,
+			expected: path.Expressions{
+				path.MatchRoot("a_set_nested"),
+				path.MatchRoot("a_set_nested").AtAnySetValue().AtName("inner_attribute"),
+				path.MatchRoot("a_set_nested").AtAnySetValue().AtName("inner_set_nested"),
+				path.MatchRoot("a_set_nested").AtAnySetValue().AtName("inner_set_nested").AtAnySetValue().AtName("leaf"),
+				path.MatchRoot("b_attribute"),
+				path.MatchRoot("c_map_nested"),
+				path.MatchRoot("c_map_nested").AtAnyMapKey().AtName("leaf"),
+				path.MatchRoot("d_set_block").AtAnySetValue().AtName("leaf"),
+				path.MatchRoot("d_set_block").AtAnySetValue().AtName("single_block").AtName("leaf"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SchemaAttributePaths(context.Background(), testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
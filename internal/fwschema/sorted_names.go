@@ -0,0 +1,18 @@
+package fwschema
+
+import "sort"
+
+// SortedNames returns the names of the given attributes or blocks in lexical
+// order, which ensures schema diagnostics are returned in a deterministic
+// order.
+func SortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,46 @@
+package fwschema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+)
+
+func TestSortedNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]fwschema.Attribute
+		expected   []string
+	}{
+		"nil": {
+			attributes: nil,
+			expected:   []string{},
+		},
+		"multiple": {
+			attributes: map[string]fwschema.Attribute{
+				"c": testschema.Attribute{},
+				"a": testschema.Attribute{},
+				"b": testschema.Attribute{},
+			},
+			expected: []string{"a", "b", "c"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SortedNames(testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtransform"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
@@ -25,8 +27,10 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 		TerraformValue: configRaw,
 	}
 
-	// Errors are handled as richer diag.Diagnostics instead.
-	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+	// Errors are handled as richer diag.Diagnostics instead. Unchanged
+	// subtrees are shared rather than deep copied, which reduces memory usage
+	// for large values.
+	d.TerraformValue, _, _ = fwtransform.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, bool, error) {
 		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
 
 		diags.Append(fwPathDiags...)
@@ -34,7 +38,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 		// Do not transform if path cannot be converted.
 		// Checking against fwPathDiags will capture all errors.
 		if fwPathDiags.HasError() {
-			return tfTypeValue, nil
+			return tfTypeValue, false, nil
 		}
 
 		configValue, configValueDiags := configData.ValueAtPath(ctx, fwPath)
@@ -43,12 +47,12 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 
 		// Do not transform if rawConfig value cannot be retrieved.
 		if configValueDiags.HasError() {
-			return tfTypeValue, nil
+			return tfTypeValue, false, nil
 		}
 
 		// Do not transform if rawConfig value is not null.
 		if !configValue.IsNull() {
-			return tfTypeValue, nil
+			return tfTypeValue, false, nil
 		}
 
 		attrAtPath, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
@@ -57,16 +61,22 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) {
 				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
 				logging.FrameworkTrace(ctx, "attribute is a non-schema attribute, not setting default")
-				return tfTypeValue, nil
+				return tfTypeValue, false, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathIsNestedObject) {
+				// ignore nested attribute and block objects, they do not have a default field
+				logging.FrameworkTrace(ctx, "attribute is a nested object, not setting default")
+				return tfTypeValue, false, nil
 			}
 
 			if errors.Is(err, fwschema.ErrPathIsBlock) {
 				// ignore blocks, they do not have a computed field
 				logging.FrameworkTrace(ctx, "attribute is a block, not setting default")
-				return tfTypeValue, nil
+				return tfTypeValue, false, nil
 			}
 
-			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+			return tftypes.Value{}, false, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
 		}
 
 		switch a := attrAtPath.(type) {
@@ -76,7 +86,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.BoolResponse{}
 				defaultValue.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithFloat64DefaultValue:
 			defaultValue := a.Float64DefaultValue()
@@ -84,7 +94,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.Float64Response{}
 				defaultValue.DefaultFloat64(ctx, defaults.Float64Request{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithInt64DefaultValue:
 			defaultValue := a.Int64DefaultValue()
@@ -92,7 +102,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.Int64Response{}
 				defaultValue.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithListDefaultValue:
 			defaultValue := a.ListDefaultValue()
@@ -100,7 +110,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.ListResponse{}
 				defaultValue.DefaultList(ctx, defaults.ListRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithMapDefaultValue:
 			defaultValue := a.MapDefaultValue()
@@ -108,7 +118,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.MapResponse{}
 				defaultValue.DefaultMap(ctx, defaults.MapRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithNumberDefaultValue:
 			defaultValue := a.NumberDefaultValue()
@@ -116,7 +126,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.NumberResponse{}
 				defaultValue.DefaultNumber(ctx, defaults.NumberRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithObjectDefaultValue:
 			defaultValue := a.ObjectDefaultValue()
@@ -124,7 +134,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.ObjectResponse{}
 				defaultValue.DefaultObject(ctx, defaults.ObjectRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithSetDefaultValue:
 			defaultValue := a.SetDefaultValue()
@@ -132,7 +142,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.SetResponse{}
 				defaultValue.DefaultSet(ctx, defaults.SetRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		case fwschema.AttributeWithStringDefaultValue:
 			defaultValue := a.StringDefaultValue()
@@ -140,12 +150,20 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 				resp := defaults.StringResponse{}
 				defaultValue.DefaultString(ctx, defaults.StringRequest{}, &resp)
 				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath.String(), resp.PlanValue.String()))
-				return resp.PlanValue.ToTerraformValue(ctx)
+				return defaultTerraformValue(ctx, resp.PlanValue)
 			}
 		}
 
-		return tfTypeValue, nil
+		return tfTypeValue, false, nil
 	})
 
 	return diags
 }
+
+// defaultTerraformValue returns the tftypes.Value of a default value, which
+// is always considered changed for fwtransform.Transform.
+func defaultTerraformValue(ctx context.Context, value attr.Value) (tftypes.Value, bool, error) {
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	return tfValue, true, err
+}
@@ -0,0 +1,83 @@
+package fwschemadata
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// NullifyLegacyZeroValues converts empty string, zero number, and false bool
+// values at paths matching any of the given path expressions to null values.
+// Data written by terraform-plugin-sdk typically contains these zero values
+// where the framework expects null values.
+func (d *Data) NullifyLegacyZeroValues(ctx context.Context, pathExpressions path.Expressions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(pathExpressions) == 0 {
+		return diags
+	}
+
+	// Errors are handled as richer diag.Diagnostics instead.
+	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		// Do not transform if value is not a known zero value. This is
+		// checked first to prevent unnecessary path conversions.
+		if !legacyZeroValue(tfTypeValue) {
+			return tfTypeValue, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		// Do not transform if path cannot be converted.
+		// Checking against fwPathDiags will capture all errors.
+		if fwPathDiags.HasError() {
+			return tfTypeValue, nil
+		}
+
+		// Do not transform if path was not requested.
+		if !pathExpressions.Matches(fwPath) {
+			return tfTypeValue, nil
+		}
+
+		// Transform to null value.
+		logging.FrameworkTrace(ctx, "Transforming legacy zero value to null value", map[string]any{
+			logging.KeyAttributePath: fwPath.String(),
+			logging.KeyDescription:   d.Description.String(),
+		})
+		return tftypes.NewValue(tfTypeValue.Type(), nil), nil
+	})
+
+	return diags
+}
+
+// legacyZeroValue returns true if the value is a known, non-null empty
+// string, zero number, or false bool.
+func legacyZeroValue(tfTypeValue tftypes.Value) bool {
+	if tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
+		return false
+	}
+
+	switch {
+	case tfTypeValue.Type().Is(tftypes.String):
+		var s string
+
+		return tfTypeValue.As(&s) == nil && s == ""
+	case tfTypeValue.Type().Is(tftypes.Number):
+		var n big.Float
+
+		return tfTypeValue.As(&n) == nil && n.Sign() == 0
+	case tfTypeValue.Type().Is(tftypes.Bool):
+		var b bool
+
+		return tfTypeValue.As(&b) == nil && !b
+	default:
+		return false
+	}
+}
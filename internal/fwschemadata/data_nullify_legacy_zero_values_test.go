@@ -0,0 +1,174 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataNullifyLegacyZeroValues(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"bool_attribute": testschema.Attribute{
+				Optional: true,
+				Computed: true,
+				Type:     types.BoolType,
+			},
+			"list_nested_attribute": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_string_attribute": testschema.Attribute{
+							Optional: true,
+							Computed: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+				Optional:    true,
+			},
+			"number_attribute": testschema.Attribute{
+				Optional: true,
+				Computed: true,
+				Type:     types.NumberType,
+			},
+			"string_attribute": testschema.Attribute{
+				Optional: true,
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	nestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_string_attribute": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool_attribute":        tftypes.Bool,
+			"list_nested_attribute": tftypes.List{ElementType: nestedObjectType},
+			"number_attribute":      tftypes.Number,
+			"string_attribute":      tftypes.String,
+		},
+	}
+
+	testValue := func(boolValue, numberValue, stringValue interface{}, nestedStringValues ...interface{}) tftypes.Value {
+		nestedValues := make([]tftypes.Value, 0, len(nestedStringValues))
+
+		for _, nestedStringValue := range nestedStringValues {
+			nestedValues = append(nestedValues, tftypes.NewValue(nestedObjectType, map[string]tftypes.Value{
+				"nested_string_attribute": tftypes.NewValue(tftypes.String, nestedStringValue),
+			}))
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"bool_attribute":        tftypes.NewValue(tftypes.Bool, boolValue),
+			"list_nested_attribute": tftypes.NewValue(tftypes.List{ElementType: nestedObjectType}, nestedValues),
+			"number_attribute":      tftypes.NewValue(tftypes.Number, numberValue),
+			"string_attribute":      tftypes.NewValue(tftypes.String, stringValue),
+		})
+	}
+
+	testCases := map[string]struct {
+		pathExpressions path.Expressions
+		value           tftypes.Value
+		expected        tftypes.Value
+		expectedDiags   diag.Diagnostics
+	}{
+		"no-path-expressions": {
+			value:    testValue(false, 0, "", ""),
+			expected: testValue(false, 0, "", ""),
+		},
+		"null": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("string_attribute"),
+			},
+			value:    tftypes.NewValue(testType, nil),
+			expected: tftypes.NewValue(testType, nil),
+		},
+		"zero-values-matched": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("bool_attribute"),
+				path.MatchRoot("number_attribute"),
+				path.MatchRoot("string_attribute"),
+			},
+			value:    testValue(false, 0, "", ""),
+			expected: testValue(nil, nil, nil, ""),
+		},
+		"zero-values-unmatched": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("string_attribute"),
+			},
+			value:    testValue(false, 0, "", ""),
+			expected: testValue(false, 0, nil, ""),
+		},
+		"non-zero-values": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("bool_attribute"),
+				path.MatchRoot("number_attribute"),
+				path.MatchRoot("string_attribute"),
+			},
+			value:    testValue(true, 1.5, "test"),
+			expected: testValue(true, 1.5, "test"),
+		},
+		"unknown-values": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("string_attribute"),
+			},
+			value:    testValue(false, 0, tftypes.UnknownValue),
+			expected: testValue(false, 0, tftypes.UnknownValue),
+		},
+		"nested-any-list-index": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("list_nested_attribute").AtAnyListIndex().AtName("nested_string_attribute"),
+			},
+			value:    testValue(false, 0, "", "", "test", ""),
+			expected: testValue(false, 0, "", nil, "test", nil),
+		},
+		"nested-list-index": {
+			pathExpressions: path.Expressions{
+				path.MatchRoot("list_nested_attribute").AtListIndex(1).AtName("nested_string_attribute"),
+			},
+			value:    testValue(false, 0, "", "", ""),
+			expected: testValue(false, 0, "", "", nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testCase.value,
+			}
+
+			diags := data.NullifyLegacyZeroValues(context.Background(), testCase.pathExpressions)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
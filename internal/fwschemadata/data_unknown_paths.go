@@ -0,0 +1,48 @@
+package fwschemadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UnknownPaths returns the paths of all unknown values, including those
+// nested in collections and objects. Values under an unknown value are not
+// walked, since only the unknown parent path exists. Paths are sorted
+// lexically based on their string representation.
+func (d Data) UnknownPaths(ctx context.Context) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var paths path.Paths
+
+	_ = tftypes.Walk(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		// If the value is fully known, no need to traverse further since a
+		// deeper value will never be unknown.
+		if tfTypeValue.IsFullyKnown() {
+			return false, nil
+		}
+
+		if tfTypeValue.IsKnown() {
+			return true, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		if !fwPathDiags.HasError() {
+			paths.Append(fwPath)
+		}
+
+		return false, nil
+	})
+
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].String() < paths[j].String()
+	})
+
+	return paths, diags
+}
@@ -0,0 +1,210 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataUnknownPaths(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list":   tftypes.List{ElementType: tftypes.String},
+			"map":    tftypes.Map{ElementType: tftypes.String},
+			"object": testObjectType,
+			"set":    tftypes.Set{ElementType: tftypes.String},
+			"string": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"map": testschema.Attribute{
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"object": testschema.Attribute{
+				Type: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"nested": types.StringType,
+					},
+				},
+				Optional: true,
+			},
+			"set": testschema.Attribute{
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"string": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		result := map[string]tftypes.Value{
+			"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			"map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"object": tftypes.NewValue(testObjectType, nil),
+			"set":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"string": tftypes.NewValue(tftypes.String, "test-value"),
+		}
+
+		for name, value := range values {
+			result[name] = value
+		}
+
+		return tftypes.NewValue(testType, result)
+	}
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: tftypes.NewValue(testType, nil),
+			},
+			expected: nil,
+		},
+		"unknown": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: tftypes.NewValue(testType, tftypes.UnknownValue),
+			},
+			expected: path.Paths{
+				path.Empty(),
+			},
+		},
+		"known": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(nil),
+			},
+			expected: nil,
+		},
+		"attribute-unknown": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(map[string]tftypes.Value{
+					"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+					"string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			expected: path.Paths{
+				path.Root("list"),
+				path.Root("string"),
+			},
+		},
+		"list-element-unknown": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(map[string]tftypes.Value{
+					"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "test-value"),
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				}),
+			},
+			expected: path.Paths{
+				path.Root("list").AtListIndex(1),
+			},
+		},
+		"map-element-unknown": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(map[string]tftypes.Value{
+					"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"key1": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"key2": tftypes.NewValue(tftypes.String, "test-value"),
+						"key3": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				}),
+			},
+			expected: path.Paths{
+				path.Root("map").AtMapKey("key1"),
+				path.Root("map").AtMapKey("key3"),
+			},
+		},
+		"object-attribute-unknown": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(map[string]tftypes.Value{
+					"object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+						"nested": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				}),
+			},
+			expected: path.Paths{
+				path.Root("object").AtName("nested"),
+			},
+		},
+		"object-unknown": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(map[string]tftypes.Value{
+					"object": tftypes.NewValue(testObjectType, tftypes.UnknownValue),
+				}),
+			},
+			expected: path.Paths{
+				path.Root("object"),
+			},
+		},
+		"set-element-unknown": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(map[string]tftypes.Value{
+					"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "test-value"),
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				}),
+			},
+			expected: path.Paths{
+				path.Root("set").AtSetValue(types.StringUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.data.UnknownPaths(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package fwschemadata_test
 
 import (
 	"context"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -1715,6 +1717,64 @@ func TestDataValueAtPath(t *testing.T) {
 			path:     path.Root("test"),
 			expected: types.StringValue("value"),
 		},
+		"WithAttributeName-Float64-precision-loss": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Number,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(1<<53+1)),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.Float64Type,
+							Required: true,
+						},
+					},
+				},
+			},
+			path:     path.Root("test"),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Float64 Type Validation Error",
+					"Value 9007199254740993 cannot be represented as a 64-bit floating point without losing precision."+
+						" The provider developer can use a Number attribute to support arbitrary precision.",
+				),
+			},
+		},
+		"WithAttributeName-Int64-out-of-range": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Number,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.Number, new(big.Float).Add(new(big.Float).SetInt64(math.MaxInt64), big.NewFloat(1))),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.Int64Type,
+							Required: true,
+						},
+					},
+				},
+			},
+			path:     path.Root("test"),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"Value 9223372036854775808 cannot be represented as a 64-bit integer."+
+						" The provider developer can use a Number attribute to support arbitrary precision.",
+				),
+			},
+		},
 		"AttrTypeWithValidateError": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
@@ -44,7 +44,7 @@ func CreateParentTerraformValue(_ context.Context, parentPath path.Path, parentT
 	default:
 		diags.AddAttributeError(
 			parentPath,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 				fmt.Sprintf("Unknown parent type %s to create value.", parentType),
 		)
@@ -70,7 +70,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if !parentValue.Type().Is(tftypes.Object{}) {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Cannot add attribute into parent type: %s", parentValue.Type()),
 			)
@@ -83,7 +83,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if err != nil {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Unable to extract object elements from parent value: %s", err),
 			)
@@ -97,7 +97,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if !parentValue.Type().Is(tftypes.List{}) {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Cannot add list element into parent type: %s", parentValue.Type()),
 			)
@@ -110,7 +110,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if err != nil {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Unable to extract list elements from parent value: %s", err),
 			)
@@ -120,7 +120,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if int(childStep) > len(parentElems) {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Cannot add list element %d as list currently has %d length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.", int(childStep)+1, len(parentElems)),
 			)
@@ -139,7 +139,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if !parentValue.Type().Is(tftypes.Map{}) {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Cannot add map value into parent type: %s", parentValue.Type()),
 			)
@@ -152,7 +152,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if err != nil {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Unable to extract map elements from parent value: %s", err),
 			)
@@ -166,7 +166,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if !parentValue.Type().Is(tftypes.Set{}) {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Cannot add set element into parent type: %s", parentValue.Type()),
 			)
@@ -179,7 +179,7 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		if err != nil {
 			diags.AddAttributeError(
 				parentPath,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					fmt.Sprintf("Unable to extract set elements from parent value: %s", err),
 			)
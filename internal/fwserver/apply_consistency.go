@@ -0,0 +1,121 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// applyConsistencyDiagnostics returns error diagnostics for each value in the
+// new state which does not match a known planned value or remains unknown,
+// after the given operation. Terraform also rejects these values after apply,
+// but without the planned and applied values and without attributing the
+// issue to a specific attribute in many cases. Sensitive values are redacted.
+func applyConsistencyDiagnostics(ctx context.Context, operation string, plan *tfsdk.Plan, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan == nil || state == nil || plan.Schema == nil || plan.Raw.IsNull() || state.Raw.IsNull() {
+		return diags
+	}
+
+	for _, inconsistency := range tfvalue.Inconsistencies(plan.Raw, state.Raw) {
+		plannedValue := "(sensitive value)"
+		appliedValue := "(sensitive value)"
+
+		if !sensitiveTerraformValue(ctx, plan.Schema, inconsistency.Path, inconsistency.Planned, inconsistency.Applied) {
+			plannedValue = terraformValueString(ctx, plan.Schema, inconsistency.Path, inconsistency.Planned)
+			appliedValue = terraformValueString(ctx, plan.Schema, inconsistency.Path, inconsistency.Applied)
+		}
+
+		summary := "Provider Produced Inconsistent Result"
+		detail := fmt.Sprintf("After %s, the provider returned a value which does not match the planned value. ", operation) +
+			"Known planned values must not change and all values must be known after applying changes. " +
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+			fmt.Sprintf("Planned Value: %s\n", plannedValue) +
+			fmt.Sprintf("Applied Value: %s", appliedValue)
+
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, inconsistency.Path, plan.Schema)
+
+		if pathDiags.HasError() {
+			diags.AddError(summary, fwdiag.ReportableErrorDetail(ctx, detail+fmt.Sprintf("\nPath: %s", inconsistency.Path)))
+
+			continue
+		}
+
+		diags.AddAttributeError(attributePath, summary, fwdiag.ReportableErrorDetail(ctx, detail))
+	}
+
+	return diags
+}
+
+// sensitiveTerraformValue returns true if the attribute at the path, any
+// parent attribute, or any attribute within the values is sensitive.
+func sensitiveTerraformValue(ctx context.Context, s fwschema.Schema, tfPath *tftypes.AttributePath, values ...tftypes.Value) bool {
+	steps := tfPath.Steps()
+
+	for i := range steps {
+		if sensitiveAttributeAtTerraformPath(ctx, s, tftypes.NewAttributePathWithSteps(steps[:i+1])) {
+			return true
+		}
+	}
+
+	for _, value := range values {
+		sensitive := false
+
+		_ = tftypes.Walk(value, func(valuePath *tftypes.AttributePath, _ tftypes.Value) (bool, error) {
+			if len(valuePath.Steps()) == 0 {
+				return true, nil
+			}
+
+			nestedSteps := make([]tftypes.AttributePathStep, 0, len(steps)+len(valuePath.Steps()))
+			nestedSteps = append(nestedSteps, steps...)
+			nestedSteps = append(nestedSteps, valuePath.Steps()...)
+
+			if sensitiveAttributeAtTerraformPath(ctx, s, tftypes.NewAttributePathWithSteps(nestedSteps)) {
+				sensitive = true
+			}
+
+			return !sensitive, nil
+		})
+
+		if sensitive {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sensitiveAttributeAtTerraformPath returns true if the path is an attribute
+// which is sensitive, including Sensitive inherited from a parent nested
+// attribute.
+func sensitiveAttributeAtTerraformPath(ctx context.Context, s fwschema.Schema, tfPath *tftypes.AttributePath) bool {
+	return fwschema.SchemaSensitiveAtTerraformPath(ctx, s, tfPath)
+}
+
+// terraformValueString returns a human-readable representation of the value
+// using the framework type at the path, such as "hello" rather than
+// tftypes.String<"hello">.
+func terraformValueString(ctx context.Context, s fwschema.Schema, tfPath *tftypes.AttributePath, value tftypes.Value) string {
+	attrType, err := s.TypeAtTerraformPath(ctx, tfPath)
+
+	if err != nil {
+		return value.String()
+	}
+
+	attrValue, err := attrType.ValueFromTerraform(ctx, value)
+
+	if err != nil {
+		return value.String()
+	}
+
+	return attrValue.String()
+}
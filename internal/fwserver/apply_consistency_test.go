@@ -0,0 +1,205 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApplyConsistencyDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Optional: true,
+						},
+						"token": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	ruleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port":  tftypes.Number,
+			"token": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":       tftypes.String,
+			"password": tftypes.String,
+			"rules":    tftypes.List{ElementType: ruleType},
+			"tags":     tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+
+	testValue := func(id interface{}, password interface{}, rules []tftypes.Value, tags map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, id),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"rules":    tftypes.NewValue(tftypes.List{ElementType: ruleType}, rules),
+			"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tags),
+		})
+	}
+	ruleValue := func(port interface{}, token interface{}) tftypes.Value {
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"port":  tftypes.NewValue(tftypes.Number, port),
+			"token": tftypes.NewValue(tftypes.String, token),
+		})
+	}
+	detail := func(planned, applied string) string {
+		return "After Create, the provider returned a value which does not match the planned value. " +
+			"Known planned values must not change and all values must be known after applying changes. " +
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+			"Planned Value: " + planned + "\n" +
+			"Applied Value: " + applied
+	}
+
+	testCases := map[string]struct {
+		planned  tftypes.Value
+		applied  tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"consistent": {
+			planned: testValue("test-id", "secret", []tftypes.Value{ruleValue(80, "token")}, nil),
+			applied: testValue("test-id", "secret", []tftypes.Value{ruleValue(80, "token")}, nil),
+		},
+		"unknown-planned-known-applied": {
+			planned: testValue(tftypes.UnknownValue, nil, nil, nil),
+			applied: testValue("test-id", nil, nil, nil),
+		},
+		"unknown-planned-unknown-applied": {
+			planned: testValue(tftypes.UnknownValue, nil, nil, nil),
+			applied: testValue(tftypes.UnknownValue, nil, nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Provider Produced Inconsistent Result",
+					detail("<unknown>", "<unknown>"),
+				),
+			},
+		},
+		"known-planned-changed": {
+			planned: testValue("test-id", nil, nil, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "planned"),
+			}),
+			applied: testValue("test-id", nil, nil, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "applied"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags").AtMapKey("key"),
+					"Provider Produced Inconsistent Result",
+					detail(`"planned"`, `"applied"`),
+				),
+			},
+		},
+		"known-planned-null-applied": {
+			planned: testValue("test-id", nil, nil, nil),
+			applied: testValue(nil, nil, nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Provider Produced Inconsistent Result",
+					detail(`"test-id"`, "<null>"),
+				),
+			},
+		},
+		"nested-changed": {
+			planned: testValue("test-id", nil, []tftypes.Value{ruleValue(80, nil), ruleValue(443, nil)}, nil),
+			applied: testValue("test-id", nil, []tftypes.Value{ruleValue(80, nil), ruleValue(8443, nil)}, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("rules").AtListIndex(1).AtName("port"),
+					"Provider Produced Inconsistent Result",
+					detail("443", "8443"),
+				),
+			},
+		},
+		"sensitive-changed": {
+			planned: testValue("test-id", "planned", nil, nil),
+			applied: testValue("test-id", "applied", nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("password"),
+					"Provider Produced Inconsistent Result",
+					detail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+		"sensitive-nested-changed": {
+			planned: testValue("test-id", nil, []tftypes.Value{ruleValue(80, "planned")}, nil),
+			applied: testValue("test-id", nil, []tftypes.Value{ruleValue(80, "applied")}, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("rules").AtListIndex(0).AtName("token"),
+					"Provider Produced Inconsistent Result",
+					detail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+		"sensitive-descendant-changed": {
+			planned: testValue("test-id", nil, []tftypes.Value{ruleValue(80, "token")}, nil),
+			applied: testValue("test-id", nil, []tftypes.Value{ruleValue(80, "token"), ruleValue(443, "token")}, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("rules"),
+					"Provider Produced Inconsistent Result",
+					detail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := &tfsdk.Plan{
+				Raw:    testCase.planned,
+				Schema: testSchema,
+			}
+			state := &tfsdk.State{
+				Raw:    testCase.applied,
+				Schema: testSchema,
+			}
+
+			got := applyConsistencyDiagnostics(context.Background(), "Create", plan, state)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
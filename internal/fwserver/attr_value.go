@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,7 +19,7 @@ func coerceListValue(ctx context.Context, schemaPath path.Path, value attr.Value
 
 	if !ok {
 		return types.ListNull(nil), diag.Diagnostics{
-			attributePlanModificationWalkError(schemaPath, value),
+			attributePlanModificationWalkError(ctx, schemaPath, value),
 		}
 	}
 
@@ -30,7 +31,7 @@ func coerceMapValue(ctx context.Context, schemaPath path.Path, value attr.Value)
 
 	if !ok {
 		return types.MapNull(nil), diag.Diagnostics{
-			attributePlanModificationWalkError(schemaPath, value),
+			attributePlanModificationWalkError(ctx, schemaPath, value),
 		}
 	}
 
@@ -42,7 +43,7 @@ func coerceObjectValue(ctx context.Context, schemaPath path.Path, value attr.Val
 
 	if !ok {
 		return types.ObjectNull(nil), diag.Diagnostics{
-			attributePlanModificationWalkError(schemaPath, value),
+			attributePlanModificationWalkError(ctx, schemaPath, value),
 		}
 	}
 
@@ -54,7 +55,7 @@ func coerceSetValue(ctx context.Context, schemaPath path.Path, value attr.Value)
 
 	if !ok {
 		return types.SetNull(nil), diag.Diagnostics{
-			attributePlanModificationWalkError(schemaPath, value),
+			attributePlanModificationWalkError(ctx, schemaPath, value),
 		}
 	}
 
@@ -151,6 +152,9 @@ func objectAttributeValueFromTerraformValue(ctx context.Context, object types.Ob
 	return elemValue, nil
 }
 
+// setElemObject returns the object of the set element at the given index,
+// such as an index returned by setElemCorrelation. A null object is returned
+// if the index is negative or not in the set.
 func setElemObject(ctx context.Context, schemaPath path.Path, set types.Set, index int, description fwschemadata.DataDescription) (types.Object, diag.Diagnostics) {
 	if set.IsNull() {
 		return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, nil)
@@ -160,7 +164,7 @@ func setElemObject(ctx context.Context, schemaPath path.Path, set types.Set, ind
 		return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, tftypes.UnknownValue)
 	}
 
-	if index >= len(set.Elements()) {
+	if index < 0 || index >= len(set.Elements()) {
 		return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, nil)
 	}
 
@@ -179,3 +183,150 @@ func setElemObjectFromTerraformValue(ctx context.Context, schemaPath path.Path,
 
 	return coerceObjectValue(ctx, schemaPath, elemValue)
 }
+
+// setElemCorrelation returns the index of the set element which correlates
+// with each of the given planned set elements, or -1 if there is no
+// correlated element, such as when an element was added or edited. Set
+// elements are identified by their value, so elements are first correlated
+// by equal values, then by each of the given matches functions in order for
+// elements which differ, such as planned elements with unknown computed
+// values. Each set element is correlated with at most one planned element.
+func setElemCorrelation(ctx context.Context, planElements []attr.Value, set types.Set, matchesFuncs ...func(element types.Object, planElement types.Object) bool) []int {
+	result := make([]int, len(planElements))
+
+	for planIdx := range result {
+		result[planIdx] = -1
+	}
+
+	if set.IsNull() || set.IsUnknown() {
+		return result
+	}
+
+	elements := set.Elements()
+	correlated := make([]bool, len(elements))
+
+	for planIdx, planElement := range planElements {
+		for idx, element := range elements {
+			if correlated[idx] || !element.Equal(planElement) {
+				continue
+			}
+
+			result[planIdx] = idx
+			correlated[idx] = true
+
+			break
+		}
+	}
+
+	for _, matches := range matchesFuncs {
+		for planIdx, planElement := range planElements {
+			if result[planIdx] != -1 {
+				continue
+			}
+
+			planObject, diags := coerceObjectValue(ctx, path.Empty(), planElement)
+
+			if diags.HasError() || planObject.IsNull() || planObject.IsUnknown() {
+				continue
+			}
+
+			for idx, element := range elements {
+				if correlated[idx] {
+					continue
+				}
+
+				object, diags := coerceObjectValue(ctx, path.Empty(), element)
+
+				if diags.HasError() || object.IsNull() || object.IsUnknown() {
+					continue
+				}
+
+				if !matches(object, planObject) {
+					continue
+				}
+
+				result[planIdx] = idx
+				correlated[idx] = true
+
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// setElemConfigMatches returns true if all non-null attribute values of the
+// configuration set element equal the planned set element values. Null
+// configuration values may have been planned as a default or computed value.
+func setElemConfigMatches(config types.Object, plan types.Object) bool {
+	planAttributes := plan.Attributes()
+
+	for name, configAttribute := range config.Attributes() {
+		if configAttribute.IsNull() {
+			continue
+		}
+
+		if !configAttribute.Equal(planAttributes[name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setElemStateMatches returns a function which returns true if all known
+// planned values of attributes which are not computed equal the prior state
+// set element values. Computed values may have changed since the prior
+// state or may not yet be determined, so they do not identify the element.
+func setElemStateMatches(attributes fwschema.UnderlyingAttributes) func(state types.Object, plan types.Object) bool {
+	return func(state types.Object, plan types.Object) bool {
+		stateAttributes := state.Attributes()
+
+		for name, planAttribute := range plan.Attributes() {
+			if attribute, ok := attributes[name]; ok && attribute.IsComputed() {
+				continue
+			}
+
+			if planAttribute.IsUnknown() {
+				continue
+			}
+
+			if !planAttribute.Equal(stateAttributes[name]) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// setElemRequiredMatches returns a function which returns true if the
+// planned values of all required attributes are known and equal the prior
+// state set element values. Required attributes are always configured and
+// never computed, so they identify an element whose optional or computed
+// values were edited, such as an optional attribute with a RequiresReplace
+// plan modifier. Elements without required attributes are never matched.
+func setElemRequiredMatches(attributes fwschema.UnderlyingAttributes) func(state types.Object, plan types.Object) bool {
+	return func(state types.Object, plan types.Object) bool {
+		stateAttributes := state.Attributes()
+		planAttributes := plan.Attributes()
+		matched := false
+
+		for name, attribute := range attributes {
+			if !attribute.IsRequired() {
+				continue
+			}
+
+			planAttribute, ok := planAttributes[name]
+
+			if !ok || planAttribute.IsUnknown() || !planAttribute.Equal(stateAttributes[name]) {
+				return false
+			}
+
+			matched = true
+		}
+
+		return matched
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -242,10 +243,22 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 
 		planElements := planSet.Elements()
 
+		// Set elements are identified by their value, so configuration and
+		// prior state elements are correlated with planned elements by value,
+		// then by required attribute values, rather than by position.
+		configIndices := setElemCorrelation(ctx, planElements, configSet, setElemConfigMatches)
+		stateIndices := setElemCorrelation(
+			ctx,
+			planElements,
+			stateSet,
+			setElemStateMatches(nestedAttributeObject.GetAttributes()),
+			setElemRequiredMatches(nestedAttributeObject.GetAttributes()),
+		)
+
 		for idx, planElem := range planElements {
 			attrPath := req.AttributePath.AtSetValue(planElem)
 
-			configObject, diags := setElemObject(ctx, attrPath, configSet, idx, fwschemadata.DataDescriptionConfiguration)
+			configObject, diags := setElemObject(ctx, attrPath, configSet, configIndices[idx], fwschemadata.DataDescriptionConfiguration)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -261,7 +274,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				return
 			}
 
-			stateObject, diags := setElemObject(ctx, attrPath, stateSet, idx, fwschemadata.DataDescriptionState)
+			stateObject, diags := setElemObject(ctx, attrPath, stateSet, stateIndices[idx], fwschemadata.DataDescriptionState)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -290,7 +303,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			planElements[idx] = objectResp.AttributePlan
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
-			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
+			resp.RequiresReplace.Append(setElementRequiresReplace(attrPath, objectResp.AttributePlan, objectResp.RequiresReplace)...)
 		}
 
 		resp.AttributePlan, diags = types.SetValue(planSet.ElementType(ctx), planElements)
@@ -441,7 +454,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
 			"Attribute Plan Modification Error",
-			"Attribute plan modifier cannot walk schema. Report this to the provider developer:\n\n"+err.Error(),
+			fwdiag.ReportableErrorDetail(ctx, "Attribute plan modifier cannot walk schema. Report this to the provider developer:\n\n"+err.Error()),
 		)
 
 		return
@@ -457,14 +470,14 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	configValuable, ok := req.AttributeConfig.(basetypes.BoolValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Bool Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Bool attribute plan modification. "+
-				"The value type must implement the basetypes.BoolValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Bool attribute plan modification",
+			"basetypes.BoolValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -482,14 +495,14 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	planValuable, ok := req.AttributePlan.(basetypes.BoolValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Bool Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Bool attribute plan modification. "+
-				"The value type must implement the basetypes.BoolValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Bool attribute plan modification",
+			"basetypes.BoolValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -507,14 +520,14 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	stateValuable, ok := req.AttributeState.(basetypes.BoolValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Bool Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Bool attribute plan modification. "+
-				"The value type must implement the basetypes.BoolValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Bool attribute plan modification",
+			"basetypes.BoolValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -557,7 +570,9 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		planModifier.PlanModifyBool(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Bool")
+		planModifier.PlanModifyBool(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -592,14 +607,14 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	configValuable, ok := req.AttributeConfig.(basetypes.Float64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Float64 Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Float64 attribute plan modification. "+
-				"The value type must implement the basetypes.Float64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Float64 attribute plan modification",
+			"basetypes.Float64Valuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -617,14 +632,14 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	planValuable, ok := req.AttributePlan.(basetypes.Float64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Float64 Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Float64 attribute plan modification. "+
-				"The value type must implement the basetypes.Float64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Float64 attribute plan modification",
+			"basetypes.Float64Valuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -642,14 +657,14 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	stateValuable, ok := req.AttributeState.(basetypes.Float64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Float64 Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Float64 attribute plan modification. "+
-				"The value type must implement the basetypes.Float64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Float64 attribute plan modification",
+			"basetypes.Float64Valuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -692,7 +707,9 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 			},
 		)
 
-		planModifier.PlanModifyFloat64(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Float64")
+		planModifier.PlanModifyFloat64(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -727,14 +744,14 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 	configValuable, ok := req.AttributeConfig.(basetypes.Int64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Int64 Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Int64 attribute plan modification. "+
-				"The value type must implement the basetypes.Int64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Int64 attribute plan modification",
+			"basetypes.Int64Valuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -752,14 +769,14 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 	planValuable, ok := req.AttributePlan.(basetypes.Int64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Int64 Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Int64 attribute plan modification. "+
-				"The value type must implement the basetypes.Int64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Int64 attribute plan modification",
+			"basetypes.Int64Valuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -777,14 +794,14 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 	stateValuable, ok := req.AttributeState.(basetypes.Int64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Int64 Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Int64 attribute plan modification. "+
-				"The value type must implement the basetypes.Int64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Int64 attribute plan modification",
+			"basetypes.Int64Valuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -827,7 +844,9 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		planModifier.PlanModifyInt64(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Int64")
+		planModifier.PlanModifyInt64(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -862,14 +881,14 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 	configValuable, ok := req.AttributeConfig.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform List attribute plan modification. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"List attribute plan modification",
+			"basetypes.ListValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -887,14 +906,14 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 	planValuable, ok := req.AttributePlan.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform List attribute plan modification. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"List attribute plan modification",
+			"basetypes.ListValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -912,14 +931,14 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 	stateValuable, ok := req.AttributeState.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform List attribute plan modification. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"List attribute plan modification",
+			"basetypes.ListValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -962,7 +981,9 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.List")
+		planModifier.PlanModifyList(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -997,14 +1018,14 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 	configValuable, ok := req.AttributeConfig.(basetypes.MapValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Map Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Map attribute plan modification. "+
-				"The value type must implement the basetypes.MapValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Map attribute plan modification",
+			"basetypes.MapValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -1022,14 +1043,14 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 	planValuable, ok := req.AttributePlan.(basetypes.MapValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Map Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Map attribute plan modification. "+
-				"The value type must implement the basetypes.MapValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Map attribute plan modification",
+			"basetypes.MapValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -1047,14 +1068,14 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 	stateValuable, ok := req.AttributeState.(basetypes.MapValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Map Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Map attribute plan modification. "+
-				"The value type must implement the basetypes.MapValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Map attribute plan modification",
+			"basetypes.MapValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -1097,7 +1118,9 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		planModifier.PlanModifyMap(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Map")
+		planModifier.PlanModifyMap(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1132,14 +1155,14 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 	configValuable, ok := req.AttributeConfig.(basetypes.NumberValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Number Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Number attribute plan modification. "+
-				"The value type must implement the basetypes.NumberValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Number attribute plan modification",
+			"basetypes.NumberValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -1157,14 +1180,14 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 	planValuable, ok := req.AttributePlan.(basetypes.NumberValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Number Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Number attribute plan modification. "+
-				"The value type must implement the basetypes.NumberValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Number attribute plan modification",
+			"basetypes.NumberValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -1182,14 +1205,14 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 	stateValuable, ok := req.AttributeState.(basetypes.NumberValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Number Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Number attribute plan modification. "+
-				"The value type must implement the basetypes.NumberValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Number attribute plan modification",
+			"basetypes.NumberValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -1232,7 +1255,9 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyNumber(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Number")
+		planModifier.PlanModifyNumber(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1267,14 +1292,14 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 	configValuable, ok := req.AttributeConfig.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Object attribute plan modification. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Object attribute plan modification",
+			"basetypes.ObjectValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -1292,14 +1317,14 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 	planValuable, ok := req.AttributePlan.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Object attribute plan modification. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Object attribute plan modification",
+			"basetypes.ObjectValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -1317,14 +1342,14 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 	stateValuable, ok := req.AttributeState.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Object attribute plan modification. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Object attribute plan modification",
+			"basetypes.ObjectValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -1367,7 +1392,9 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Object")
+		planModifier.PlanModifyObject(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1402,14 +1429,14 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 	configValuable, ok := req.AttributeConfig.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Set attribute plan modification. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Set attribute plan modification",
+			"basetypes.SetValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -1427,14 +1454,14 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 	planValuable, ok := req.AttributePlan.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Set attribute plan modification. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Set attribute plan modification",
+			"basetypes.SetValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -1452,14 +1479,14 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 	stateValuable, ok := req.AttributeState.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Set attribute plan modification. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Set attribute plan modification",
+			"basetypes.SetValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -1502,7 +1529,9 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Set")
+		planModifier.PlanModifySet(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1537,14 +1566,14 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 	configValuable, ok := req.AttributeConfig.(basetypes.StringValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid String Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform String attribute plan modification. "+
-				"The value type must implement the basetypes.StringValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"String attribute plan modification",
+			"basetypes.StringValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -1562,14 +1591,14 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 	planValuable, ok := req.AttributePlan.(basetypes.StringValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid String Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform String attribute plan modification. "+
-				"The value type must implement the basetypes.StringValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"String attribute plan modification",
+			"basetypes.StringValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -1587,14 +1616,14 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 	stateValuable, ok := req.AttributeState.(basetypes.StringValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid String Attribute Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform String attribute plan modification. "+
-				"The value type must implement the basetypes.StringValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"String attribute plan modification",
+			"basetypes.StringValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -1637,7 +1666,9 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyString(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.String")
+		planModifier.PlanModifyString(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1681,7 +1712,9 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 				},
 			)
 
-			objectValidator.PlanModifyObject(ctx, req, planModifyResp)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Object")
+			objectValidator.PlanModifyObject(methodCtx, req, planModifyResp)
+			afterProviderMethod()
 
 			logging.FrameworkDebug(
 				ctx,
@@ -1769,18 +1802,69 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 func attributePlanModificationValueError(ctx context.Context, value attr.Value, description fwschemadata.DataDescription, err error) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Attribute Plan Modification "+description.Title()+" Value Error",
-		"An unexpected error occurred while fetching a "+value.Type(ctx).String()+" element value in the "+description.String()+". "+
-			"This is an issue with the provider and should be reported to the provider developers.\n\n"+
-			"Original Error: "+err.Error(),
+		fwdiag.ReportableErrorDetail(
+			ctx,
+			"An unexpected error occurred while fetching a "+value.Type(ctx).String()+" element value in the "+description.String()+". "+
+				"This is an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Original Error: "+err.Error(),
+		),
 	)
 }
 
-func attributePlanModificationWalkError(schemaPath path.Path, value attr.Value) diag.Diagnostic {
+func attributePlanModificationWalkError(ctx context.Context, schemaPath path.Path, value attr.Value) diag.Diagnostic {
 	return diag.NewAttributeErrorDiagnostic(
 		schemaPath,
 		"Attribute Plan Modification Walk Error",
-		"An unexpected error occurred while walking the schema for attribute plan modification. "+
-			"This is an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
-			fmt.Sprintf("unknown attribute value type (%T) at path: %s", value, schemaPath),
+		fwdiag.ReportableErrorDetail(
+			ctx,
+			"An unexpected error occurred while walking the schema for attribute plan modification. "+
+				"This is an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("unknown attribute value type (%T) at path: %s", value, schemaPath),
+		),
 	)
 }
+
+// setElementRequiresReplace returns the given paths with any path beneath
+// the set element path updated to reference the modified element value.
+// Set elements are identified by their value, so paths referencing the
+// element value prior to nested plan modification would not be found in the
+// planned value returned to Terraform.
+func setElementRequiresReplace(elementPath path.Path, modifiedElement attr.Value, paths path.Paths) path.Paths {
+	elementSteps := elementPath.Steps()
+	modifiedElementPath := elementPath.ParentPath().AtSetValue(modifiedElement)
+
+	if modifiedElementPath.Equal(elementPath) {
+		return paths
+	}
+
+	result := make(path.Paths, 0, len(paths))
+
+	for _, p := range paths {
+		steps := p.Steps()
+
+		if len(steps) < len(elementSteps) || !steps[:len(elementSteps)].Equal(elementSteps) {
+			result = append(result, p)
+
+			continue
+		}
+
+		updatedPath := modifiedElementPath
+
+		for _, step := range steps[len(elementSteps):] {
+			switch s := step.(type) {
+			case path.PathStepAttributeName:
+				updatedPath = updatedPath.AtName(string(s))
+			case path.PathStepElementKeyInt:
+				updatedPath = updatedPath.AtListIndex(int(s))
+			case path.PathStepElementKeyString:
+				updatedPath = updatedPath.AtMapKey(string(s))
+			case path.PathStepElementKeyValue:
+				updatedPath = updatedPath.AtSetValue(s.Value)
+			}
+		}
+
+		result = append(result, updatedPath)
+	}
+
+	return result
+}
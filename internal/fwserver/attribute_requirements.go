@@ -0,0 +1,87 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// AttributeRequirementsValidate checks all attribute requirements against
+// the remote system capabilities. Attributes matching a requirement path
+// expression which are configured with a known, non-null value return an
+// error diagnostic for each unsatisfied requirement. Requirements are not
+// checked when capabilities is nil.
+func AttributeRequirementsValidate(ctx context.Context, capabilities *capability.Capabilities, config tfsdk.Config, requirements []capability.AttributeRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if capabilities == nil {
+		logging.FrameworkTrace(ctx, "Skipping attribute requirements as provider did not set capabilities")
+
+		return diags
+	}
+
+	if config.Raw.IsNull() {
+		return diags
+	}
+
+	configData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         config.Schema,
+		TerraformValue: config.Raw,
+	}
+
+	for _, requirement := range requirements {
+		matchedPaths, matchedPathsDiags := configData.PathMatches(ctx, requirement.PathExpression)
+
+		diags.Append(matchedPathsDiags...)
+
+		if matchedPathsDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			value, valueDiags := configData.ValueAtPath(ctx, matchedPath)
+
+			diags.Append(valueDiags...)
+
+			if valueDiags.HasError() {
+				continue
+			}
+
+			if value == nil || value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			var unsatisfied []string
+
+			for _, r := range requirement.Requirements {
+				if r.Satisfied(ctx, capabilities) {
+					continue
+				}
+
+				unsatisfied = append(unsatisfied, r.Description(ctx))
+			}
+
+			if len(unsatisfied) == 0 {
+				continue
+			}
+
+			diags.AddAttributeError(
+				matchedPath,
+				diag.SummaryAttributeNotSupported,
+				fmt.Sprintf("The attribute %s is configured, but is not supported by the configured endpoint. ", matchedPath)+
+					"Remove the attribute from the configuration or upgrade the endpoint.\n\n"+
+					fmt.Sprintf("Unsatisfied Requirements: %s\n", strings.Join(unsatisfied, ", "))+
+					fmt.Sprintf("Endpoint Capabilities: %s", capabilities),
+			)
+		}
+	}
+
+	return diags
+}
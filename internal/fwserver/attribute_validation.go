@@ -3,9 +3,11 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -56,7 +58,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
 			"Invalid Attribute Definition",
-			"Attribute missing Required, Optional, or Computed definition. This is always a problem with the provider and should be reported to the provider developer.",
+			fwdiag.ReportableErrorDetail(ctx, "Attribute missing Required, Optional, or Computed definition. This is always a problem with the provider and should be reported to the provider developer."),
 		)
 
 		return
@@ -83,7 +85,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	if a.IsComputed() && !a.IsOptional() && !attributeConfig.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
-			"Invalid Configuration for Read-Only Attribute",
+			diag.SummaryInvalidConfigurationForReadOnlyAttribute,
 			"Cannot set value for this attribute as the provider has marked it as read-only. Remove the configuration line setting the value.\n\n"+
 				"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
 		)
@@ -97,7 +99,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	if a.IsRequired() && attributeConfig.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
-			"Missing Configuration for Required Attribute",
+			diag.SummaryMissingConfigurationForRequiredAttribute,
 			fmt.Sprintf("Must set a configuration value for the %s attribute as the provider has marked it as required.\n\n", req.AttributePath.String())+
 				"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
 		)
@@ -126,14 +128,30 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		AttributeValidateString(ctx, attributeWithValidators, req, resp)
 	}
 
+	if attributeWithMapKeyValidators, ok := a.(fwxschema.AttributeWithMapKeyValidators); ok {
+		AttributeValidateMapKeys(ctx, attributeWithMapKeyValidators, req, resp)
+	}
+
 	AttributeValidateNestedAttributes(ctx, a, req, resp)
 
 	// Show deprecation warnings only for known values.
 	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
+		deprecationMessage := a.GetDeprecationMessage()
+
+		if attributeWithReplacement, ok := a.(fwschema.AttributeWithDeprecationReplacement); ok {
+			replacement := attributeWithReplacement.GetDeprecationReplacement()
+
+			if len(replacement.Steps()) > 0 {
+				replacementPath := req.AttributePathExpression.Merge(replacement).Resolve()
+
+				deprecationMessage += fmt.Sprintf("\n\nReplacement Attribute: %s", replacementPath)
+			}
+		}
+
 		resp.Diagnostics.AddAttributeWarning(
 			req.AttributePath,
 			"Attribute Deprecated",
-			a.GetDeprecationMessage(),
+			deprecationMessage,
 		)
 	}
 }
@@ -147,14 +165,14 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 	configValuable, ok := req.AttributeConfig.(basetypes.BoolValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Bool Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Bool attribute validation. "+
-				"The value type must implement the basetypes.BoolValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Bool attribute validation",
+			"basetypes.BoolValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -189,7 +207,9 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
-		attributeValidator.ValidateBool(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Bool")
+		attributeValidator.ValidateBool(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -212,14 +232,14 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 	configValuable, ok := req.AttributeConfig.(basetypes.Float64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Float64 Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Float64 attribute validation. "+
-				"The value type must implement the basetypes.Float64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Float64 attribute validation",
+			"basetypes.Float64Valuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -254,7 +274,9 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		attributeValidator.ValidateFloat64(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Float64")
+		attributeValidator.ValidateFloat64(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -277,14 +299,14 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 	configValuable, ok := req.AttributeConfig.(basetypes.Int64Valuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Int64 Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Int64 attribute validation. "+
-				"The value type must implement the basetypes.Int64Valuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Int64 attribute validation",
+			"basetypes.Int64Valuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -319,7 +341,9 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		attributeValidator.ValidateInt64(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Int64")
+		attributeValidator.ValidateInt64(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -342,14 +366,14 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 	configValuable, ok := req.AttributeConfig.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform List attribute validation. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"List attribute validation",
+			"basetypes.ListValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -384,7 +408,9 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
-		attributeValidator.ValidateList(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.List")
+		attributeValidator.ValidateList(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -407,14 +433,14 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 	configValuable, ok := req.AttributeConfig.(basetypes.MapValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Map Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Map attribute validation. "+
-				"The value type must implement the basetypes.MapValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Map attribute validation",
+			"basetypes.MapValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -449,7 +475,9 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
-		attributeValidator.ValidateMap(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Map")
+		attributeValidator.ValidateMap(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -463,6 +491,96 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 	}
 }
 
+// AttributeValidateMapKeys performs all types.Map key validation. Each key is
+// validated as a known types.String value with the path of the map element.
+func AttributeValidateMapKeys(ctx context.Context, attribute fwxschema.AttributeWithMapKeyValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	keyValidators := attribute.MapKeyValidators()
+
+	if len(keyValidators) == 0 {
+		return
+	}
+
+	// Use basetypes.MapValuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.MapValuable)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
+			req.AttributePath,
+			"Invalid Map Attribute Key Validator Value Type",
+			"Map attribute key validation",
+			"basetypes.MapValuable",
+			req.AttributeConfig,
+		))
+
+		return
+	}
+
+	configValue, diags := configValuable.ToMapValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	elements := configValue.Elements()
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	// Ensure deterministic diagnostics ordering.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		validateReq := validator.StringRequest{
+			Config:         req.Config,
+			ConfigValue:    basetypes.NewStringValue(key),
+			Path:           req.AttributePath.AtMapKey(key),
+			PathExpression: req.AttributePathExpression.AtMapKey(key),
+		}
+
+		for _, keyValidator := range keyValidators {
+			// Instantiate a new response for each request to prevent validators
+			// from modifying or removing diagnostics.
+			validateResp := &validator.StringResponse{}
+
+			logging.FrameworkDebug(
+				ctx,
+				"Calling provider defined map key validator.String",
+				map[string]interface{}{
+					logging.KeyDescription: keyValidator.Description(ctx),
+				},
+			)
+
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "map key validator.String")
+			keyValidator.ValidateString(methodCtx, validateReq, validateResp)
+			afterProviderMethod()
+
+			logging.FrameworkDebug(
+				ctx,
+				"Called provider defined map key validator.String",
+				map[string]interface{}{
+					logging.KeyDescription: keyValidator.Description(ctx),
+				},
+			)
+
+			resp.Diagnostics.Append(validateResp.Diagnostics...)
+		}
+	}
+}
+
 // AttributeValidateNumber performs all types.Number validation.
 func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeWithNumberValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.NumberValuable until custom types cannot re-implement
@@ -472,14 +590,14 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 	configValuable, ok := req.AttributeConfig.(basetypes.NumberValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Number Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Number attribute validation. "+
-				"The value type must implement the basetypes.NumberValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Number attribute validation",
+			"basetypes.NumberValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -514,7 +632,9 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateNumber(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Number")
+		attributeValidator.ValidateNumber(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -537,14 +657,14 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 	configValuable, ok := req.AttributeConfig.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Object attribute validation. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Object attribute validation",
+			"basetypes.ObjectValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -579,7 +699,9 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateObject(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Object")
+		attributeValidator.ValidateObject(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -602,14 +724,14 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 	configValuable, ok := req.AttributeConfig.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Set attribute validation. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Set attribute validation",
+			"basetypes.SetValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -624,6 +746,8 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 		return
 	}
 
+	resp.Diagnostics.Append(SetValidateDuplicates(ctx, req.AttributePath, configValue)...)
+
 	validateReq := validator.SetRequest{
 		Config:         req.Config,
 		ConfigValue:    configValue,
@@ -644,7 +768,9 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
-		attributeValidator.ValidateSet(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Set")
+		attributeValidator.ValidateSet(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -667,14 +793,14 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 	configValuable, ok := req.AttributeConfig.(basetypes.StringValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid String Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform String attribute validation. "+
-				"The value type must implement the basetypes.StringValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"String attribute validation",
+			"basetypes.StringValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -709,7 +835,9 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateString(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.String")
+		attributeValidator.ValidateString(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -748,7 +876,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Attribute Validation Error Invalid Value Type",
-				"A type that implements basetypes.ListValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.ListValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -761,6 +889,10 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
+		if l.IsNull() || l.IsUnknown() {
+			return
+		}
+
 		for idx, value := range l.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
@@ -782,7 +914,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Attribute Validation Error Invalid Value Type",
-				"A type that implements basetypes.SetValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.SetValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -795,7 +927,11 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
-		for _, value := range s.Elements() {
+		if s.IsNull() || s.IsUnknown() {
+			return
+		}
+
+		for _, value := range sortedSetElements(s.Elements()) {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
 				AttributePath:           req.AttributePath.AtSetValue(value),
@@ -816,7 +952,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Attribute Validation Error Invalid Value Type",
-				"A type that implements basetypes.MapValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.MapValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -829,9 +965,15 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
-		for key, value := range m.Elements() {
+		if m.IsNull() || m.IsUnknown() {
+			return
+		}
+
+		elements := m.Elements()
+
+		for _, key := range fwschema.SortedNames(elements) {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
+				AttributeConfig:         elements[key],
 				AttributePath:           req.AttributePath.AtMapKey(key),
 				AttributePathExpression: req.AttributePathExpression.AtMapKey(key),
 				Config:                  req.Config,
@@ -850,7 +992,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Attribute Validation Error Invalid Value Type",
-				"A type that implements basetypes.ObjectValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.ObjectValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -883,7 +1025,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
 			"Attribute Validation Error",
-			"Attribute validation cannot walk schema. Report this to the provider developer:\n\n"+err.Error(),
+			fwdiag.ReportableErrorDetail(ctx, "Attribute validation cannot walk schema. Report this to the provider developer:\n\n"+err.Error()),
 		)
 
 		return
@@ -900,9 +1042,12 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Attribute Validation Walk Error",
-				"An unexpected error occurred while walking the schema for attribute validation. "+
-					"This is an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Unknown attribute value type (%T) at path: %s", req.AttributeConfig, req.AttributePath),
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					"An unexpected error occurred while walking the schema for attribute validation. "+
+						"This is an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("Unknown attribute value type (%T) at path: %s", req.AttributeConfig, req.AttributePath),
+				),
 			)
 
 			return
@@ -938,7 +1083,9 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 				},
 			)
 
-			objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Object")
+			objectValidator.ValidateObject(methodCtx, validateReq, validateResp)
+			afterProviderMethod()
 
 			logging.FrameworkDebug(
 				ctx,
@@ -952,7 +1099,17 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	// Null or unknown objects, such as an unknown list element, have no
+	// nested attribute values to validate.
+	if req.AttributeConfig == nil || req.AttributeConfig.IsNull() || req.AttributeConfig.IsUnknown() {
+		return
+	}
+
+	nestedAttrs := o.GetAttributes()
+
+	for _, nestedName := range fwschema.SortedNames(nestedAttrs) {
+		nestedAttr := nestedAttrs[nestedName]
+
 		nestedAttrReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
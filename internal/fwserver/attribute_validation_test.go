@@ -383,6 +383,123 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"deprecation-replacement-known": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:                   types.StringType,
+								Optional:               true,
+								DeprecationMessage:     "Use something else instead.",
+								DeprecationReplacement: path.MatchRoot("other"),
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use something else instead.\n\nReplacement Attribute: other",
+					),
+				},
+			},
+		},
+		"deprecation-replacement-known-relative": {
+			req: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:                   types.StringType,
+								Optional:               true,
+								DeprecationMessage:     "Use something else instead.",
+								DeprecationReplacement: path.MatchRelative().AtParent().AtName("other"),
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use something else instead.\n\nReplacement Attribute: other",
+					),
+				},
+			},
+		},
+		"deprecation-replacement-null": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:                   types.StringType,
+								Optional:               true,
+								DeprecationMessage:     "Use something else instead.",
+								DeprecationReplacement: path.MatchRoot("other"),
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"deprecation-replacement-unknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:                   types.StringType,
+								Optional:               true,
+								DeprecationMessage:     "Use something else instead.",
+								DeprecationReplacement: path.MatchRoot("other"),
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
 		"deprecation-message-null": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -2474,6 +2591,173 @@ func TestAttributeValidateList(t *testing.T) {
 	}
 }
 
+func TestAttributeValidateMapKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithMapKeyValidators
+		request   ValidateAttributeRequest
+		response  *ValidateAttributeResponse
+		expected  *ValidateAttributeResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithMapValidators{
+				ElementType: types.StringType,
+				KeyValidators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							got := req.Path
+							expected := path.Root("test").AtMapKey("testkey")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{"testkey": types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithMapValidators{
+				ElementType: types.StringType,
+				KeyValidators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test").AtMapKey("testkey")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{"testkey": types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithMapValidators{
+				ElementType: types.StringType,
+				KeyValidators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							got := req.ConfigValue
+							expected := types.StringValue("testkey")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{"testkey": types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-configvalue-unknown": {
+			attribute: testschema.AttributeWithMapValidators{
+				ElementType: types.StringType,
+				KeyValidators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddError("Unexpected Validation", "Key validators should not run for unknown maps")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.MapUnknown(types.StringType),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithMapValidators{
+				ElementType: types.StringType,
+				KeyValidators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail: "+req.ConfigValue.ValueString())
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"testkey2": types.StringValue("testvalue"),
+						"testkey1": types.StringValue("testvalue"),
+					},
+				),
+			},
+			response: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(path.Root("other"), "existing warning summary", "existing warning detail"),
+				},
+			},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(path.Root("other"), "existing warning summary", "existing warning detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("test").AtMapKey("testkey1"), "error summary", "error detail: testkey1"),
+					diag.NewAttributeErrorDiagnostic(path.Root("test").AtMapKey("testkey2"), "error summary", "error detail: testkey2"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributeValidateMapKeys(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestAttributeValidateMap(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,42 @@
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/audit"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// writeAuditRecord writes an audit record of a resource operation, which
+// started at the given time and resulted in the given diagnostics, to the
+// AuditSink. It is intended to be deferred so the final diagnostics are
+// recorded. Sink errors are logged rather than returned, so auditing never
+// affects the resource operation.
+func (s *Server) writeAuditRecord(ctx context.Context, typeName string, action audit.Action, start time.Time, diags *diag.Diagnostics) {
+	if s.AuditSink == nil {
+		return
+	}
+
+	record := audit.Record{
+		Action:       action,
+		Duration:     time.Since(start),
+		Labels:       s.AuditLabels,
+		Outcome:      audit.OutcomeSuccess,
+		ResourceType: typeName,
+		Time:         start,
+	}
+
+	if diags.HasError() {
+		record.Outcome = audit.OutcomeFailure
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined AuditSink Write")
+
+	if err := s.AuditSink.Write(ctx, record); err != nil {
+		logging.FrameworkError(ctx, "Unable to write audit record", map[string]interface{}{logging.KeyError: err.Error()})
+	}
+
+	logging.FrameworkTrace(ctx, "Called provider defined AuditSink Write")
+}
@@ -0,0 +1,202 @@
+package fwserver_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/audit"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// testAuditRecorder is an audit.Sink which records audit records.
+type testAuditRecorder struct {
+	mutex   sync.Mutex
+	records []audit.Record
+}
+
+func (r *testAuditRecorder) Write(_ context.Context, record audit.Record) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.records = append(r.records, record)
+
+	return nil
+}
+
+func TestServerAuditSink(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := func(value string) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	testResource := &testprovider.Resource{
+		CreateMethod: func(_ context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+			resp.State.Raw = req.Plan.Raw
+		},
+		DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {},
+		ReadMethod:   func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {},
+		UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+			resp.Diagnostics.AddError("error summary", "error detail")
+		},
+	}
+
+	var recorder testAuditRecorder
+
+	server := &fwserver.Server{
+		AuditLabels: map[string]string{
+			"environment": "test",
+		},
+		AuditSink: &recorder,
+		Provider:  &testprovider.Provider{},
+	}
+
+	ctx := context.Background()
+
+	server.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+		Config:         &tfsdk.Config{Raw: testValue("one"), Schema: testSchema},
+		PlannedState:   &tfsdk.Plan{Raw: testValue("one"), Schema: testSchema},
+		PriorState:     &tfsdk.State{Raw: tftypes.NewValue(testType, nil), Schema: testSchema},
+		Resource:       testResource,
+		ResourceSchema: testSchema,
+		TypeName:       "test_resource",
+	}, &fwserver.ApplyResourceChangeResponse{})
+
+	server.ReadResource(ctx, &fwserver.ReadResourceRequest{
+		CurrentState: &tfsdk.State{Raw: testValue("one"), Schema: testSchema},
+		Resource:     testResource,
+		TypeName:     "test_resource",
+	}, &fwserver.ReadResourceResponse{})
+
+	server.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+		Config:         &tfsdk.Config{Raw: testValue("two"), Schema: testSchema},
+		PlannedState:   &tfsdk.Plan{Raw: testValue("two"), Schema: testSchema},
+		PriorState:     &tfsdk.State{Raw: testValue("one"), Schema: testSchema},
+		Resource:       testResource,
+		ResourceSchema: testSchema,
+		TypeName:       "test_resource",
+	}, &fwserver.ApplyResourceChangeResponse{})
+
+	server.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+		PlannedState:   &tfsdk.Plan{Raw: tftypes.NewValue(testType, nil), Schema: testSchema},
+		PriorState:     &tfsdk.State{Raw: testValue("one"), Schema: testSchema},
+		Resource:       testResource,
+		ResourceSchema: testSchema,
+		TypeName:       "test_resource",
+	}, &fwserver.ApplyResourceChangeResponse{})
+
+	expectedLabels := map[string]string{
+		"environment": "test",
+	}
+
+	expected := []audit.Record{
+		{
+			Action:       audit.ActionCreate,
+			Labels:       expectedLabels,
+			Outcome:      audit.OutcomeSuccess,
+			ResourceType: "test_resource",
+		},
+		{
+			Action:       audit.ActionRead,
+			Labels:       expectedLabels,
+			Outcome:      audit.OutcomeSuccess,
+			ResourceType: "test_resource",
+		},
+		{
+			Action:       audit.ActionUpdate,
+			Labels:       expectedLabels,
+			Outcome:      audit.OutcomeFailure,
+			ResourceType: "test_resource",
+		},
+		{
+			Action:       audit.ActionDelete,
+			Labels:       expectedLabels,
+			Outcome:      audit.OutcomeSuccess,
+			ResourceType: "test_resource",
+		},
+	}
+
+	if diff := cmp.Diff(recorder.records, expected, cmpopts.IgnoreFields(audit.Record{}, "Duration", "Time")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	for _, record := range recorder.records {
+		if record.Time.IsZero() {
+			t.Errorf("expected %s record time to be set", record.Action)
+		}
+
+		if record.Duration < 0 {
+			t.Errorf("unexpected %s record duration: %s", record.Action, record.Duration)
+		}
+	}
+}
+
+func TestServerAuditSink_WriteError(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	server := &fwserver.Server{
+		AuditSink: audit.SinkFunc(func(_ context.Context, _ audit.Record) error {
+			return errors.New("test error")
+		}),
+		Provider: &testprovider.Provider{},
+	}
+
+	resp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(context.Background(), &fwserver.ReadResourceRequest{
+		CurrentState: &tfsdk.State{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "one"),
+			}),
+			Schema: testSchema,
+		},
+		Resource: &testprovider.Resource{
+			ReadMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {},
+		},
+		TypeName: "test_resource",
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected audit sink error diagnostics: %v", resp.Diagnostics)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -162,10 +163,22 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 
 		planElements := planSet.Elements()
 
+		// Set elements are identified by their value, so configuration and
+		// prior state elements are correlated with planned elements by value,
+		// then by required attribute values, rather than by position.
+		configIndices := setElemCorrelation(ctx, planElements, configSet, setElemConfigMatches)
+		stateIndices := setElemCorrelation(
+			ctx,
+			planElements,
+			stateSet,
+			setElemStateMatches(nestedBlockObject.GetAttributes()),
+			setElemRequiredMatches(nestedBlockObject.GetAttributes()),
+		)
+
 		for idx, planElem := range planElements {
 			attrPath := req.AttributePath.AtSetValue(planElem)
 
-			configObject, diags := setElemObject(ctx, attrPath, configSet, idx, fwschemadata.DataDescriptionConfiguration)
+			configObject, diags := setElemObject(ctx, attrPath, configSet, configIndices[idx], fwschemadata.DataDescriptionConfiguration)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -181,7 +194,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 				return
 			}
 
-			stateObject, diags := setElemObject(ctx, attrPath, stateSet, idx, fwschemadata.DataDescriptionState)
+			stateObject, diags := setElemObject(ctx, attrPath, stateSet, stateIndices[idx], fwschemadata.DataDescriptionState)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -210,7 +223,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			planElements[idx] = objectResp.AttributePlan
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
-			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
+			resp.RequiresReplace.Append(setElementRequiresReplace(attrPath, objectResp.AttributePlan, objectResp.RequiresReplace)...)
 		}
 
 		resp.AttributePlan, diags = types.SetValue(planSet.ElementType(ctx), planElements)
@@ -274,7 +287,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
 			"Block Plan Modification Error",
-			"Block plan modification cannot walk schema. Report this to the provider developer:\n\n"+err.Error(),
+			fwdiag.ReportableErrorDetail(ctx, "Block plan modification cannot walk schema. Report this to the provider developer:\n\n"+err.Error()),
 		)
 
 		return
@@ -290,14 +303,14 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 	configValuable, ok := req.AttributeConfig.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform List block plan modification. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"List block plan modification",
+			"basetypes.ListValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -315,14 +328,14 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 	planValuable, ok := req.AttributePlan.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform List block plan modification. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"List block plan modification",
+			"basetypes.ListValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -340,14 +353,14 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 	stateValuable, ok := req.AttributeState.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform List block plan modification. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"List block plan modification",
+			"basetypes.ListValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -390,7 +403,9 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 			},
 		)
 
-		planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.List")
+		planModifier.PlanModifyList(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -425,14 +440,14 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 	configValuable, ok := req.AttributeConfig.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Object block plan modification. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Object block plan modification",
+			"basetypes.ObjectValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -450,14 +465,14 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 	planValuable, ok := req.AttributePlan.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Object block plan modification. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Object block plan modification",
+			"basetypes.ObjectValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -475,14 +490,14 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 	stateValuable, ok := req.AttributeState.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Object block plan modification. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Object block plan modification",
+			"basetypes.ObjectValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -525,7 +540,9 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 			},
 		)
 
-		planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Object")
+		planModifier.PlanModifyObject(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -560,14 +577,14 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 	configValuable, ok := req.AttributeConfig.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Set block plan modification. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Set block plan modification",
+			"basetypes.SetValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -585,14 +602,14 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 	planValuable, ok := req.AttributePlan.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Set block plan modification. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
-		)
+			"Set block plan modification",
+			"basetypes.SetValuable",
+			req.AttributePlan,
+		))
 
 		return
 	}
@@ -610,14 +627,14 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 	stateValuable, ok := req.AttributeState.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Block Plan Modifier Value Type",
-			"An unexpected value type was encountered while attempting to perform Set block plan modification. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
-		)
+			"Set block plan modification",
+			"basetypes.SetValuable",
+			req.AttributeState,
+		))
 
 		return
 	}
@@ -660,7 +677,9 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 			},
 		)
 
-		planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Set")
+		planModifier.PlanModifySet(methodCtx, planModifyReq, planModifyResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -704,7 +723,9 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 				},
 			)
 
-			objectValidator.PlanModifyObject(ctx, req, planModifyResp)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Object")
+			objectValidator.PlanModifyObject(methodCtx, req, planModifyResp)
+			afterProviderMethod()
 
 			logging.FrameworkDebug(
 				ctx,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -55,7 +56,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Block Validation Error Invalid Value Type",
-				"A type that implements basetypes.ListValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.ListValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -68,6 +69,10 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
+		if l.IsNull() || l.IsUnknown() {
+			return
+		}
+
 		for idx, value := range l.Elements() {
 			nestedBlockObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
@@ -89,7 +94,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Block Validation Error Invalid Value Type",
-				"A type that implements basetypes.SetValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.SetValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -102,7 +107,11 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
-		for _, value := range s.Elements() {
+		if s.IsNull() || s.IsUnknown() {
+			return
+		}
+
+		for _, value := range sortedSetElements(s.Elements()) {
 			nestedBlockObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
 				AttributePath:           req.AttributePath.AtSetValue(value),
@@ -123,7 +132,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Block Validation Error Invalid Value Type",
-				"A type that implements basetypes.ObjectValuable is expected here. Report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "A type that implements basetypes.ObjectValuable is expected here. Report this to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
@@ -136,6 +145,10 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
+		if o.IsNull() || o.IsUnknown() {
+			return
+		}
+
 		nestedBlockObjectReq := ValidateAttributeRequest{
 			AttributeConfig:         o,
 			AttributePath:           req.AttributePath,
@@ -152,7 +165,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
 			"Block Validation Error",
-			"Block validation cannot walk schema. Report this to the provider developer:\n\n"+err.Error(),
+			fwdiag.ReportableErrorDetail(ctx, "Block validation cannot walk schema. Report this to the provider developer:\n\n"+err.Error()),
 		)
 
 		return
@@ -177,14 +190,14 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 	configValuable, ok := req.AttributeConfig.(basetypes.ListValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid List Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform List attribute validation. "+
-				"The value type must implement the basetypes.ListValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"List attribute validation",
+			"basetypes.ListValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -219,7 +232,9 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 			},
 		)
 
-		blockValidator.ValidateList(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.List")
+		blockValidator.ValidateList(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -242,14 +257,14 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 	configValuable, ok := req.AttributeConfig.(basetypes.ObjectValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Object Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Object attribute validation. "+
-				"The value type must implement the basetypes.ObjectValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Object attribute validation",
+			"basetypes.ObjectValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -284,7 +299,9 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 			},
 		)
 
-		blockValidator.ValidateObject(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Object")
+		blockValidator.ValidateObject(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -307,14 +324,14 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 	configValuable, ok := req.AttributeConfig.(basetypes.SetValuable)
 
 	if !ok {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.Append(invalidValueTypeDiag(
+			ctx,
 			req.AttributePath,
 			"Invalid Set Attribute Validator Value Type",
-			"An unexpected value type was encountered while attempting to perform Set attribute validation. "+
-				"The value type must implement the basetypes.SetValuable interface. "+
-				"Please report this to the provider developers.\n\n"+
-				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
-		)
+			"Set attribute validation",
+			"basetypes.SetValuable",
+			req.AttributeConfig,
+		))
 
 		return
 	}
@@ -329,6 +346,8 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 		return
 	}
 
+	resp.Diagnostics.Append(SetValidateDuplicates(ctx, req.AttributePath, configValue)...)
+
 	validateReq := validator.SetRequest{
 		Config:         req.Config,
 		ConfigValue:    configValue,
@@ -349,7 +368,9 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 			},
 		)
 
-		blockValidator.ValidateSet(ctx, validateReq, validateResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Set")
+		blockValidator.ValidateSet(methodCtx, validateReq, validateResp)
+		afterProviderMethod()
 
 		logging.FrameworkDebug(
 			ctx,
@@ -373,9 +394,12 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 			resp.Diagnostics.AddAttributeError(
 				req.AttributePath,
 				"Block Validation Walk Error",
-				"An unexpected error occurred while walking the schema for block validation. "+
-					"This is an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Unknown block value type (%T) at path: %s", req.AttributeConfig, req.AttributePath),
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					"An unexpected error occurred while walking the schema for block validation. "+
+						"This is an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("Unknown block value type (%T) at path: %s", req.AttributeConfig, req.AttributePath),
+				),
 			)
 
 			return
@@ -411,7 +435,9 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 				},
 			)
 
-			objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "validator.Object")
+			objectValidator.ValidateObject(methodCtx, validateReq, validateResp)
+			afterProviderMethod()
 
 			logging.FrameworkDebug(
 				ctx,
@@ -425,7 +451,17 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	// Null or unknown objects, such as an unknown list element, have no
+	// nested attribute or block values to validate.
+	if req.AttributeConfig == nil || req.AttributeConfig.IsNull() || req.AttributeConfig.IsUnknown() {
+		return
+	}
+
+	nestedAttrs := o.GetAttributes()
+
+	for _, nestedName := range fwschema.SortedNames(nestedAttrs) {
+		nestedAttr := nestedAttrs[nestedName]
+
 		nestedAttrReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
@@ -438,7 +474,11 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
 	}
 
-	for nestedName, nestedBlock := range o.GetBlocks() {
+	nestedBlocks := o.GetBlocks()
+
+	for _, nestedName := range fwschema.SortedNames(nestedBlocks) {
+		nestedBlock := nestedBlocks[nestedName]
+
 		nestedBlockReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
@@ -743,6 +743,93 @@ func TestBlockValidate(t *testing.T) {
 				},
 			},
 		},
+		"list-validators-max-items": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue1"),
+										},
+									),
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue2"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Blocks: map[string]fwschema.Block{
+							"test": testschema.BlockWithListValidators{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_attr": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								Validators: []validator.List{
+									testvalidator.List{
+										ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+											if len(req.ConfigValue.Elements()) > 1 {
+												resp.Diagnostics.AddAttributeError(
+													req.Path,
+													"Too Many Blocks",
+													fmt.Sprintf("At most 1 block is allowed, got: %d", len(req.ConfigValue.Elements())),
+												)
+											}
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Too Many Blocks",
+						"At most 1 block is allowed, got: 2",
+					),
+				},
+			},
+		},
 		"set-no-validation": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
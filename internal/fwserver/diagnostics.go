@@ -0,0 +1,82 @@
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// DiagnosticsContext returns a context containing the DiagnosticsDetailSuffix,
+// which is appended to framework-generated error diagnostics created with the
+// context. Protocol servers call this for each RPC before any conversion.
+func (s *Server) DiagnosticsContext(ctx context.Context) context.Context {
+	return fwdiag.WithDetailSuffix(ctx, s.DiagnosticsDetailSuffix)
+}
+
+// invalidValueTypeDiag returns an error diagnostic for a value which does not
+// implement the expected value interface while performing the operation, such
+// as "Bool attribute plan modification".
+func invalidValueTypeDiag(ctx context.Context, valuePath path.Path, summary string, operation string, valueInterface string, value any) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		valuePath,
+		summary,
+		fwdiag.ReportableErrorDetail(
+			ctx,
+			"An unexpected value type was encountered while attempting to perform "+operation+". "+
+				"The value type must implement the "+valueInterface+" interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", value),
+		),
+	)
+}
+
+// logDiagnostics emits logs for provider defined diagnostics which contain
+// information that is not sent to Terraform as-is.
+//
+// Diagnostics which preserve an underlying Go error are logged at ERROR
+// level with the error chain, since only the diagnostic summary and detail
+// are sent to Terraform. Other diagnostics with a code are logged at DEBUG
+// level, so the code is available as a structured field.
+func logDiagnostics(ctx context.Context, diags diag.Diagnostics) {
+	for _, d := range diags {
+		fields := map[string]interface{}{
+			logging.KeyDiagnosticSummary: d.Summary(),
+		}
+
+		if diagWithCode, ok := d.(diag.DiagnosticWithCode); ok && diagWithCode.Code() != "" {
+			fields[logging.KeyDiagnosticCode] = diagWithCode.Code()
+		}
+
+		var err error
+
+		if diagWithError, ok := d.(diag.DiagnosticWithError); ok {
+			err = diagWithError.Unwrap()
+		}
+
+		if err == nil {
+			if _, ok := fields[logging.KeyDiagnosticCode]; ok {
+				fields[logging.KeyDiagnosticSeverity] = d.Severity().String()
+
+				logging.FrameworkDebug(ctx, "Provider returned diagnostic with code", fields)
+			}
+
+			continue
+		}
+
+		var errorChain []string
+
+		for chainErr := err; chainErr != nil; chainErr = errors.Unwrap(chainErr) {
+			errorChain = append(errorChain, fmt.Sprintf("%T", chainErr))
+		}
+
+		fields[logging.KeyError] = err.Error()
+		fields[logging.KeyErrorChain] = errorChain
+
+		logging.FrameworkError(ctx, "Provider returned diagnostic with error", fields)
+	}
+}
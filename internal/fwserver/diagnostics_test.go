@@ -0,0 +1,202 @@
+package fwserver_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var errTestNotFound = errors.New("not found")
+
+func TestServerReadResource_DiagnosticWithErrorLogging(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testErr := fmt.Errorf("unable to read thing: %w", errTestNotFound)
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+	req := &fwserver.ReadResourceRequest{
+		CurrentState: testState,
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = testSchema
+			},
+			ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.Append(
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+					diag.WithPath(path.Root("test_required"), diag.NewErrorDiagnosticWithError("Unable to Read", testErr)),
+				)
+			},
+		},
+	}
+	resp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(ctx, req, resp)
+
+	if !errors.Is(resp.Diagnostics.WrappedErrors()[0], errTestNotFound) {
+		t.Errorf("expected wrapped error to match errTestNotFound, got: %v", resp.Diagnostics.WrappedErrors())
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	var errorEntries []map[string]interface{}
+
+	for _, entry := range entries {
+		if entry["@level"] == "error" {
+			errorEntries = append(errorEntries, entry)
+		}
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":             "error",
+			"@message":           "Provider returned diagnostic with error",
+			"@module":            "sdk.framework",
+			"diagnostic_summary": "Unable to Read",
+			"error":              "unable to read thing: not found",
+			"error_chain": []interface{}{
+				"*fmt.wrapError",
+				"*errors.errorString",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(errorEntries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestServerReadResource_DiagnosticWithCodeLogging(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+	req := &fwserver.ReadResourceRequest{
+		CurrentState: testState,
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = testSchema
+			},
+			ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.Append(
+					diag.NewWarningDiagnostic("uncoded summary", "uncoded detail"),
+					diag.WithPath(path.Root("test_required"), diag.NewWarningDiagnosticWithCode("TEST_DEPRECATED", "coded warning summary", "coded warning detail")),
+					diag.NewErrorDiagnosticWithCode("TEST_QUOTA_EXCEEDED", "coded error summary", "coded error detail"),
+				)
+			},
+		},
+	}
+	resp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(ctx, req, resp)
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	var codeEntries []map[string]interface{}
+
+	for _, entry := range entries {
+		if _, ok := entry["diagnostic_code"]; ok {
+			codeEntries = append(codeEntries, entry)
+		}
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":              "debug",
+			"@message":            "Provider returned diagnostic with code",
+			"@module":             "sdk.framework",
+			"diagnostic_code":     "TEST_DEPRECATED",
+			"diagnostic_severity": "Warning",
+			"diagnostic_summary":  "coded warning summary",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Provider returned diagnostic with code",
+			"@module":             "sdk.framework",
+			"diagnostic_code":     "TEST_QUOTA_EXCEEDED",
+			"diagnostic_severity": "Error",
+			"diagnostic_summary":  "coded error summary",
+		},
+	}
+
+	if diff := cmp.Diff(codeEntries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
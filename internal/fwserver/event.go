@@ -0,0 +1,60 @@
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// EmitEvent calls all EventListeners with an event of the given type, which
+// occurred now and was caused by an operation of the given duration.
+func (s *Server) EmitEvent(ctx context.Context, eventType provider.EventType, duration time.Duration) {
+	s.emitEvent(ctx, provider.Event{
+		Type:     eventType,
+		Duration: duration,
+	})
+}
+
+// emitAttributeValidationFailedEvents calls all EventListeners with an
+// EventTypeAttributeValidationFailed event for each error diagnostic with an
+// attribute path.
+func (s *Server) emitAttributeValidationFailedEvents(ctx context.Context, typeName string, diags diag.Diagnostics) {
+	if len(s.EventListeners) == 0 {
+		return
+	}
+
+	for _, d := range diags.Errors() {
+		diagWithPath, ok := d.(diag.DiagnosticWithPath)
+
+		if !ok {
+			continue
+		}
+
+		s.emitEvent(ctx, provider.Event{
+			Type:          provider.EventTypeAttributeValidationFailed,
+			AttributePath: diagWithPath.Path(),
+			TypeName:      typeName,
+		})
+	}
+}
+
+// emitEvent calls all EventListeners with the given event. The event Time is
+// set to now.
+func (s *Server) emitEvent(ctx context.Context, event provider.Event) {
+	if len(s.EventListeners) == 0 {
+		return
+	}
+
+	event.Time = time.Now()
+
+	logging.FrameworkTrace(ctx, "Calling provider defined EventListeners", map[string]interface{}{logging.KeyEventType: string(event.Type)})
+
+	for _, listener := range s.EventListeners {
+		listener(ctx, event)
+	}
+
+	logging.FrameworkTrace(ctx, "Called provider defined EventListeners", map[string]interface{}{logging.KeyEventType: string(event.Type)})
+}
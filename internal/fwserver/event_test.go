@@ -0,0 +1,217 @@
+package fwserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// testEventRecorder is a provider.EventListener which records event types.
+type testEventRecorder struct {
+	mutex  sync.Mutex
+	events []provider.Event
+}
+
+func (r *testEventRecorder) Listener(_ context.Context, event provider.Event) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *testEventRecorder) EventTypes() []provider.EventType {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var eventTypes []provider.EventType
+
+	for _, event := range r.events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+
+	return eventTypes
+}
+
+func TestServerEmitEvent(t *testing.T) {
+	t.Parallel()
+
+	var recorder testEventRecorder
+
+	server := &fwserver.Server{
+		EventListeners: []provider.EventListener{recorder.Listener},
+		Provider:       &testprovider.Provider{},
+	}
+
+	before := time.Now()
+
+	server.EmitEvent(context.Background(), provider.EventTypeServingStarted, time.Second)
+
+	if len(recorder.events) != 1 {
+		t.Fatalf("expected 1 event, got: %d", len(recorder.events))
+	}
+
+	event := recorder.events[0]
+
+	if event.Type != provider.EventTypeServingStarted {
+		t.Errorf("unexpected event type: %s", event.Type)
+	}
+
+	if event.Duration != time.Second {
+		t.Errorf("unexpected event duration: %s", event.Duration)
+	}
+
+	if event.Time.Before(before) {
+		t.Errorf("unexpected event time %s before %s", event.Time, before)
+	}
+}
+
+func TestServerEmitEvent_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var recorder testEventRecorder
+
+	server := &fwserver.Server{
+		EventListeners: []provider.EventListener{recorder.Listener},
+		Provider: &testprovider.Provider{
+			ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+				time.Sleep(time.Millisecond)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, &fwserver.GetProviderSchemaResponse{})
+	server.ConfigureProvider(ctx, &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+	server.ConfigureProvider(ctx, &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+
+	expected := []provider.EventType{
+		provider.EventTypeSchemaServed,
+		provider.EventTypeFirstConfigure,
+	}
+
+	if diff := cmp.Diff(recorder.EventTypes(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if recorder.events[1].Duration < time.Millisecond {
+		t.Errorf("expected first configure duration of at least 1ms, got: %s", recorder.events[1].Duration)
+	}
+}
+
+func TestServerEmitEvent_SchemaError(t *testing.T) {
+	t.Parallel()
+
+	var recorder testEventRecorder
+
+	server := &fwserver.Server{
+		EventListeners: []provider.EventListener{recorder.Listener},
+		Provider: &testprovider.Provider{
+			SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Diagnostics.AddError("error summary", "error detail")
+			},
+		},
+	}
+
+	server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, &fwserver.GetProviderSchemaResponse{})
+
+	if eventTypes := recorder.EventTypes(); len(eventTypes) != 0 {
+		t.Errorf("expected no events, got: %v", eventTypes)
+	}
+}
+
+func TestServerEmitEvent_AttributeValidationFailed(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_error":   tftypes.String,
+			"test_warning": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_error": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+						},
+					},
+				},
+			},
+			"test_warning": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "warning summary", "warning detail")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var recorder testEventRecorder
+
+	server := &fwserver.Server{
+		EventListeners: []provider.EventListener{recorder.Listener},
+		Provider:       &testprovider.Provider{},
+	}
+
+	server.ValidateResourceConfig(
+		context.Background(),
+		&fwserver.ValidateResourceConfigRequest{
+			Config: &tfsdk.Config{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test_error":   tftypes.NewValue(tftypes.String, "test-value"),
+					"test_warning": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				Schema: testSchema,
+			},
+			Resource: &testprovider.ResourceWithValidateConfig{
+				Resource: &testprovider.Resource{},
+				ValidateConfigMethod: func(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+					resp.Diagnostics.AddAttributeError(path.Root("test_error"), "resource error summary", "resource error detail")
+				},
+			},
+			TypeName: "test_resource",
+		},
+		&fwserver.ValidateResourceConfigResponse{},
+	)
+
+	if len(recorder.events) != 1 {
+		t.Fatalf("expected 1 event, got: %d", len(recorder.events))
+	}
+
+	event := recorder.events[0]
+
+	if event.Type != provider.EventTypeAttributeValidationFailed {
+		t.Errorf("unexpected event type: %s", event.Type)
+	}
+
+	if !event.AttributePath.Equal(path.Root("test_error")) {
+		t.Errorf("unexpected event attribute path: %s", event.AttributePath)
+	}
+
+	if event.TypeName != "test_resource" {
+		t.Errorf("unexpected event type name: %s", event.TypeName)
+	}
+}
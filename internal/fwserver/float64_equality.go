@@ -0,0 +1,201 @@
+package fwserver
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtransform"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// float64EqualityValue returns the value with any Float64 attribute values
+// which are equal to the value at the same path in the prior value replaced
+// with the prior value. Values are considered equal when:
+//
+//   - The attribute type is a Float64 type and both values are the same
+//     float64 with a different Terraform number representation, such as 0.3
+//     in configuration.
+//   - The new value type implements
+//     basetypes.Float64ValuableWithSemanticEquals and its
+//     Float64SemanticEquals method returns true.
+//   - The values are within the schema defined equality tolerance.
+//
+// This prevents differences when the remote system rounds values. Values
+// without a prior value at the same path, such as set elements containing the
+// changed value, are not replaced.
+func float64EqualityValue(ctx context.Context, s fwschema.Schema, value tftypes.Value, prior tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if s == nil || value.IsNull() || prior.IsNull() || !prior.IsKnown() {
+		return value, diags
+	}
+
+	// Errors are never returned, as values which cannot be compared are
+	// left unchanged.
+	result, _, _ := fwtransform.Transform(value, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (tftypes.Value, bool, error) {
+		if !tfValue.Type().Is(tftypes.Number) || !tfValue.IsKnown() || tfValue.IsNull() {
+			return tfValue, false, nil
+		}
+
+		attribute, err := s.AttributeAtTerraformPath(ctx, tfPath)
+
+		if err != nil {
+			return tfValue, false, nil
+		}
+
+		priorRaw, remaining, err := tftypes.WalkAttributePath(prior, tfPath)
+
+		if err != nil || len(remaining.Steps()) > 0 {
+			return tfValue, false, nil
+		}
+
+		priorValue, ok := priorRaw.(tftypes.Value)
+
+		if !ok || !priorValue.Type().Is(tftypes.Number) || !priorValue.IsKnown() || priorValue.IsNull() {
+			return tfValue, false, nil
+		}
+
+		var bigFloat, priorBigFloat big.Float
+
+		if tfValue.As(&bigFloat) != nil || priorValue.As(&priorBigFloat) != nil {
+			return tfValue, false, nil
+		}
+
+		if bigFloat.Cmp(&priorBigFloat) == 0 {
+			return tfValue, false, nil
+		}
+
+		f, _ := bigFloat.Float64()
+		priorF, _ := priorBigFloat.Float64()
+
+		if float64Typ, ok := attribute.GetType().(basetypes.Float64Typable); ok {
+			if f == priorF {
+				logging.FrameworkTrace(
+					ctx,
+					"Keeping prior value representation of equal float64 value",
+					map[string]interface{}{
+						logging.KeyAttributePath: tfPath.String(),
+					},
+				)
+
+				return priorValue, true, nil
+			}
+
+			equal, semanticEqualsDiags := float64SemanticEquals(ctx, float64Typ, tfValue, priorValue)
+
+			diags.Append(semanticEqualsDiags...)
+
+			if equal {
+				logging.FrameworkTrace(
+					ctx,
+					"Keeping prior value which is semantically equal",
+					map[string]interface{}{
+						logging.KeyAttributePath: tfPath.String(),
+					},
+				)
+
+				return priorValue, true, nil
+			}
+		}
+
+		equalityAttribute, ok := attribute.(fwschema.AttributeWithFloat64Equality)
+
+		if !ok {
+			return tfValue, false, nil
+		}
+
+		epsilon := equalityAttribute.GetEqualityEpsilon()
+		significantDigits := equalityAttribute.GetEqualitySignificantDigits()
+
+		if epsilon <= 0 && significantDigits <= 0 {
+			return tfValue, false, nil
+		}
+
+		if f == priorF || !float64Equal(f, priorF, epsilon, significantDigits) {
+			return tfValue, false, nil
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Keeping prior value within attribute equality tolerance",
+			map[string]interface{}{
+				logging.KeyAttributePath: tfPath.String(),
+			},
+		)
+
+		return priorValue, true, nil
+	})
+
+	return result, diags
+}
+
+// float64SemanticEquals returns true if the value type implements
+// basetypes.Float64ValuableWithSemanticEquals and the value is semantically
+// equal to the prior value.
+func float64SemanticEquals(ctx context.Context, typ basetypes.Float64Typable, tfValue tftypes.Value, priorTfValue tftypes.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, err := typ.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		return false, diags
+	}
+
+	valueWithSemanticEquals, ok := value.(basetypes.Float64ValuableWithSemanticEquals)
+
+	if !ok {
+		return false, diags
+	}
+
+	priorValue, err := typ.ValueFromTerraform(ctx, priorTfValue)
+
+	if err != nil {
+		return false, diags
+	}
+
+	priorValuable, ok := priorValue.(basetypes.Float64Valuable)
+
+	if !ok {
+		return false, diags
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined type-based Float64SemanticEquals")
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "type-based Float64SemanticEquals")
+	equal, semanticEqualsDiags := valueWithSemanticEquals.Float64SemanticEquals(methodCtx, priorValuable)
+	afterProviderMethod()
+	logging.FrameworkDebug(ctx, "Called provider defined type-based Float64SemanticEquals")
+
+	diags.Append(semanticEqualsDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return equal, diags
+}
+
+// float64Equal returns true if the values are within epsilon of each other
+// or are equal when rounded to the number of significant digits. Zero values
+// for epsilon and significantDigits disable that comparison.
+func float64Equal(a, b float64, epsilon float64, significantDigits int) bool {
+	if a == b {
+		return true
+	}
+
+	if epsilon > 0 && math.Abs(a-b) <= epsilon {
+		return true
+	}
+
+	if significantDigits > 0 && strconv.FormatFloat(a, 'e', significantDigits-1, 64) == strconv.FormatFloat(b, 'e', significantDigits-1, 64) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,330 @@
+package fwserver
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestFloat64EqualityValue(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"epsilon": schema.Float64Attribute{
+				EqualityEpsilon: 0.01,
+				Optional:        true,
+			},
+			"exact": schema.Float64Attribute{
+				Optional: true,
+			},
+			"list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"significant_digits": schema.Float64Attribute{
+							EqualitySignificantDigits: 3,
+							Optional:                  true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"significant_digits": tftypes.Number,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"epsilon":     tftypes.Number,
+			"exact":       tftypes.Number,
+			"list_nested": tftypes.List{ElementType: nestedType},
+		},
+	}
+
+	newValue := func(epsilon, exact interface{}, significantDigits ...interface{}) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(significantDigits))
+
+		for _, value := range significantDigits {
+			elements = append(elements, tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"significant_digits": tftypes.NewValue(tftypes.Number, value),
+			}))
+		}
+
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"epsilon":     tftypes.NewValue(tftypes.Number, epsilon),
+			"exact":       tftypes.NewValue(tftypes.Number, exact),
+			"list_nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, elements),
+		})
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		prior    tftypes.Value
+		expected tftypes.Value
+	}{
+		"equal": {
+			value:    newValue(1.2, 3.4, 5.6),
+			prior:    newValue(1.2, 3.4, 5.6),
+			expected: newValue(1.2, 3.4, 5.6),
+		},
+		"epsilon-within": {
+			value:    newValue(1.205, 3.4, 5.6),
+			prior:    newValue(1.2, 3.4, 5.6),
+			expected: newValue(1.2, 3.4, 5.6),
+		},
+		"epsilon-outside": {
+			value:    newValue(1.3, 3.4, 5.6),
+			prior:    newValue(1.2, 3.4, 5.6),
+			expected: newValue(1.3, 3.4, 5.6),
+		},
+		"exact-different": {
+			value:    newValue(1.2, 3.4000001, 5.6),
+			prior:    newValue(1.2, 3.4, 5.6),
+			expected: newValue(1.2, 3.4000001, 5.6),
+		},
+		"significant-digits-within": {
+			value:    newValue(1.2, 3.4, 1.2341, 5.6),
+			prior:    newValue(1.2, 3.4, 1.2338, 5.6),
+			expected: newValue(1.2, 3.4, 1.2338, 5.6),
+		},
+		"significant-digits-outside": {
+			value:    newValue(1.2, 3.4, 1.244),
+			prior:    newValue(1.2, 3.4, 1.234),
+			expected: newValue(1.2, 3.4, 1.244),
+		},
+		"prior-element-missing": {
+			value:    newValue(1.2, 3.4, 5.6, 7.8),
+			prior:    newValue(1.2, 3.4, 5.6),
+			expected: newValue(1.2, 3.4, 5.6, 7.8),
+		},
+		"prior-null-value": {
+			value:    newValue(1.205, 3.4),
+			prior:    newValue(nil, 3.4),
+			expected: newValue(1.205, 3.4),
+		},
+		"prior-unknown-value": {
+			value:    newValue(1.205, 3.4),
+			prior:    newValue(tftypes.UnknownValue, 3.4),
+			expected: newValue(1.205, 3.4),
+		},
+		"prior-null": {
+			value:    newValue(1.205, 3.4),
+			prior:    tftypes.NewValue(schemaType, nil),
+			expected: newValue(1.205, 3.4),
+		},
+		"value-null": {
+			value:    tftypes.NewValue(schemaType, nil),
+			prior:    newValue(1.2, 3.4),
+			expected: tftypes.NewValue(schemaType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := float64EqualityValue(context.Background(), testSchema, testCase.value, testCase.prior)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected error diagnostics: %s", diags)
+			}
+		})
+	}
+}
+
+func TestFloat64EqualityValueSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"exact": schema.Float64Attribute{
+				Optional: true,
+			},
+			"number": schema.NumberAttribute{
+				Optional: true,
+			},
+			"semantic_equals": schema.Float64Attribute{
+				CustomType: testtypes.Float64ToleranceType{},
+				Optional:   true,
+			},
+		},
+	}
+
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"exact":           tftypes.Number,
+			"number":          tftypes.Number,
+			"semantic_equals": tftypes.Number,
+		},
+	}
+
+	newValue := func(exact, number, semanticEquals interface{}) tftypes.Value {
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"exact":           tftypes.NewValue(tftypes.Number, exact),
+			"number":          tftypes.NewValue(tftypes.Number, number),
+			"semantic_equals": tftypes.NewValue(tftypes.Number, semanticEquals),
+		})
+	}
+
+	// parseFloat parses a string into a *big.Float similar to configuration
+	// values.
+	parseFloat := func(s string) *big.Float {
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", s, err)
+		}
+
+		return f
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		prior    tftypes.Value
+		expected tftypes.Value
+	}{
+		"representation-decimal": {
+			value:    newValue(0.3, 1.2, 5.6),
+			prior:    newValue(parseFloat("0.3"), 1.2, 5.6),
+			expected: newValue(parseFloat("0.3"), 1.2, 5.6),
+		},
+		"representation-scientific": {
+			value:    newValue(0.3, 1.2, 5.6),
+			prior:    newValue(parseFloat("3e-1"), 1.2, 5.6),
+			expected: newValue(parseFloat("3e-1"), 1.2, 5.6),
+		},
+		"representation-number": {
+			value:    newValue(1.2, 0.3, 5.6),
+			prior:    newValue(1.2, parseFloat("0.3"), 5.6),
+			expected: newValue(1.2, 0.3, 5.6),
+		},
+		"rounding-without-semantic-equals": {
+			value:    newValue(0.30000000000000004, 1.2, 5.6),
+			prior:    newValue(parseFloat("0.3"), 1.2, 5.6),
+			expected: newValue(0.30000000000000004, 1.2, 5.6),
+		},
+		"semantic-equals-within": {
+			value:    newValue(1.2, 1.2, 0.30000000000000004),
+			prior:    newValue(1.2, 1.2, parseFloat("0.3")),
+			expected: newValue(1.2, 1.2, parseFloat("0.3")),
+		},
+		"semantic-equals-scientific": {
+			value:    newValue(1.2, 1.2, 0.30000000000000004),
+			prior:    newValue(1.2, 1.2, parseFloat("3e-1")),
+			expected: newValue(1.2, 1.2, parseFloat("3e-1")),
+		},
+		"semantic-equals-outside": {
+			value:    newValue(1.2, 1.2, 0.31),
+			prior:    newValue(1.2, 1.2, parseFloat("0.3")),
+			expected: newValue(1.2, 1.2, 0.31),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := float64EqualityValue(context.Background(), testSchema, testCase.value, testCase.prior)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected error diagnostics: %s", diags)
+			}
+		})
+	}
+}
+
+func TestFloat64Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a                 float64
+		b                 float64
+		epsilon           float64
+		significantDigits int
+		expected          bool
+	}{
+		"equal": {
+			a:        1.5,
+			b:        1.5,
+			expected: true,
+		},
+		"no-tolerance": {
+			a:        1.5,
+			b:        1.5000001,
+			expected: false,
+		},
+		"epsilon-within": {
+			a:        1.5,
+			b:        1.55,
+			epsilon:  0.1,
+			expected: true,
+		},
+		"epsilon-outside": {
+			a:        1.5,
+			b:        1.7,
+			epsilon:  0.1,
+			expected: false,
+		},
+		"significant-digits-within": {
+			a:                 12345.6,
+			b:                 12349.1,
+			significantDigits: 4,
+			expected:          true,
+		},
+		"significant-digits-outside": {
+			a:                 12345.6,
+			b:                 12355.1,
+			significantDigits: 4,
+			expected:          false,
+		},
+		"significant-digits-small": {
+			a:                 0.00012341,
+			b:                 0.00012339,
+			significantDigits: 3,
+			expected:          true,
+		},
+		"either-tolerance": {
+			a:                 1.5,
+			b:                 1.55,
+			epsilon:           0.1,
+			significantDigits: 5,
+			expected:          true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := float64Equal(testCase.a, testCase.b, testCase.epsilon, testCase.significantDigits)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/instrumentation"
+)
+
+// instrumentationKey is the context key of the RPC instrumentation, which is
+// used for provider-defined method calls within the RPC.
+type instrumentationKey struct{}
+
+// instrumentationValue is the context value of the RPC instrumentation.
+type instrumentationValue struct {
+	instrumentation instrumentation.Instrumentation
+	rpc             string
+	typeName        string
+}
+
+// InstrumentRPC calls the Instrumentation BeforeProviderMethod for the
+// handling of the RPC, then returns a context which enables instrumentation
+// of provider-defined method calls with the context, and a function which
+// calls the Instrumentation AfterProviderMethod. Protocol servers call this
+// for each RPC before any conversion and defer the returned function. If
+// Instrumentation is not set, the context is returned unchanged.
+func (s *Server) InstrumentRPC(ctx context.Context, rpc string, typeName string) (context.Context, func()) {
+	if s.Instrumentation == nil {
+		return ctx, func() {}
+	}
+
+	method := instrumentation.Method{
+		RPC:      rpc,
+		TypeName: typeName,
+	}
+
+	start := time.Now()
+	ctx = s.Instrumentation.BeforeProviderMethod(ctx, method)
+	ctx = context.WithValue(ctx, instrumentationKey{}, instrumentationValue{
+		instrumentation: s.Instrumentation,
+		rpc:             rpc,
+		typeName:        typeName,
+	})
+
+	return ctx, func() {
+		s.Instrumentation.AfterProviderMethod(ctx, method, time.Since(start))
+	}
+}
+
+// beforeProviderMethod calls any Instrumentation BeforeProviderMethod from
+// the context for the provider-defined method call, such as "Resource
+// Read", then returns the context for the call and a function which calls
+// the Instrumentation AfterProviderMethod. The function must be called
+// after the provider-defined method returns.
+func beforeProviderMethod(ctx context.Context, name string) (context.Context, func()) {
+	value, ok := ctx.Value(instrumentationKey{}).(instrumentationValue)
+
+	if !ok {
+		return ctx, func() {}
+	}
+
+	method := instrumentation.Method{
+		RPC:      value.rpc,
+		Name:     name,
+		TypeName: value.typeName,
+	}
+
+	start := time.Now()
+	methodCtx := value.instrumentation.BeforeProviderMethod(ctx, method)
+
+	return methodCtx, func() {
+		value.instrumentation.AfterProviderMethod(methodCtx, method, time.Since(start))
+	}
+}
@@ -0,0 +1,75 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwinterface"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// dataSourceInterfaceReports returns the optional interface detection report
+// of each data source type. Errors fetching data sources are ignored, as
+// they are returned by the schema handling.
+func (s *Server) dataSourceInterfaceReports(ctx context.Context) map[string]fwinterface.Report {
+	dataSourceFuncs, _ := s.DataSourceFuncs(ctx)
+
+	reports := make(map[string]fwinterface.Report, len(dataSourceFuncs))
+
+	for typeName, dataSourceFunc := range dataSourceFuncs {
+		reports[typeName] = fwinterface.Detect(dataSourceFunc(), fwinterface.DataSourceInterfaces)
+	}
+
+	return reports
+}
+
+// resourceInterfaceReports returns the optional interface detection report
+// of each resource type. Errors fetching resources are ignored, as they are
+// returned by the schema handling.
+func (s *Server) resourceInterfaceReports(ctx context.Context) map[string]fwinterface.Report {
+	resourceFuncs, _ := s.ResourceFuncs(ctx)
+
+	reports := make(map[string]fwinterface.Report, len(resourceFuncs))
+
+	for typeName, resourceFunc := range resourceFuncs {
+		reports[typeName] = fwinterface.Detect(resourceFunc(), fwinterface.ResourceInterfaces)
+	}
+
+	return reports
+}
+
+// logInterfaceReport logs the optional interfaces detected for each data
+// source and resource type, once per server, so provider developers can
+// verify which optional behaviors the framework will call. Methods which
+// have the name of an optional interface method, but are silently ignored
+// because they do not implement the interface, are logged as warnings.
+func (s *Server) logInterfaceReport(ctx context.Context) {
+	s.interfaceReportOnce.Do(func() {
+		for typeName, report := range s.dataSourceInterfaceReports(ctx) {
+			logging.FrameworkDebug(ctx, "Detected data source optional interfaces", map[string]interface{}{
+				logging.KeyDataSourceType:     typeName,
+				logging.KeyOptionalInterfaces: report.Implemented,
+			})
+
+			for _, mismatch := range report.Mismatched {
+				logging.FrameworkWarn(ctx, "Data source method does not implement optional interface", map[string]interface{}{
+					logging.KeyDataSourceType:            typeName,
+					logging.KeyOptionalInterfaceMismatch: mismatch.String(),
+				})
+			}
+		}
+
+		for typeName, report := range s.resourceInterfaceReports(ctx) {
+			logging.FrameworkDebug(ctx, "Detected resource optional interfaces", map[string]interface{}{
+				logging.KeyResourceType:       typeName,
+				logging.KeyOptionalInterfaces: report.Implemented,
+			})
+
+			for _, mismatch := range report.Mismatched {
+				logging.FrameworkWarn(ctx, "Resource method does not implement optional interface", map[string]interface{}{
+					logging.KeyResourceType:              typeName,
+					logging.KeyOptionalInterfaceMismatch: mismatch.String(),
+				})
+			}
+		}
+	})
+}
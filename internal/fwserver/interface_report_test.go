@@ -0,0 +1,190 @@
+package fwserver_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// testResourceImportStateMismatch has an ImportState method with the
+// request parameter incorrectly declared as a pointer, so it does not
+// implement resource.ResourceWithImportState.
+type testResourceImportStateMismatch struct {
+	testprovider.Resource
+}
+
+func (r *testResourceImportStateMismatch) ImportState(_ context.Context, _ *resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+}
+
+func testInterfaceReportProvider() *testprovider.Provider {
+	return &testprovider.Provider{
+		DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+			return []func() datasource.DataSource{
+				func() datasource.DataSource {
+					return &testprovider.DataSourceWithConfigure{
+						DataSource: &testprovider.DataSource{
+							MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+								resp.TypeName = "test_data_source"
+							},
+							SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+								resp.Schema = datasourceschema.Schema{
+									Description: "test data source",
+								}
+							},
+						},
+					}
+				},
+			}
+		},
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testResourceImportStateMismatch{
+						Resource: testprovider.Resource{
+							MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = "test_resource"
+							},
+							SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+								resp.Schema = resourceschema.Schema{
+									Description: "test resource",
+								}
+							},
+						},
+					}
+				},
+			}
+		},
+	}
+}
+
+func TestServerGetProviderSchema_InterfaceReport(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	server := &fwserver.Server{
+		Provider: testInterfaceReportProvider(),
+	}
+
+	// The report is only logged once per server.
+	for i := 0; i < 2; i++ {
+		server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, &fwserver.GetProviderSchemaResponse{})
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	var got []map[string]interface{}
+
+	for _, entry := range entries {
+		if _, ok := entry[logging.KeyOptionalInterfaces]; ok {
+			got = append(got, entry)
+		}
+
+		if _, ok := entry[logging.KeyOptionalInterfaceMismatch]; ok {
+			got = append(got, entry)
+		}
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"@level":                      "debug",
+			"@message":                    "Detected data source optional interfaces",
+			"@module":                     "sdk.framework",
+			logging.KeyDataSourceType:     "test_data_source",
+			logging.KeyOptionalInterfaces: []interface{}{"datasource.DataSourceWithConfigure"},
+		},
+		{
+			"@level":                      "debug",
+			"@message":                    "Detected resource optional interfaces",
+			"@module":                     "sdk.framework",
+			logging.KeyOptionalInterfaces: nil,
+			logging.KeyResourceType:       "test_resource",
+		},
+		{
+			"@level":                "warn",
+			"@message":              "Resource method does not implement optional interface",
+			"@module":               "sdk.framework",
+			logging.KeyResourceType: "test_resource",
+			logging.KeyOptionalInterfaceMismatch: "ImportState method does not implement resource.ResourceWithImportState: " +
+				"expected signature func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse), " +
+				"got: func(context.Context, *resource.ImportStateRequest, *resource.ImportStateResponse)",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestServerStrictMode_GetProviderSchema_InterfaceMismatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictMode          bool
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"disabled": {
+			strictMode: false,
+		},
+		"enabled": {
+			strictMode: true,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Optional Interface Not Implemented",
+					"The test_resource resource has a ImportState method which does not implement the resource.ResourceWithImportState interface, so the framework never calls it. "+
+						"This warning is only returned when the provider server is in strict mode.\n\n"+
+						"Reason: expected signature func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse), "+
+						"got: func(context.Context, *resource.ImportStateRequest, *resource.ImportStateResponse)",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testProvider := testInterfaceReportProvider()
+			testProvider.SchemaMethod = func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Schema = providerschema.Schema{
+					Description: "test provider",
+				}
+			}
+
+			server := &fwserver.Server{
+				Provider:   testProvider,
+				StrictMode: testCase.strictMode,
+			}
+			resp := &fwserver.GetProviderSchemaResponse{}
+
+			server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
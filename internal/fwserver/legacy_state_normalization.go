@@ -0,0 +1,51 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// normalizeLegacyState converts zero values in the state to null values at
+// the paths returned by a resource.ResourceWithLegacyStateNormalization
+// implementation. The state is unmodified if the resource does not implement
+// the interface.
+func normalizeLegacyState(ctx context.Context, r resource.Resource, state *tfsdk.State) diag.Diagnostics {
+	if state == nil || state.Raw.IsNull() {
+		return nil
+	}
+
+	resourceWithLegacyStateNormalization, ok := r.(resource.ResourceWithLegacyStateNormalization)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithLegacyStateNormalization")
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource LegacyStateNormalizationPaths")
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource LegacyStateNormalizationPaths")
+	pathExpressions := resourceWithLegacyStateNormalization.LegacyStateNormalizationPaths(methodCtx)
+	afterProviderMethod()
+	logging.FrameworkDebug(ctx, "Called provider defined Resource LegacyStateNormalizationPaths")
+
+	stateData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         state.Schema,
+		TerraformValue: state.Raw,
+	}
+
+	diags := stateData.NullifyLegacyZeroValues(ctx, pathExpressions)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Raw = stateData.TerraformValue
+
+	return diags
+}
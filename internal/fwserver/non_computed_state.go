@@ -0,0 +1,196 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// nonComputedStateDiagnostics returns error diagnostics for each value in the
+// new state which differs from the configuration value of an attribute which
+// is not Computed, after the given operation. Only Computed attributes, and
+// attributes within them, may be set by the provider. Terraform also rejects
+// these values after apply, but the error describes the plan rather than the
+// schema definition. Sensitive values are redacted.
+//
+// No diagnostics are returned if the resource implements the
+// resource.ResourceWithNonComputedStateWrites interface and allows the
+// writes.
+func nonComputedStateDiagnostics(ctx context.Context, operation string, r resource.Resource, config *tfsdk.Config, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config == nil || state == nil || state.Schema == nil || config.Raw.IsNull() || state.Raw.IsNull() {
+		return diags
+	}
+
+	if resourceWithNonComputedStateWrites, ok := r.(resource.ResourceWithNonComputedStateWrites); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithNonComputedStateWrites")
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource AllowNonComputedStateWrites")
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource AllowNonComputedStateWrites")
+		allow := resourceWithNonComputedStateWrites.AllowNonComputedStateWrites(methodCtx)
+		afterProviderMethod()
+		logging.FrameworkDebug(ctx, "Called provider defined Resource AllowNonComputedStateWrites")
+
+		if allow {
+			return diags
+		}
+	}
+
+	for _, change := range tfvalue.Changes(config.Raw, state.Raw) {
+		if !nonComputedAttributeAtTerraformPath(ctx, state.Schema, change.Path) {
+			continue
+		}
+
+		configValue, stateValue := terraformValuesAtPath(change.Path, config.Raw, state.Raw)
+
+		configValueString := "(sensitive value)"
+		stateValueString := "(sensitive value)"
+
+		if !sensitiveTerraformValue(ctx, state.Schema, change.Path, configValue, stateValue) {
+			configValueString = terraformValueString(ctx, state.Schema, change.Path, configValue)
+			stateValueString = terraformValueString(ctx, state.Schema, change.Path, stateValue)
+		}
+
+		summary := "Provider Set Non-Computed Attribute"
+		problem := fmt.Sprintf("After %s, the provider returned a value which does not match the configuration for an attribute which is not Computed. ", operation) +
+			"Only Computed attributes may be set by the provider."
+		details := "Either mark the attribute as Computed in the schema or return the configuration value.\n\n" +
+			fmt.Sprintf("Configuration Value: %s\n", configValueString) +
+			fmt.Sprintf("State Value: %s", stateValueString)
+
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, change.Path, state.Schema)
+
+		if pathDiags.HasError() {
+			diags.Append(fwdiag.ProviderErrorDiag(ctx, summary, problem, details+fmt.Sprintf("\nPath: %s", change.Path)))
+
+			continue
+		}
+
+		diags.Append(fwdiag.ProviderAttributeErrorDiag(ctx, attributePath, summary, problem, details))
+	}
+
+	return diags
+}
+
+// nonComputedAttributeAtTerraformPath returns true if the path is, or is
+// within, an attribute which is not Computed and which is not within a
+// Computed attribute. Paths which are only within blocks, such as an added
+// block element, and paths to attributes with Computed nested attributes,
+// such as a set whose elements contain Computed values, return false since
+// the difference may be caused by Computed values.
+func nonComputedAttributeAtTerraformPath(ctx context.Context, s fwschema.Schema, tfPath *tftypes.AttributePath) bool {
+	var attribute fwschema.Attribute
+
+	steps := tfPath.Steps()
+
+	for i := range steps {
+		stepAttribute, err := s.AttributeAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:i+1]))
+
+		if err != nil {
+			continue
+		}
+
+		if stepAttribute.IsComputed() {
+			return false
+		}
+
+		attribute = stepAttribute
+	}
+
+	if attribute == nil {
+		return false
+	}
+
+	return !hasComputedNestedAttribute(attribute)
+}
+
+// hasComputedNestedAttribute returns true if the attribute has any Computed
+// nested attributes, at any depth.
+func hasComputedNestedAttribute(attribute fwschema.Attribute) bool {
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return false
+	}
+
+	for _, nestedAttr := range nestedAttribute.GetNestedObject().GetAttributes() {
+		if nestedAttr.IsComputed() || hasComputedNestedAttribute(nestedAttr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// terraformValuesAtPath returns the values at the path of the given
+// configuration and state values. A value which is missing at the path, such
+// as an added list element, is returned as null.
+func terraformValuesAtPath(tfPath *tftypes.AttributePath, config, state tftypes.Value) (tftypes.Value, tftypes.Value) {
+	configValue, configOk := terraformValueAtPath(tfPath, config)
+	stateValue, stateOk := terraformValueAtPath(tfPath, state)
+
+	switch {
+	case !configOk && stateOk:
+		configValue = tftypes.NewValue(stateValue.Type(), nil)
+	case configOk && !stateOk:
+		stateValue = tftypes.NewValue(configValue.Type(), nil)
+	}
+
+	return configValue, stateValue
+}
+
+// terraformValueAtPath returns the value at the path and whether it exists.
+func terraformValueAtPath(tfPath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, bool) {
+	rawValue, _, err := tftypes.WalkAttributePath(value, tfPath)
+
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	result, ok := rawValue.(tftypes.Value)
+
+	return result, ok
+}
+
+// withoutDiagnosticPaths returns the diagnostics, except those with the same
+// path as any of the excluded diagnostics. This prevents a more general
+// diagnostic, such as an inconsistent result, from being returned alongside
+// a more specific diagnostic for the same attribute.
+func withoutDiagnosticPaths(diags diag.Diagnostics, excluded diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		dWithPath, ok := d.(diag.DiagnosticWithPath)
+
+		if !ok || !diagnosticsContainPath(excluded, dWithPath.Path()) {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// diagnosticsContainPath returns true if any of the diagnostics have the path.
+func diagnosticsContainPath(diags diag.Diagnostics, p path.Path) bool {
+	for _, d := range diags {
+		dWithPath, ok := d.(diag.DiagnosticWithPath)
+
+		if ok && dWithPath.Path().Equal(p) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,282 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestNonComputedStateDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"optional": schema.StringAttribute{
+				Optional: true,
+			},
+			"optional_computed": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Optional: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	ruleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port":   tftypes.Number,
+			"status": tftypes.String,
+		},
+	}
+	settingsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"mode": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed":          tftypes.String,
+			"optional":          tftypes.String,
+			"optional_computed": tftypes.String,
+			"password":          tftypes.String,
+			"rules":             tftypes.List{ElementType: ruleType},
+			"settings":          settingsType,
+		},
+	}
+
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := map[string]tftypes.Value{
+			"computed":          tftypes.NewValue(tftypes.String, nil),
+			"optional":          tftypes.NewValue(tftypes.String, nil),
+			"optional_computed": tftypes.NewValue(tftypes.String, nil),
+			"password":          tftypes.NewValue(tftypes.String, nil),
+			"rules":             tftypes.NewValue(tftypes.List{ElementType: ruleType}, nil),
+			"settings":          tftypes.NewValue(settingsType, nil),
+		}
+
+		for name, value := range values {
+			attributes[name] = value
+		}
+
+		return tftypes.NewValue(schemaType, attributes)
+	}
+	ruleValue := func(port interface{}, status interface{}) tftypes.Value {
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"port":   tftypes.NewValue(tftypes.Number, port),
+			"status": tftypes.NewValue(tftypes.String, status),
+		})
+	}
+	detail := func(config, state string) string {
+		return "After Create, the provider returned a value which does not match the configuration for an attribute which is not Computed. " +
+			"Only Computed attributes may be set by the provider. " +
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+			"Either mark the attribute as Computed in the schema or return the configuration value.\n\n" +
+			"Configuration Value: " + config + "\n" +
+			"State Value: " + state
+	}
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		config   tftypes.Value
+		state    tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"matching": {
+			config: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			state: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"computed-set": {
+			config: testValue(nil),
+			state: testValue(map[string]tftypes.Value{
+				"computed": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"optional-computed-null-config-set": {
+			config: testValue(nil),
+			state: testValue(map[string]tftypes.Value{
+				"optional_computed": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"optional-computed-config-changed": {
+			config: testValue(map[string]tftypes.Value{
+				"optional_computed": tftypes.NewValue(tftypes.String, "config"),
+			}),
+			state: testValue(map[string]tftypes.Value{
+				"optional_computed": tftypes.NewValue(tftypes.String, "state"),
+			}),
+		},
+		"optional-computed-parent-nested-set": {
+			config: testValue(nil),
+			state: testValue(map[string]tftypes.Value{
+				"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+					"mode": tftypes.NewValue(tftypes.String, "test"),
+				}),
+			}),
+		},
+		"optional-null-config-set": {
+			config: testValue(nil),
+			state: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("optional"),
+					"Provider Set Non-Computed Attribute",
+					detail("<null>", `"test"`),
+				),
+			},
+		},
+		"optional-config-changed": {
+			config: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "config"),
+			}),
+			state: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "state"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("optional"),
+					"Provider Set Non-Computed Attribute",
+					detail(`"config"`, `"state"`),
+				),
+			},
+		},
+		"nested-computed-set": {
+			config: testValue(map[string]tftypes.Value{
+				"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{ruleValue(80, nil)}),
+			}),
+			state: testValue(map[string]tftypes.Value{
+				"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{ruleValue(80, "active")}),
+			}),
+		},
+		"nested-optional-changed": {
+			config: testValue(map[string]tftypes.Value{
+				"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{ruleValue(80, nil)}),
+			}),
+			state: testValue(map[string]tftypes.Value{
+				"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{ruleValue(443, "active")}),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("rules").AtListIndex(0).AtName("port"),
+					"Provider Set Non-Computed Attribute",
+					detail("80", "443"),
+				),
+			},
+		},
+		"sensitive-changed": {
+			config: testValue(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "config"),
+			}),
+			state: testValue(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "state"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("password"),
+					"Provider Set Non-Computed Attribute",
+					detail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+		"allowed": {
+			resource: &testprovider.ResourceWithNonComputedStateWrites{
+				AllowNonComputedStateWritesMethod: func(_ context.Context) bool {
+					return true
+				},
+			},
+			config: testValue(nil),
+			state: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"not-allowed": {
+			resource: &testprovider.ResourceWithNonComputedStateWrites{
+				AllowNonComputedStateWritesMethod: func(_ context.Context) bool {
+					return false
+				},
+			},
+			config: testValue(nil),
+			state: testValue(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("optional"),
+					"Provider Set Non-Computed Attribute",
+					detail("<null>", `"test"`),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testCase.resource
+
+			if r == nil {
+				r = &testprovider.Resource{}
+			}
+
+			config := &tfsdk.Config{
+				Raw:    testCase.config,
+				Schema: testSchema,
+			}
+			state := &tfsdk.State{
+				Raw:    testCase.state,
+				Schema: testSchema,
+			}
+
+			got := nonComputedStateDiagnostics(context.Background(), "Create", r, config, state)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package fwserver
+
+import (
+	"context"
+	"sync"
+)
+
+// readResourceQueue limits the number of concurrently processed ReadResource
+// RPCs. Requests beyond the limit wait in per resource type queues, which are
+// served in round-robin order so a single resource type with many instances
+// cannot starve other resource types during large refreshes.
+type readResourceQueue struct {
+	// limit is the maximum number of concurrently active requests.
+	limit int
+
+	// active is the number of requests currently holding a slot.
+	active int
+
+	// mutex protects concurrent access to all fields.
+	mutex sync.Mutex
+
+	// order is the round-robin order of resource type names with waiting
+	// requests. Each type name appears at most once.
+	order []string
+
+	// waiting is the queued requests for each resource type name. Closing
+	// a channel hands the caller an active slot.
+	waiting map[string][]chan struct{}
+}
+
+// newReadResourceQueue returns a readResourceQueue with the given limit.
+func newReadResourceQueue(limit int) *readResourceQueue {
+	return &readResourceQueue{
+		limit:   limit,
+		waiting: make(map[string][]chan struct{}),
+	}
+}
+
+// Acquire blocks until the request for the resource type name may be
+// processed or the context is canceled. Each successful Acquire must be
+// followed by a Release.
+func (q *readResourceQueue) Acquire(ctx context.Context, typeName string) error {
+	q.mutex.Lock()
+
+	if q.active < q.limit && len(q.order) == 0 {
+		q.active++
+		q.mutex.Unlock()
+
+		return nil
+	}
+
+	ready := make(chan struct{})
+
+	if len(q.waiting[typeName]) == 0 {
+		q.order = append(q.order, typeName)
+	}
+
+	q.waiting[typeName] = append(q.waiting[typeName], ready)
+	q.mutex.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		q.mutex.Lock()
+		removed := q.remove(typeName, ready)
+		q.mutex.Unlock()
+
+		// The slot was handed off concurrently with the cancellation, so
+		// it must be passed along to prevent leaking it.
+		if !removed {
+			q.Release()
+		}
+
+		return ctx.Err()
+	}
+}
+
+// Release gives up an active slot, handing it to the next waiting request
+// in round-robin resource type order, if any.
+func (q *readResourceQueue) Release() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.order) == 0 {
+		q.active--
+
+		return
+	}
+
+	typeName := q.order[0]
+	q.order = q.order[1:]
+
+	ready := q.waiting[typeName][0]
+	q.waiting[typeName] = q.waiting[typeName][1:]
+
+	if len(q.waiting[typeName]) > 0 {
+		q.order = append(q.order, typeName)
+	} else {
+		delete(q.waiting, typeName)
+	}
+
+	close(ready)
+}
+
+// remove deletes a waiting request, returning false if it was no longer
+// waiting. The caller must hold the mutex.
+func (q *readResourceQueue) remove(typeName string, ready chan struct{}) bool {
+	for i, waiting := range q.waiting[typeName] {
+		if waiting != ready {
+			continue
+		}
+
+		q.waiting[typeName] = append(q.waiting[typeName][:i], q.waiting[typeName][i+1:]...)
+
+		if len(q.waiting[typeName]) > 0 {
+			return true
+		}
+
+		delete(q.waiting, typeName)
+
+		for j, orderTypeName := range q.order {
+			if orderTypeName == typeName {
+				q.order = append(q.order[:j], q.order[j+1:]...)
+
+				break
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,122 @@
+package fwserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadResourceQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	queue := newReadResourceQueue(1)
+
+	if err := queue.Acquire(ctx, "test_a"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	var gotMutex sync.Mutex
+	var wg sync.WaitGroup
+
+	// Queue requests in order, waiting until each is registered, so the
+	// round-robin order between resource types is deterministic.
+	for i, typeName := range []string{"test_a", "test_a", "test_a", "test_b", "test_c"} {
+		wg.Add(1)
+
+		go func(typeName string) {
+			defer wg.Done()
+
+			if err := queue.Acquire(ctx, typeName); err != nil {
+				t.Errorf("unexpected error: %s", err)
+
+				return
+			}
+
+			gotMutex.Lock()
+			got = append(got, typeName)
+			gotMutex.Unlock()
+
+			queue.Release()
+		}(typeName)
+
+		testWaitForQueued(t, queue, i+1)
+	}
+
+	queue.Release()
+	wg.Wait()
+
+	expected := []string{"test_a", "test_b", "test_c", "test_a", "test_a"}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if queue.active != 0 {
+		t.Errorf("expected no active requests, got: %d", queue.active)
+	}
+}
+
+func TestReadResourceQueue_canceled(t *testing.T) {
+	t.Parallel()
+
+	queue := newReadResourceQueue(1)
+
+	if err := queue.Acquire(context.Background(), "test_a"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- queue.Acquire(ctx, "test_b")
+	}()
+
+	testWaitForQueued(t, queue, 1)
+	cancel()
+
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+
+	queue.Release()
+
+	if queue.active != 0 {
+		t.Errorf("expected no active requests, got: %d", queue.active)
+	}
+
+	if len(queue.order) != 0 || len(queue.waiting) != 0 {
+		t.Errorf("expected no waiting requests, got order: %v", queue.order)
+	}
+}
+
+// testWaitForQueued waits until the queue has the expected number of waiting
+// requests.
+func testWaitForQueued(t *testing.T, queue *readResourceQueue, expected int) {
+	t.Helper()
+
+	for i := 0; i < 1000; i++ {
+		queue.mutex.Lock()
+
+		var queued int
+
+		for _, waiting := range queue.waiting {
+			queued += len(waiting)
+		}
+
+		queue.mutex.Unlock()
+
+		if queued == expected {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d queued requests", expected)
+}
@@ -0,0 +1,131 @@
+package fwserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// RPCContexts tracks the contexts of in-flight RPCs, so they can be canceled
+// by the StopProvider RPC. Protocol servers embed this type and register a
+// context for each RPC.
+type RPCContexts struct {
+	// applyCancels are the cancellation functions of in-flight
+	// ApplyResourceChange RPCs, which are handled according to the Server
+	// StopApplyMode.
+	applyCancels map[uint64]context.CancelFunc
+
+	// cancels are the cancellation functions of other in-flight RPCs. Both
+	// maps are keyed by a registration ID, so each function is removed when
+	// its RPC handling completes.
+	cancels map[uint64]context.CancelFunc
+
+	// id is the last registration ID.
+	id uint64
+
+	// applyStopped is true after StopProvider with StopApplyModeFutureOnly,
+	// so contexts of later ApplyResourceChange RPCs are canceled. Contexts of
+	// other later RPCs are unaffected.
+	applyStopped bool
+
+	mutex sync.Mutex
+}
+
+// RegisterContext returns a cancellable context for the given RPC, which is
+// canceled by CancelRegisteredContexts or after any Server RPCTimeout. The
+// context loggers include a unique request ID and the RPC name, and the
+// context carries any Server DiagnosticsDetailSuffix for framework-generated
+// error diagnostics. The returned function must be called when the RPC
+// handling completes, which cancels the context and stops tracking it.
+func (c *RPCContexts) RegisterContext(in context.Context, s *Server, rpc string) (context.Context, context.CancelFunc) {
+	return c.register(in, s, rpc, false)
+}
+
+// RegisterApplyContext is the same as RegisterContext, except the context is
+// canceled by CancelRegisteredContexts according to the Server
+// StopApplyMode.
+func (c *RPCContexts) RegisterApplyContext(in context.Context, s *Server, rpc string) (context.Context, context.CancelFunc) {
+	return c.register(in, s, rpc, true)
+}
+
+// register implements RegisterContext and RegisterApplyContext.
+func (c *RPCContexts) register(in context.Context, s *Server, rpc string, apply bool) (context.Context, context.CancelFunc) {
+	ctx, cancel := s.RPCTimeoutContext(s.DiagnosticsContext(logging.RequestContext(in, rpc)))
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if apply && c.applyStopped {
+		cancel()
+		return ctx, cancel
+	}
+	if c.cancels == nil {
+		c.cancels = make(map[uint64]context.CancelFunc)
+	}
+	if c.applyCancels == nil {
+		c.applyCancels = make(map[uint64]context.CancelFunc)
+	}
+	c.id++
+	id := c.id
+	if apply {
+		c.applyCancels[id] = cancel
+	} else {
+		c.cancels[id] = cancel
+	}
+	return ctx, func() {
+		c.mutex.Lock()
+		delete(c.applyCancels, id)
+		delete(c.cancels, id)
+		c.mutex.Unlock()
+		cancel()
+	}
+}
+
+// CancelRegisteredContexts cancels the contexts of all in-flight RPCs.
+// Contexts of ApplyResourceChange RPCs are canceled according to the Server
+// StopApplyMode.
+func (c *RPCContexts) CancelRegisteredContexts(ctx context.Context, s *Server) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, cancel := range c.cancels {
+		cancel()
+	}
+	c.cancels = nil
+
+	applyCancels := c.applyCancels
+	c.applyCancels = nil
+
+	switch s.StopApplyMode {
+	case StopApplyModeGracePeriod:
+		logging.FrameworkDebug(ctx, "Delaying cancellation of in-flight ApplyResourceChange", map[string]interface{}{logging.KeyStopApplyGracePeriod: s.StopApplyGracePeriod.String()})
+
+		time.AfterFunc(s.StopApplyGracePeriod, func() {
+			for _, cancel := range applyCancels {
+				cancel()
+			}
+		})
+	case StopApplyModeFutureOnly:
+		logging.FrameworkDebug(ctx, "Skipping cancellation of in-flight ApplyResourceChange")
+
+		c.applyStopped = true
+	default:
+		for _, cancel := range applyCancels {
+			cancel()
+		}
+	}
+}
+
+// LogRequestCompletion logs a summary of a completed RPC, including its
+// duration since the given start time and the number of response diagnostics.
+func LogRequestCompletion(ctx context.Context, start time.Time, diags *diag.Diagnostics) {
+	logging.FrameworkDebug(
+		ctx,
+		"Completed framework request",
+		map[string]interface{}{
+			logging.KeyDiagnosticErrorCount:   diags.ErrorsCount(),
+			logging.KeyDiagnosticWarningCount: diags.WarningsCount(),
+			logging.KeyRequestDurationMs:      time.Since(start).Milliseconds(),
+		},
+	)
+}
@@ -0,0 +1,113 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRPCContextsRegisterContext_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+	c := &RPCContexts{}
+
+	for i := 0; i < 5000; i++ {
+		_, cancel := c.RegisterContext(context.Background(), s, "GetProviderSchema")
+		cancel()
+
+		_, applyCancel := c.RegisterApplyContext(context.Background(), s, "ApplyResourceChange")
+		applyCancel()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.cancels) != 0 {
+		t.Errorf("expected no registered contexts, got: %d", len(c.cancels))
+	}
+
+	if len(c.applyCancels) != 0 {
+		t.Errorf("expected no registered apply contexts, got: %d", len(c.applyCancels))
+	}
+}
+
+func TestRPCContextsCancelRegisteredContexts_StopApplyMode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stopApplyMode        StopApplyMode
+		stopApplyGracePeriod time.Duration
+		expectApplyCanceled  bool
+		expectFutureCanceled bool
+	}{
+		"immediate": {
+			stopApplyMode:       StopApplyModeImmediate,
+			expectApplyCanceled: true,
+		},
+		"grace-period": {
+			stopApplyMode:        StopApplyModeGracePeriod,
+			stopApplyGracePeriod: 100 * time.Millisecond,
+			expectApplyCanceled:  true,
+		},
+		"future-only": {
+			stopApplyMode:        StopApplyModeFutureOnly,
+			expectFutureCanceled: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Server{
+				StopApplyGracePeriod: testCase.stopApplyGracePeriod,
+				StopApplyMode:        testCase.stopApplyMode,
+			}
+			c := &RPCContexts{}
+
+			ctx, cancel := c.RegisterContext(context.Background(), s, "Test")
+			defer cancel()
+
+			applyCtx, applyCancel := c.RegisterApplyContext(context.Background(), s, "ApplyResourceChange")
+			defer applyCancel()
+
+			c.CancelRegisteredContexts(context.Background(), s)
+
+			if ctx.Err() == nil {
+				t.Error("expected non-apply context to be canceled immediately")
+			}
+
+			if testCase.stopApplyGracePeriod > 0 && applyCtx.Err() != nil {
+				t.Error("expected apply context to not be canceled before grace period")
+			}
+
+			select {
+			case <-applyCtx.Done():
+				if !testCase.expectApplyCanceled {
+					t.Error("expected apply context to not be canceled")
+				}
+			case <-time.After(testCase.stopApplyGracePeriod + time.Second):
+				if testCase.expectApplyCanceled {
+					t.Error("timed out waiting for apply context to be canceled")
+				}
+			}
+
+			futureCtx, futureCancel := c.RegisterApplyContext(context.Background(), s, "ApplyResourceChange")
+			defer futureCancel()
+
+			if got := futureCtx.Err() != nil; got != testCase.expectFutureCanceled {
+				t.Errorf("expected future context canceled to be %t, got: %t", testCase.expectFutureCanceled, got)
+			}
+
+			futureNonApplyCtx, futureNonApplyCancel := c.RegisterContext(context.Background(), s, "ReadResource")
+			defer futureNonApplyCancel()
+
+			if futureNonApplyCtx.Err() != nil {
+				t.Error("expected future non-apply context to not be canceled")
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// rpcTimeoutKey is the context key of the Server RPCTimeout.
+type rpcTimeoutKey struct{}
+
+// RPCTimeoutContext returns a cancellable context, which has a deadline of
+// the RPCTimeout if set. Protocol servers call this for each RPC and call
+// RPCTimeoutDiagnostics with the context before returning the response.
+func (s *Server) RPCTimeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.RPCTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(context.WithValue(ctx, rpcTimeoutKey{}, s.RPCTimeout), s.RPCTimeout)
+}
+
+// RPCTimeoutDiagnostics returns an error diagnostic if the context deadline
+// from RPCTimeoutContext was exceeded, which explains any context errors
+// returned by provider-defined logic.
+func RPCTimeoutDiagnostics(ctx context.Context, rpc string) diag.Diagnostics {
+	timeout, ok := ctx.Value(rpcTimeoutKey{}).(time.Duration)
+
+	if !ok || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Provider Operation Timeout",
+			fmt.Sprintf("The provider did not finish the %s operation within the provider RPC timeout of %s, so the operation was aborted. ", rpc, timeout)+
+				"Other errors, such as context deadline exceeded errors, may be caused by the aborted operation. "+
+				"Retry the operation or report this issue to the provider developers if it persists.",
+		),
+	}
+}
@@ -51,7 +51,9 @@ type ModifySchemaPlanResponse struct {
 }
 
 // SchemaModifyPlan runs all AttributePlanModifiers in all schema attributes
-// and blocks.
+// and blocks. Attributes are modified before blocks, each in lexical name
+// order. The Plan in each plan modifier request includes the modifications
+// of the attributes and blocks which were already modified.
 //
 // TODO: Clean up this abstraction back into an internal Schema type method.
 // The extra Schema parameter is a carry-over of creating the proto6server
@@ -78,12 +80,15 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		TerraformValue: req.State.Raw,
 	}
 
-	for name, attribute := range s.GetAttributes() {
+	attributes := s.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		attribute := attributes[name]
 		attrReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
-			Plan:          req.Plan,
+			Plan:          resp.Plan,
 			ProviderMeta:  req.ProviderMeta,
 			Private:       req.Private,
 		}
@@ -135,12 +140,15 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		resp.Private = attrResp.Private
 	}
 
-	for name, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		block := blocks[name]
 		blockReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
-			Plan:          req.Plan,
+			Plan:          resp.Plan,
 			ProviderMeta:  req.ProviderMeta,
 			Private:       req.Private,
 		}
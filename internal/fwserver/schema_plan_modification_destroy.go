@@ -0,0 +1,149 @@
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SchemaModifyDestroyPlan runs all plan modifiers of root schema attributes
+// and blocks which implement planmodifier.Destroy. It is only intended to be
+// called for destroy plans, where the plan is null, and the response Plan is
+// never modified.
+func SchemaModifyDestroyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPlanRequest, resp *ModifySchemaPlanResponse) {
+	stateData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         req.State.Schema,
+		TerraformValue: req.State.Raw,
+	}
+
+	planModifiers := make(map[string][]planmodifier.Describer, len(s.GetAttributes())+len(s.GetBlocks()))
+
+	for name, attribute := range s.GetAttributes() {
+		planModifiers[name] = schemaPlanModifiers(attribute)
+	}
+
+	for name, block := range s.GetBlocks() {
+		planModifiers[name] = schemaPlanModifiers(block)
+	}
+
+	names := make([]string, 0, len(planModifiers))
+
+	for name := range planModifiers {
+		names = append(names, name)
+	}
+
+	// Ensure deterministic diagnostics ordering.
+	sort.Strings(names)
+
+	for _, name := range names {
+		attributePath := path.Root(name)
+
+		for _, planModifier := range planModifiers[name] {
+			destroyPlanModifier, ok := planModifier.(planmodifier.Destroy)
+
+			if !ok {
+				continue
+			}
+
+			stateValue, diags := stateData.ValueAtPath(ctx, attributePath)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			destroyReq := planmodifier.DestroyRequest{
+				Path:           attributePath,
+				PathExpression: attributePath.Expression(),
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        resp.Private,
+			}
+
+			// Instantiate a new response for each request to prevent plan modifiers
+			// from modifying or removing diagnostics.
+			destroyResp := &planmodifier.DestroyResponse{
+				Private: resp.Private,
+			}
+
+			logging.FrameworkDebug(
+				ctx,
+				"Calling provider defined planmodifier.Destroy",
+				map[string]interface{}{
+					logging.KeyAttributePath: attributePath.String(),
+					logging.KeyDescription:   planModifier.Description(ctx),
+				},
+			)
+
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "planmodifier.Destroy")
+			destroyPlanModifier.PlanModifyDestroy(methodCtx, destroyReq, destroyResp)
+			afterProviderMethod()
+
+			logging.FrameworkDebug(
+				ctx,
+				"Called provider defined planmodifier.Destroy",
+				map[string]interface{}{
+					logging.KeyAttributePath: attributePath.String(),
+					logging.KeyDescription:   planModifier.Description(ctx),
+				},
+			)
+
+			resp.Diagnostics.Append(destroyResp.Diagnostics...)
+			resp.Private = destroyResp.Private
+		}
+	}
+}
+
+// schemaPlanModifiers returns all plan modifiers of the given schema
+// attribute or block, regardless of value type.
+func schemaPlanModifiers(a any) []planmodifier.Describer {
+	var result []planmodifier.Describer
+
+	switch a := a.(type) {
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		for _, planModifier := range a.BoolPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		for _, planModifier := range a.Float64PlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		for _, planModifier := range a.Int64PlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		for _, planModifier := range a.ListPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		for _, planModifier := range a.MapPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ NumberPlanModifiers() []planmodifier.Number }:
+		for _, planModifier := range a.NumberPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		for _, planModifier := range a.ObjectPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		for _, planModifier := range a.SetPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		for _, planModifier := range a.StringPlanModifiers() {
+			result = append(result, planModifier)
+		}
+	}
+
+	return result
+}
@@ -2442,3 +2442,92 @@ func TestSchemaModifyPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaModifyPlan_PriorModifications(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"volume_id":   tftypes.String,
+			"snapshot_id": tftypes.String,
+		},
+	}
+
+	// The snapshot_id attribute is modified before volume_id, due to lexical
+	// ordering, so the volume_id plan modifier can read the modified value.
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"snapshot_id": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = req.StateValue
+						},
+					},
+				},
+			},
+			"volume_id": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							var planSnapshotID, stateSnapshotID types.String
+
+							resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("snapshot_id"), &planSnapshotID)...)
+							resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("snapshot_id"), &stateSnapshotID)...)
+
+							if planSnapshotID.Equal(stateSnapshotID) {
+								resp.PlanValue = req.StateValue
+							}
+						},
+					},
+				},
+			},
+		},
+	}
+
+	req := ModifySchemaPlanRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"snapshot_id": tftypes.NewValue(tftypes.String, nil),
+				"volume_id":   tftypes.NewValue(tftypes.String, nil),
+			}),
+			Schema: schema,
+		},
+		Plan: tfsdk.Plan{
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"snapshot_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"volume_id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			Schema: schema,
+		},
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"snapshot_id": tftypes.NewValue(tftypes.String, "snap-123"),
+				"volume_id":   tftypes.NewValue(tftypes.String, "vol-123"),
+			}),
+			Schema: schema,
+		},
+	}
+
+	expectedResp := ModifySchemaPlanResponse{
+		Plan: tfsdk.Plan{
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"snapshot_id": tftypes.NewValue(tftypes.String, "snap-123"),
+				"volume_id":   tftypes.NewValue(tftypes.String, "vol-123"),
+			}),
+			Schema: schema,
+		},
+	}
+
+	got := ModifySchemaPlanResponse{
+		Plan: req.Plan,
+	}
+
+	SchemaModifyPlan(context.Background(), schema, req, &got)
+
+	if diff := cmp.Diff(expectedResp, got, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+		t.Errorf("Unexpected response (-wanted, +got): %s", diff)
+	}
+}
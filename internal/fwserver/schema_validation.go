@@ -34,7 +34,12 @@ type ValidateSchemaResponse struct {
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
-	for name, attribute := range s.GetAttributes() {
+	// Validate attributes and blocks in name order, so diagnostics are
+	// returned in a deterministic order.
+	attributes := s.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		attribute := attributes[name]
 
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
@@ -50,7 +55,11 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 		resp.Diagnostics.Append(attributeResp.Diagnostics...)
 	}
 
-	for name, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		block := blocks[name]
+
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
 			AttributePathExpression: path.MatchRoot(name),
@@ -4,9 +4,16 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/audit"
+	"github.com/hashicorp/terraform-plugin-framework/capability"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/instrumentation"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -19,6 +26,11 @@ import (
 type Server struct {
 	Provider provider.Provider
 
+	// Capabilities is the [provider.ConfigureResponse.Capabilities] field
+	// value which is used to validate resource and data source attribute
+	// requirements.
+	Capabilities *capability.Capabilities
+
 	// DataSourceConfigureData is the
 	// [provider.ConfigureResponse.DataSourceData] field value which is passed
 	// to [datasource.ConfigureRequest.ProviderData].
@@ -29,6 +41,57 @@ type Server struct {
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// AuditLabels are included in every audit record written to AuditSink.
+	AuditLabels map[string]string
+
+	// AuditSink, if set, receives an audit record for each resource Create,
+	// Read, Update, and Delete operation.
+	AuditSink audit.Sink
+
+	// DiagnosticsDetailSuffix is appended to the detail of framework-generated
+	// error diagnostics which should be reported to the provider developers,
+	// such as a provider issue tracker URL. Protocol servers must call
+	// DiagnosticsContext for each RPC.
+	DiagnosticsDetailSuffix string
+
+	// EventListeners receive provider process lifecycle events.
+	EventListeners []provider.EventListener
+
+	// Instrumentation, if set, is called around the handling of each RPC and
+	// each provider-defined method call. Protocol servers must call
+	// InstrumentRPC for each RPC.
+	Instrumentation instrumentation.Instrumentation
+
+	// ReadResourceConcurrency is the maximum number of ReadResource RPCs
+	// which are processed concurrently. Additional requests are queued
+	// fairly per resource type. Zero or less is unlimited.
+	ReadResourceConcurrency int
+
+	// RPCTimeout is the default deadline of each RPC, after which the
+	// context of provider-defined logic is canceled. Zero or less is
+	// unlimited.
+	RPCTimeout time.Duration
+
+	// StopApplyMode determines how the StopProvider RPC affects in-flight
+	// ApplyResourceChange RPCs.
+	StopApplyMode StopApplyMode
+
+	// StopApplyGracePeriod is the delay before in-flight ApplyResourceChange
+	// RPCs are canceled by the StopProvider RPC, when StopApplyMode is
+	// StopApplyModeGracePeriod.
+	StopApplyGracePeriod time.Duration
+
+	// StopTimeout is the deadline of the provider defined Stop method during
+	// the StopProvider RPC, before in-flight RPCs are canceled. Zero or less
+	// uses DefaultStopTimeout.
+	StopTimeout time.Duration
+
+	// StrictMode enables development time checks, such as recovering panics
+	// into error diagnostics with stack traces, schema warnings, and state
+	// consistency errors. It is intended for provider development and
+	// testing, not production usage.
+	StrictMode bool
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -43,6 +106,16 @@ type Server struct {
 	// access from race conditions.
 	dataSourceSchemasMutex sync.Mutex
 
+	// validatedDataSourceConfigs is the set of data source type names and
+	// fully known configurations which were validated by the
+	// ValidateDataSourceConfig RPC, so the ReadDataSource RPC does not
+	// validate them again.
+	validatedDataSourceConfigs map[string]struct{}
+
+	// validatedDataSourceConfigsMutex is a mutex to protect concurrent
+	// validatedDataSourceConfigs access from race conditions.
+	validatedDataSourceConfigsMutex sync.Mutex
+
 	// dataSourceFuncs is the cached DataSource functions for RPCs that need to
 	// access data sources. If not found, it will be fetched from the
 	// Provider.DataSources() method.
@@ -71,6 +144,18 @@ type Server struct {
 	// access from race conditions.
 	providerSchemaMutex sync.Mutex
 
+	// interfaceReportOnce is used to log the optional interface report.
+	interfaceReportOnce sync.Once
+
+	// providerConfigureOnce is used to emit the first Configure event.
+	providerConfigureOnce sync.Once
+
+	// configureDataMutex is a mutex to protect concurrent Capabilities,
+	// DataSourceConfigureData, and ResourceConfigureData access from race
+	// conditions, since ConfigureProvider can be called while other RPCs are
+	// in flight.
+	configureDataMutex sync.RWMutex
+
 	// providerMetaSchema is the cached Provider Meta Schema for RPCs that need
 	// to convert configuration data from the protocol. If not found, it will
 	// be fetched from the Provider.GetMetaSchema() method.
@@ -85,9 +170,21 @@ type Server struct {
 	// access from race conditions.
 	providerMetaSchemaMutex sync.Mutex
 
-	// providerTypeName is the type name of the provider, if the provider
-	// implemented the Metadata method.
-	providerTypeName string
+	// providerTypeName is the cached type name of the provider, if the
+	// provider implemented the Metadata method. If not found, it will be
+	// fetched from the Provider.Metadata() method.
+	providerTypeName *string
+
+	// providerTypeNameMutex is a mutex to protect concurrent providerTypeName
+	// access from race conditions.
+	providerTypeNameMutex sync.Mutex
+
+	// readResourceQueue is the queue for limiting concurrent ReadResource
+	// RPCs, if ReadResourceConcurrency is configured.
+	readResourceQueue *readResourceQueue
+
+	// readResourceQueueOnce is used to create readResourceQueue on first use.
+	readResourceQueueOnce sync.Once
 
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
@@ -103,6 +200,16 @@ type Server struct {
 	// access from race conditions.
 	resourceSchemasMutex sync.Mutex
 
+	// unknownResourceConfigs is the configurations containing unknown values
+	// which were validated by the ValidateResourceConfig RPC, by resource
+	// type name, so the PlanResourceChange RPC validates those values once
+	// known.
+	unknownResourceConfigs map[string][]tftypes.Value
+
+	// unknownResourceConfigsMutex is a mutex to protect concurrent
+	// unknownResourceConfigs access from race conditions.
+	unknownResourceConfigsMutex sync.Mutex
+
 	// resourceFuncs is the cached Resource functions for RPCs that need to
 	// access resources. If not found, it will be fetched from the
 	// Provider.Resources() method.
@@ -126,7 +233,7 @@ func (s *Server) DataSource(ctx context.Context, typeName string) (datasource.Da
 
 	if !ok {
 		diags.AddError(
-			"Data Source Type Not Found",
+			diag.SummaryDataSourceTypeNotFound,
 			fmt.Sprintf("No data source type named %q was found in the provider.", typeName),
 		)
 
@@ -144,20 +251,22 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 	defer s.dataSourceTypesMutex.Unlock()
 
 	if s.dataSourceFuncs != nil {
-		return s.dataSourceFuncs, s.dataSourceTypesDiags
+		return s.dataSourceFuncs, copyDiagnostics(s.dataSourceTypesDiags)
 	}
 
 	s.dataSourceFuncs = make(map[string]func() datasource.DataSource)
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider DataSources")
-	dataSourceFuncsSlice := s.Provider.DataSources(ctx)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider DataSources")
+	dataSourceFuncsSlice := s.Provider.DataSources(methodCtx)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Provider DataSources")
 
 	for _, dataSourceFunc := range dataSourceFuncsSlice {
 		dataSource := dataSourceFunc()
 
 		dataSourceTypeNameReq := datasource.MetadataRequest{
-			ProviderTypeName: s.providerTypeName,
+			ProviderTypeName: s.ProviderTypeName(ctx),
 		}
 		dataSourceTypeNameResp := datasource.MetadataResponse{}
 
@@ -166,8 +275,11 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 		if dataSourceTypeNameResp.TypeName == "" {
 			s.dataSourceTypesDiags.AddError(
 				"Data Source Type Name Missing",
-				fmt.Sprintf("The %T DataSource returned an empty string from the Metadata method. ", dataSource)+
-					"This is always an issue with the provider and should be reported to the provider developers.",
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					fmt.Sprintf("The %T DataSource returned an empty string from the Metadata method. ", dataSource)+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
 			)
 			continue
 		}
@@ -176,10 +288,13 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 
 		if _, ok := s.dataSourceFuncs[dataSourceTypeNameResp.TypeName]; ok {
 			s.dataSourceTypesDiags.AddError(
-				"Duplicate Data Source Type Defined",
-				fmt.Sprintf("The %s data source type name was returned for multiple data sources. ", dataSourceTypeNameResp.TypeName)+
-					"Data source type names must be unique. "+
-					"This is always an issue with the provider and should be reported to the provider developers.",
+				diag.SummaryDuplicateDataSourceTypeDefined,
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					fmt.Sprintf("The %s data source type name was returned for multiple data sources. ", dataSourceTypeNameResp.TypeName)+
+						"Data source type names must be unique. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
 			)
 			continue
 		}
@@ -187,7 +302,7 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 		s.dataSourceFuncs[dataSourceTypeNameResp.TypeName] = dataSourceFunc
 	}
 
-	return s.dataSourceFuncs, s.dataSourceTypesDiags
+	return s.dataSourceFuncs, copyDiagnostics(s.dataSourceTypesDiags)
 }
 
 // DataSourceSchema returns the Schema associated with the DataSourceType for
@@ -200,8 +315,11 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 	if !ok {
 		diags.AddError(
 			"Data Source Schema Not Found",
-			fmt.Sprintf("No data source type named %q was found in the provider to fetch the schema. ", typeName)+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				fmt.Sprintf("No data source type named %q was found in the provider to fetch the schema. ", typeName)+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+			),
 		)
 
 		return nil, diags
@@ -218,7 +336,7 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 	defer s.dataSourceSchemasMutex.Unlock()
 
 	if s.dataSourceSchemas != nil {
-		return s.dataSourceSchemas, s.dataSourceSchemasDiags
+		return s.dataSourceSchemas, copyDiagnostics(s.dataSourceSchemasDiags)
 	}
 
 	s.dataSourceSchemas = map[string]fwschema.Schema{}
@@ -227,32 +345,34 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 
 	s.dataSourceSchemasDiags = diags
 
-	for dataSourceTypeName, dataSourceFunc := range dataSourceFuncs {
-		dataSource := dataSourceFunc()
+	for _, dataSourceTypeName := range fwschema.SortedNames(dataSourceFuncs) {
+		dataSource := dataSourceFuncs[dataSourceTypeName]()
 
 		schemaReq := datasource.SchemaRequest{}
 		schemaResp := datasource.SchemaResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource Schema", map[string]interface{}{logging.KeyDataSourceType: dataSourceTypeName})
-		dataSource.Schema(ctx, schemaReq, &schemaResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource Schema")
+		dataSource.Schema(methodCtx, schemaReq, &schemaResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource Schema", map[string]interface{}{logging.KeyDataSourceType: dataSourceTypeName})
 
 		s.dataSourceSchemasDiags.Append(schemaResp.Diagnostics...)
 
 		if s.dataSourceSchemasDiags.HasError() {
-			return s.dataSourceSchemas, s.dataSourceSchemasDiags
+			return s.dataSourceSchemas, copyDiagnostics(s.dataSourceSchemasDiags)
 		}
 
 		s.dataSourceSchemasDiags.Append(schemaResp.Schema.ValidateImplementation(ctx)...)
 
 		if s.dataSourceSchemasDiags.HasError() {
-			return s.dataSourceSchemas, s.dataSourceSchemasDiags
+			return s.dataSourceSchemas, copyDiagnostics(s.dataSourceSchemasDiags)
 		}
 
 		s.dataSourceSchemas[dataSourceTypeName] = schemaResp.Schema
 	}
 
-	return s.dataSourceSchemas, s.dataSourceSchemasDiags
+	return s.dataSourceSchemas, copyDiagnostics(s.dataSourceSchemasDiags)
 }
 
 // ProviderSchema returns the Schema associated with the Provider. The Schema
@@ -263,14 +383,16 @@ func (s *Server) ProviderSchema(ctx context.Context) (fwschema.Schema, diag.Diag
 	defer s.providerSchemaMutex.Unlock()
 
 	if s.providerSchema != nil {
-		return s.providerSchema, s.providerSchemaDiags
+		return s.providerSchema, copyDiagnostics(s.providerSchemaDiags)
 	}
 
 	schemaReq := provider.SchemaRequest{}
 	schemaResp := provider.SchemaResponse{}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Schema")
-	s.Provider.Schema(ctx, schemaReq, &schemaResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider Schema")
+	s.Provider.Schema(methodCtx, schemaReq, &schemaResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Schema")
 
 	s.providerSchema = schemaResp.Schema
@@ -278,7 +400,7 @@ func (s *Server) ProviderSchema(ctx context.Context) (fwschema.Schema, diag.Diag
 
 	s.providerSchemaDiags.Append(schemaResp.Schema.ValidateImplementation(ctx)...)
 
-	return s.providerSchema, s.providerSchemaDiags
+	return s.providerSchema, copyDiagnostics(s.providerSchemaDiags)
 }
 
 // ProviderMetaSchema returns the Meta Schema associated with the Provider, if
@@ -297,14 +419,16 @@ func (s *Server) ProviderMetaSchema(ctx context.Context) (fwschema.Schema, diag.
 	defer s.providerMetaSchemaMutex.Unlock()
 
 	if s.providerMetaSchema != nil {
-		return s.providerMetaSchema, s.providerMetaSchemaDiags
+		return s.providerMetaSchema, copyDiagnostics(s.providerMetaSchemaDiags)
 	}
 
 	req := provider.MetaSchemaRequest{}
 	resp := &provider.MetaSchemaResponse{}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider MetaSchema")
-	providerWithMetaSchema.MetaSchema(ctx, req, resp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider MetaSchema")
+	providerWithMetaSchema.MetaSchema(methodCtx, req, resp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Provider MetaSchema")
 
 	s.providerMetaSchema = resp.Schema
@@ -312,7 +436,7 @@ func (s *Server) ProviderMetaSchema(ctx context.Context) (fwschema.Schema, diag.
 
 	s.providerMetaSchemaDiags.Append(resp.Schema.ValidateImplementation(ctx)...)
 
-	return s.providerMetaSchema, s.providerMetaSchemaDiags
+	return s.providerMetaSchema, copyDiagnostics(s.providerMetaSchemaDiags)
 }
 
 // Resource returns the Resource for a given type name.
@@ -323,7 +447,7 @@ func (s *Server) Resource(ctx context.Context, typeName string) (resource.Resour
 
 	if !ok {
 		diags.AddError(
-			"Resource Type Not Found",
+			diag.SummaryResourceTypeNotFound,
 			fmt.Sprintf("No resource type named %q was found in the provider.", typeName),
 		)
 
@@ -333,6 +457,32 @@ func (s *Server) Resource(ctx context.Context, typeName string) (resource.Resour
 	return resourceFunc(), diags
 }
 
+// ProviderTypeName returns the type name of the provider from the
+// Provider.Metadata() method. The result is cached on first use, so data
+// source and resource type names can be determined regardless of RPC order.
+func (s *Server) ProviderTypeName(ctx context.Context) string {
+	logging.FrameworkTrace(ctx, "Checking ProviderTypeName lock")
+	s.providerTypeNameMutex.Lock()
+	defer s.providerTypeNameMutex.Unlock()
+
+	if s.providerTypeName != nil {
+		return *s.providerTypeName
+	}
+
+	metadataReq := provider.MetadataRequest{}
+	metadataResp := provider.MetadataResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Metadata")
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider Metadata")
+	s.Provider.Metadata(methodCtx, metadataReq, &metadataResp)
+	afterProviderMethod()
+	logging.FrameworkDebug(ctx, "Called provider defined Provider Metadata")
+
+	s.providerTypeName = &metadataResp.TypeName
+
+	return *s.providerTypeName
+}
+
 // ResourceFuncs returns a map of Resource functions. The results are cached
 // on first use.
 func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.Resource, diag.Diagnostics) {
@@ -341,20 +491,22 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 	defer s.resourceTypesMutex.Unlock()
 
 	if s.resourceFuncs != nil {
-		return s.resourceFuncs, s.resourceTypesDiags
+		return s.resourceFuncs, copyDiagnostics(s.resourceTypesDiags)
 	}
 
 	s.resourceFuncs = make(map[string]func() resource.Resource)
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Resources")
-	resourceFuncsSlice := s.Provider.Resources(ctx)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider Resources")
+	resourceFuncsSlice := s.Provider.Resources(methodCtx)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Resources")
 
 	for _, resourceFunc := range resourceFuncsSlice {
 		res := resourceFunc()
 
 		resourceTypeNameReq := resource.MetadataRequest{
-			ProviderTypeName: s.providerTypeName,
+			ProviderTypeName: s.ProviderTypeName(ctx),
 		}
 		resourceTypeNameResp := resource.MetadataResponse{}
 
@@ -363,8 +515,11 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 		if resourceTypeNameResp.TypeName == "" {
 			s.resourceTypesDiags.AddError(
 				"Resource Type Name Missing",
-				fmt.Sprintf("The %T Resource returned an empty string from the Metadata method. ", res)+
-					"This is always an issue with the provider and should be reported to the provider developers.",
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					fmt.Sprintf("The %T Resource returned an empty string from the Metadata method. ", res)+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
 			)
 			continue
 		}
@@ -373,10 +528,13 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 
 		if _, ok := s.resourceFuncs[resourceTypeNameResp.TypeName]; ok {
 			s.resourceTypesDiags.AddError(
-				"Duplicate Resource Type Defined",
-				fmt.Sprintf("The %s resource type name was returned for multiple resources. ", resourceTypeNameResp.TypeName)+
-					"Resource type names must be unique. "+
-					"This is always an issue with the provider and should be reported to the provider developers.",
+				diag.SummaryDuplicateResourceTypeDefined,
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					fmt.Sprintf("The %s resource type name was returned for multiple resources. ", resourceTypeNameResp.TypeName)+
+						"Resource type names must be unique. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
 			)
 			continue
 		}
@@ -384,7 +542,7 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 		s.resourceFuncs[resourceTypeNameResp.TypeName] = resourceFunc
 	}
 
-	return s.resourceFuncs, s.resourceTypesDiags
+	return s.resourceFuncs, copyDiagnostics(s.resourceTypesDiags)
 }
 
 // ResourceSchema returns the Schema associated with the ResourceType for
@@ -397,8 +555,11 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 	if !ok {
 		diags.AddError(
 			"Resource Schema Not Found",
-			fmt.Sprintf("No resource type named %q was found in the provider to fetch the schema. ", typeName)+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				fmt.Sprintf("No resource type named %q was found in the provider to fetch the schema. ", typeName)+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+			),
 		)
 
 		return nil, diags
@@ -415,7 +576,7 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 	defer s.resourceSchemasMutex.Unlock()
 
 	if s.resourceSchemas != nil {
-		return s.resourceSchemas, s.resourceSchemasDiags
+		return s.resourceSchemas, copyDiagnostics(s.resourceSchemasDiags)
 	}
 
 	s.resourceSchemas = map[string]fwschema.Schema{}
@@ -424,30 +585,47 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 
 	s.resourceSchemasDiags = diags
 
-	for resourceTypeName, resourceFunc := range resourceFuncs {
-		res := resourceFunc()
+	for _, resourceTypeName := range fwschema.SortedNames(resourceFuncs) {
+		res := resourceFuncs[resourceTypeName]()
 
 		schemaReq := resource.SchemaRequest{}
 		schemaResp := resource.SchemaResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Schema", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
-		res.Schema(ctx, schemaReq, &schemaResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Schema")
+		res.Schema(methodCtx, schemaReq, &schemaResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Schema", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
 
 		s.resourceSchemasDiags.Append(schemaResp.Diagnostics...)
 
 		if s.resourceSchemasDiags.HasError() {
-			return s.resourceSchemas, s.resourceSchemasDiags
+			return s.resourceSchemas, copyDiagnostics(s.resourceSchemasDiags)
 		}
 
 		s.resourceSchemasDiags.Append(schemaResp.Schema.ValidateImplementation(ctx)...)
 
 		if s.resourceSchemasDiags.HasError() {
-			return s.resourceSchemas, s.resourceSchemasDiags
+			return s.resourceSchemas, copyDiagnostics(s.resourceSchemasDiags)
 		}
 
 		s.resourceSchemas[resourceTypeName] = schemaResp.Schema
 	}
 
-	return s.resourceSchemas, s.resourceSchemasDiags
+	return s.resourceSchemas, copyDiagnostics(s.resourceSchemasDiags)
+}
+
+// copyDiagnostics returns a copy of cached diagnostics, so callers appending
+// to the returned diagnostics during concurrent RPCs cannot write into the
+// shared underlying array.
+func copyDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	if diags == nil {
+		return nil
+	}
+
+	result := make(diag.Diagnostics, len(diags))
+
+	copy(result, diags)
+
+	return result
 }
@@ -21,6 +21,8 @@ type ApplyResourceChangeRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+	// TypeName is the resource type name, which is used for audit records.
+	TypeName string
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
@@ -33,6 +35,8 @@ type ApplyResourceChangeResponse struct {
 
 // ApplyResourceChange implements the framework server ApplyResourceChange RPC.
 func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest, resp *ApplyResourceChangeResponse) {
+	defer s.recoverStrictModePanic(ctx, "ApplyResourceChange", &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
@@ -48,6 +52,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 			ProviderMeta:   req.ProviderMeta,
 			ResourceSchema: req.ResourceSchema,
 			Resource:       req.Resource,
+			TypeName:       req.TypeName,
 		}
 		createResp := &CreateResourceResponse{}
 
@@ -57,6 +62,26 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		resp.NewState = createResp.NewState
 		resp.Private = createResp.Private
 
+		if !resp.Diagnostics.HasError() && resp.NewState != nil && req.PlannedState != nil {
+			var float64EqualityDiags diag.Diagnostics
+
+			resp.NewState.Raw, float64EqualityDiags = float64EqualityValue(ctx, req.ResourceSchema, resp.NewState.Raw, req.PlannedState.Raw)
+
+			resp.Diagnostics.Append(float64EqualityDiags...)
+		}
+
+		if !resp.Diagnostics.HasError() {
+			nonComputedDiags := nonComputedStateDiagnostics(ctx, "Create", req.Resource, req.Config, resp.NewState)
+			consistencyDiags := applyConsistencyDiagnostics(ctx, "Create", req.PlannedState, resp.NewState)
+
+			resp.Diagnostics.Append(withoutDiagnosticPaths(consistencyDiags, nonComputedDiags)...)
+			resp.Diagnostics.Append(nonComputedDiags...)
+		}
+
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(s.strictModeStateDiagnostics(ctx, "Create", resp.NewState)...)
+		}
+
 		return
 	}
 
@@ -70,6 +95,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 			ProviderMeta:   req.ProviderMeta,
 			ResourceSchema: req.ResourceSchema,
 			Resource:       req.Resource,
+			TypeName:       req.TypeName,
 		}
 		deleteResp := &DeleteResourceResponse{}
 
@@ -93,6 +119,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		ProviderMeta:   req.ProviderMeta,
 		ResourceSchema: req.ResourceSchema,
 		Resource:       req.Resource,
+		TypeName:       req.TypeName,
 	}
 	updateResp := &UpdateResourceResponse{}
 
@@ -101,4 +128,24 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = updateResp.NewState
 	resp.Private = updateResp.Private
+
+	if !resp.Diagnostics.HasError() && resp.NewState != nil && req.PlannedState != nil {
+		var float64EqualityDiags diag.Diagnostics
+
+		resp.NewState.Raw, float64EqualityDiags = float64EqualityValue(ctx, req.ResourceSchema, resp.NewState.Raw, req.PlannedState.Raw)
+
+		resp.Diagnostics.Append(float64EqualityDiags...)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		nonComputedDiags := nonComputedStateDiagnostics(ctx, "Update", req.Resource, req.Config, resp.NewState)
+		consistencyDiags := applyConsistencyDiagnostics(ctx, "Update", req.PlannedState, resp.NewState)
+
+		resp.Diagnostics.Append(withoutDiagnosticPaths(consistencyDiags, nonComputedDiags)...)
+		resp.Diagnostics.Append(nonComputedDiags...)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(s.strictModeStateDiagnostics(ctx, "Update", resp.NewState)...)
+	}
 }
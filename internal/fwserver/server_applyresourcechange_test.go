@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -108,6 +109,30 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
 
+	// Update implementations in these tests do not call resp.State.Set(),
+	// so the new state does not match the planned state or configuration.
+	testUpdateInconsistentDiagnostics := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test_computed"),
+			"Provider Produced Inconsistent Result",
+			"After Update, the provider returned a value which does not match the planned value. "+
+				"Known planned values must not change and all values must be known after applying changes. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Planned Value: \"test-plannedstate-value\"\n"+
+				"Applied Value: <null>",
+		),
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test_required"),
+			"Provider Set Non-Computed Attribute",
+			"After Update, the provider returned a value which does not match the configuration for an attribute which is not Computed. "+
+				"Only Computed attributes may be set by the provider. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Either mark the attribute as Computed in the schema or return the configuration value.\n\n"+
+				"Configuration Value: \"test-new-value\"\n"+
+				"State Value: \"test-old-value\"",
+		),
+	}
+
 	testEmptyPrivate := &privatestate.Data{
 		Provider: testEmptyProviderData,
 	}
@@ -706,6 +731,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -763,6 +789,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -820,6 +847,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -878,6 +906,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -2,23 +2,65 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/capability"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	defer s.recoverStrictModePanic(ctx, "ConfigureProvider", &resp.Diagnostics)
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
 
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider Configure")
+	configureStart := time.Now()
+
 	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
+		s.Provider.Configure(methodCtx, *req, resp)
 	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+		s.Provider.Configure(methodCtx, provider.ConfigureRequest{}, resp)
 	}
 
+	configureDuration := time.Since(configureStart)
+	afterProviderMethod()
+
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Configure")
+	logDiagnostics(ctx, resp.Diagnostics)
 
+	s.configureDataMutex.Lock()
+	s.Capabilities = resp.Capabilities
 	s.DataSourceConfigureData = resp.DataSourceData
 	s.ResourceConfigureData = resp.ResourceData
+	s.configureDataMutex.Unlock()
+
+	s.providerConfigureOnce.Do(func() {
+		s.EmitEvent(ctx, provider.EventTypeFirstConfigure, configureDuration)
+	})
+}
+
+// capabilities returns the Capabilities field value.
+func (s *Server) capabilities() *capability.Capabilities {
+	s.configureDataMutex.RLock()
+	defer s.configureDataMutex.RUnlock()
+
+	return s.Capabilities
+}
+
+// dataSourceConfigureData returns the DataSourceConfigureData field value.
+func (s *Server) dataSourceConfigureData() any {
+	s.configureDataMutex.RLock()
+	defer s.configureDataMutex.RUnlock()
+
+	return s.DataSourceConfigureData
+}
+
+// resourceConfigureData returns the ResourceConfigureData field value.
+func (s *Server) resourceConfigureData() any {
+	s.configureDataMutex.RLock()
+	defer s.configureDataMutex.RUnlock()
+
+	return s.ResourceConfigureData
 }
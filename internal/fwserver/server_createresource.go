@@ -2,10 +2,13 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/audit"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -22,6 +25,8 @@ type CreateResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+	// TypeName is the resource type name, which is used for audit records.
+	TypeName string
 }
 
 // CreateResourceResponse is the framework server response for a create request
@@ -39,16 +44,20 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
+	defer s.writeAuditRecord(ctx, req.TypeName, audit.ActionCreate, time.Now(), &resp.Diagnostics)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -94,8 +103,11 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Create")
-	req.Resource.Create(ctx, createReq, &createResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Create")
+	req.Resource.Create(methodCtx, createReq, &createResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Create")
+	logDiagnostics(ctx, createResp.Diagnostics)
 
 	resp.Diagnostics = createResp.Diagnostics
 	resp.NewState = &createResp.State
@@ -112,7 +124,7 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 
 		resp.Diagnostics.AddError(
 			"Missing Resource State After Create",
-			detail,
+			fwdiag.ReportableErrorDetail(ctx, detail),
 		)
 	}
 
@@ -2,9 +2,11 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/audit"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -21,6 +23,8 @@ type DeleteResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+	// TypeName is the resource type name, which is used for audit records.
+	TypeName string
 }
 
 // DeleteResourceResponse is the framework server response for a delete request
@@ -38,16 +42,20 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		return
 	}
 
+	defer s.writeAuditRecord(ctx, req.TypeName, audit.ActionDelete, time.Now(), &resp.Diagnostics)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -84,8 +92,11 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Delete")
-	req.Resource.Delete(ctx, deleteReq, &deleteResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Delete")
+	req.Resource.Delete(methodCtx, deleteReq, &deleteResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Delete")
+	logDiagnostics(ctx, deleteResp.Diagnostics)
 
 	if !deleteResp.Diagnostics.HasError() {
 		logging.FrameworkTrace(ctx, "No provider defined Delete errors detected, ensuring State is cleared")
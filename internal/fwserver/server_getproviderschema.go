@@ -2,6 +2,7 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
@@ -26,18 +27,17 @@ type GetProviderSchemaResponse struct {
 
 // GetProviderSchema implements the framework server GetProviderSchema RPC.
 func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRequest, resp *GetProviderSchemaResponse) {
+	defer s.recoverStrictModePanic(ctx, "GetProviderSchema", &resp.Diagnostics)
+
+	schemaStart := time.Now()
+
 	resp.ServerCapabilities = &ServerCapabilities{
 		PlanDestroy: true,
 	}
 
-	metadataReq := provider.MetadataRequest{}
-	metadataResp := provider.MetadataResponse{}
-
-	logging.FrameworkDebug(ctx, "Calling provider defined Provider Metadata")
-	s.Provider.Metadata(ctx, metadataReq, &metadataResp)
-	logging.FrameworkDebug(ctx, "Called provider defined Provider Metadata")
-
-	s.providerTypeName = metadataResp.TypeName
+	// Ensure the provider type name is available for data source and
+	// resource type names.
+	s.ProviderTypeName(ctx)
 
 	providerSchema, diags := s.ProviderSchema(ctx)
 
@@ -78,4 +78,34 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 	}
 
 	resp.DataSourceSchemas = dataSourceSchemas
+
+	s.logInterfaceReport(ctx)
+
+	if s.StrictMode {
+		logging.FrameworkTrace(ctx, "Checking schemas in strict mode")
+
+		resp.Diagnostics.Append(s.strictModeSchemaDiagnostics("provider", providerSchema)...)
+
+		for _, typeName := range fwschema.SortedNames(resourceSchemas) {
+			resp.Diagnostics.Append(s.strictModeSchemaDiagnostics(typeName+" resource", resourceSchemas[typeName])...)
+		}
+
+		for _, typeName := range fwschema.SortedNames(dataSourceSchemas) {
+			resp.Diagnostics.Append(s.strictModeSchemaDiagnostics(typeName+" data source", dataSourceSchemas[typeName])...)
+		}
+
+		resourceReports := s.resourceInterfaceReports(ctx)
+
+		for _, typeName := range fwschema.SortedNames(resourceReports) {
+			resp.Diagnostics.Append(s.strictModeInterfaceDiagnostics(typeName+" resource", resourceReports[typeName])...)
+		}
+
+		dataSourceReports := s.dataSourceInterfaceReports(ctx)
+
+		for _, typeName := range fwschema.SortedNames(dataSourceReports) {
+			resp.Diagnostics.Append(s.strictModeInterfaceDiagnostics(typeName+" data source", dataSourceReports[typeName])...)
+		}
+	}
+
+	s.EmitEvent(ctx, provider.EventTypeSchemaServed, time.Since(schemaStart))
 }
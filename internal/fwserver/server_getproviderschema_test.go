@@ -165,6 +165,52 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"datasourceschemas-nested-attribute-missing-behavior": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+										resp.Schema = datasourceschema.Schema{
+											Attributes: map[string]datasourceschema.Attribute{
+												"test": datasourceschema.SingleNestedAttribute{
+													Attributes: map[string]datasourceschema.Attribute{
+														"test_nested": datasourceschema.StringAttribute{},
+													},
+													Optional: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					PlanDestroy: true,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test.test_nested\" is missing the Computed, Optional, or Required field. "+
+							"One of these fields is required to describe how the attribute is configured.",
+					),
+				},
+			},
+		},
 		"datasourceschemas-duplicate-type-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
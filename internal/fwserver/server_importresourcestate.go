@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -43,6 +44,8 @@ type ImportResourceStateResponse struct {
 
 // ImportResourceState implements the framework server ImportResourceState RPC.
 func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	defer s.recoverStrictModePanic(ctx, "ImportResourceState", &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
@@ -51,12 +54,14 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -80,7 +85,7 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		// created with a method such as:
 		//    ImportNotImplementedMessage(context.Context) string.
 		resp.Diagnostics.AddError(
-			"Resource Import Not Implemented",
+			diag.SummaryResourceImportNotImplemented,
 			"This resource does not support import. Please contact the provider developer for additional information.",
 		)
 		return
@@ -101,8 +106,11 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource ImportState")
-	resourceWithImportState.ImportState(ctx, importReq, &importResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource ImportState")
+	resourceWithImportState.ImportState(methodCtx, importReq, &importResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Resource ImportState")
+	logDiagnostics(ctx, importResp.Diagnostics)
 
 	resp.Diagnostics.Append(importResp.Diagnostics...)
 
@@ -113,8 +121,11 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	if importResp.State.Raw.Equal(req.EmptyState.Raw) {
 		resp.Diagnostics.AddError(
 			"Missing Resource Import State",
-			"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
-				"Resource ImportState method returned no State in response. If import is intentionally not supported, remove the Resource type ImportState method or return an error.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
+					"Resource ImportState method returned no State in response. If import is intentionally not supported, remove the Resource type ImportState method or return an error.",
+			),
 		)
 		return
 	}
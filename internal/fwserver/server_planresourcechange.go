@@ -10,8 +10,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtransform"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +31,7 @@ type PlanResourceChangeRequest struct {
 	ProposedNewState *tfsdk.Plan
 	ProviderMeta     *tfsdk.Config
 	ResourceSchema   fwschema.Schema
+	ResourceTypeName string
 	Resource         resource.Resource
 }
 
@@ -42,20 +46,27 @@ type PlanResourceChangeResponse struct {
 
 // PlanResourceChange implements the framework server PlanResourceChange RPC.
 func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
+	defer s.recoverStrictModePanic(ctx, "PlanResourceChange", &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
 
+	// Changes must not be persisted during planning.
+	ctx = dryrun.NewContext(ctx)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -106,6 +117,43 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 	resp.PlannedState = planToState(*req.ProposedNewState)
 
+	// Validate values which were unknown during the ValidateResourceConfig
+	// RPC, such as collections referencing other resource attributes, so
+	// validators such as collection size constraints are enforced with the
+	// now known values. Only the attributes and blocks which were unknown are
+	// validated, as all other validation already returned its diagnostics.
+	// This is skipped for resource destruction, as the configuration is null.
+	if !req.Config.Raw.IsNull() {
+		if unknownPaths := s.resourceConfigUnknownPaths(req.ResourceTypeName, *req.Config); len(unknownPaths) > 0 {
+			logging.FrameworkDebug(ctx, "Validating Resource configuration values which were unknown before planning")
+
+			resp.Diagnostics.Append(s.resourceConfigValidatePaths(ctx, req.ResourceTypeName, *req.Config, unknownPaths)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Check any attribute requirements against the remote system
+	// capabilities. This is skipped for resource destruction, as the
+	// configuration is null.
+	if resourceWithAttributeRequirements, ok := req.Resource.(resource.ResourceWithAttributeRequirements); ok && !req.Config.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithAttributeRequirements")
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource AttributeRequirements")
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource AttributeRequirements")
+		requirements := resourceWithAttributeRequirements.AttributeRequirements(methodCtx)
+		afterProviderMethod()
+		logging.FrameworkDebug(ctx, "Called provider defined Resource AttributeRequirements")
+
+		resp.Diagnostics.Append(AttributeRequirementsValidate(ctx, s.capabilities(), *req.Config, requirements)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Set Defaults.
 	//
 	// If the planned state is not null (i.e., not a destroy operation) we traverse the schema,
@@ -224,18 +272,27 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 		logging.FrameworkDebug(ctx, "Marking Computed attributes with null configuration values as unknown (known after apply) in the plan to prevent potential Terraform errors")
 
-		modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema))
+		markComputedNilsAsUnknown := MarkComputedNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema)
+
+		// Unchanged subtrees are shared with the prior planned state rather
+		// than deep copied, which reduces memory usage for large values.
+		modifiedPlan, changed, err := fwtransform.Transform(resp.PlannedState.Raw, func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, bool, error) {
+			newVal, err := markComputedNilsAsUnknown(path, val)
+
+			// Only unknown values are returned instead of the given value.
+			return newVal, newVal.IsKnown() != val.IsKnown(), err
+		})
 
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error modifying plan",
-				"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(ctx, "There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error()),
 			)
 
 			return
 		}
 
-		if !resp.PlannedState.Raw.Equal(modifiedPlan) {
+		if changed {
 			logging.FrameworkTrace(ctx, "At least one Computed null Config value was changed to unknown")
 		}
 
@@ -277,6 +334,37 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 	}
 
+	// Execute any plan modifiers which opted into destroy plans via the
+	// planmodifier.Destroy interface, such as for returning warnings that
+	// the remote object is not deleted.
+	if resp.PlannedState.Raw.IsNull() && !req.PriorState.Raw.IsNull() {
+		modifySchemaPlanReq := ModifySchemaPlanRequest{
+			Config:  *req.Config,
+			Plan:    stateToPlan(*resp.PlannedState),
+			State:   *req.PriorState,
+			Private: resp.PlannedPrivate.Provider,
+		}
+
+		if req.ProviderMeta != nil {
+			modifySchemaPlanReq.ProviderMeta = *req.ProviderMeta
+		}
+
+		modifySchemaPlanResp := ModifySchemaPlanResponse{
+			Diagnostics: resp.Diagnostics,
+			Plan:        modifySchemaPlanReq.Plan,
+			Private:     modifySchemaPlanReq.Private,
+		}
+
+		SchemaModifyDestroyPlan(ctx, req.ResourceSchema, modifySchemaPlanReq, &modifySchemaPlanResp)
+
+		resp.Diagnostics = modifySchemaPlanResp.Diagnostics
+		resp.PlannedPrivate.Provider = modifySchemaPlanResp.Private
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Execute any resource-level ModifyPlan method again. This allows
 	// overwriting any unknown values.
 	//
@@ -307,8 +395,11 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource ModifyPlan")
-		resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource ModifyPlan")
+		resourceWithModifyPlan.ModifyPlan(methodCtx, modifyPlanReq, &modifyPlanResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource ModifyPlan")
+		logDiagnostics(ctx, modifyPlanResp.Diagnostics)
 
 		resp.Diagnostics = modifyPlanResp.Diagnostics
 		resp.PlannedState = planToState(modifyPlanResp.Plan)
@@ -319,15 +410,104 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	// Ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
 
+	// RequiresReplace is only meaningful when updating an existing resource,
+	// so it is not emitted for create and destroy plans.
+	if req.PriorState.Raw.IsNull() || resp.PlannedState.Raw.IsNull() {
+		if len(resp.RequiresReplace) > 0 {
+			logging.FrameworkDebug(ctx, "Removing RequiresReplace from create or destroy plan")
+		}
+
+		resp.RequiresReplace = nil
+	}
+
+	resp.RequiresReplace = ValidateRequiresReplace(ctx, req.ResourceSchema, req.ResourceTypeName, resp.RequiresReplace, &resp.Diagnostics)
+
 	// If this was a destroy resource plan, ensure the plan remained null.
 	if req.ProposedNewState.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
 		resp.Diagnostics.AddError(
 			"Unexpected Planned Resource State on Destroy",
-			"The Terraform Provider unexpectedly returned resource state data when the resource was planned for destruction. "+
-				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
-				"Ensure all resource plan modifiers do not attempt to change resource plan data from being a null value if the request plan is a null value.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				"The Terraform Provider unexpectedly returned resource state data when the resource was planned for destruction. "+
+					"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+					"Ensure all resource plan modifiers do not attempt to change resource plan data from being a null value if the request plan is a null value.",
+			),
 		)
 	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Preconditions are only checked when the configuration is fully known,
+	// as the remote system checks typically depend on configured values,
+	// such as names. Destruction is skipped as the configuration is null.
+	if resourceWithCheckPreconditions, ok := req.Resource.(resource.ResourceWithCheckPreconditions); ok && !req.Config.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithCheckPreconditions")
+
+		if req.Config.Raw.IsFullyKnown() {
+			checkPreconditionsReq := resource.CheckPreconditionsRequest{
+				Config: *req.Config,
+				Plan:   stateToPlan(*resp.PlannedState),
+				State:  *req.PriorState,
+			}
+
+			if req.ProviderMeta != nil {
+				checkPreconditionsReq.ProviderMeta = *req.ProviderMeta
+			}
+
+			checkPreconditionsResp := resource.CheckPreconditionsResponse{}
+
+			logging.FrameworkDebug(ctx, "Calling provider defined Resource CheckPreconditions")
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource CheckPreconditions")
+			resourceWithCheckPreconditions.CheckPreconditions(methodCtx, checkPreconditionsReq, &checkPreconditionsResp)
+			afterProviderMethod()
+			logging.FrameworkDebug(ctx, "Called provider defined Resource CheckPreconditions")
+
+			resp.Diagnostics.Append(checkPreconditionsResp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		} else {
+			logging.FrameworkDebug(ctx, "Skipping Resource CheckPreconditions as configuration contains unknown values")
+		}
+	}
+
+	if resourceWithPlanImpact, ok := req.Resource.(resource.ResourceWithPlanImpact); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithPlanImpact")
+
+		planImpactReq := resource.PlanImpactRequest{
+			Config:          *req.Config,
+			Plan:            stateToPlan(*resp.PlannedState),
+			State:           *req.PriorState,
+			RequiresReplace: resp.RequiresReplace,
+		}
+
+		if req.ProviderMeta != nil {
+			planImpactReq.ProviderMeta = *req.ProviderMeta
+		}
+
+		planImpactResp := resource.PlanImpactResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource PlanImpact")
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource PlanImpact")
+		resourceWithPlanImpact.PlanImpact(methodCtx, planImpactReq, &planImpactResp)
+		afterProviderMethod()
+		logging.FrameworkDebug(ctx, "Called provider defined Resource PlanImpact")
+
+		resp.Diagnostics.Append(planImpactResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		logging.FrameworkInfo(ctx, "Resource plan impact", map[string]any{
+			logging.KeyPlanImpactClassification:      planImpactResp.Classification.String(),
+			logging.KeyPlanImpactEstimatedDurationMs: planImpactResp.EstimatedDuration.Milliseconds(),
+			logging.KeyPlanImpactMetadata:            planImpactResp.Metadata,
+		})
+	}
 }
 
 func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
@@ -370,6 +550,13 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 				return val, nil
 			}
 
+			if errors.Is(err, fwschema.ErrPathIsNestedObject) {
+				// ignore nested attribute and block objects, the attributes
+				// within them are marked unknown individually
+				logging.FrameworkTrace(ctx, "attribute is a nested object, not marking unknown")
+				return val, nil
+			}
+
 			if errors.Is(err, fwschema.ErrPathIsBlock) {
 				// ignore blocks, they do not have a computed field
 				logging.FrameworkTrace(ctx, "attribute is a block, not marking unknown")
@@ -462,18 +649,57 @@ func NormaliseRequiresReplace(ctx context.Context, rs path.Paths) path.Paths {
 	return ret[:j]
 }
 
-// planToState returns a *tfsdk.State with a copied value from a tfsdk.Plan.
+// ValidateRequiresReplace returns the RequiresReplace paths which exist in
+// the resource schema. Terraform returns confusing errors for paths which do
+// not exist, so an error diagnostic naming the path and resource type is
+// added for each invalid path instead.
+func ValidateRequiresReplace(ctx context.Context, resourceSchema fwschema.Schema, resourceTypeName string, rs path.Paths, diags *diag.Diagnostics) path.Paths {
+	if len(rs) == 0 || resourceSchema == nil {
+		return rs
+	}
+
+	result := make(path.Paths, 0, len(rs))
+
+	for _, p := range rs {
+		_, typeDiags := fwschema.SchemaTypeAtPath(ctx, resourceSchema, p)
+
+		if typeDiags.HasError() {
+			logging.FrameworkError(ctx, "Invalid path in RequiresReplace", map[string]interface{}{logging.KeyAttributePath: p.String()})
+
+			diags.AddError(
+				"Invalid Resource RequiresReplace Path",
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					"The Terraform Provider returned a RequiresReplace path which does not exist in the resource schema. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("Resource Type: %s\n", resourceTypeName)+
+						fmt.Sprintf("Path: %s", p),
+				),
+			)
+
+			continue
+		}
+
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// planToState returns a *tfsdk.State with the value from a tfsdk.Plan. The
+// value is shared rather than deep copied, as tftypes.Value are immutable.
 func planToState(plan tfsdk.Plan) *tfsdk.State {
 	return &tfsdk.State{
-		Raw:    plan.Raw.Copy(),
+		Raw:    plan.Raw,
 		Schema: plan.Schema,
 	}
 }
 
-// stateToPlan returns a tfsdk.Plan with a copied value from a tfsdk.State.
+// stateToPlan returns a tfsdk.Plan with the value from a tfsdk.State. The
+// value is shared rather than deep copied, as tftypes.Value are immutable.
 func stateToPlan(state tfsdk.State) tfsdk.Plan {
 	return tfsdk.Plan{
-		Raw:    state.Raw.Copy(),
+		Raw:    state.Raw,
 		Schema: state.Schema,
 	}
 }
@@ -12,10 +12,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,6 +32,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -115,6 +120,27 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 				Optional: true,
 				Computed: true,
 			},
+			// nested computed attributes two levels deep should be unknown
+			"nested-list-value-optional-computed": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"string-nil": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+								"string-set": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			// nil blocks should remain nil
@@ -186,6 +212,37 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 			"string-nil": tftypes.NewValue(tftypes.String, nil),
 			"string-set": tftypes.NewValue(tftypes.String, "bar"),
 		}),
+		"nested-list-value-optional-computed": tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"string-nil": tftypes.String,
+							"string-set": tftypes.String,
+						},
+					},
+				},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"string-nil": tftypes.String,
+						"string-set": tftypes.String,
+					},
+				},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"string-nil": tftypes.String,
+						"string-set": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"string-nil": tftypes.NewValue(tftypes.String, nil),
+					"string-set": tftypes.NewValue(tftypes.String, "bar"),
+				}),
+			}),
+		}),
 		"block-nil-optional-computed": tftypes.NewValue(tftypes.Set{
 			ElementType: tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -249,6 +306,37 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 			"string-nil": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 			"string-set": tftypes.NewValue(tftypes.String, "bar"),
 		}),
+		"nested-list-value-optional-computed": tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"string-nil": tftypes.String,
+							"string-set": tftypes.String,
+						},
+					},
+				},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"string-nil": tftypes.String,
+						"string-set": tftypes.String,
+					},
+				},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"string-nil": tftypes.String,
+						"string-set": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"string-nil": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"string-set": tftypes.NewValue(tftypes.String, "bar"),
+				}),
+			}),
+		}),
 		"block-nil-optional-computed": tftypes.NewValue(tftypes.Set{
 			ElementType: tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -1527,9 +1615,258 @@ func TestServerPlanResourceChange(t *testing.T) {
 				// be overly burdensome on provider developers to have the
 				// framework raise an error if it is technically valid in the
 				// protocol.
-				RequiresReplace: path.Paths{
-					path.Root("test_required"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithcheckpreconditions-request-config": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithCheckPreconditions{
+					CheckPreconditionsMethod: func(ctx context.Context, req resource.CheckPreconditionsRequest, resp *resource.CheckPreconditionsResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test_required"), &got)...)
+
+						if got.ValueString() != "test-config-value" {
+							resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+got.String())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithcheckpreconditions-response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithCheckPreconditions{
+					CheckPreconditionsMethod: func(ctx context.Context, req resource.CheckPreconditionsRequest, resp *resource.CheckPreconditionsResponse) {
+						resp.Diagnostics.AddAttributeError(path.Root("test_required"), "Name Unavailable", "The name is already in use.")
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("test_required"), "Name Unavailable", "The name is already in use."),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithcheckpreconditions-unknown-config": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithCheckPreconditions{
+					CheckPreconditionsMethod: func(ctx context.Context, req resource.CheckPreconditionsRequest, resp *resource.CheckPreconditionsResponse) {
+						resp.Diagnostics.AddError("Unexpected CheckPreconditions Call", "CheckPreconditions should not be called with unknown configuration values.")
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithplanimpact-request-plan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithPlanImpact{
+					PlanImpactMethod: func(ctx context.Context, req resource.PlanImpactRequest, resp *resource.PlanImpactResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_computed"), &got)...)
+
+						if !got.IsUnknown() {
+							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+got.String())
+						}
+
+						resp.Classification = resource.PlanImpactClassificationNonDisruptive
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithplanimpact-response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithPlanImpact{
+					PlanImpactMethod: func(ctx context.Context, req resource.PlanImpactRequest, resp *resource.PlanImpactResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"delete-resourcewithplanimpact-request-requiresreplace": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: testEmptyPlan,
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithPlanImpact{
+					PlanImpactMethod: func(ctx context.Context, req resource.PlanImpactRequest, resp *resource.PlanImpactResponse) {
+						if !req.Plan.Raw.IsNull() {
+							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Expected null plan on destroy")
+						}
+
+						if len(req.RequiresReplace) != 0 {
+							resp.Diagnostics.AddError("Unexpected req.RequiresReplace Value", "Got: "+req.RequiresReplace.String())
+						}
+
+						resp.Classification = resource.PlanImpactClassificationDestructive
+					},
 				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState:   testEmptyState,
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
@@ -1577,6 +1914,46 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-resourcewithmodifyplan-request-dryrun": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						if !dryrun.IsDryRun(ctx) {
+							resp.Diagnostics.AddError("Unexpected ctx", "Expected dry run")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-resourcewithmodifyplan-request-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -1884,9 +2261,6 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
-				RequiresReplace: path.Paths{
-					path.Root("test_required"),
-				},
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
@@ -2214,10 +2588,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
-				PlannedState: testEmptyState,
-				RequiresReplace: path.Paths{
-					path.Root("test_required"),
-				},
+				PlannedState:   testEmptyState,
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
@@ -2289,6 +2660,61 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivateProvider,
 			},
 		},
+		"delete-attributeplanmodifier-destroy": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ProposedNewState: testEmptyPlan,
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"test_computed": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								testplanmodifier.String{
+									PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+										resp.Diagnostics.AddError("unexpected call", "plan modifiers without planmodifier.Destroy should not be called on destroy")
+									},
+								},
+							},
+						},
+						"test_required": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								testplanmodifier.StringWithDestroy{
+									PlanModifyDestroyMethod: func(ctx context.Context, req planmodifier.DestroyRequest, resp *planmodifier.DestroyResponse) {
+										if !req.StateValue.Equal(types.StringValue("test-state-value")) {
+											resp.Diagnostics.AddError("unexpected req.StateValue value", req.StateValue.String())
+										}
+
+										resp.Diagnostics.AddAttributeWarning(req.Path, "warning summary", "warning detail")
+									},
+								},
+							},
+						},
+					},
+				},
+				Resource: &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(path.Root("test_required"), "warning summary", "warning detail"),
+				},
+				PlannedState:   testEmptyState,
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-mark-computed-config-nils-as-unknown": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3651,6 +4077,66 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"update-resourcewithmodifyplan-response-requiresreplace-invalid-path": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema:   testSchema,
+				ResourceTypeName: "test_resource",
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.RequiresReplace = path.Paths{
+							path.Root("test_required"),
+							path.Root("test_nonexistent"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Resource RequiresReplace Path",
+						"The Terraform Provider returned a RequiresReplace path which does not exist in the resource schema. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Resource Type: test_resource\n"+
+							"Path: test_nonexistent",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_required"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-resourcewithmodifyplan-response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3760,3 +4246,224 @@ func TestServerPlanResourceChange(t *testing.T) {
 		})
 	}
 }
+
+func TestServerPlanResourceChange_Validation(t *testing.T) {
+	t.Parallel()
+
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list":      tftypes.List{ElementType: tftypes.String},
+			"test_set_block": tftypes.Set{ElementType: testBlockType},
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_set_block": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_string": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								testvalidator.String{
+									ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+										if req.ConfigValue.ValueString() == "invalid" {
+											resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", "test_string cannot be invalid")
+										}
+									},
+								},
+							},
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+
+	testValue := func(list interface{}, blocks interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_list":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, list),
+			"test_set_block": tftypes.NewValue(tftypes.Set{ElementType: testBlockType}, blocks),
+		})
+	}
+
+	testListValue := func(elements ...interface{}) []tftypes.Value {
+		result := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			result = append(result, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return result
+	}
+
+	testBlockValue := func(elements ...interface{}) []tftypes.Value {
+		result := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			result = append(result, tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, element),
+			}))
+		}
+
+		return result
+	}
+
+	testCases := map[string]struct {
+		validateConfig      tftypes.Value
+		planConfig          tftypes.Value
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"known-validate-invalid-plan": {
+			validateConfig: testValue(testListValue("zero"), testBlockValue()),
+			planConfig:     testValue(testListValue("zero"), testBlockValue()),
+			// Diagnostics were returned by the ValidateResourceConfig RPC.
+			expectedDiagnostics: nil,
+		},
+		"unknown-list-validate-invalid-plan": {
+			validateConfig: testValue(tftypes.UnknownValue, testBlockValue()),
+			planConfig:     testValue(testListValue("one"), testBlockValue()),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid Attribute Value",
+					"Attribute test_list list must contain at least 2 elements, got: 1",
+				),
+			},
+		},
+		"unknown-block-validate-invalid-plan": {
+			validateConfig: testValue(testListValue("one", "two"), tftypes.UnknownValue),
+			planConfig:     testValue(testListValue("one", "two"), testBlockValue("one", "two")),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_set_block"),
+					"Invalid Attribute Value",
+					"Attribute test_set_block set must contain at most 1 elements, got: 2",
+				),
+			},
+		},
+		"unknown-list-validate-valid-plan": {
+			validateConfig:      testValue(tftypes.UnknownValue, testBlockValue("one")),
+			planConfig:          testValue(testListValue("one", "two"), testBlockValue("one")),
+			expectedDiagnostics: nil,
+		},
+		// Only values which were unknown are validated, so diagnostics for
+		// known values returned by the ValidateResourceConfig RPC are not
+		// duplicated.
+		"unknown-list-validate-invalid-block-plan": {
+			validateConfig:      testValue(tftypes.UnknownValue, testBlockValue("one", "two")),
+			planConfig:          testValue(testListValue("one", "two"), testBlockValue("one", "two")),
+			expectedDiagnostics: nil,
+		},
+		// The list attribute was known during validation, so its validators
+		// already returned diagnostics.
+		"unknown-list-element-validate-invalid-plan": {
+			validateConfig:      testValue(testListValue(tftypes.UnknownValue), testBlockValue()),
+			planConfig:          testValue(testListValue("one"), testBlockValue()),
+			expectedDiagnostics: nil,
+		},
+		"unknown-block-element-validate-invalid-plan": {
+			validateConfig: testValue(testListValue("one", "two"), testBlockValue(tftypes.UnknownValue)),
+			planConfig:     testValue(testListValue("one", "two"), testBlockValue("invalid")),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_set_block").AtSetValue(types.ObjectValueMust(
+						map[string]attr.Type{
+							"test_string": types.StringType,
+						},
+						map[string]attr.Value{
+							"test_string": types.StringValue("invalid"),
+						},
+					)).AtName("test_string"),
+					"Invalid Attribute Value",
+					"test_string cannot be invalid",
+				),
+			},
+		},
+		// Configurations which differ in known values were validated by
+		// another ValidateResourceConfig RPC.
+		"unknown-list-validate-different-plan": {
+			validateConfig:      testValue(tftypes.UnknownValue, testBlockValue("one")),
+			planConfig:          testValue(testListValue("one"), testBlockValue("two")),
+			expectedDiagnostics: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var validateConfigCount int
+
+			testResource := &testprovider.ResourceWithValidateConfig{
+				Resource: &testprovider.Resource{},
+				ValidateConfigMethod: func(_ context.Context, _ resource.ValidateConfigRequest, _ *resource.ValidateConfigResponse) {
+					validateConfigCount++
+				},
+			}
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			server.ValidateResourceConfig(context.Background(), &fwserver.ValidateResourceConfigRequest{
+				Config: &tfsdk.Config{
+					Raw:    testCase.validateConfig,
+					Schema: testSchema,
+				},
+				Resource: testResource,
+				TypeName: "test_resource",
+			}, &fwserver.ValidateResourceConfigResponse{})
+
+			resp := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testCase.planConfig,
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testCase.planConfig,
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+				ResourceSchema:   testSchema,
+				ResourceTypeName: "test_resource",
+				Resource:         testResource,
+			}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// The resource ValidateConfig method is only called by the
+			// ValidateResourceConfig RPC.
+			if validateConfigCount != 1 {
+				t.Errorf("expected ValidateConfig count 1, got %d", validateConfigCount)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -17,6 +18,7 @@ type ReadDataSourceRequest struct {
 	DataSourceSchema fwschema.Schema
 	DataSource       datasource.DataSource
 	ProviderMeta     *tfsdk.Config
+	TypeName         string
 }
 
 // ReadDataSourceResponse is the framework server response for the
@@ -28,6 +30,8 @@ type ReadDataSourceResponse struct {
 
 // ReadDataSource implements the framework server ReadDataSource RPC.
 func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest, resp *ReadDataSourceResponse) {
+	defer s.recoverStrictModePanic(ctx, "ReadDataSource", &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
@@ -36,12 +40,14 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
 		configureReq := datasource.ConfigureRequest{
-			ProviderData: s.DataSourceConfigureData,
+			ProviderData: s.dataSourceConfigureData(),
 		}
 		configureResp := datasource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource Configure")
-		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource Configure")
+		dataSourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -51,6 +57,22 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		}
 	}
 
+	// Terraform skips the ValidateDataResourceConfig RPC for configurations
+	// which were unknown during validation, such as those referencing
+	// resources created during apply, so validate the now known
+	// configuration. Configurations which were already validated while fully
+	// known are skipped to prevent duplicate diagnostics.
+	if req.Config != nil && !s.validatedDataSourceConfig(req.TypeName, *req.Config) {
+		logging.FrameworkDebug(ctx, "Validating DataSource configuration before Read")
+
+		// Changes must not be persisted during validation.
+		resp.Diagnostics.Append(s.dataSourceConfigValidate(dryrun.NewContext(ctx), req.TypeName, req.DataSource, *req.Config)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	readReq := datasource.ReadRequest{
 		Config: tfsdk.Config{
 			Schema: req.DataSourceSchema,
@@ -71,10 +93,65 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		readReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	if dataSourceWithAttributeRequirements, ok := req.DataSource.(datasource.DataSourceWithAttributeRequirements); ok {
+		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithAttributeRequirements")
+
+		logging.FrameworkDebug(ctx, "Calling provider defined DataSource AttributeRequirements")
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource AttributeRequirements")
+		requirements := dataSourceWithAttributeRequirements.AttributeRequirements(methodCtx)
+		afterProviderMethod()
+		logging.FrameworkDebug(ctx, "Called provider defined DataSource AttributeRequirements")
+
+		resp.Diagnostics.Append(AttributeRequirementsValidate(ctx, s.capabilities(), readReq.Config, requirements)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var readCache *datasource.ReadCache
+	var readCacheKey string
+
+	if dataSourceWithReadCache, ok := req.DataSource.(datasource.DataSourceWithReadCache); ok {
+		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithReadCache")
+
+		logging.FrameworkDebug(ctx, "Calling provider defined DataSource ReadCache")
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource ReadCache")
+		readCache = dataSourceWithReadCache.ReadCache(methodCtx)
+		afterProviderMethod()
+		logging.FrameworkDebug(ctx, "Called provider defined DataSource ReadCache")
+
+		readCacheKey = readReq.Config.Raw.String() + "\n" + readReq.ProviderMeta.Raw.String()
+	}
+
+	if readCache != nil {
+		if cachedState, ok := readCache.Get(readCacheKey); ok {
+			logging.FrameworkDebug(ctx, "Using cached DataSource Read result")
+
+			readResp.State.Raw = cachedState
+			resp.State = &readResp.State
+
+			return
+		}
+	}
+
 	logging.FrameworkDebug(ctx, "Calling provider defined DataSource Read")
-	req.DataSource.Read(ctx, readReq, &readResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource Read")
+	req.DataSource.Read(methodCtx, readReq, &readResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined DataSource Read")
+	logDiagnostics(ctx, readResp.Diagnostics)
 
-	resp.Diagnostics = readResp.Diagnostics
+	resp.Diagnostics.Append(readResp.Diagnostics...)
 	resp.State = &readResp.State
+
+	if readCache != nil && len(readResp.Diagnostics) == 0 {
+		logging.FrameworkTrace(ctx, "Caching DataSource Read result")
+
+		readCache.Set(readCacheKey, readResp.State.Raw)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(s.strictModeStateDiagnostics(ctx, "Read", resp.State)...)
+	}
 }
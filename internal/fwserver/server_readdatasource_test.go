@@ -6,11 +6,15 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/capability"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -62,6 +66,12 @@ func TestServerReadDataSource(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testCapabilities := capability.NewCapabilities()
+
+	if err := testCapabilities.SetVersion("api", "2.1"); err != nil {
+		t.Fatalf("unexpected error setting version: %s", err)
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ReadDataSourceRequest
@@ -126,6 +136,71 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"request-attribute-requirements-satisfied": {
+			server: &fwserver.Server{
+				Capabilities: testCapabilities,
+				Provider:     &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithAttributeRequirements{
+					AttributeRequirementsMethod: func(ctx context.Context) []capability.AttributeRequirement {
+						return []capability.AttributeRequirement{
+							{
+								PathExpression: path.MatchRoot("test_required"),
+								Requirements: []capability.Requirement{
+									capability.MinimumVersion("api", "2.0"),
+								},
+							},
+						}
+					},
+					DataSource: &testprovider.DataSource{},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testStateUnchanged,
+			},
+		},
+		"request-attribute-requirements-unsatisfied": {
+			server: &fwserver.Server{
+				Capabilities: testCapabilities,
+				Provider:     &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithAttributeRequirements{
+					AttributeRequirementsMethod: func(ctx context.Context) []capability.AttributeRequirement {
+						return []capability.AttributeRequirement{
+							{
+								PathExpression: path.MatchRoot("test_required"),
+								Requirements: []capability.Requirement{
+									capability.MinimumVersion("api", "3.0"),
+								},
+							},
+						}
+					},
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected Read call", "Read should not be called with unsatisfied requirements")
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Attribute Not Supported",
+						"The attribute test_required is configured, but is not supported by the configured endpoint. "+
+							"Remove the attribute from the configuration or upgrade the endpoint.\n\n"+
+							"Unsatisfied Requirements: api version >= 3.0\n"+
+							"Endpoint Capabilities: [api=2.1]",
+					),
+				},
+			},
+		},
 		"resource-configure-data": {
 			server: &fwserver.Server{
 				DataSourceConfigureData: "test-provider-configure-value",
@@ -238,3 +313,464 @@ func TestServerReadDataSource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerReadDataSource_ReadCache(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := func(required string) *tfsdk.Config {
+		return &tfsdk.Config{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, nil),
+				"test_required": tftypes.NewValue(tftypes.String, required),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testState := func(required string, computed string) *tfsdk.State {
+		return &tfsdk.State{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, computed),
+				"test_required": tftypes.NewValue(tftypes.String, required),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	var readCache datasource.ReadCache
+	var readCount int
+	var readError bool
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+	dataSource := &testprovider.DataSourceWithReadCache{
+		DataSource: &testprovider.DataSource{
+			ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+				readCount++
+
+				if readError {
+					resp.Diagnostics.AddError("error summary", "error detail")
+
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), fmt.Sprintf("read-%d", readCount))...)
+			},
+		},
+		ReadCacheMethod: func(_ context.Context) *datasource.ReadCache {
+			return &readCache
+		},
+	}
+
+	// Tests are sequential, as each step depends on the cache contents of
+	// the prior steps.
+	steps := []struct {
+		name              string
+		required          string
+		invalidate        bool
+		readError         bool
+		expectedReadCount int
+		expectedResponse  *fwserver.ReadDataSourceResponse
+	}{
+		{
+			name:              "miss",
+			required:          "one",
+			expectedReadCount: 1,
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testState("one", "read-1"),
+			},
+		},
+		{
+			name:              "hit",
+			required:          "one",
+			expectedReadCount: 1,
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testState("one", "read-1"),
+			},
+		},
+		{
+			name:              "miss-different-config",
+			required:          "two",
+			expectedReadCount: 2,
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testState("two", "read-2"),
+			},
+		},
+		{
+			name:              "miss-invalidated",
+			required:          "one",
+			invalidate:        true,
+			expectedReadCount: 3,
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testState("one", "read-3"),
+			},
+		},
+		{
+			name:              "miss-error-not-cached",
+			required:          "three",
+			readError:         true,
+			expectedReadCount: 4,
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+				State: &tfsdk.State{
+					Raw:    testConfig("three").Raw,
+					Schema: testSchema,
+				},
+			},
+		},
+		{
+			name:              "miss-after-error",
+			required:          "three",
+			expectedReadCount: 5,
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testState("three", "read-5"),
+			},
+		},
+	}
+
+	for _, step := range steps {
+		if step.invalidate {
+			readCache.Invalidate()
+		}
+
+		readError = step.readError
+
+		request := &fwserver.ReadDataSourceRequest{
+			Config:           testConfig(step.required),
+			DataSourceSchema: testSchema,
+			DataSource:       dataSource,
+		}
+		response := &fwserver.ReadDataSourceResponse{}
+
+		server.ReadDataSource(context.Background(), request, response)
+
+		if readCount != step.expectedReadCount {
+			t.Errorf("%s: expected read count %d, got: %d", step.name, step.expectedReadCount, readCount)
+		}
+
+		if diff := cmp.Diff(response, step.expectedResponse); diff != "" {
+			t.Errorf("%s: unexpected difference: %s", step.name, diff)
+		}
+	}
+}
+
+func TestServerReadDataSource_Validation(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	var validateCount int
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							if req.ConfigValue.IsUnknown() {
+								return
+							}
+
+							validateCount++
+
+							if req.ConfigValue.ValueString() == "invalid" {
+								resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+							}
+
+							resp.Diagnostics.AddAttributeWarning(req.Path, "warning summary", "warning detail")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfig := func(required interface{}) *tfsdk.Config {
+		return &tfsdk.Config{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, nil),
+				"test_required": tftypes.NewValue(tftypes.String, required),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testWarning := diag.NewAttributeWarningDiagnostic(path.Root("test_required"), "warning summary", "warning detail")
+
+	var readCount int
+
+	dataSource := &testprovider.DataSourceWithValidateConfig{
+		DataSource: &testprovider.DataSource{
+			ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+				readCount++
+
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-state-value")...)
+			},
+		},
+		ValidateConfigMethod: func(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+			var required types.String
+
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test_required"), &required)...)
+
+			if required.ValueString() == "invalid-data-source" {
+				resp.Diagnostics.AddAttributeError(path.Root("test_required"), "data source error summary", "data source error detail")
+			}
+		},
+	}
+
+	// Steps are sequential, as each step depends on the configurations
+	// validated by the prior steps.
+	steps := []struct {
+		name                  string
+		validateConfig        *tfsdk.Config
+		readConfig            *tfsdk.Config
+		expectedValidateCount int
+		expectedReadCount     int
+		expectedDiagnostics   diag.Diagnostics
+	}{
+		{
+			name:                  "unknown-validate-invalid-read",
+			validateConfig:        testConfig(tftypes.UnknownValue),
+			readConfig:            testConfig("invalid"),
+			expectedValidateCount: 1,
+			expectedReadCount:     0,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_required"), "error summary", "error detail"),
+				testWarning,
+			},
+		},
+		{
+			name:                  "unknown-validate-invalid-data-source-read",
+			validateConfig:        testConfig(tftypes.UnknownValue),
+			readConfig:            testConfig("invalid-data-source"),
+			expectedValidateCount: 2,
+			expectedReadCount:     0,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_required"), "data source error summary", "data source error detail"),
+				testWarning,
+			},
+		},
+		{
+			name:                  "unknown-validate-valid-read",
+			validateConfig:        testConfig(tftypes.UnknownValue),
+			readConfig:            testConfig("valid"),
+			expectedValidateCount: 3,
+			expectedReadCount:     1,
+			expectedDiagnostics: diag.Diagnostics{
+				testWarning,
+			},
+		},
+		{
+			name:                  "known-validate-same-read",
+			validateConfig:        testConfig("known"),
+			readConfig:            testConfig("known"),
+			expectedValidateCount: 4,
+			expectedReadCount:     2,
+			expectedDiagnostics:   nil,
+		},
+		{
+			name:                  "known-validate-different-read",
+			validateConfig:        testConfig("known"),
+			readConfig:            testConfig("different"),
+			expectedValidateCount: 6,
+			expectedReadCount:     3,
+			expectedDiagnostics: diag.Diagnostics{
+				testWarning,
+			},
+		},
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	for _, step := range steps {
+		validateResp := &fwserver.ValidateDataSourceConfigResponse{}
+
+		server.ValidateDataSourceConfig(context.Background(), &fwserver.ValidateDataSourceConfigRequest{
+			Config:     step.validateConfig,
+			DataSource: dataSource,
+			TypeName:   "test_data_source",
+		}, validateResp)
+
+		readResp := &fwserver.ReadDataSourceResponse{}
+
+		server.ReadDataSource(context.Background(), &fwserver.ReadDataSourceRequest{
+			Config:           step.readConfig,
+			DataSourceSchema: testSchema,
+			DataSource:       dataSource,
+			TypeName:         "test_data_source",
+		}, readResp)
+
+		if diff := cmp.Diff(readResp.Diagnostics, step.expectedDiagnostics); diff != "" {
+			t.Errorf("%s: unexpected diagnostics difference: %s", step.name, diff)
+		}
+
+		if validateCount != step.expectedValidateCount {
+			t.Errorf("%s: expected validate count %d, got %d", step.name, step.expectedValidateCount, validateCount)
+		}
+
+		if readCount != step.expectedReadCount {
+			t.Errorf("%s: expected read count %d, got %d", step.name, step.expectedReadCount, readCount)
+		}
+	}
+}
+
+func TestServerReadDataSource_ValidationOnce(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testDataSourceWarning := diag.NewWarningDiagnostic("data source warning summary", "data source warning detail")
+	testAttributeWarning := diag.NewAttributeWarningDiagnostic(path.Root("test_required"), "warning summary", "warning detail")
+
+	testCases := map[string]struct {
+		dataSource func(validateFunc func(context.Context, datasource.ValidateConfigRequest, *datasource.ValidateConfigResponse)) datasource.DataSource
+	}{
+		"config-validators": {
+			dataSource: func(validateFunc func(context.Context, datasource.ValidateConfigRequest, *datasource.ValidateConfigResponse)) datasource.DataSource {
+				return &testprovider.DataSourceWithConfigValidators{
+					DataSource: &testprovider.DataSource{},
+					ConfigValidatorsMethod: func(_ context.Context) []datasource.ConfigValidator {
+						return []datasource.ConfigValidator{
+							&testprovider.DataSourceConfigValidator{
+								ValidateDataSourceMethod: validateFunc,
+							},
+						}
+					},
+				}
+			},
+		},
+		"validate-config": {
+			dataSource: func(validateFunc func(context.Context, datasource.ValidateConfigRequest, *datasource.ValidateConfigResponse)) datasource.DataSource {
+				return &testprovider.DataSourceWithValidateConfig{
+					DataSource:           &testprovider.DataSource{},
+					ValidateConfigMethod: validateFunc,
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attributeValidateCount, dataSourceValidateCount int
+
+			testSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_computed": schema.StringAttribute{
+						Computed: true,
+					},
+					"test_required": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									attributeValidateCount++
+
+									resp.Diagnostics.AddAttributeWarning(req.Path, "warning summary", "warning detail")
+								},
+							},
+						},
+					},
+				},
+			}
+
+			testConfig := &tfsdk.Config{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "known"),
+				}),
+				Schema: testSchema,
+			}
+
+			dataSource := testCase.dataSource(func(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+				dataSourceValidateCount++
+
+				resp.Diagnostics.AddWarning("data source warning summary", "data source warning detail")
+			})
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			validateResp := &fwserver.ValidateDataSourceConfigResponse{}
+
+			server.ValidateDataSourceConfig(context.Background(), &fwserver.ValidateDataSourceConfigRequest{
+				Config:     testConfig,
+				DataSource: dataSource,
+				TypeName:   "test_data_source",
+			}, validateResp)
+
+			readResp := &fwserver.ReadDataSourceResponse{}
+
+			server.ReadDataSource(context.Background(), &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource:       dataSource,
+				TypeName:         "test_data_source",
+			}, readResp)
+
+			expectedValidateDiagnostics := diag.Diagnostics{
+				testDataSourceWarning,
+				testAttributeWarning,
+			}
+
+			if diff := cmp.Diff(validateResp.Diagnostics, expectedValidateDiagnostics); diff != "" {
+				t.Errorf("unexpected validate diagnostics difference: %s", diff)
+			}
+
+			// The identical fully known configuration was already validated,
+			// so its diagnostics are not duplicated.
+			if diff := cmp.Diff(readResp.Diagnostics, diag.Diagnostics(nil)); diff != "" {
+				t.Errorf("unexpected read diagnostics difference: %s", diff)
+			}
+
+			if attributeValidateCount != 1 {
+				t.Errorf("expected attribute validator count 1, got %d", attributeValidateCount)
+			}
+
+			if dataSourceValidateCount != 1 {
+				t.Errorf("expected data source validator count 1, got %d", dataSourceValidateCount)
+			}
+		})
+	}
+}
@@ -2,10 +2,17 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/audit"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -17,6 +24,11 @@ type ReadResourceRequest struct {
 	Resource     resource.Resource
 	Private      *privatestate.Data
 	ProviderMeta *tfsdk.Config
+
+	// TypeName is the resource type name, which is used for fairly queuing
+	// requests when ReadResourceConcurrency is configured and for audit
+	// records.
+	TypeName string
 }
 
 // ReadResourceResponse is the framework server response for the
@@ -29,6 +41,8 @@ type ReadResourceResponse struct {
 
 // ReadResource implements the framework server ReadResource RPC.
 func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, resp *ReadResourceResponse) {
+	defer s.recoverStrictModePanic(ctx, "ReadResource", &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
@@ -36,23 +50,51 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 	if req.CurrentState == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Read Request",
-			"An unexpected error was encountered when reading the resource. The current state was missing.\n\n"+
-				"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				"An unexpected error was encountered when reading the resource. The current state was missing.\n\n"+
+					"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer.",
+			),
 		)
 
 		return
 	}
 
+	if s.ReadResourceConcurrency > 0 {
+		s.readResourceQueueOnce.Do(func() {
+			s.readResourceQueue = newReadResourceQueue(s.ReadResourceConcurrency)
+		})
+
+		logging.FrameworkTrace(ctx, "Waiting for ReadResource concurrency limit", map[string]interface{}{logging.KeyResourceType: req.TypeName})
+
+		if err := s.readResourceQueue.Acquire(ctx, req.TypeName); err != nil {
+			resp.Diagnostics.AddError(
+				"Read Request Canceled",
+				"The resource read was canceled while waiting for other resource reads to complete: "+err.Error(),
+			)
+
+			return
+		}
+
+		defer s.readResourceQueue.Release()
+
+		logging.FrameworkTrace(ctx, "Acquired ReadResource concurrency limit", map[string]interface{}{logging.KeyResourceType: req.TypeName})
+	}
+
+	defer s.writeAuditRecord(ctx, req.TypeName, audit.ActionRead, time.Now(), &resp.Diagnostics)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -62,16 +104,27 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		}
 	}
 
+	currentState := &tfsdk.State{
+		Schema: req.CurrentState.Schema,
+		Raw:    req.CurrentState.Raw,
+	}
+
+	resp.Diagnostics.Append(normalizeLegacyState(ctx, req.Resource, currentState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	readReq := resource.ReadRequest{
 		State: tfsdk.State{
-			Schema: req.CurrentState.Schema,
-			Raw:    req.CurrentState.Raw.Copy(),
+			Schema: currentState.Schema,
+			Raw:    currentState.Raw.Copy(),
 		},
 	}
 	readResp := resource.ReadResponse{
 		State: tfsdk.State{
-			Schema: req.CurrentState.Schema,
-			Raw:    req.CurrentState.Raw.Copy(),
+			Schema: currentState.Schema,
+			Raw:    currentState.Raw.Copy(),
 		},
 	}
 
@@ -94,8 +147,11 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Read")
-	req.Resource.Read(ctx, readReq, &readResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Read")
+	req.Resource.Read(methodCtx, readReq, &readResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Read")
+	logDiagnostics(ctx, readResp.Diagnostics)
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
@@ -107,4 +163,109 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 
 		resp.Private.Provider = readResp.Private
 	}
+
+	if len(readResp.RefreshedPaths) > 0 && !resp.Diagnostics.HasError() && !readResp.State.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Preserving prior state values outside of Resource Read RefreshedPaths")
+
+		newState, diags := partialRefreshState(ctx, *currentState, readResp.State, readResp.RefreshedPaths)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		resp.NewState = &newState
+	}
+
+	if !resp.Diagnostics.HasError() {
+		var float64EqualityDiags diag.Diagnostics
+
+		resp.NewState.Raw, float64EqualityDiags = float64EqualityValue(ctx, currentState.Schema, resp.NewState.Raw, currentState.Raw)
+
+		resp.Diagnostics.Append(float64EqualityDiags...)
+	}
+
+	if !resp.Diagnostics.HasError() && logging.FrameworkDebugEnabled() {
+		logReadDrift(ctx, *req.CurrentState, *resp.NewState)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(s.strictModeStateDiagnostics(ctx, "Read", resp.NewState)...)
+	}
+}
+
+// logReadDrift logs the paths of values which differ between the prior
+// state and the new state at DEBUG level, which explains why Terraform may
+// plan changes after a refresh. Values are not logged, as they may be
+// sensitive.
+func logReadDrift(ctx context.Context, priorState tfsdk.State, newState tfsdk.State) {
+	if priorState.Raw.IsNull() || newState.Raw.IsNull() {
+		return
+	}
+
+	changes := tfvalue.Changes(priorState.Raw, newState.Raw)
+
+	if len(changes) == 0 {
+		return
+	}
+
+	attributeChanges := make([]map[string]string, 0, len(changes))
+
+	for _, change := range changes {
+		changePath := change.Path.String()
+		attributePath, diags := fromtftypes.AttributePath(ctx, change.Path, newState.Schema)
+
+		if !diags.HasError() {
+			changePath = attributePath.String()
+		}
+
+		attributeChanges = append(attributeChanges, map[string]string{
+			"change": string(change.Kind),
+			"path":   changePath,
+		})
+	}
+
+	logging.FrameworkDebug(
+		ctx,
+		"Detected resource state changes during Read",
+		map[string]interface{}{
+			logging.KeyAttributeChanges: attributeChanges,
+		},
+	)
+}
+
+// partialRefreshState returns the prior state with only the values at the
+// refreshed paths updated from the new state.
+func partialRefreshState(ctx context.Context, priorState tfsdk.State, newState tfsdk.State, refreshedPaths path.Paths) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newStateData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         newState.Schema,
+		TerraformValue: newState.Raw,
+	}
+
+	resultData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         priorState.Schema,
+		TerraformValue: priorState.Raw.Copy(),
+	}
+
+	for _, refreshedPath := range refreshedPaths {
+		value, valueDiags := newStateData.ValueAtPath(ctx, refreshedPath)
+
+		diags.Append(valueDiags...)
+
+		if valueDiags.HasError() {
+			continue
+		}
+
+		diags.Append(resultData.SetAtPath(ctx, refreshedPath, value)...)
+	}
+
+	return tfsdk.State{
+		Raw:    resultData.TerraformValue,
+		Schema: priorState.Schema,
+	}, diags
 }
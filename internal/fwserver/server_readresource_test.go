@@ -8,11 +8,14 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -50,6 +53,18 @@ func TestServerReadResource(t *testing.T) {
 		},
 	}
 
+	testSchemaFloat64Equality := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_epsilon": schema.Float64Attribute{
+				Computed:        true,
+				EqualityEpsilon: 0.001,
+			},
+			"test_exact": schema.Float64Attribute{
+				Computed: true,
+			},
+		},
+	}
+
 	testConfig := &tfsdk.Config{
 		Raw:    testCurrentStateValue,
 		Schema: testSchema,
@@ -151,6 +166,45 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"request-currentstate-legacy-state-normalization": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, ""),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Resource: &testprovider.ResourceWithLegacyStateNormalization{
+					LegacyStateNormalizationPathsMethod: func(ctx context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_computed"),
+						}
+					},
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							var data struct {
+								TestComputed types.String `tfsdk:"test_computed"`
+								TestRequired types.String `tfsdk:"test_required"`
+							}
+
+							resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+							if !data.TestComputed.IsNull() {
+								resp.Diagnostics.AddError("unexpected req.State value", data.TestComputed.String())
+							}
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"request-providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -326,6 +380,126 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-state-float64-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_epsilon": tftypes.Number,
+							"test_exact":   tftypes.Number,
+						},
+					}, map[string]tftypes.Value{
+						"test_epsilon": tftypes.NewValue(tftypes.Number, 1.25),
+						"test_exact":   tftypes.NewValue(tftypes.Number, 1.25),
+					}),
+					Schema: testSchemaFloat64Equality,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_epsilon"), 1.2500001)...)
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_exact"), 1.2500001)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_epsilon": tftypes.Number,
+							"test_exact":   tftypes.Number,
+						},
+					}, map[string]tftypes.Value{
+						"test_epsilon": tftypes.NewValue(tftypes.Number, 1.25),
+						"test_exact":   tftypes.NewValue(tftypes.Number, 1.2500001),
+					}),
+					Schema: testSchemaFloat64Equality,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-refreshedpaths": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						var data struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						// Only test_computed is refreshed, test_required
+						// should be preserved from prior state.
+						data.TestComputed = types.StringValue("test-newstate-value")
+						data.TestRequired = types.StringNull()
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+						resp.RefreshedPaths = path.Paths{
+							path.Root("test_computed"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-refreshedpaths-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.RefreshedPaths = path.Paths{
+							path.Root("test_missing"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_missing"),
+						"State Read Error",
+						"An unexpected error was encountered trying to retrieve type information at a given path. "+
+							"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Error: AttributeName(\"test_missing\") still remains in the path: could not find attribute or block \"test_missing\" in schema",
+					),
+				},
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-refreshedpaths-removeresource": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.State.RemoveResource(ctx)
+
+						resp.RefreshedPaths = path.Paths{
+							path.Root("test_computed"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewStateRemoved,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-state-removeresource": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -343,6 +517,32 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-state-removeresource-warning": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resource.NewWarningResourceNotFound("test_resource", "test-id"))
+
+						resp.State.RemoveResource(ctx)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Not Found",
+						"The test_resource resource with identifier \"test-id\" was not found and has been removed from the Terraform state. "+
+							"This can occur if the resource was deleted outside of Terraform.",
+					),
+				},
+				NewState: testNewStateRemoved,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -382,6 +582,34 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testPrivate,
 			},
 		},
+		"server-readresourceconcurrency": {
+			server: &fwserver.Server{
+				Provider:                &testprovider.Provider{},
+				ReadResourceConcurrency: 1,
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						var data struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringValue("test-newstate-value")
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private:  testEmptyPrivate,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -399,3 +627,150 @@ func TestServerReadResource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerReadResource_DriftLogging(t *testing.T) {
+	// Environment variables cannot be set in parallel tests.
+	t.Setenv("TF_LOG", "DEBUG")
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG_SDK", "")
+	t.Setenv("TF_LOG_SDK_FRAMEWORK", "")
+
+	settingsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"level":   tftypes.Number,
+		},
+	}
+	ruleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port": tftypes.Number,
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password": tftypes.String,
+			"rules":    tftypes.List{ElementType: ruleType},
+			"settings": settingsType,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"level": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testCurrentState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, "old-secret"),
+			"rules": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"port": tftypes.NewValue(tftypes.Number, 80),
+				}),
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"port": tftypes.NewValue(tftypes.Number, 443),
+				}),
+			}),
+			"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"level":   tftypes.NewValue(tftypes.Number, 1),
+			}),
+		}),
+		Schema: testSchema,
+	}
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+	req := &fwserver.ReadResourceRequest{
+		CurrentState: testCurrentState,
+		Resource: &testprovider.Resource{
+			ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password"), "new-secret")...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("settings").AtName("level"), 2)...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rules"), []struct {
+					Port int64 `tfsdk:"port"`
+				}{
+					{Port: 80},
+				})...)
+			},
+		},
+	}
+	resp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	var got []map[string]interface{}
+
+	for _, entry := range entries {
+		if _, ok := entry[logging.KeyAttributeChanges]; ok {
+			got = append(got, entry)
+		}
+	}
+
+	// Values are never logged, including the sensitive password.
+	expected := []map[string]interface{}{
+		{
+			"@level":   "debug",
+			"@message": "Detected resource state changes during Read",
+			"@module":  "sdk.framework",
+			logging.KeyAttributeChanges: []interface{}{
+				map[string]interface{}{
+					"change": "changed",
+					"path":   "password",
+				},
+				map[string]interface{}{
+					"change": "removed",
+					"path":   "rules[1]",
+				},
+				map[string]interface{}{
+					"change": "changed",
+					"path":   "settings.level",
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
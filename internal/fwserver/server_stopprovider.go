@@ -0,0 +1,83 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// DefaultStopTimeout is the StopTimeout used when the Server StopTimeout is
+// zero or less.
+const DefaultStopTimeout = 5 * time.Second
+
+// StopProviderResponse is the framework server response for the
+// StopProvider RPC.
+type StopProviderResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+// StopProvider implements the framework server StopProvider RPC. If the
+// provider implements provider.ProviderWithStop, its Stop method is called
+// with a context deadline of the StopTimeout. The protocol servers cancel
+// the contexts of in-flight RPCs after this returns, which happens at the
+// deadline even if the Stop method has not returned.
+func (s *Server) StopProvider(ctx context.Context, resp *StopProviderResponse) {
+	providerWithStop, ok := s.Provider.(provider.ProviderWithStop)
+
+	if !ok {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithStop")
+
+	timeout := s.StopTimeout
+
+	if timeout <= 0 {
+		timeout = DefaultStopTimeout
+	}
+
+	stopCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Stop is called in a goroutine, so the deadline is enforced even if
+	// the provider ignores the context.
+	stopDiags := make(chan diag.Diagnostics, 1)
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Stop")
+
+	methodCtx, afterProviderMethod := beforeProviderMethod(stopCtx, "Provider Stop")
+
+	go func() {
+		stopDiags <- providerWithStop.Stop(methodCtx)
+	}()
+
+	select {
+	case diags := <-stopDiags:
+		afterProviderMethod()
+
+		logging.FrameworkDebug(ctx, "Called provider defined Provider Stop")
+
+		resp.Diagnostics.Append(diags...)
+	case <-stopCtx.Done():
+		afterProviderMethod()
+
+		logging.FrameworkWarn(
+			ctx,
+			"Provider defined Provider Stop did not return before the timeout",
+			map[string]interface{}{
+				logging.KeyStopTimeout: timeout.String(),
+			},
+		)
+
+		resp.Diagnostics.AddError(
+			"Provider Stop Timeout",
+			fmt.Sprintf("The provider did not finish stopping within %s. In-flight operations are canceled regardless.", timeout),
+		)
+	}
+
+	logDiagnostics(ctx, resp.Diagnostics)
+}
@@ -2,10 +2,13 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/audit"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -23,6 +26,8 @@ type UpdateResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+	// TypeName is the resource type name, which is used for audit records.
+	TypeName string
 }
 
 // UpdateResourceResponse is the framework server response for an update request
@@ -40,16 +45,20 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	defer s.writeAuditRecord(ctx, req.TypeName, audit.ActionUpdate, time.Now(), &resp.Diagnostics)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -115,8 +124,11 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Update")
-	req.Resource.Update(ctx, updateReq, &updateResp)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Update")
+	req.Resource.Update(methodCtx, updateReq, &updateResp)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Update")
+	logDiagnostics(ctx, updateResp.Diagnostics)
 
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = &updateResp.State
@@ -124,8 +136,11 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 	if !resp.Diagnostics.HasError() && updateResp.State.Raw.Equal(nullSchemaData) {
 		resp.Diagnostics.AddError(
 			"Missing Resource State After Update",
-			"The Terraform Provider unexpectedly returned no resource state after having no errors in the resource update. "+
-				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				"The Terraform Provider unexpectedly returned no resource state after having no errors in the resource update. "+
+					"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+			),
 		)
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,6 +36,8 @@ type UpgradeResourceStateResponse struct {
 
 // UpgradeResourceState implements the framework server UpgradeResourceState RPC.
 func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+	defer s.recoverStrictModePanic(ctx, "UpgradeResourceState", &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
@@ -83,19 +86,30 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Previously Saved State for UpgradeResourceState",
-				"There was an error reading the saved resource state using the current resource schema.\n\n"+
-					"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
-					"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
-					"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					"There was an error reading the saved resource state using the current resource schema.\n\n"+
+						"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
+						"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
+						"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
+				),
 			)
 			return
 		}
 
+		resp.Diagnostics.Append(undefinedStateAttributesDiags(ctx, req.Resource, req.RawState, resourceSchemaType)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		resp.UpgradedState = &tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    rawStateValue,
 		}
 
+		resp.Diagnostics.Append(normalizeLegacyState(ctx, req.Resource, resp.UpgradedState)...)
+
 		return
 	}
 
@@ -103,12 +117,14 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -123,9 +139,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unable to Upgrade Resource State",
-			"This resource was implemented without an UpgradeState() method, "+
-				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
-				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				"This resource was implemented without an UpgradeState() method, "+
+					fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			),
 		)
 		return
 	}
@@ -133,7 +152,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeState")
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeState")
-	resourceStateUpgraders := resourceWithUpgradeState.UpgradeState(ctx)
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource UpgradeState")
+	resourceStateUpgraders := resourceWithUpgradeState.UpgradeState(methodCtx)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeState")
 
 	// Panic prevention
@@ -146,9 +167,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unable to Upgrade Resource State",
-			"This resource was implemented with an UpgradeState() method, "+
-				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
-				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				"This resource was implemented with an UpgradeState() method, "+
+					fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			),
 		)
 		return
 	}
@@ -167,12 +191,21 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Previously Saved State for UpgradeResourceState",
-				fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", req.Version)+
-					"Please report this to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", req.Version)+
+						"Please report this to the provider developer:\n\n"+err.Error(),
+				),
 			)
 			return
 		}
 
+		resp.Diagnostics.Append(undefinedStateAttributesDiags(ctx, req.Resource, req.RawState, priorSchemaType)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		upgradeResourceStateRequest.State = &tfsdk.State{
 			Raw:    rawStateValue,
 			Schema: *resourceStateUpgrader.PriorSchema,
@@ -192,7 +225,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	// any errors.
 
 	logging.FrameworkDebug(ctx, "Calling provider defined StateUpgrader")
-	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	methodCtx, afterProviderMethod = beforeProviderMethod(ctx, "StateUpgrader")
+	resourceStateUpgrader.StateUpgrader(methodCtx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	afterProviderMethod()
 	logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
 
 	resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
@@ -209,8 +244,11 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Upgrade Resource State",
-				fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that was not compatible with the current schema.\n\n", req.Version)+
-					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
+				fwdiag.ReportableErrorDetail(
+					ctx,
+					fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that was not compatible with the current schema.\n\n", req.Version)+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
+				),
 			)
 			return
 		}
@@ -220,18 +258,25 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 			Raw:    upgradedStateValue,
 		}
 
+		resp.Diagnostics.Append(normalizeLegacyState(ctx, req.Resource, resp.UpgradedState)...)
+
 		return
 	}
 
 	if upgradeResourceStateResponse.State.Raw.Type() == nil || upgradeResourceStateResponse.State.Raw.IsNull() {
 		resp.Diagnostics.AddError(
 			"Missing Upgraded Resource State",
-			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", req.Version)+
-				"Preventing the unexpected loss of resource state data. "+
-				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			fwdiag.ReportableErrorDetail(
+				ctx,
+				fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", req.Version)+
+					"Preventing the unexpected loss of resource state data. "+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			),
 		)
 		return
 	}
 
 	resp.UpgradedState = &upgradeResourceStateResponse.State
+
+	resp.Diagnostics.Append(normalizeLegacyState(ctx, req.Resource, resp.UpgradedState)...)
 }
@@ -148,6 +148,138 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"RawState-legacy-state-normalization": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"optional_attribute": "",
+					"required_attribute": "",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithLegacyStateNormalization{
+					Resource: &testprovider.Resource{},
+					LegacyStateNormalizationPathsMethod: func(ctx context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("optional_attribute"),
+						}
+					},
+				},
+				Version: 1, // Must match current Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, ""),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"RawState-undefined-attributes-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                  "test-id-value",
+					"required_attribute":  "true",
+					"undefined_attribute": "test",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUndefinedStateAttributes{
+					Resource: &testprovider.Resource{},
+					UndefinedStateAttributesMethod: func(ctx context.Context) resource.UndefinedStateAttributesBehavior {
+						return resource.UndefinedStateAttributesBehaviorError
+					},
+				},
+				Version: 1, // Must match current Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Undefined Resource State Attributes",
+						"The saved resource state contains attributes which are not defined in the resource schema. "+
+							"This can occur after manually modifying the resource state or downgrading the provider. "+
+							"Remove the attributes from the resource state or upgrade the provider to continue.\n\n"+
+							`AttributeName("undefined_attribute"): unsupported attribute "undefined_attribute"`,
+					),
+				},
+			},
+		},
+		"RawState-undefined-attributes-ignore": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                  "test-id-value",
+					"required_attribute":  "true",
+					"undefined_attribute": "test",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUndefinedStateAttributes{
+					Resource: &testprovider.Resource{},
+					UndefinedStateAttributesMethod: func(ctx context.Context) resource.UndefinedStateAttributesBehavior {
+						return resource.UndefinedStateAttributesBehaviorIgnore
+					},
+				},
+				Version: 1, // Must match current Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"RawState-undefined-attributes-warn": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                  "test-id-value",
+					"required_attribute":  "true",
+					"undefined_attribute": "test",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUndefinedStateAttributes{
+					Resource: &testprovider.Resource{},
+					UndefinedStateAttributesMethod: func(ctx context.Context) resource.UndefinedStateAttributesBehavior {
+						return resource.UndefinedStateAttributesBehaviorWarn
+					},
+				},
+				Version: 1, // Must match current Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Undefined Resource State Attributes",
+						"The saved resource state contains attributes which are not defined in the resource schema. "+
+							"These attributes were removed from the resource state. "+
+							"This can occur after manually modifying the resource state or downgrading the provider.\n\n"+
+							`AttributeName("undefined_attribute"): unsupported attribute "undefined_attribute"`,
+					),
+				},
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"RawState-missing": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
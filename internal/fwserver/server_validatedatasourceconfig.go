@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -14,6 +15,7 @@ import (
 type ValidateDataSourceConfigRequest struct {
 	Config     *tfsdk.Config
 	DataSource datasource.DataSource
+	TypeName   string
 }
 
 // ValidateDataSourceConfigResponse is the framework server response for the
@@ -24,20 +26,27 @@ type ValidateDataSourceConfigResponse struct {
 
 // ValidateDataSourceConfig implements the framework server ValidateDataSourceConfig RPC.
 func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
+	defer s.recoverStrictModePanic(ctx, "ValidateDataSourceConfig", &resp.Diagnostics)
+
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	// Changes must not be persisted during validation.
+	ctx = dryrun.NewContext(ctx)
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
 		configureReq := datasource.ConfigureRequest{
-			ProviderData: s.DataSourceConfigureData,
+			ProviderData: s.dataSourceConfigureData(),
 		}
 		configureResp := datasource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource Configure")
-		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource Configure")
+		dataSourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -47,14 +56,27 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		}
 	}
 
+	resp.Diagnostics.Append(s.dataSourceConfigValidate(ctx, req.TypeName, req.DataSource, *req.Config)...)
+
+	if req.Config.Raw.IsFullyKnown() {
+		s.setValidatedDataSourceConfig(req.TypeName, *req.Config)
+	}
+}
+
+// dataSourceConfigValidate returns the diagnostics of the data source config
+// validators, ValidateConfig method, and schema-based validation for the
+// configuration.
+func (s *Server) dataSourceConfigValidate(ctx context.Context, typeName string, dataSource datasource.DataSource, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	vdscReq := datasource.ValidateConfigRequest{
-		Config: *req.Config,
+		Config: config,
 	}
 
-	if dataSource, ok := req.DataSource.(datasource.DataSourceWithConfigValidators); ok {
+	if dataSourceWithConfigValidators, ok := dataSource.(datasource.DataSourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigValidators")
 
-		for _, configValidator := range dataSource.ConfigValidators(ctx) {
+		for _, configValidator := range dataSourceWithConfigValidators.ConfigValidators(ctx) {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			vdscResp := &datasource.ValidateConfigResponse{}
@@ -66,7 +88,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateDataSource(ctx, vdscReq, vdscResp)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "ConfigValidator")
+			configValidator.ValidateDataSource(methodCtx, vdscReq, vdscResp)
+			afterProviderMethod()
 			logging.FrameworkDebug(
 				ctx,
 				"Called provider defined ConfigValidator",
@@ -75,11 +99,11 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 				},
 			)
 
-			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+			diags.Append(vdscResp.Diagnostics...)
 		}
 	}
 
-	if dataSource, ok := req.DataSource.(datasource.DataSourceWithValidateConfig); ok {
+	if dataSourceWithValidateConfig, ok := dataSource.(datasource.DataSourceWithValidateConfig); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithValidateConfig")
 
 		// Instantiate a new response for each request to prevent validators
@@ -87,20 +111,57 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		vdscResp := &datasource.ValidateConfigResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource ValidateConfig")
-		dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "DataSource ValidateConfig")
+		dataSourceWithValidateConfig.ValidateConfig(methodCtx, vdscReq, vdscResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource ValidateConfig")
+		logDiagnostics(ctx, vdscResp.Diagnostics)
 
-		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		diags.Append(vdscResp.Diagnostics...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config: config,
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
 	validateSchemaResp := ValidateSchemaResponse{}
 
-	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+	SchemaValidate(ctx, config.Schema, validateSchemaReq, &validateSchemaResp)
+
+	s.emitAttributeValidationFailedEvents(ctx, typeName, validateSchemaResp.Diagnostics)
+
+	diags.Append(validateSchemaResp.Diagnostics...)
+
+	return diags
+}
+
+// validatedDataSourceConfigKey returns the validatedDataSourceConfigs key for
+// the data source type name and configuration.
+func validatedDataSourceConfigKey(typeName string, config tfsdk.Config) string {
+	return typeName + "\n" + config.Raw.String()
+}
+
+// setValidatedDataSourceConfig saves that the fully known configuration was
+// validated.
+func (s *Server) setValidatedDataSourceConfig(typeName string, config tfsdk.Config) {
+	s.validatedDataSourceConfigsMutex.Lock()
+	defer s.validatedDataSourceConfigsMutex.Unlock()
+
+	if s.validatedDataSourceConfigs == nil {
+		s.validatedDataSourceConfigs = make(map[string]struct{})
+	}
+
+	s.validatedDataSourceConfigs[validatedDataSourceConfigKey(typeName, config)] = struct{}{}
+}
+
+// validatedDataSourceConfig returns true if the configuration was validated
+// by the ValidateDataSourceConfig RPC.
+func (s *Server) validatedDataSourceConfig(typeName string, config tfsdk.Config) bool {
+	s.validatedDataSourceConfigsMutex.Lock()
+	defer s.validatedDataSourceConfigsMutex.Unlock()
+
+	_, ok := s.validatedDataSourceConfigs[validatedDataSourceConfigKey(typeName, config)]
 
-	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+	return ok
 }
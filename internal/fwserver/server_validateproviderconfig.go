@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -24,10 +25,33 @@ type ValidateProviderConfigResponse struct {
 
 // ValidateProviderConfig implements the framework server ValidateProviderConfig RPC.
 func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
+	defer s.recoverStrictModePanic(ctx, "ValidateProviderConfig", &resp.Diagnostics)
+
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	// Changes must not be persisted during validation.
+	ctx = dryrun.NewContext(ctx)
+
+	// Attribute validation runs before provider-level validation, so
+	// diagnostics about individual attributes are returned first. Unknown
+	// values are passed through unchanged, as provider configurations often
+	// reference values which are unknown until apply, so provider-level
+	// validation can choose to skip them.
+	validateSchemaReq := ValidateSchemaRequest{
+		Config: *req.Config,
+	}
+	// Instantiate a new response for each request to prevent validators
+	// from modifying or removing diagnostics.
+	validateSchemaResp := ValidateSchemaResponse{}
+
+	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+
+	s.emitAttributeValidationFailedEvents(ctx, "", validateSchemaResp.Diagnostics)
+
+	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+
 	vpcReq := provider.ValidateConfigRequest{
 		Config: *req.Config,
 	}
@@ -47,7 +71,9 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateProvider(ctx, vpcReq, vpcRes)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "ConfigValidator")
+			configValidator.ValidateProvider(methodCtx, vpcReq, vpcRes)
+			afterProviderMethod()
 			logging.FrameworkDebug(
 				ctx,
 				"Called provider defined ConfigValidator",
@@ -68,23 +94,15 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		vpcRes := &provider.ValidateConfigResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Provider ValidateConfig")
-		providerWithValidateConfig.ValidateConfig(ctx, vpcReq, vpcRes)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Provider ValidateConfig")
+		providerWithValidateConfig.ValidateConfig(methodCtx, vpcReq, vpcRes)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Provider ValidateConfig")
+		logDiagnostics(ctx, vpcRes.Diagnostics)
 
 		resp.Diagnostics.Append(vpcRes.Diagnostics...)
 	}
 
-	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
-	}
-	// Instantiate a new response for each request to prevent validators
-	// from modifying or removing diagnostics.
-	validateSchemaResp := ValidateSchemaResponse{}
-
-	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
-
-	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
-
 	// This RPC allows a modified configuration to be returned. This was
 	// previously used to allow a "required" provider attribute (as defined
 	// by a schema) to still be "optional" with a default value, typically
@@ -86,6 +86,68 @@ func TestServerValidateProviderConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testConflictingType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"token":    tftypes.String,
+			"username": tftypes.String,
+		},
+	}
+
+	testConflictingSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Optional: true,
+			},
+			"username": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConflictingConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(testConflictingType, map[string]tftypes.Value{
+			"token":    tftypes.NewValue(tftypes.String, "test-token"),
+			"username": tftypes.NewValue(tftypes.String, "test-username"),
+		}),
+		Schema: testConflictingSchema,
+	}
+
+	testConflictingConfigUnknown := tfsdk.Config{
+		Raw: tftypes.NewValue(testConflictingType, map[string]tftypes.Value{
+			"token":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"username": tftypes.NewValue(tftypes.String, "test-username"),
+		}),
+		Schema: testConflictingSchema,
+	}
+
+	// testConflictingConfigValidator returns an error on the username
+	// attribute when both token and username are configured, skipping
+	// unknown values.
+	testConflictingConfigValidator := &testprovider.ProviderConfigValidator{
+		ValidateProviderMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+			var token, username types.String
+
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username"), &username)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if token.IsUnknown() || username.IsUnknown() {
+				return
+			}
+
+			if !token.IsNull() && !username.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("username"),
+					"Conflicting Provider Configuration",
+					"The token and username attributes cannot both be configured.",
+				)
+			}
+		},
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateProviderConfigRequest
@@ -231,6 +293,94 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				PreparedConfig: &testConfig,
 			},
 		},
+		"request-config-ProviderWithConfigValidators-conflicting-attributes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testConflictingSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						return []provider.ConfigValidator{
+							testConflictingConfigValidator,
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConflictingConfig,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("username"),
+						"Conflicting Provider Configuration",
+						"The token and username attributes cannot both be configured.",
+					),
+				},
+				PreparedConfig: &testConflictingConfig,
+			},
+		},
+		"request-config-ProviderWithConfigValidators-conflicting-attributes-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testConflictingSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						return []provider.ConfigValidator{
+							testConflictingConfigValidator,
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConflictingConfigUnknown,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				PreparedConfig: &testConflictingConfigUnknown,
+			},
+		},
+		"request-config-ProviderWithConfigValidators-AttributeValidator-diagnostic-ordering": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchemaAttributeValidatorError
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						return []provider.ConfigValidator{
+							&testprovider.ProviderConfigValidator{
+								ValidateProviderMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("provider error summary", "provider error detail")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfigAttributeValidatorError,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail",
+					),
+					diag.NewErrorDiagnostic(
+						"provider error summary",
+						"provider error detail",
+					),
+				},
+				PreparedConfig: &testConfigAttributeValidatorError,
+			},
+		},
 		"request-config-ProviderWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.ProviderWithValidateConfig{
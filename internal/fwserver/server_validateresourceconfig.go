@@ -2,8 +2,16 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -14,6 +22,7 @@ import (
 type ValidateResourceConfigRequest struct {
 	Config   *tfsdk.Config
 	Resource resource.Resource
+	TypeName string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -24,20 +33,27 @@ type ValidateResourceConfigResponse struct {
 
 // ValidateResourceConfig implements the framework server ValidateResourceConfig RPC.
 func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
+	defer s.recoverStrictModePanic(ctx, "ValidateResourceConfig", &resp.Diagnostics)
+
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	// Changes must not be persisted during validation.
+	ctx = dryrun.NewContext(ctx)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
 		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
+			ProviderData: s.resourceConfigureData(),
 		}
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(methodCtx, configureReq, &configureResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -47,11 +63,24 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		}
 	}
 
+	resp.Diagnostics.Append(s.resourceConfigValidate(ctx, req.TypeName, req.Resource, *req.Config)...)
+
+	if !req.Config.Raw.IsFullyKnown() {
+		s.setUnknownResourceConfig(req.TypeName, *req.Config)
+	}
+}
+
+// resourceConfigValidate returns the diagnostics of the resource config
+// validators, ValidateConfig method, and schema-based validation for the
+// configuration.
+func (s *Server) resourceConfigValidate(ctx context.Context, typeName string, r resource.Resource, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	vdscReq := resource.ValidateConfigRequest{
-		Config: *req.Config,
+		Config: config,
 	}
 
-	if resourceWithConfigValidators, ok := req.Resource.(resource.ResourceWithConfigValidators); ok {
+	if resourceWithConfigValidators, ok := r.(resource.ResourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigValidators")
 
 		for _, configValidator := range resourceWithConfigValidators.ConfigValidators(ctx) {
@@ -66,7 +95,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateResource(ctx, vdscReq, vdscResp)
+			methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "ResourceConfigValidator")
+			configValidator.ValidateResource(methodCtx, vdscReq, vdscResp)
+			afterProviderMethod()
 			logging.FrameworkDebug(
 				ctx,
 				"Called provider defined ResourceConfigValidator",
@@ -75,11 +106,11 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 				},
 			)
 
-			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+			diags.Append(vdscResp.Diagnostics...)
 		}
 	}
 
-	if resourceWithValidateConfig, ok := req.Resource.(resource.ResourceWithValidateConfig); ok {
+	if resourceWithValidateConfig, ok := r.(resource.ResourceWithValidateConfig); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithValidateConfig")
 
 		// Instantiate a new response for each request to prevent validators
@@ -87,20 +118,297 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		vdscResp := &resource.ValidateConfigResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource ValidateConfig")
-		resourceWithValidateConfig.ValidateConfig(ctx, vdscReq, vdscResp)
+		methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource ValidateConfig")
+		resourceWithValidateConfig.ValidateConfig(methodCtx, vdscReq, vdscResp)
+		afterProviderMethod()
 		logging.FrameworkDebug(ctx, "Called provider defined Resource ValidateConfig")
+		logDiagnostics(ctx, vdscResp.Diagnostics)
 
-		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		diags.Append(vdscResp.Diagnostics...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config: config,
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
 	validateSchemaResp := ValidateSchemaResponse{}
 
-	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+	SchemaValidate(ctx, config.Schema, validateSchemaReq, &validateSchemaResp)
+
+	s.emitAttributeValidationFailedEvents(ctx, typeName, validateSchemaResp.Diagnostics)
+
+	diags.Append(validateSchemaResp.Diagnostics...)
+
+	return diags
+}
+
+// resourceConfigValidatePaths returns the diagnostics of the schema-based
+// validation for the attributes, blocks, and nested objects at the given
+// paths of the configuration, such as those which were unknown during the
+// ValidateResourceConfig RPC. Other paths, such as elements of collection
+// attributes, are skipped as the attribute containing them was validated.
+func (s *Server) resourceConfigValidatePaths(ctx context.Context, typeName string, config tfsdk.Config, tfPaths []*tftypes.AttributePath) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         config.Schema,
+		TerraformValue: config.Raw,
+	}
+
+	for _, tfPath := range tfPaths {
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, tfPath, config.Schema)
+
+		diags.Append(pathDiags...)
+
+		if pathDiags.HasError() {
+			continue
+		}
+
+		schemaElement, _, err := tftypes.WalkAttributePath(config.Schema, tfPath)
+
+		if err != nil {
+			diags.Append(fwdiag.FrameworkErrorDiag(
+				ctx,
+				"Resource Configuration Validation Error",
+				"validating the resource configuration",
+				fmt.Sprintf("Unable to find schema definition at path %s: %s", attributePath, err),
+			))
+
+			continue
+		}
+
+		validateReq := ValidateAttributeRequest{
+			AttributePath:           attributePath,
+			AttributePathExpression: attributePath.Expression(),
+			Config:                  config,
+		}
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &ValidateAttributeResponse{}
+
+		switch schemaElement := schemaElement.(type) {
+		case fwschema.Attribute:
+			AttributeValidate(ctx, schemaElement, validateReq, validateResp)
+		case fwschema.Block:
+			BlockValidate(ctx, schemaElement, validateReq, validateResp)
+		case fwschema.NestedAttributeObject:
+			attributeConfig, valueDiags := configData.ValueAtPath(ctx, attributePath)
+
+			diags.Append(valueDiags...)
+
+			if valueDiags.HasError() {
+				continue
+			}
+
+			validateReq.AttributeConfig = attributeConfig
+
+			NestedAttributeObjectValidate(ctx, schemaElement, validateReq, validateResp)
+		case fwschema.NestedBlockObject:
+			attributeConfig, valueDiags := configData.ValueAtPath(ctx, attributePath)
+
+			diags.Append(valueDiags...)
+
+			if valueDiags.HasError() {
+				continue
+			}
+
+			validateReq.AttributeConfig = attributeConfig
+
+			NestedBlockObjectValidate(ctx, schemaElement, validateReq, validateResp)
+		}
+
+		s.emitAttributeValidationFailedEvents(ctx, typeName, validateResp.Diagnostics)
+
+		diags.Append(validateResp.Diagnostics...)
+	}
+
+	return diags
+}
+
+// setUnknownResourceConfig saves the configuration containing unknown values
+// which was validated by the ValidateResourceConfig RPC, so the values can be
+// validated once known.
+func (s *Server) setUnknownResourceConfig(typeName string, config tfsdk.Config) {
+	s.unknownResourceConfigsMutex.Lock()
+	defer s.unknownResourceConfigsMutex.Unlock()
+
+	for _, unknownConfig := range s.unknownResourceConfigs[typeName] {
+		if unknownConfig.Equal(config.Raw) {
+			return
+		}
+	}
+
+	if s.unknownResourceConfigs == nil {
+		s.unknownResourceConfigs = make(map[string][]tftypes.Value)
+	}
+
+	s.unknownResourceConfigs[typeName] = append(s.unknownResourceConfigs[typeName], config.Raw)
+}
+
+// resourceConfigUnknownPaths returns the paths of the fully known
+// configuration which were unknown in a configuration of the resource type
+// validated by the ValidateResourceConfig RPC, where all other values of that
+// configuration are equal. Nil is returned if no such configuration was
+// validated, such as when the configuration was validated while fully known.
+func (s *Server) resourceConfigUnknownPaths(typeName string, config tfsdk.Config) []*tftypes.AttributePath {
+	if !config.Raw.IsFullyKnown() {
+		return nil
+	}
+
+	s.unknownResourceConfigsMutex.Lock()
+	defer s.unknownResourceConfigsMutex.Unlock()
 
-	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+	for _, unknownConfig := range s.unknownResourceConfigs[typeName] {
+		if unknownPaths, ok := knownValueUnknownPaths(tftypes.NewAttributePath(), config.Raw, unknownConfig); ok {
+			return unknownPaths
+		}
+	}
+
+	return nil
+}
+
+// knownValueUnknownPaths returns the paths of the known value which are
+// unknown in the unknown value and whether all other values are equal. Set
+// elements containing unknown values are paired with the first remaining
+// known element which matches them.
+func knownValueUnknownPaths(p *tftypes.AttributePath, known, unknown tftypes.Value) ([]*tftypes.AttributePath, bool) {
+	if !unknown.IsKnown() {
+		return []*tftypes.AttributePath{p}, true
+	}
+
+	if unknown.IsFullyKnown() || known.IsNull() || unknown.IsNull() {
+		return nil, known.Equal(unknown)
+	}
+
+	var unknownPaths []*tftypes.AttributePath
+
+	switch typ := unknown.Type().(type) {
+	case tftypes.List, tftypes.Tuple, tftypes.Set:
+		var knownElems, unknownElems []tftypes.Value
+
+		if err := known.As(&knownElems); err != nil {
+			return nil, false
+		}
+
+		if err := unknown.As(&unknownElems); err != nil {
+			return nil, false
+		}
+
+		if len(knownElems) != len(unknownElems) {
+			return nil, false
+		}
+
+		if _, ok := typ.(tftypes.Set); !ok {
+			for i := range unknownElems {
+				elemUnknownPaths, ok := knownValueUnknownPaths(p.WithElementKeyInt(i), knownElems[i], unknownElems[i])
+
+				if !ok {
+					return nil, false
+				}
+
+				unknownPaths = append(unknownPaths, elemUnknownPaths...)
+			}
+
+			return unknownPaths, true
+		}
+
+		paired := make([]bool, len(knownElems))
+
+		// Pair fully known elements first, so elements containing unknown
+		// values cannot claim them.
+		for _, unknownElem := range unknownElems {
+			if !unknownElem.IsFullyKnown() {
+				continue
+			}
+
+			pairedIndex := -1
+
+			for i, knownElem := range knownElems {
+				if !paired[i] && knownElem.Equal(unknownElem) {
+					pairedIndex = i
+
+					break
+				}
+			}
+
+			if pairedIndex == -1 {
+				return nil, false
+			}
+
+			paired[pairedIndex] = true
+		}
+
+		for _, unknownElem := range unknownElems {
+			if unknownElem.IsFullyKnown() {
+				continue
+			}
+
+			pairedIndex := -1
+
+			for i, knownElem := range knownElems {
+				if paired[i] {
+					continue
+				}
+
+				elemUnknownPaths, ok := knownValueUnknownPaths(p.WithElementKeyValue(knownElem), knownElem, unknownElem)
+
+				if ok {
+					pairedIndex = i
+					unknownPaths = append(unknownPaths, elemUnknownPaths...)
+
+					break
+				}
+			}
+
+			if pairedIndex == -1 {
+				return nil, false
+			}
+
+			paired[pairedIndex] = true
+		}
+
+		return unknownPaths, true
+	case tftypes.Map, tftypes.Object:
+		var knownElems, unknownElems map[string]tftypes.Value
+
+		if err := known.As(&knownElems); err != nil {
+			return nil, false
+		}
+
+		if err := unknown.As(&unknownElems); err != nil {
+			return nil, false
+		}
+
+		if len(knownElems) != len(unknownElems) {
+			return nil, false
+		}
+
+		for _, key := range fwschema.SortedNames(unknownElems) {
+			knownElem, ok := knownElems[key]
+
+			if !ok {
+				return nil, false
+			}
+
+			elemPath := p.WithAttributeName(key)
+
+			if _, ok := typ.(tftypes.Map); ok {
+				elemPath = p.WithElementKeyString(key)
+			}
+
+			elemUnknownPaths, ok := knownValueUnknownPaths(elemPath, knownElem, unknownElems[key])
+
+			if !ok {
+				return nil, false
+			}
+
+			unknownPaths = append(unknownPaths, elemUnknownPaths...)
+		}
+
+		return unknownPaths, true
+	default:
+		return nil, known.Equal(unknown)
+	}
 }
@@ -2,10 +2,12 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/dryrun"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
@@ -256,6 +258,27 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-ResourceWithValidateConfig-dryrun": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithValidateConfig{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ValidateConfigMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+						if !dryrun.IsDryRun(ctx) {
+							resp.Diagnostics.AddError("Incorrect ctx", "expected dry run")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
 		"request-config-ResourceWithValidateConfig-diagnostic": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -303,3 +326,318 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServerValidateResourceConfig_Unknown(t *testing.T) {
+	t.Parallel()
+
+	testLeafType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"leaf": tftypes.String,
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"leaf": tftypes.String,
+			"list": tftypes.List{ElementType: testLeafType},
+		},
+	}
+
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"leaf":   tftypes.String,
+			"single": testLeafType,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block":  tftypes.List{ElementType: testBlockType},
+			"nested": testNestedType,
+		},
+	}
+
+	// Validators attached to collections and objects report when they receive
+	// an unknown value, which must still occur.
+	testListValidator := testvalidator.List{
+		ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			if req.ConfigValue.IsUnknown() {
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown Value", "list validator called with unknown value")
+			}
+		},
+	}
+
+	testObjectValidator := testvalidator.Object{
+		ValidateObjectMethod: func(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+			if req.ConfigValue.IsUnknown() {
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown Value", "object validator called with unknown value")
+			}
+		},
+	}
+
+	// Leaf attribute values in the test configurations are never directly
+	// null or unknown, so receiving either means validation descended into a
+	// null or unknown parent.
+	testStringValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(req.Path, "Unexpected Validation", "string validator called with null or unknown value")
+			}
+		},
+	}
+
+	testLeafAttributes := map[string]schema.Attribute{
+		"leaf": schema.StringAttribute{
+			Required:   true,
+			Validators: []validator.String{testStringValidator},
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"leaf": schema.StringAttribute{
+						Required:   true,
+						Validators: []validator.String{testStringValidator},
+					},
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: testLeafAttributes,
+							Validators: []validator.Object{testObjectValidator},
+						},
+						Optional:   true,
+						Validators: []validator.List{testListValidator},
+					},
+				},
+				Optional:   true,
+				Validators: []validator.Object{testObjectValidator},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: testLeafAttributes,
+					Blocks: map[string]schema.Block{
+						"single": schema.SingleNestedBlock{
+							Attributes: testLeafAttributes,
+							Validators: []validator.Object{testObjectValidator},
+						},
+					},
+					Validators: []validator.Object{testObjectValidator},
+				},
+				Validators: []validator.List{testListValidator},
+			},
+		},
+	}
+
+	testLeafValue := tftypes.NewValue(testLeafType, map[string]tftypes.Value{
+		"leaf": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testNestedValue := func(list tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+			"leaf": tftypes.NewValue(tftypes.String, "test-value"),
+			"list": list,
+		})
+	}
+
+	testBlockValue := func(single tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+			"leaf":   tftypes.NewValue(tftypes.String, "test-value"),
+			"single": single,
+		})
+	}
+
+	testCases := map[string]struct {
+		block         tftypes.Value
+		nested        tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			block: tftypes.NewValue(tftypes.List{ElementType: testBlockType}, []tftypes.Value{
+				testBlockValue(testLeafValue),
+			}),
+			nested: testNestedValue(tftypes.NewValue(tftypes.List{ElementType: testLeafType}, []tftypes.Value{
+				testLeafValue,
+			})),
+		},
+		"nested-attribute-unknown": {
+			block:  tftypes.NewValue(tftypes.List{ElementType: testBlockType}, nil),
+			nested: tftypes.NewValue(testNestedType, tftypes.UnknownValue),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("nested"),
+					"Unknown Value",
+					"object validator called with unknown value",
+				),
+			},
+		},
+		"list-nested-attribute-unknown": {
+			block:  tftypes.NewValue(tftypes.List{ElementType: testBlockType}, nil),
+			nested: testNestedValue(tftypes.NewValue(tftypes.List{ElementType: testLeafType}, tftypes.UnknownValue)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("nested").AtName("list"),
+					"Unknown Value",
+					"list validator called with unknown value",
+				),
+			},
+		},
+		"list-nested-attribute-element-unknown": {
+			block: tftypes.NewValue(tftypes.List{ElementType: testBlockType}, nil),
+			nested: testNestedValue(tftypes.NewValue(tftypes.List{ElementType: testLeafType}, []tftypes.Value{
+				tftypes.NewValue(testLeafType, tftypes.UnknownValue),
+			})),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("nested").AtName("list").AtListIndex(0),
+					"Unknown Value",
+					"object validator called with unknown value",
+				),
+			},
+		},
+		"list-nested-block-unknown": {
+			block:  tftypes.NewValue(tftypes.List{ElementType: testBlockType}, tftypes.UnknownValue),
+			nested: tftypes.NewValue(testNestedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("block"),
+					"Unknown Value",
+					"list validator called with unknown value",
+				),
+			},
+		},
+		"list-nested-block-element-unknown": {
+			block: tftypes.NewValue(tftypes.List{ElementType: testBlockType}, []tftypes.Value{
+				tftypes.NewValue(testBlockType, tftypes.UnknownValue),
+			}),
+			nested: tftypes.NewValue(testNestedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("block").AtListIndex(0),
+					"Unknown Value",
+					"object validator called with unknown value",
+				),
+			},
+		},
+		"single-nested-block-null": {
+			block: tftypes.NewValue(tftypes.List{ElementType: testBlockType}, []tftypes.Value{
+				testBlockValue(tftypes.NewValue(testLeafType, nil)),
+			}),
+			nested: tftypes.NewValue(testNestedType, nil),
+		},
+		"single-nested-block-unknown": {
+			block: tftypes.NewValue(tftypes.List{ElementType: testBlockType}, []tftypes.Value{
+				testBlockValue(tftypes.NewValue(testLeafType, tftypes.UnknownValue)),
+			}),
+			nested: tftypes.NewValue(testNestedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("block").AtListIndex(0).AtName("single"),
+					"Unknown Value",
+					"object validator called with unknown value",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.ValidateResourceConfigRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"block":  testCase.block,
+						"nested": testCase.nested,
+					}),
+					Schema: testSchema,
+				},
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+				},
+			}
+			response := &fwserver.ValidateResourceConfigResponse{}
+
+			server.ValidateResourceConfig(context.Background(), request, response)
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerValidateResourceConfig_DiagnosticsOrdering(t *testing.T) {
+	t.Parallel()
+
+	testAttributeTypes := map[string]tftypes.Type{}
+	testAttributeValues := map[string]tftypes.Value{}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{},
+	}
+
+	var expectedDiags diag.Diagnostics
+
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("test_%02d", i)
+
+		testAttributeTypes[name] = tftypes.String
+		testAttributeValues[name] = tftypes.NewValue(tftypes.String, "test-value")
+		testSchema.Attributes[name] = schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				testvalidator.String{
+					ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+						resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+					},
+				},
+			},
+		}
+
+		expectedDiags = append(expectedDiags, diag.NewAttributeErrorDiagnostic(
+			path.Root(name),
+			"error summary",
+			"error detail",
+		))
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: testAttributeTypes,
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+	request := &fwserver.ValidateResourceConfigRequest{
+		Config: &tfsdk.Config{
+			Raw:    tftypes.NewValue(testType, testAttributeValues),
+			Schema: testSchema,
+		},
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = testSchema
+			},
+		},
+	}
+
+	// Map iteration order is randomized, so repeat validation to verify the
+	// diagnostics ordering is stable.
+	for i := 0; i < 10; i++ {
+		response := &fwserver.ValidateResourceConfigResponse{}
+
+		server.ValidateResourceConfig(context.Background(), request, response)
+
+		if diff := cmp.Diff(response.Diagnostics, expectedDiags); diff != "" {
+			t.Fatalf("unexpected difference on run %d: %s", i, diff)
+		}
+	}
+}
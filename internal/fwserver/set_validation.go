@@ -0,0 +1,84 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SetValidateDuplicates returns an error diagnostic for each element of a set
+// configuration value which is equal to an earlier element. Elements are
+// compared with the attr.Value Equal method, so custom types with semantic
+// equality are honored. Otherwise, Terraform raises an error late and without
+// the element path when the provider returns the set.
+//
+// Comparison is skipped when the set or any element contains unknown values,
+// since duplicates cannot be determined until the values are known.
+func SetValidateDuplicates(ctx context.Context, setPath path.Path, setValue basetypes.SetValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if setValue.IsNull() || setValue.IsUnknown() {
+		return diags
+	}
+
+	elements := setValue.Elements()
+
+	for _, element := range elements {
+		tfValue, err := element.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddAttributeError(
+				setPath,
+				"Set Validation Error",
+				fwdiag.ReportableErrorDetail(ctx, "An unexpected error was encountered trying to validate set elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error()),
+			)
+
+			return diags
+		}
+
+		if !tfValue.IsFullyKnown() {
+			return diags
+		}
+	}
+
+	for indexOuter, elementOuter := range elements {
+		for _, elementInner := range elements[:indexOuter] {
+			if !elementInner.Equal(elementOuter) {
+				continue
+			}
+
+			diags.AddAttributeError(
+				setPath.AtSetValue(elementOuter),
+				diag.SummaryDuplicateSetElement,
+				"This attribute contains an element which is equal to another element. Set elements must be unique. "+
+					"Remove or change the duplicate element.\n\n"+
+					fmt.Sprintf("Duplicate Element: %s", elementOuter),
+			)
+
+			break
+		}
+	}
+
+	return diags
+}
+
+// sortedSetElements returns a copy of the set elements ordered by their string
+// representation, which ensures set element validation diagnostics are
+// returned in a deterministic order.
+func sortedSetElements(elements []attr.Value) []attr.Value {
+	result := make([]attr.Value, len(elements))
+
+	copy(result, elements)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+
+	return result
+}
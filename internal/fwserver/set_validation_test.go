@@ -0,0 +1,382 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetValidateDuplicates(t *testing.T) {
+	t.Parallel()
+
+	testCaseInsensitiveString := func(value string) testtypes.CaseInsensitiveString {
+		return testtypes.CaseInsensitiveString{StringValue: types.StringValue(value)}
+	}
+
+	testDuplicateDiagnostic := func(element attr.Value) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("test").AtSetValue(element),
+			diag.SummaryDuplicateSetElement,
+			"This attribute contains an element which is equal to another element. Set elements must be unique. "+
+				"Remove or change the duplicate element.\n\n"+
+				"Duplicate Element: "+element.String(),
+		)
+	}
+
+	testObjectType := map[string]attr.Type{
+		"name": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		setValue types.Set
+		expected diag.Diagnostics
+	}{
+		"null": {
+			setValue: types.SetNull(types.StringType),
+		},
+		"unknown": {
+			setValue: types.SetUnknown(types.StringType),
+		},
+		"unique": {
+			setValue: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+		},
+		"duplicate": {
+			setValue: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+				types.StringValue("one"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(types.StringValue("one")),
+			},
+		},
+		"duplicate-multiple": {
+			setValue: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(types.StringValue("one")),
+				testDuplicateDiagnostic(types.StringValue("two")),
+			},
+		},
+		// Identical diagnostics are deduplicated.
+		"duplicate-multiple-same-element": {
+			setValue: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("one"),
+				types.StringValue("one"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(types.StringValue("one")),
+			},
+		},
+		"duplicate-semantic-equality": {
+			setValue: types.SetValueMust(testtypes.CaseInsensitiveStringType{}, []attr.Value{
+				testCaseInsensitiveString("one"),
+				testCaseInsensitiveString("ONE"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(testCaseInsensitiveString("ONE")),
+			},
+		},
+		"duplicate-object": {
+			setValue: types.SetValueMust(types.ObjectType{AttrTypes: testObjectType}, []attr.Value{
+				types.ObjectValueMust(testObjectType, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				}),
+				types.ObjectValueMust(testObjectType, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				}),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(types.ObjectValueMust(testObjectType, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				})),
+			},
+		},
+		"duplicate-element-unknown": {
+			setValue: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("one"),
+				types.StringUnknown(),
+			}),
+		},
+		"duplicate-element-object-attribute-unknown": {
+			setValue: types.SetValueMust(types.ObjectType{AttrTypes: testObjectType}, []attr.Value{
+				types.ObjectValueMust(testObjectType, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				}),
+				types.ObjectValueMust(testObjectType, map[string]attr.Value{
+					"name": types.StringValue("one"),
+				}),
+				types.ObjectValueMust(testObjectType, map[string]attr.Value{
+					"name": types.StringUnknown(),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.SetValidateDuplicates(context.Background(), path.Root("test"), testCase.setValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerValidateResourceConfig_SetDuplicates(t *testing.T) {
+	t.Parallel()
+
+	testCaseInsensitiveString := func(value string) testtypes.CaseInsensitiveString {
+		return testtypes.CaseInsensitiveString{StringValue: types.StringValue(value)}
+	}
+
+	testNameType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testNameValue := func(name interface{}) tftypes.Value {
+		return tftypes.NewValue(testNameType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	testNameAttrValue := func(name string) attr.Value {
+		return types.ObjectValueMust(
+			map[string]attr.Type{
+				"name": testtypes.CaseInsensitiveStringType{},
+			},
+			map[string]attr.Value{
+				"name": testCaseInsensitiveString(name),
+			},
+		)
+	}
+
+	// Each schema is a list containing a set, where set elements are
+	// semantically equal regardless of letter case.
+	testSchemas := map[string]schema.Schema{
+		"SetAttribute": {
+			Attributes: map[string]schema.Attribute{
+				"test": schema.ListNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"set": schema.SetAttribute{
+								ElementType: testtypes.CaseInsensitiveStringType{},
+								Optional:    true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+		},
+		"SetNestedAttribute": {
+			Attributes: map[string]schema.Attribute{
+				"test": schema.ListNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"set": schema.SetNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											CustomType: testtypes.CaseInsensitiveStringType{},
+											Optional:   true,
+										},
+									},
+								},
+								Optional: true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+		},
+		"SetNestedBlock": {
+			Blocks: map[string]schema.Block{
+				"test": schema.ListNestedBlock{
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"set": schema.SetNestedBlock{
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											CustomType: testtypes.CaseInsensitiveStringType{},
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfig := func(schemaName string, setType tftypes.Type, setElements []tftypes.Value) tfsdk.Config {
+		listElementType := tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"set": tftypes.Set{ElementType: setType},
+			},
+		}
+
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{ElementType: listElementType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.List{ElementType: listElementType},
+						[]tftypes.Value{
+							tftypes.NewValue(listElementType, map[string]tftypes.Value{
+								"set": tftypes.NewValue(tftypes.Set{ElementType: setType}, setElements),
+							}),
+						},
+					),
+				},
+			),
+			Schema: testSchemas[schemaName],
+		}
+	}
+
+	testDuplicateDiagnostic := func(element attr.Value) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("test").AtListIndex(0).AtName("set").AtSetValue(element),
+			diag.SummaryDuplicateSetElement,
+			"This attribute contains an element which is equal to another element. Set elements must be unique. "+
+				"Remove or change the duplicate element.\n\n"+
+				"Duplicate Element: "+element.String(),
+		)
+	}
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected diag.Diagnostics
+	}{
+		"SetAttribute-unique": {
+			config: testConfig("SetAttribute", tftypes.String, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+		},
+		"SetAttribute-duplicate": {
+			config: testConfig("SetAttribute", tftypes.String, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "ONE"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(testCaseInsensitiveString("ONE")),
+			},
+		},
+		"SetAttribute-duplicate-unknown": {
+			config: testConfig("SetAttribute", tftypes.String, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "ONE"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"SetNestedAttribute-unique": {
+			config: testConfig("SetNestedAttribute", testNameType, []tftypes.Value{
+				testNameValue("one"),
+				testNameValue("two"),
+			}),
+		},
+		"SetNestedAttribute-duplicate": {
+			config: testConfig("SetNestedAttribute", testNameType, []tftypes.Value{
+				testNameValue("one"),
+				testNameValue("ONE"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(testNameAttrValue("ONE")),
+			},
+		},
+		"SetNestedAttribute-duplicate-unknown": {
+			config: testConfig("SetNestedAttribute", testNameType, []tftypes.Value{
+				testNameValue("one"),
+				testNameValue("ONE"),
+				testNameValue(tftypes.UnknownValue),
+			}),
+		},
+		"SetNestedBlock-unique": {
+			config: testConfig("SetNestedBlock", testNameType, []tftypes.Value{
+				testNameValue("one"),
+				testNameValue("two"),
+			}),
+		},
+		"SetNestedBlock-duplicate": {
+			config: testConfig("SetNestedBlock", testNameType, []tftypes.Value{
+				testNameValue("one"),
+				testNameValue("ONE"),
+			}),
+			expected: diag.Diagnostics{
+				testDuplicateDiagnostic(testNameAttrValue("ONE")),
+			},
+		},
+		"SetNestedBlock-duplicate-unknown": {
+			config: testConfig("SetNestedBlock", testNameType, []tftypes.Value{
+				testNameValue("one"),
+				testNameValue("ONE"),
+				testNameValue(tftypes.UnknownValue),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			resp := &fwserver.ValidateResourceConfigResponse{}
+
+			server.ValidateResourceConfig(context.Background(), &fwserver.ValidateResourceConfigRequest{
+				Config: &testCase.config,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testCase.config.Schema.(schema.Schema)
+					},
+				},
+			}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
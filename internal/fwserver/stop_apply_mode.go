@@ -0,0 +1,22 @@
+package fwserver
+
+// StopApplyMode determines how the StopProvider RPC affects in-flight
+// ApplyResourceChange RPCs. Other in-flight RPCs are always canceled
+// immediately.
+type StopApplyMode int
+
+const (
+	// StopApplyModeImmediate cancels in-flight ApplyResourceChange RPCs
+	// immediately. This is the default.
+	StopApplyModeImmediate StopApplyMode = 0
+
+	// StopApplyModeGracePeriod cancels in-flight ApplyResourceChange RPCs
+	// after the Server StopApplyGracePeriod.
+	StopApplyModeGracePeriod StopApplyMode = 1
+
+	// StopApplyModeFutureOnly never cancels in-flight ApplyResourceChange
+	// RPCs. Instead, ApplyResourceChange RPCs started after StopProvider
+	// receive an already canceled context. Other RPCs started after
+	// StopProvider are unaffected.
+	StopApplyModeFutureOnly StopApplyMode = 2
+)
@@ -0,0 +1,201 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwinterface"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// recoverStrictModePanic converts a panic of the given RPC into an error
+// diagnostic with the stack trace when StrictMode is enabled. It must be
+// called directly with defer. Otherwise the panic is not recovered.
+func (s *Server) recoverStrictModePanic(ctx context.Context, rpc string, diags *diag.Diagnostics) {
+	if !s.StrictMode {
+		return
+	}
+
+	r := recover()
+
+	if r == nil {
+		return
+	}
+
+	logging.FrameworkError(ctx, "Recovered from panic in strict mode", map[string]interface{}{logging.KeyError: fmt.Sprintf("%v", r)})
+
+	diags.Append(fwdiag.ProviderErrorDiag(
+		ctx,
+		"Unexpected Provider Panic",
+		fmt.Sprintf("The provider panicked while handling the %s request.", rpc),
+		fmt.Sprintf("Panic: %v\n\n", r)+
+			fmt.Sprintf("Stack Trace:\n%s", debug.Stack()),
+	))
+}
+
+// strictModeSchemaDiagnostics returns warning diagnostics for schema issues
+// which are not invalid, but are typically unintended, when StrictMode is
+// enabled. The schemaDescription is used in diagnostic details, such as
+// "test_resource resource".
+func (s *Server) strictModeSchemaDiagnostics(schemaDescription string, schema fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !s.StrictMode || schema == nil {
+		return diags
+	}
+
+	if schema.GetDescription() == "" && schema.GetMarkdownDescription() == "" {
+		diags.AddWarning(
+			"Missing Schema Description",
+			fmt.Sprintf("The %s schema is missing a Description or MarkdownDescription, which is used for documentation. ", schemaDescription)+
+				"This warning is only returned when the provider server is in strict mode.",
+		)
+	}
+
+	attributes := schema.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		diags.Append(strictModeAttributeDiagnostics(schemaDescription, path.Root(name), attributes[name])...)
+	}
+
+	blocks := schema.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		diags.Append(strictModeBlockDiagnostics(schemaDescription, path.Root(name), blocks[name])...)
+	}
+
+	return diags
+}
+
+// strictModeInterfaceDiagnostics returns warning diagnostics for each method
+// which has the name of an optional interface method, but does not implement
+// the interface, so the framework never calls it.
+func (s *Server) strictModeInterfaceDiagnostics(typeDescription string, report fwinterface.Report) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !s.StrictMode {
+		return diags
+	}
+
+	for _, mismatch := range report.Mismatched {
+		diags.AddWarning(
+			"Optional Interface Not Implemented",
+			fmt.Sprintf("The %s has a %s method which does not implement the %s interface, so the framework never calls it. ", typeDescription, mismatch.Method, mismatch.Interface)+
+				"This warning is only returned when the provider server is in strict mode.\n\n"+
+				fmt.Sprintf("Reason: %s", mismatch.Reason),
+		)
+	}
+
+	return diags
+}
+
+// strictModeAttributeDiagnostics returns strict mode schema diagnostics for
+// the attribute and any nested attributes.
+func strictModeAttributeDiagnostics(schemaDescription string, attributePath path.Path, attribute fwschema.Attribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if attribute.GetDescription() == "" && attribute.GetMarkdownDescription() == "" {
+		diags.Append(strictModeMissingDescriptionDiag(schemaDescription, attributePath))
+	}
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return diags
+	}
+
+	nestedAttrs := nestedAttribute.GetNestedObject().GetAttributes()
+
+	for _, name := range fwschema.SortedNames(nestedAttrs) {
+		diags.Append(strictModeAttributeDiagnostics(schemaDescription, attributePath.AtName(name), nestedAttrs[name])...)
+	}
+
+	return diags
+}
+
+// strictModeBlockDiagnostics returns strict mode schema diagnostics for the
+// block and any nested attributes and blocks.
+func strictModeBlockDiagnostics(schemaDescription string, blockPath path.Path, block fwschema.Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if block.GetDescription() == "" && block.GetMarkdownDescription() == "" {
+		diags.Append(strictModeMissingDescriptionDiag(schemaDescription, blockPath))
+	}
+
+	nestedObject := block.GetNestedObject()
+
+	nestedAttrs := nestedObject.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(nestedAttrs) {
+		diags.Append(strictModeAttributeDiagnostics(schemaDescription, blockPath.AtName(name), nestedAttrs[name])...)
+	}
+
+	nestedBlocks := nestedObject.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(nestedBlocks) {
+		diags.Append(strictModeBlockDiagnostics(schemaDescription, blockPath.AtName(name), nestedBlocks[name])...)
+	}
+
+	return diags
+}
+
+// strictModeMissingDescriptionDiag returns a warning diagnostic for an
+// attribute or block which is missing a description.
+func strictModeMissingDescriptionDiag(schemaDescription string, schemaPath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewWarningDiagnostic(
+		"Missing Schema Description",
+		fmt.Sprintf("%q in the %s schema is missing a Description or MarkdownDescription, which is used for documentation. ", schemaPath, schemaDescription)+
+			"This warning is only returned when the provider server is in strict mode.",
+	)
+}
+
+// strictModeStateDiagnostics returns error diagnostics for each unknown
+// value in the given state, after the given operation, when StrictMode is
+// enabled. Terraform also rejects unknown values after these operations, but
+// without the attribute path.
+func (s *Server) strictModeStateDiagnostics(ctx context.Context, operation string, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !s.StrictMode || state == nil || state.Raw.IsFullyKnown() {
+		return diags
+	}
+
+	_ = tftypes.Walk(state.Raw, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (bool, error) {
+		if tfValue.IsKnown() {
+			return true, nil
+		}
+
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, tfPath, state.Schema)
+
+		diags.Append(pathDiags...)
+
+		if pathDiags.HasError() {
+			return false, nil
+		}
+
+		diags.Append(fwdiag.ProviderAttributeErrorDiag(
+			ctx,
+			attributePath,
+			"Unexpected Unknown Value",
+			fmt.Sprintf("After %s, the provider returned an unknown value in the state. ", operation)+
+				"All values must be known after this operation.",
+			"",
+		))
+
+		return false, nil
+	})
+
+	return diags
+}
@@ -0,0 +1,221 @@
+package fwserver_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerStrictMode_Panic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictMode  bool
+		expectPanic bool
+	}{
+		"disabled": {
+			strictMode:  false,
+			expectPanic: true,
+		},
+		"enabled": {
+			strictMode:  true,
+			expectPanic: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+						panic("test panic")
+					},
+				},
+				StrictMode: testCase.strictMode,
+			}
+			resp := &provider.ConfigureResponse{}
+
+			panicked := func() (panicked bool) {
+				defer func() {
+					panicked = recover() != nil
+				}()
+
+				server.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, resp)
+
+				return false
+			}()
+
+			if panicked != testCase.expectPanic {
+				t.Fatalf("expected panic to be %t, got: %t", testCase.expectPanic, panicked)
+			}
+
+			if testCase.expectPanic {
+				return
+			}
+
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected 1 diagnostic, got: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics[0].Summary(); got != "Unexpected Provider Panic" {
+				t.Errorf("unexpected diagnostic summary: %s", got)
+			}
+
+			for _, expected := range []string{"ConfigureProvider", "Panic: test panic", "Stack Trace:"} {
+				if !strings.Contains(resp.Diagnostics[0].Detail(), expected) {
+					t.Errorf("expected diagnostic detail to contain %q, got: %s", expected, resp.Diagnostics[0].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestServerStrictMode_GetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictMode          bool
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"disabled": {
+			strictMode: false,
+		},
+		"enabled": {
+			strictMode: true,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Missing Schema Description",
+					"\"test_undescribed\" in the provider schema is missing a Description or MarkdownDescription, which is used for documentation. "+
+						"This warning is only returned when the provider server is in strict mode.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+						resp.Schema = providerschema.Schema{
+							Description: "test provider",
+							Attributes: map[string]providerschema.Attribute{
+								"test_described": providerschema.StringAttribute{
+									Description: "test attribute",
+									Optional:    true,
+								},
+								"test_undescribed": providerschema.StringAttribute{
+									Optional: true,
+								},
+							},
+						}
+					},
+				},
+				StrictMode: testCase.strictMode,
+			}
+			resp := &fwserver.GetProviderSchemaResponse{}
+
+			server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerStrictMode_ReadDataSource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+		},
+	}
+
+	testSchema := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"test_computed": datasourceschema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		strictMode          bool
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"disabled": {
+			strictMode: false,
+		},
+		"enabled": {
+			strictMode: true,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_computed"),
+					"Unexpected Unknown Value",
+					"After Read, the provider returned an unknown value in the state. "+
+						"All values must be known after this operation. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider:   &testprovider.Provider{},
+				StrictMode: testCase.strictMode,
+			}
+			req := &fwserver.ReadDataSourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(_ context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.State.Raw = tftypes.NewValue(testType, map[string]tftypes.Value{
+							"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						})
+					},
+				},
+			}
+			resp := &fwserver.ReadDataSourceResponse{}
+
+			server.ReadDataSource(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// undefinedStateAttributesDiags returns diagnostics for attributes in the raw
+// state which are not defined in the given schema type, based on the
+// resource.ResourceWithUndefinedStateAttributes implementation. No
+// diagnostics are returned if the resource does not implement the interface.
+//
+// The raw state must already be known to unmarshal when ignoring undefined
+// attributes, so any strict unmarshal error is caused by undefined
+// attributes.
+//
+// Undefined attributes are not preserved in private state, since the
+// UpgradeResourceState RPC response has no private state and the
+// ReadResource RPC only receives the upgraded state without them.
+func undefinedStateAttributesDiags(ctx context.Context, r resource.Resource, rawState *tfprotov6.RawState, schemaType tftypes.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceWithUndefinedStateAttributes, ok := r.(resource.ResourceWithUndefinedStateAttributes)
+
+	if !ok {
+		return diags
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUndefinedStateAttributes")
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource UndefinedStateAttributes")
+	methodCtx, afterProviderMethod := beforeProviderMethod(ctx, "Resource UndefinedStateAttributes")
+	behavior := resourceWithUndefinedStateAttributes.UndefinedStateAttributes(methodCtx)
+	afterProviderMethod()
+	logging.FrameworkDebug(ctx, "Called provider defined Resource UndefinedStateAttributes")
+
+	if behavior == resource.UndefinedStateAttributesBehaviorIgnore {
+		return diags
+	}
+
+	_, err := rawState.Unmarshal(schemaType)
+
+	if err == nil {
+		return diags
+	}
+
+	switch behavior {
+	case resource.UndefinedStateAttributesBehaviorWarn:
+		diags.AddWarning(
+			"Undefined Resource State Attributes",
+			"The saved resource state contains attributes which are not defined in the resource schema. "+
+				"These attributes were removed from the resource state. "+
+				"This can occur after manually modifying the resource state or downgrading the provider.\n\n"+err.Error(),
+		)
+	case resource.UndefinedStateAttributesBehaviorError:
+		diags.AddError(
+			"Undefined Resource State Attributes",
+			"The saved resource state contains attributes which are not defined in the resource schema. "+
+				"This can occur after manually modifying the resource state or downgrading the provider. "+
+				"Remove the attributes from the resource state or upgrade the provider to continue.\n\n"+err.Error(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,9 @@
+// Package fwtransform implements a structurally sharing alternative to
+// tftypes.Transform for framework value transformations, such as planning.
+//
+// The tftypes.Transform function rebuilds every collection and object value,
+// which deep copies the entire value even when only a single nested
+// attribute is modified. This can cause memory spikes for resources with
+// very large nested state. Transform instead returns unchanged subtrees
+// as-is, so they are shared between the given and returned values.
+package fwtransform
@@ -0,0 +1,169 @@
+package fwtransform
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TransformFunc is called with the path and value of each value, after any
+// elements or attributes of the value are transformed. It returns the value
+// to use instead and whether that value differs from the given value.
+// Returning false with a different value is invalid, as the returned value
+// may be discarded.
+type TransformFunc func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, bool, error)
+
+// Transform calls the TransformFunc with each value in val, including val
+// itself, similar to tftypes.Transform. Collection and object values are only
+// rebuilt when an element or attribute changed, otherwise the original value
+// is shared. The returned bool reports whether any value changed.
+func Transform(val tftypes.Value, fn TransformFunc) (tftypes.Value, bool, error) {
+	return transform(tftypes.NewAttributePath(), val, fn)
+}
+
+// transform is the recursive implementation of Transform.
+func transform(path *tftypes.AttributePath, val tftypes.Value, fn TransformFunc) (tftypes.Value, bool, error) {
+	valType := val.Type()
+
+	if valType == nil {
+		return val, false, path.NewError(errors.New("invalid transform: value missing type"))
+	}
+
+	newVal := val
+	changed := false
+
+	if val.IsKnown() && !val.IsNull() {
+		var err error
+
+		switch {
+		case valType.Is(tftypes.List{}), valType.Is(tftypes.Set{}), valType.Is(tftypes.Tuple{}):
+			newVal, changed, err = transformElements(path, val, fn)
+		case valType.Is(tftypes.Map{}), valType.Is(tftypes.Object{}):
+			newVal, changed, err = transformAttributes(path, val, fn)
+		}
+
+		if err != nil {
+			return val, false, err
+		}
+	}
+
+	result, resultChanged, err := fn(path, newVal)
+
+	if err != nil {
+		return val, false, path.NewError(err)
+	}
+
+	if !resultChanged {
+		return newVal, changed, nil
+	}
+
+	if result.Type() == nil {
+		return val, false, path.NewError(errors.New("invalid transform: new value missing type"))
+	}
+
+	if !result.Type().UsableAs(valType) {
+		return val, false, path.NewError(errors.New("invalid transform: value changed type"))
+	}
+
+	return result, true, nil
+}
+
+// transformElements transforms the elements of a known, non-null list, set,
+// or tuple value. The value is only rebuilt if an element changed.
+func transformElements(path *tftypes.AttributePath, val tftypes.Value, fn TransformFunc) (tftypes.Value, bool, error) {
+	var elements []tftypes.Value
+
+	// The slice is shared with val and must not be modified.
+	if err := val.As(&elements); err != nil {
+		return val, false, path.NewError(err)
+	}
+
+	var newElements []tftypes.Value
+
+	for index, element := range elements {
+		elementPath := path.WithElementKeyInt(index)
+
+		if val.Type().Is(tftypes.Set{}) {
+			elementPath = path.WithElementKeyValue(element)
+		}
+
+		newElement, elementChanged, err := transform(elementPath, element, fn)
+
+		if err != nil {
+			return val, false, err
+		}
+
+		if !elementChanged {
+			continue
+		}
+
+		if newElements == nil {
+			newElements = make([]tftypes.Value, len(elements))
+			copy(newElements, elements)
+		}
+
+		newElements[index] = newElement
+	}
+
+	if newElements == nil {
+		return val, false, nil
+	}
+
+	if err := tftypes.ValidateValue(val.Type(), newElements); err != nil {
+		return val, false, path.NewError(err)
+	}
+
+	return tftypes.NewValue(val.Type(), newElements), true, nil
+}
+
+// transformAttributes transforms the elements of a known, non-null map or
+// the attributes of a known, non-null object value. The value is only
+// rebuilt if an element or attribute changed.
+func transformAttributes(path *tftypes.AttributePath, val tftypes.Value, fn TransformFunc) (tftypes.Value, bool, error) {
+	var attributes map[string]tftypes.Value
+
+	// The map is shared with val and must not be modified.
+	if err := val.As(&attributes); err != nil {
+		return val, false, path.NewError(err)
+	}
+
+	var newAttributes map[string]tftypes.Value
+
+	for name, attribute := range attributes {
+		attributePath := path.WithAttributeName(name)
+
+		if val.Type().Is(tftypes.Map{}) {
+			attributePath = path.WithElementKeyString(name)
+		}
+
+		newAttribute, attributeChanged, err := transform(attributePath, attribute, fn)
+
+		if err != nil {
+			return val, false, err
+		}
+
+		if !attributeChanged {
+			continue
+		}
+
+		if newAttributes == nil {
+			newAttributes = make(map[string]tftypes.Value, len(attributes))
+
+			for k, v := range attributes {
+				newAttributes[k] = v
+			}
+		}
+
+		newAttributes[name] = newAttribute
+	}
+
+	if newAttributes == nil {
+		return val, false, nil
+	}
+
+	if err := tftypes.ValidateValue(val.Type(), newAttributes); err != nil {
+		return val, false, path.NewError(err)
+	}
+
+	return tftypes.NewValue(val.Type(), newAttributes), true, nil
+}
@@ -0,0 +1,266 @@
+package fwtransform_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtransform"
+)
+
+var benchValue tftypes.Value
+
+func benchmarkTransform(b *testing.B, elementCount int) {
+	elementType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+		},
+	}
+	elements := make([]tftypes.Value, elementCount)
+
+	for idx := range elements {
+		elements[idx] = tftypes.NewValue(elementType, map[string]tftypes.Value{
+			"computed": tftypes.NewValue(tftypes.String, strconv.Itoa(idx)),
+		})
+	}
+
+	// Only the first element is changed.
+	elements[0] = tftypes.NewValue(elementType, map[string]tftypes.Value{
+		"computed": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	in := tftypes.NewValue(tftypes.List{ElementType: elementType}, elements)
+	fn := func(_ *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, bool, error) {
+		if !val.IsNull() {
+			return val, false, nil
+		}
+
+		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), true, nil
+	}
+
+	var got tftypes.Value // Prevent compiler optimization
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		got, _, _ = fwtransform.Transform(in, fn)
+	}
+
+	benchValue = got
+}
+
+func BenchmarkTransform100(b *testing.B) {
+	benchmarkTransform(b, 100)
+}
+
+func BenchmarkTransform10000(b *testing.B) {
+	benchmarkTransform(b, 10000)
+}
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"list": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.String,
+					},
+				},
+			},
+			"map": tftypes.Map{ElementType: tftypes.String},
+			"set": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	testValue := func(computed tftypes.Value, nested tftypes.Value, mapElement tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"computed": computed,
+			"list": tftypes.NewValue(objectType.AttributeTypes["list"], []tftypes.Value{
+				tftypes.NewValue(objectType.AttributeTypes["list"].(tftypes.List).ElementType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, "unchanged"),
+				}),
+				tftypes.NewValue(objectType.AttributeTypes["list"].(tftypes.List).ElementType, map[string]tftypes.Value{
+					"nested": nested,
+				}),
+			}),
+			"map": tftypes.NewValue(objectType.AttributeTypes["map"], map[string]tftypes.Value{
+				"key": mapElement,
+			}),
+			"set": tftypes.NewValue(objectType.AttributeTypes["set"], []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		})
+	}
+
+	nullToUnknown := func(_ *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, bool, error) {
+		if !val.IsNull() {
+			return val, false, nil
+		}
+
+		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), true, nil
+	}
+
+	testCases := map[string]struct {
+		value           tftypes.Value
+		fn              fwtransform.TransformFunc
+		expected        tftypes.Value
+		expectedChanged bool
+		expectedError   error
+	}{
+		"unchanged": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			fn: nullToUnknown,
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+		},
+		"changed-attribute": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			fn: nullToUnknown,
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			expectedChanged: true,
+		},
+		"changed-nested-list-element": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			fn: nullToUnknown,
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			expectedChanged: true,
+		},
+		"changed-map-element": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, nil),
+			),
+			fn: nullToUnknown,
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			),
+			expectedChanged: true,
+		},
+		"paths": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			fn: func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, bool, error) {
+				expected := tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("nested")
+
+				if !path.Equal(expected) {
+					return val, false, nil
+				}
+
+				return tftypes.NewValue(tftypes.String, "path"), true, nil
+			},
+			expected: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "path"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			expectedChanged: true,
+		},
+		"error": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			fn: func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, bool, error) {
+				if !path.Equal(tftypes.NewAttributePath().WithAttributeName("computed")) {
+					return val, false, nil
+				}
+
+				return val, false, errors.New("test error")
+			},
+			expectedError: tftypes.NewAttributePath().WithAttributeName("computed").NewError(errors.New("test error")),
+		},
+		"error-changed-type": {
+			value: testValue(
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.String, "value"),
+			),
+			fn: func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, bool, error) {
+				if !path.Equal(tftypes.NewAttributePath().WithAttributeName("computed")) {
+					return val, false, nil
+				}
+
+				return tftypes.NewValue(tftypes.Bool, true), true, nil
+			},
+			expectedError: tftypes.NewAttributePath().WithAttributeName("computed").NewError(errors.New("invalid transform: value changed type")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := testCase.value.Copy()
+
+			got, gotChanged, err := fwtransform.Transform(testCase.value, testCase.fn)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if gotChanged != testCase.expectedChanged {
+				t.Errorf("expected changed %t, got: %t", testCase.expectedChanged, gotChanged)
+			}
+
+			// The given value must never be modified, as unchanged subtrees
+			// are shared with the returned value.
+			if diff := cmp.Diff(testCase.value, original); diff != "" {
+				t.Errorf("unexpected given value modification: %s", diff)
+			}
+		})
+	}
+}
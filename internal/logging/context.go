@@ -3,6 +3,8 @@ package logging
 import (
 	"context"
 
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
@@ -20,3 +22,54 @@ func InitContext(ctx context.Context) context.Context {
 
 	return ctx
 }
+
+// DataSourceContext injects the data source type into logger contexts. It
+// must be called before InitContext so the framework subsystem logger also
+// receives the field.
+func DataSourceContext(ctx context.Context, dataSource string) context.Context {
+	ctx = tfsdklog.SetField(ctx, KeyDataSourceType, dataSource)
+	ctx = tflog.SetField(ctx, KeyDataSourceType, dataSource)
+
+	return ctx
+}
+
+// RequestContext injects a unique framework request ID and the RPC name into
+// logger contexts, so all framework and provider logs of the request can be
+// correlated. It must be called before InitContext so the framework
+// subsystem logger also receives the fields.
+func RequestContext(ctx context.Context, rpc string) context.Context {
+	reqID, err := uuid.GenerateUUID()
+
+	if err != nil {
+		reqID = "unable to assign request ID: " + err.Error()
+	}
+
+	ctx = context.WithValue(ctx, requestIDContextKey{}, reqID)
+	ctx = tfsdklog.SetField(ctx, KeyFrameworkRequestID, reqID)
+	ctx = tflog.SetField(ctx, KeyFrameworkRequestID, reqID)
+	ctx = tfsdklog.SetField(ctx, KeyRPC, rpc)
+	ctx = tflog.SetField(ctx, KeyRPC, rpc)
+
+	return ctx
+}
+
+// RequestID returns the framework request ID injected by RequestContext, or
+// an empty string if not set.
+func RequestID(ctx context.Context) string {
+	reqID, _ := ctx.Value(requestIDContextKey{}).(string)
+
+	return reqID
+}
+
+// requestIDContextKey is the context key for the framework request ID.
+type requestIDContextKey struct{}
+
+// ResourceContext injects the resource type into logger contexts. It must be
+// called before InitContext so the framework subsystem logger also receives
+// the field.
+func ResourceContext(ctx context.Context, resource string) context.Context {
+	ctx = tfsdklog.SetField(ctx, KeyResourceType, resource)
+	ctx = tflog.SetField(ctx, KeyResourceType, resource)
+
+	return ctx
+}
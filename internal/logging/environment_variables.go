@@ -2,8 +2,33 @@ package logging
 
 // Environment variables.
 const (
+	// EnvTfLog is an environment variable that sets the logging level of
+	// Terraform and, if EnvTfLogProvider is unset, which provider logs are
+	// output.
+	EnvTfLog = "TF_LOG"
+
+	// EnvTfLogProvider is an environment variable that sets which provider
+	// logs are output.
+	EnvTfLogProvider = "TF_LOG_PROVIDER"
+
+	// EnvTfLogSdk is an environment variable that sets the logging level of
+	// root SDK loggers, which is inherited by SDK subsystem loggers.
+	EnvTfLogSdk = "TF_LOG_SDK"
+
 	// EnvTfLogSdkFramework is an environment variable that sets the logging
 	// level of SDK framework loggers. Infers root SDK logging level, if
 	// unset.
 	EnvTfLogSdkFramework = "TF_LOG_SDK_FRAMEWORK"
+
+	// EnvTfLogSdkFrameworkReflect is an environment variable that enables
+	// TRACE logging of each reflection step when converting between data
+	// models and Terraform values, such as during Get and Set. Any non-empty
+	// value enables the logging, which is verbose.
+	EnvTfLogSdkFrameworkReflect = "TF_LOG_SDK_FRAMEWORK_REFLECT"
+
+	// EnvTfLogSdkProtoDataDir is an environment variable that sets the
+	// directory to write protocol data files, which is shared with
+	// terraform-plugin-go. Framework files include the request ID and are
+	// written with sensitive values redacted.
+	EnvTfLogSdkProtoDataDir = "TF_LOG_SDK_PROTO_DATA_DIR"
 )
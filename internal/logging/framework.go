@@ -2,6 +2,8 @@ package logging
 
 import (
 	"context"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
@@ -16,11 +18,50 @@ func FrameworkDebug(ctx context.Context, msg string, additionalFields ...map[str
 	tfsdklog.SubsystemDebug(ctx, SubsystemFramework, msg, additionalFields...)
 }
 
+// FrameworkDebugEnabled returns true if framework DEBUG level logs are
+// likely to be output, based on the TF_LOG_SDK_FRAMEWORK, TF_LOG_SDK,
+// TF_LOG_PROVIDER, and TF_LOG environment variables. Callers should check
+// this before computing expensive log fields. The logger level itself is not
+// available, so this may return true when logs are discarded.
+func FrameworkDebugEnabled() bool {
+	sdkLevel := os.Getenv(EnvTfLogSdkFramework)
+
+	if sdkLevel == "" {
+		sdkLevel = os.Getenv(EnvTfLogSdk)
+	}
+
+	// Unset SDK levels default to TRACE.
+	if sdkLevel != "" && !debugLevelEnabled(sdkLevel) {
+		return false
+	}
+
+	outputLevel := os.Getenv(EnvTfLogProvider)
+
+	if outputLevel == "" {
+		outputLevel = os.Getenv(EnvTfLog)
+	}
+
+	return debugLevelEnabled(outputLevel)
+}
+
 // FrameworkError emits a framework subsystem log at ERROR level.
 func FrameworkError(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
 	tfsdklog.SubsystemError(ctx, SubsystemFramework, msg, additionalFields...)
 }
 
+// FrameworkInfo emits a framework subsystem log at INFO level.
+func FrameworkInfo(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
+	tfsdklog.SubsystemInfo(ctx, SubsystemFramework, msg, additionalFields...)
+}
+
+// FrameworkReflectTraceEnabled returns true if the
+// TF_LOG_SDK_FRAMEWORK_REFLECT environment variable is set. Callers should
+// check this before emitting verbose reflection logging, which is not
+// emitted by default.
+func FrameworkReflectTraceEnabled() bool {
+	return os.Getenv(EnvTfLogSdkFrameworkReflect) != ""
+}
+
 // FrameworkTrace emits a framework subsystem log at TRACE level.
 func FrameworkTrace(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
 	tfsdklog.SubsystemTrace(ctx, SubsystemFramework, msg, additionalFields...)
@@ -38,3 +79,14 @@ func FrameworkWithAttributePath(ctx context.Context, attributePath string) conte
 	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemFramework, KeyAttributePath, attributePath)
 	return ctx
 }
+
+// debugLevelEnabled returns true if the log level environment variable value
+// includes DEBUG level logs. JSON is equivalent to TRACE.
+func debugLevelEnabled(level string) bool {
+	switch strings.ToUpper(level) {
+	case "DEBUG", "JSON", "TRACE":
+		return true
+	default:
+		return false
+	}
+}
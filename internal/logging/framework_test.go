@@ -40,6 +40,87 @@ func TestFrameworkDebug(t *testing.T) {
 	}
 }
 
+func TestFrameworkDebugEnabled(t *testing.T) {
+	// Environment variables cannot be set in parallel tests.
+	testCases := map[string]struct {
+		env      map[string]string
+		expected bool
+	}{
+		"unset": {
+			expected: false,
+		},
+		"tf-log-debug": {
+			env: map[string]string{
+				"TF_LOG": "DEBUG",
+			},
+			expected: true,
+		},
+		"tf-log-trace": {
+			env: map[string]string{
+				"TF_LOG": "trace",
+			},
+			expected: true,
+		},
+		"tf-log-json": {
+			env: map[string]string{
+				"TF_LOG": "JSON",
+			},
+			expected: true,
+		},
+		"tf-log-info": {
+			env: map[string]string{
+				"TF_LOG": "INFO",
+			},
+			expected: false,
+		},
+		"tf-log-provider-overrides-tf-log": {
+			env: map[string]string{
+				"TF_LOG":          "DEBUG",
+				"TF_LOG_PROVIDER": "WARN",
+			},
+			expected: false,
+		},
+		"tf-log-sdk-info": {
+			env: map[string]string{
+				"TF_LOG":     "DEBUG",
+				"TF_LOG_SDK": "INFO",
+			},
+			expected: false,
+		},
+		"tf-log-sdk-framework-overrides-tf-log-sdk": {
+			env: map[string]string{
+				"TF_LOG":               "DEBUG",
+				"TF_LOG_SDK":           "INFO",
+				"TF_LOG_SDK_FRAMEWORK": "DEBUG",
+			},
+			expected: true,
+		},
+		"tf-log-sdk-framework-off": {
+			env: map[string]string{
+				"TF_LOG":               "TRACE",
+				"TF_LOG_SDK_FRAMEWORK": "OFF",
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_SDK", "TF_LOG_SDK_FRAMEWORK"} {
+				t.Setenv(key, testCase.env[key])
+			}
+
+			got := logging.FrameworkDebugEnabled()
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestFrameworkError(t *testing.T) {
 	t.Parallel()
 
@@ -69,6 +150,35 @@ func TestFrameworkError(t *testing.T) {
 	}
 }
 
+func TestFrameworkInfo(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	logging.FrameworkInfo(ctx, "test message")
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":   "info",
+			"@message": "test message",
+			"@module":  "sdk.framework",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestFrameworkTrace(t *testing.T) {
 	t.Parallel()
 
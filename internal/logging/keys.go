@@ -8,6 +8,9 @@ package logging
 // Refer to the terraform-plugin-go logging keys as well, which should be
 // equivalent to these when possible.
 const (
+	// Paths of changed attributes and how each changed, such as "added".
+	KeyAttributeChanges = "tf_attribute_changes"
+
 	// Attribute path representation, which is typically in flatmap form such
 	// as parent.0.child in this project.
 	KeyAttributePath = "tf_attribute_path"
@@ -15,13 +18,97 @@ const (
 	// The type of data source being operated on, such as "archive_file"
 	KeyDataSourceType = "tf_data_source_type"
 
+	// Number of error diagnostics in a response.
+	KeyDiagnosticErrorCount = "diagnostic_error_count"
+
+	// Machine-readable code of a provider defined diagnostic.
+	KeyDiagnosticCode = "diagnostic_code"
+
+	// Severity of a provider defined diagnostic, such as "Error".
+	KeyDiagnosticSeverity = "diagnostic_severity"
+
+	// Summary of a provider defined diagnostic.
+	KeyDiagnosticSummary = "diagnostic_summary"
+
+	// Number of warning diagnostics in a response.
+	KeyDiagnosticWarningCount = "diagnostic_warning_count"
+
 	// Human readable string when calling a provider defined type that must
 	// implement the Description() method, such as validators.
 	KeyDescription = "description"
 
+	// The type of provider process lifecycle event, such as "schema_served".
+	KeyEventType = "tf_event_type"
+
 	// Underlying Go error string when logging an error.
 	KeyError = "error"
 
+	// Go types of each error in an underlying error chain, from outermost to
+	// innermost, when logging an error.
+	KeyErrorChain = "error_chain"
+
+	// Unique identifier of a framework handled RPC, which correlates all
+	// framework and provider logs of a single request. This is separate from
+	// the terraform-plugin-go tf_req_id so it does not replace that value.
+	KeyFrameworkRequestID = "tf_framework_req_id"
+
+	// Description of a data source or resource method which has the name of
+	// an optional interface method, but does not implement the interface.
+	KeyOptionalInterfaceMismatch = "tf_optional_interface_mismatch"
+
+	// Names of the optional interfaces implemented by a data source or
+	// resource, such as "resource.ResourceWithImportState".
+	KeyOptionalInterfaces = "tf_optional_interfaces"
+
+	// Provider-defined impact classification of a resource plan, such as
+	// "destructive".
+	KeyPlanImpactClassification = "tf_plan_impact_classification"
+
+	// Provider-defined estimated duration of applying a resource plan, in
+	// milliseconds.
+	KeyPlanImpactEstimatedDurationMs = "tf_plan_impact_estimated_duration_ms"
+
+	// Provider-defined additional metadata about a resource plan impact.
+	KeyPlanImpactMetadata = "tf_plan_impact_metadata"
+
+	// Description of a provider-defined method call or RPC handling, such as
+	// "Resource Read", for instrumentation timing logs.
+	KeyProviderMethod = "tf_provider_method"
+
+	// Duration in milliseconds of a provider-defined method call or RPC
+	// handling, for instrumentation timing logs.
+	KeyProviderMethodDurationMs = "tf_provider_method_duration_ms"
+
+	// Path to a written protocol data file.
+	KeyProtocolDataFile = "tf_proto_data_file"
+
+	// Delay before canceling in-flight ApplyResourceChange RPCs after a
+	// StopProvider RPC.
+	KeyStopApplyGracePeriod = "tf_stop_apply_grace_period"
+
+	// Deadline of the provider defined Stop method during a StopProvider
+	// RPC.
+	KeyStopTimeout = "tf_stop_timeout"
+
+	// Duration in milliseconds of a framework handled RPC.
+	KeyRequestDurationMs = "tf_req_duration_ms"
+
+	// Go struct field name of a reflection step, such as "Name".
+	KeyReflectGoField = "tf_reflect_go_field"
+
+	// Source type of a reflection step, such as a Terraform type when
+	// converting into a data model or a Go type when converting from a data
+	// model.
+	KeyReflectSourceType = "tf_reflect_source_type"
+
+	// Target type of a reflection step, such as a Go type when converting
+	// into a data model or a framework type when converting from a data
+	// model.
+	KeyReflectTargetType = "tf_reflect_target_type"
+
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
+
+	// The RPC being handled, such as "ReadResource".
+	KeyRPC = "tf_rpc"
 )
@@ -0,0 +1,200 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// ProtocolDataMessageRequest is the protocol data message for data
+	// received from Terraform.
+	ProtocolDataMessageRequest = "Request"
+
+	// ProtocolDataMessageResponse is the protocol data message for data
+	// returned to Terraform.
+	ProtocolDataMessageResponse = "Response"
+
+	// protocolDataUnknownJSON is the JSON representation of an unknown value,
+	// which JSON cannot otherwise represent.
+	protocolDataUnknownJSON = "<unknown>"
+)
+
+// ProtocolDataSensitiveFunc returns true if the value at the given path must
+// be redacted from protocol data files. Implementations typically check the
+// schema Sensitive field of the attribute at the path.
+type ProtocolDataSensitiveFunc func(*tftypes.AttributePath) bool
+
+// ProtocolData writes the given value to MessagePack and JSON files in the
+// TF_LOG_SDK_PROTO_DATA_DIR directory, if set, and logs the file paths at
+// TRACE level. Values for which sensitive returns true are redacted by
+// replacing them with null values of the same type. Files are named with the
+// current time, the framework request ID, the RPC, the message (request or
+// response), and the field, such as "Config".
+func ProtocolData(ctx context.Context, rpc string, message string, field string, value tftypes.Value, sensitive ProtocolDataSensitiveFunc) {
+	dataDir := os.Getenv(EnvTfLogSdkProtoDataDir)
+
+	if dataDir == "" {
+		return
+	}
+
+	redactedValue, err := protocolDataRedact(value, sensitive)
+
+	if err != nil {
+		FrameworkError(ctx, "Unable to redact protocol data", map[string]interface{}{KeyError: err.Error()})
+
+		return
+	}
+
+	fileName := fmt.Sprintf("%d_%s_%s_%s_%s", time.Now().UnixNano(), RequestID(ctx), rpc, message, field)
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(redactedValue.Type(), redactedValue)
+
+	if err != nil {
+		FrameworkError(ctx, "Unable to marshal protocol data to MessagePack", map[string]interface{}{KeyError: err.Error()})
+
+		return
+	}
+
+	protocolDataWriteFile(ctx, filepath.Join(dataDir, fileName+".msgpack"), dynamicValue.MsgPack)
+
+	jsonValue, err := protocolDataJSONValue(redactedValue)
+
+	if err != nil {
+		FrameworkError(ctx, "Unable to convert protocol data to JSON", map[string]interface{}{KeyError: err.Error()})
+
+		return
+	}
+
+	jsonBytes, err := json.Marshal(jsonValue)
+
+	if err != nil {
+		FrameworkError(ctx, "Unable to marshal protocol data to JSON", map[string]interface{}{KeyError: err.Error()})
+
+		return
+	}
+
+	protocolDataWriteFile(ctx, filepath.Join(dataDir, fileName+".json"), jsonBytes)
+}
+
+// protocolDataWriteFile writes a protocol data file and logs the file path.
+func protocolDataWriteFile(ctx context.Context, fileName string, data []byte) {
+	err := os.WriteFile(fileName, data, 0600)
+
+	if err != nil {
+		FrameworkError(ctx, "Unable to write protocol data file", map[string]interface{}{KeyError: err.Error(), KeyProtocolDataFile: fileName})
+
+		return
+	}
+
+	FrameworkTrace(ctx, "Wrote protocol data file", map[string]interface{}{KeyProtocolDataFile: fileName})
+}
+
+// protocolDataRedact returns the value with sensitive values replaced by
+// null values of the same type.
+func protocolDataRedact(value tftypes.Value, sensitive ProtocolDataSensitiveFunc) (tftypes.Value, error) {
+	if sensitive == nil {
+		return value, nil
+	}
+
+	return tftypes.Transform(value, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (tftypes.Value, error) {
+		if tfValue.IsNull() || !sensitive(tfPath) {
+			return tfValue, nil
+		}
+
+		return tftypes.NewValue(tfValue.Type(), nil), nil
+	})
+}
+
+// protocolDataJSONValue returns the value as a Go type suitable for JSON
+// marshaling. Unknown values, which JSON cannot represent, are returned as
+// the "<unknown>" string.
+func protocolDataJSONValue(value tftypes.Value) (interface{}, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	if !value.IsKnown() {
+		return protocolDataUnknownJSON, nil
+	}
+
+	valueType := value.Type()
+
+	switch {
+	case valueType.Is(tftypes.Bool):
+		var b bool
+
+		err := value.As(&b)
+
+		return b, err
+	case valueType.Is(tftypes.Number):
+		var n big.Float
+
+		err := value.As(&n)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Number(n.Text('g', -1)), nil
+	case valueType.Is(tftypes.String):
+		var s string
+
+		err := value.As(&s)
+
+		return s, err
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		err := value.As(&elements)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]interface{}, 0, len(elements))
+
+		for _, element := range elements {
+			jsonElement, err := protocolDataJSONValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, jsonElement)
+		}
+
+		return result, nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		err := value.As(&elements)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]interface{}, len(elements))
+
+		for key, element := range elements {
+			jsonElement, err := protocolDataJSONValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = jsonElement
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", valueType)
+	}
+}
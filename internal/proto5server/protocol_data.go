@@ -0,0 +1,55 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// logProtocolDataConfig writes protocol data files for the configuration, if
+// enabled, with sensitive values redacted.
+func logProtocolDataConfig(ctx context.Context, rpc string, message string, field string, config *tfsdk.Config) {
+	if config == nil {
+		return
+	}
+
+	logProtocolData(ctx, rpc, message, field, config.Raw, config.Schema)
+}
+
+// logProtocolDataPlan writes protocol data files for the plan, if enabled,
+// with sensitive values redacted.
+func logProtocolDataPlan(ctx context.Context, rpc string, message string, field string, plan *tfsdk.Plan) {
+	if plan == nil {
+		return
+	}
+
+	logProtocolData(ctx, rpc, message, field, plan.Raw, plan.Schema)
+}
+
+// logProtocolDataState writes protocol data files for the state, if enabled,
+// with sensitive values redacted.
+func logProtocolDataState(ctx context.Context, rpc string, message string, field string, state *tfsdk.State) {
+	if state == nil {
+		return
+	}
+
+	logProtocolData(ctx, rpc, message, field, state.Raw, state.Schema)
+}
+
+// logProtocolData writes protocol data files for the value, if enabled, with
+// values of Sensitive schema attributes, including those inheriting Sensitive
+// from a parent nested attribute, redacted. Nothing is written without
+// a schema, since sensitive values cannot be determined.
+func logProtocolData(ctx context.Context, rpc string, message string, field string, value tftypes.Value, schema fwschema.Schema) {
+	if schema == nil {
+		return
+	}
+
+	logging.ProtocolData(ctx, rpc, message, field, value, func(tfPath *tftypes.AttributePath) bool {
+		return fwschema.SchemaSensitiveAtTerraformPath(ctx, schema, tfPath)
+	})
+}
@@ -0,0 +1,14 @@
+package proto5server
+
+import (
+	"context"
+)
+
+// processResponse appends any RPC timeout diagnostic to the response and
+// returns the response. The response is the tfprotov5 response pointer type
+// of the RPC, such as *tfprotov5.ReadResourceResponse.
+func processResponse[T any](ctx context.Context, rpc string, resp T) T {
+	appendRPCTimeoutDiagnostics(ctx, rpc, resp)
+
+	return resp
+}
@@ -0,0 +1,47 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+)
+
+// appendRPCTimeoutDiagnostics appends an error diagnostic to the response
+// if the RPC context was canceled by the FrameworkServer RPCTimeout. The
+// response is the tfprotov5 response pointer type of the RPC, such as
+// *tfprotov5.ReadResourceResponse.
+func appendRPCTimeoutDiagnostics(ctx context.Context, rpc string, resp any) {
+	diags := toproto5.Diagnostics(ctx, fwserver.RPCTimeoutDiagnostics(ctx, rpc))
+
+	if len(diags) == 0 {
+		return
+	}
+
+	switch resp := resp.(type) {
+	case *tfprotov5.ApplyResourceChangeResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.ConfigureProviderResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.GetProviderSchemaResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.ImportResourceStateResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.PlanResourceChangeResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.PrepareProviderConfigResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.ReadDataSourceResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.ReadResourceResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.UpgradeResourceStateResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.ValidateDataSourceConfigResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov5.ValidateResourceTypeConfigResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	}
+}
@@ -0,0 +1,113 @@
+package proto5server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// schemaCompatibilityDiagnostics returns an error diagnostic for each schema
+// feature in the response which requires protocol version 6, such as nested
+// attributes. Schemas are walked in a consistent order so all incompatible
+// features are reported together, rather than only the first converted.
+func schemaCompatibilityDiagnostics(ctx context.Context, fwResp *fwserver.GetProviderSchemaResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(schemaCompatibilitySchemaDiagnostics(ctx, "provider", fwResp.Provider)...)
+	diags.Append(schemaCompatibilitySchemaDiagnostics(ctx, "provider_meta", fwResp.ProviderMeta)...)
+
+	dataSourceTypes := make([]string, 0, len(fwResp.DataSourceSchemas))
+
+	for dataSourceType := range fwResp.DataSourceSchemas {
+		dataSourceTypes = append(dataSourceTypes, dataSourceType)
+	}
+
+	sort.Strings(dataSourceTypes)
+
+	for _, dataSourceType := range dataSourceTypes {
+		diags.Append(schemaCompatibilitySchemaDiagnostics(ctx, dataSourceType+" data source", fwResp.DataSourceSchemas[dataSourceType])...)
+	}
+
+	resourceTypes := make([]string, 0, len(fwResp.ResourceSchemas))
+
+	for resourceType := range fwResp.ResourceSchemas {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		diags.Append(schemaCompatibilitySchemaDiagnostics(ctx, resourceType+" resource", fwResp.ResourceSchemas[resourceType])...)
+	}
+
+	return diags
+}
+
+// schemaCompatibilitySchemaDiagnostics returns protocol version 5
+// compatibility diagnostics for the schema. The schemaDescription is used in
+// diagnostic details, such as "test_resource resource".
+func schemaCompatibilitySchemaDiagnostics(ctx context.Context, schemaDescription string, schema fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if schema == nil {
+		return diags
+	}
+
+	diags.Append(schemaCompatibilityObjectDiagnostics(ctx, schemaDescription, path.Empty(), schema.GetAttributes(), schema.GetBlocks())...)
+
+	return diags
+}
+
+// schemaCompatibilityObjectDiagnostics returns protocol version 5
+// compatibility diagnostics for the attributes and blocks, including any
+// nested blocks. Attributes under a nested attribute are not walked, as the
+// nested attribute itself is already incompatible.
+func schemaCompatibilityObjectDiagnostics(ctx context.Context, schemaDescription string, objectPath path.Path, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributeNames := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		attributeNames = append(attributeNames, name)
+	}
+
+	sort.Strings(attributeNames)
+
+	for _, name := range attributeNames {
+		if _, ok := attributes[name].(fwschema.NestedAttribute); !ok {
+			continue
+		}
+
+		// The diagnostic path is intentionally omitted as it is invalid in
+		// this context. Diagnostic paths are intended to be mapped to actual
+		// data, while this path information must be synthesized.
+		diags.Append(fwdiag.ProviderErrorDiag(
+			ctx,
+			"Protocol Version 5 Incompatible Schema",
+			fmt.Sprintf("The %s schema contains the nested attribute %q, which requires protocol version 6.", schemaDescription, objectPath.AtName(name)),
+			"Provider developers can convert the attribute to a block or serve the provider with protocol version 6.",
+		))
+	}
+
+	blockNames := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		blockNames = append(blockNames, name)
+	}
+
+	sort.Strings(blockNames)
+
+	for _, name := range blockNames {
+		nestedObject := blocks[name].GetNestedObject()
+
+		diags.Append(schemaCompatibilityObjectDiagnostics(ctx, schemaDescription, objectPath.AtName(name), nestedObject.GetAttributes(), nestedObject.GetBlocks())...)
+	}
+
+	return diags
+}
@@ -2,9 +2,10 @@ package proto5server
 
 import (
 	"context"
-	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -14,30 +15,22 @@ var _ tfprotov5.ProviderServer = &Server{}
 type Server struct {
 	FrameworkServer fwserver.Server
 
-	contextCancels   []context.CancelFunc
-	contextCancelsMu sync.Mutex
-}
-
-func (s *Server) registerContext(in context.Context) context.Context {
-	ctx, cancel := context.WithCancel(in)
-	s.contextCancelsMu.Lock()
-	defer s.contextCancelsMu.Unlock()
-	s.contextCancels = append(s.contextCancels, cancel)
-	return ctx
-}
-
-func (s *Server) cancelRegisteredContexts(_ context.Context) {
-	s.contextCancelsMu.Lock()
-	defer s.contextCancelsMu.Unlock()
-	for _, cancel := range s.contextCancels {
-		cancel()
-	}
-	s.contextCancels = nil
+	// RPCContexts tracks the contexts of in-flight RPCs, which are canceled
+	// by StopProvider.
+	fwserver.RPCContexts
 }
 
 // StopProvider satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) StopProvider(ctx context.Context, _ *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
-	s.cancelRegisteredContexts(ctx)
+	s.FrameworkServer.EmitEvent(ctx, provider.EventTypeStopRequested, 0)
+
+	fwResp := &fwserver.StopProviderResponse{}
+
+	// The provider is given a chance to gracefully stop before in-flight
+	// RPCs are canceled.
+	s.FrameworkServer.StopProvider(ctx, fwResp)
+
+	s.CancelRegisteredContexts(ctx, &s.FrameworkServer)
 
-	return &tfprotov5.StopProviderResponse{}, nil
+	return toproto5.StopProviderResponse(ctx, fwResp), nil
 }
@@ -27,8 +27,8 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			ctx := context.Background()
-			ctx = s.registerContext(ctx)
+			ctx, cancel := s.RegisterContext(context.Background(), &s.FrameworkServer, "Test")
+			defer cancel()
 			select {
 			case <-time.After(time.Second * 10):
 				t.Error("timed out waiting to be canceled")
@@ -45,7 +45,7 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// sending us requests after it told us to stop
 	time.Sleep(200 * time.Millisecond)
 
-	s.cancelRegisteredContexts(testCtx)
+	s.CancelRegisteredContexts(testCtx, &s.FrameworkServer)
 
 	wg.Wait()
 	// if we got here, that means that either all our contexts have been
@@ -91,3 +91,28 @@ func testNewTfprotov6RawState(t *testing.T, jsonMap map[string]interface{}) *tfp
 		JSON: rawStateJSON,
 	}
 }
+
+// testNormalizeRequestLogEntries verifies all log entries have the same
+// non-empty framework request ID, then replaces the request ID and any
+// request duration with static values for comparison.
+func testNormalizeRequestLogEntries(t *testing.T, entries []map[string]interface{}) {
+	t.Helper()
+
+	var requestID interface{}
+
+	for _, entry := range entries {
+		if requestID == nil {
+			requestID = entry["tf_framework_req_id"]
+		}
+
+		if requestID == "" || entry["tf_framework_req_id"] != requestID {
+			t.Errorf("expected request ID %q, got: %v", requestID, entry["tf_framework_req_id"])
+		}
+
+		entry["tf_framework_req_id"] = "test-request-id"
+
+		if _, ok := entry["tf_req_duration_ms"]; ok {
+			entry["tf_req_duration_ms"] = float64(0)
+		}
+	}
+}
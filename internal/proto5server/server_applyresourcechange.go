@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,25 @@ import (
 
 // ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterApplyContext(ctx, &s.FrameworkServer, "ApplyResourceChange")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto5Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ApplyResourceChange", proto5Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ApplyResourceChange", toproto5.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -30,7 +39,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ApplyResourceChange", toproto5.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +47,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ApplyResourceChange", toproto5.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ApplyResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
@@ -46,10 +55,16 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ApplyResourceChange", toproto5.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataConfig(ctx, "ApplyResourceChange", logging.ProtocolDataMessageRequest, "Config", fwReq.Config)
+	logProtocolDataPlan(ctx, "ApplyResourceChange", logging.ProtocolDataMessageRequest, "PlannedState", fwReq.PlannedState)
+	logProtocolDataState(ctx, "ApplyResourceChange", logging.ProtocolDataMessageRequest, "PriorState", fwReq.PriorState)
+
 	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
 
-	return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "ApplyResourceChange", logging.ProtocolDataMessageResponse, "NewState", fwResp.NewState)
+
+	return processResponse(ctx, "ApplyResourceChange", toproto5.ApplyResourceChangeResponse(ctx, fwResp)), nil
 }
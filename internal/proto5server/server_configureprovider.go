@@ -2,8 +2,10 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -12,17 +14,24 @@ import (
 
 // ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ConfigureProvider")
+	defer cancel()
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ConfigureProvider", "")
+	defer afterRPC()
+
 	fwResp := &provider.ConfigureResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ConfigureProvider", toproto5.ConfigureProviderResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ConfigureProviderRequest(ctx, proto5Req, providerSchema)
@@ -30,10 +39,10 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.Con
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ConfigureProvider", toproto5.ConfigureProviderResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
 
-	return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
+	return processResponse(ctx, "ConfigureProvider", toproto5.ConfigureProviderResponse(ctx, fwResp)), nil
 }
@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,13 +13,32 @@ import (
 
 // GetProviderSchema satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) GetProviderSchema(ctx context.Context, proto5Req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "GetProviderSchema")
+	defer cancel()
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "GetProviderSchema", "")
+	defer afterRPC()
+
 	fwReq := fromproto5.GetProviderSchemaRequest(ctx, proto5Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
-	return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
+	compatibilityDiags := schemaCompatibilityDiagnostics(ctx, fwResp)
+
+	fwResp.Diagnostics.Append(compatibilityDiags...)
+
+	// Schema conversion would otherwise return only the first incompatible
+	// feature, without consistent ordering.
+	if compatibilityDiags.HasError() {
+		return processResponse(ctx, "GetProviderSchema", &tfprotov5.GetProviderSchemaResponse{
+			Diagnostics: toproto5.Diagnostics(ctx, fwResp.Diagnostics),
+		}), nil
+	}
+
+	return processResponse(ctx, "GetProviderSchema", toproto5.GetProviderSchemaResponse(ctx, fwResp)), nil
 }
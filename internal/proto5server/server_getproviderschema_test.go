@@ -364,6 +364,139 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-blocks": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = resourceschema.Schema{
+												Blocks: map[string]resourceschema.Block{
+													"test_block": resourceschema.SingleNestedBlock{
+														Attributes: map[string]resourceschema.Attribute{
+															"test_attribute": resourceschema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.GetProviderSchemaRequest{},
+			expectedResponse: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Provider: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Required: true,
+												Type:     tftypes.String,
+											},
+										},
+									},
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+				ServerCapabilities: &tfprotov5.ServerCapabilities{
+					PlanDestroy: true,
+				},
+			},
+		},
+		"resourceschemas-nested-attributes": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = resourceschema.Schema{
+												Attributes: map[string]resourceschema.Attribute{
+													"test_attribute": resourceschema.SingleNestedAttribute{
+														Attributes: map[string]resourceschema.Attribute{
+															"test_nested_attribute": resourceschema.StringAttribute{
+																Required: true,
+															},
+														},
+														Required: true,
+													},
+												},
+												Blocks: map[string]resourceschema.Block{
+													"test_block": resourceschema.ListNestedBlock{
+														NestedObject: resourceschema.NestedBlockObject{
+															Attributes: map[string]resourceschema.Attribute{
+																"test_attribute": resourceschema.ListNestedAttribute{
+																	NestedObject: resourceschema.NestedAttributeObject{
+																		Attributes: map[string]resourceschema.Attribute{
+																			"test_nested_attribute": resourceschema.StringAttribute{
+																				Required: true,
+																			},
+																		},
+																	},
+																	Optional: true,
+																},
+															},
+														},
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.GetProviderSchemaRequest{},
+			expectedResponse: &tfprotov5.GetProviderSchemaResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Protocol Version 5 Incompatible Schema",
+						Detail: "The test_resource resource schema contains the nested attribute \"test_attribute\", which requires protocol version 6. " +
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+							"Provider developers can convert the attribute to a block or serve the provider with protocol version 6.",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Protocol Version 5 Incompatible Schema",
+						Detail: "The test_resource resource schema contains the nested attribute \"test_block.test_attribute\", which requires protocol version 6. " +
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+							"Provider developers can convert the attribute to a block or serve the provider with protocol version 6.",
+					},
+				},
+			},
+		},
 		"resourceschemas-duplicate-type-name": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -513,71 +646,130 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 		t.Fatalf("unable to read multiple line JSON: %s", err)
 	}
 
+	testNormalizeRequestLogEntries(t, entries)
+
 	expectedEntries := []map[string]interface{}{
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Metadata",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ProviderTypeName lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider Metadata",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider Metadata",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "trace",
+			"@message":            "Checking ProviderSchema lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider Schema",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Metadata",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider Schema",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking ProviderSchema lock",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ResourceSchemas lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Schema",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ResourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Schema",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider Resources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking ResourceSchemas lock",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider Resources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking ResourceTypes lock",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking DataSourceSchemas lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Resources",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking DataSourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Resources",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider DataSources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking DataSourceSchemas lock",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider DataSources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking DataSourceTypes lock",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking DataSourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider DataSources",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ResourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider DataSources",
-			"@module":  "sdk.framework",
+			"@level":                   "debug",
+			"@message":                 "Completed framework request",
+			"@module":                  "sdk.framework",
+			"diagnostic_error_count":   float64(0),
+			"diagnostic_warning_count": float64(0),
+			"tf_framework_req_id":      "test-request-id",
+			"tf_req_duration_ms":       float64(0),
+			"tf_rpc":                   "GetProviderSchema",
 		},
 	}
 
@@ -2,27 +2,37 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
 // PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "PlanResourceChange")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto5Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "PlanResourceChange", proto5Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -30,7 +40,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +48,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.PlanResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
@@ -46,10 +56,27 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataConfig(ctx, "PlanResourceChange", logging.ProtocolDataMessageRequest, "Config", fwReq.Config)
+	logProtocolDataState(ctx, "PlanResourceChange", logging.ProtocolDataMessageRequest, "PriorState", fwReq.PriorState)
+	logProtocolDataPlan(ctx, "PlanResourceChange", logging.ProtocolDataMessageRequest, "ProposedNewState", fwReq.ProposedNewState)
+
 	s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
 
-	return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "PlanResourceChange", logging.ProtocolDataMessageResponse, "PlannedState", fwResp.PlannedState)
+
+	// Reuse the request data when the state is unchanged, such as during
+	// refresh-only plans, rather than marshaling an equal value.
+	if fwResp.PlannedState != nil && fwReq.ProposedNewState != nil && tfvalue.Equal(fwResp.PlannedState.Raw, fwReq.ProposedNewState.Raw) {
+		fwResp.PlannedState = nil
+
+		proto5Resp := toproto5.PlanResourceChangeResponse(ctx, fwResp)
+		proto5Resp.PlannedState = proto5Req.ProposedNewState
+
+		return processResponse(ctx, "PlanResourceChange", proto5Resp), nil
+	}
+
+	return processResponse(ctx, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 }
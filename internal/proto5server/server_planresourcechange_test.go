@@ -392,9 +392,6 @@ func TestServerPlanResourceChange(t *testing.T) {
 					"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
 				}),
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test_required"),
-				},
 			},
 		},
 		"delete-request-priorstate": {
@@ -637,9 +634,6 @@ func TestServerPlanResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov5.PlanResourceChangeResponse{
 				PlannedState: &testEmptyDynamicValue,
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test_required"),
-				},
 			},
 		},
 		"update-request-config": {
@@ -979,6 +973,49 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"update-response-plannedstate-unchanged": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			// The request data is JSON encoded to verify it is reused, as
+			// marshaling the plan would instead result in MessagePack data.
+			request: &tfprotov5.PlanResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				ProposedNewState: &tfprotov5.DynamicValue{
+					JSON: []byte(`{"test_computed":"test-computed-value","test_required":"test-value"}`),
+				},
+				PriorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov5.PlanResourceChangeResponse{
+				PlannedState: &tfprotov5.DynamicValue{
+					JSON: []byte(`{"test_computed":"test-computed-value","test_required":"test-value"}`),
+				},
+			},
+		},
 		"update-response-requiresreplace": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,24 @@ import (
 
 // PrepareProviderConfig satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "PrepareProviderConfig")
+	defer cancel()
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "PrepareProviderConfig", "")
+	defer afterRPC()
+
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "PrepareProviderConfig", toproto5.PrepareProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.PrepareProviderConfigRequest(ctx, proto5Req, providerSchema)
@@ -30,10 +38,10 @@ func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "PrepareProviderConfig", toproto5.PrepareProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
 
-	return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+	return processResponse(ctx, "PrepareProviderConfig", toproto5.PrepareProviderConfigResponse(ctx, fwResp)), nil
 }
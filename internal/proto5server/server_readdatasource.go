@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,25 @@ import (
 
 // ReadDataSource satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ReadDataSource")
+	defer cancel()
+
+	ctx = logging.DataSourceContext(ctx, proto5Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ReadDataSource", proto5Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto5Req.TypeName)
@@ -30,7 +39,7 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +47,7 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ReadDataSourceRequest(ctx, proto5Req, dataSource, dataSourceSchema, providerMetaSchema)
@@ -46,10 +55,14 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataConfig(ctx, "ReadDataSource", logging.ProtocolDataMessageRequest, "Config", fwReq.Config)
+
 	s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
 
-	return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "ReadDataSource", logging.ProtocolDataMessageResponse, "State", fwResp.State)
+
+	return processResponse(ctx, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 }
@@ -2,28 +2,38 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 )
 
 // ReadResource satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ReadResource")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto5Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ReadResource", proto5Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -31,7 +41,7 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -39,7 +49,7 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ReadResourceRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
@@ -47,10 +57,25 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataState(ctx, "ReadResource", logging.ProtocolDataMessageRequest, "CurrentState", fwReq.CurrentState)
+
 	s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
 
-	return toproto5.ReadResourceResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "ReadResource", logging.ProtocolDataMessageResponse, "NewState", fwResp.NewState)
+
+	// Reuse the request data when the state is unchanged, such as during
+	// refresh-only plans, rather than marshaling an equal value.
+	if fwResp.NewState != nil && fwReq.CurrentState != nil && tfvalue.Equal(fwResp.NewState.Raw, fwReq.CurrentState.Raw) {
+		fwResp.NewState = nil
+
+		proto5Resp := toproto5.ReadResourceResponse(ctx, fwResp)
+		proto5Resp.NewState = proto5Req.CurrentState
+
+		return processResponse(ctx, "ReadResource", proto5Resp), nil
+	}
+
+	return processResponse(ctx, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 }
@@ -288,6 +288,42 @@ func TestServerReadResource(t *testing.T) {
 				NewState: testCurrentStateValue,
 			},
 		},
+		"response-state-unchanged": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			// The request data is JSON encoded to verify it is reused, as
+			// marshaling the state would instead result in MessagePack data.
+			request: &tfprotov5.ReadResourceRequest{
+				CurrentState: &tfprotov5.DynamicValue{
+					JSON: []byte(`{"test_computed":null,"test_required":"test-currentstate-value"}`),
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov5.ReadResourceResponse{
+				NewState: &tfprotov5.DynamicValue{
+					JSON: []byte(`{"test_computed":null,"test_required":"test-currentstate-value"}`),
+				},
+			},
+		},
 		"response-state": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -329,6 +365,50 @@ func TestServerReadResource(t *testing.T) {
 				NewState: testNewStateDynamicValue,
 			},
 		},
+		"response-state-provider-type-name": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+							resp.TypeName = "test"
+						},
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = req.ProviderTypeName + "_resource"
+										},
+										ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+											var data struct {
+												TestComputed types.String `tfsdk:"test_computed"`
+												TestRequired types.String `tfsdk:"test_required"`
+											}
+
+											resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+											data.TestComputed = types.StringValue("test-newstate-value")
+
+											resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ReadResourceRequest{
+				CurrentState: testCurrentStateValue,
+				TypeName:     "test_resource",
+			},
+			expectedResponse: &tfprotov5.ReadResourceResponse{
+				NewState: testNewStateDynamicValue,
+			},
+		},
 		"response-state-removeresource": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
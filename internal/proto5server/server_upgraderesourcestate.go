@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,13 +13,27 @@ import (
 
 // UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "UpgradeResourceState")
+	defer cancel()
+
+	var typeName string
+
+	if proto5Req != nil {
+		typeName = proto5Req.TypeName
+		ctx = logging.ResourceContext(ctx, typeName)
+	}
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "UpgradeResourceState", typeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.UpgradeResourceStateResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	if proto5Req == nil {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
@@ -26,7 +41,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -34,7 +49,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.UpgradeResourceStateRequest(ctx, proto5Req, resource, resourceSchema)
@@ -42,10 +57,10 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
 
-	return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	return processResponse(ctx, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 }
@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,25 @@ import (
 
 // ValidateDataSourceConfig satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ValidateDataSourceConfig")
+	defer cancel()
+
+	ctx = logging.DataSourceContext(ctx, proto5Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ValidateDataSourceConfig", proto5Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto5Req.TypeName)
@@ -30,7 +39,7 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ValidateDataSourceConfigRequest(ctx, proto5Req, dataSource, dataSourceSchema)
@@ -38,10 +47,10 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
 
-	return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	return processResponse(ctx, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 }
@@ -2,6 +2,7 @@ package proto5server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,25 @@ import (
 
 // ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ValidateResourceTypeConfig")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto5Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ValidateResourceTypeConfig", proto5Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -30,7 +39,7 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ValidateResourceTypeConfigRequest(ctx, proto5Req, resource, resourceSchema)
@@ -38,10 +47,10 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
 
-	return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+	return processResponse(ctx, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 }
@@ -0,0 +1,189 @@
+package proto6server
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Tests in this file cannot be parallel as they set the
+// TF_LOG_SDK_PROTO_DATA_DIR environment variable.
+
+func TestServerProtocolData(t *testing.T) {
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_sensitive": tftypes.String,
+			"test_string":    tftypes.String,
+		},
+	}
+
+	testValue := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_sensitive": tftypes.NewValue(tftypes.String, "test-secret"),
+		"test_string":    tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testNullValue, err := tfprotov6.NewDynamicValue(testSchemaType, tftypes.NewValue(testSchemaType, nil))
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test_sensitive": schema.StringAttribute{
+												Required:  true,
+												Sensitive: true,
+											},
+											"test_string": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		call          func(context.Context) error
+		expectedFiles []string
+	}{
+		"PlanResourceChange": {
+			call: func(ctx context.Context) error {
+				_, err := testServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+					Config:           testValue,
+					PriorState:       &testNullValue,
+					ProposedNewState: testValue,
+					TypeName:         "test_resource",
+				})
+
+				return err
+			},
+			expectedFiles: []string{
+				"PlanResourceChange_Request_Config.json",
+				"PlanResourceChange_Request_Config.msgpack",
+				"PlanResourceChange_Request_PriorState.json",
+				"PlanResourceChange_Request_PriorState.msgpack",
+				"PlanResourceChange_Request_ProposedNewState.json",
+				"PlanResourceChange_Request_ProposedNewState.msgpack",
+				"PlanResourceChange_Response_PlannedState.json",
+				"PlanResourceChange_Response_PlannedState.msgpack",
+			},
+		},
+		"ReadResource": {
+			call: func(ctx context.Context) error {
+				_, err := testServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+					CurrentState: testValue,
+					TypeName:     "test_resource",
+				})
+
+				return err
+			},
+			expectedFiles: []string{
+				"ReadResource_Request_CurrentState.json",
+				"ReadResource_Request_CurrentState.msgpack",
+				"ReadResource_Response_NewState.json",
+				"ReadResource_Response_NewState.msgpack",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			dataDir := t.TempDir()
+
+			t.Setenv(logging.EnvTfLogSdkProtoDataDir, dataDir)
+
+			err := testCase.call(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			dirEntries, err := os.ReadDir(dataDir)
+
+			if err != nil {
+				t.Fatalf("unable to read protocol data directory: %s", err)
+			}
+
+			var gotFiles []string
+
+			for _, dirEntry := range dirEntries {
+				// Remove the time and request ID prefix.
+				nameParts := strings.SplitN(dirEntry.Name(), "_", 3)
+
+				if len(nameParts) != 3 || nameParts[1] == "" {
+					t.Fatalf("unexpected file name: %s", dirEntry.Name())
+				}
+
+				gotFiles = append(gotFiles, nameParts[2])
+
+				fileBytes, err := os.ReadFile(filepath.Join(dataDir, dirEntry.Name()))
+
+				if err != nil {
+					t.Fatalf("unable to read file: %s", err)
+				}
+
+				if strings.Contains(string(fileBytes), "test-secret") {
+					t.Errorf("expected sensitive value to be redacted in %s", dirEntry.Name())
+				}
+
+				if filepath.Ext(dirEntry.Name()) != ".json" || strings.Contains(dirEntry.Name(), "PriorState") {
+					continue
+				}
+
+				var got map[string]interface{}
+
+				if err := json.Unmarshal(fileBytes, &got); err != nil {
+					t.Fatalf("unable to unmarshal JSON: %s", err)
+				}
+
+				expected := map[string]interface{}{
+					"test_sensitive": nil,
+					"test_string":    "test-value",
+				}
+
+				if diff := cmp.Diff(got, expected); diff != "" {
+					t.Errorf("unexpected %s difference: %s", dirEntry.Name(), diff)
+				}
+			}
+
+			sort.Strings(gotFiles)
+
+			if diff := cmp.Diff(gotFiles, testCase.expectedFiles); diff != "" {
+				t.Errorf("unexpected files difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// RequestFunc is called with an incoming protocol version 6 request for the
+// given RPC, before conversion into the framework server request. The
+// request is the tfprotov6 request pointer type of the RPC, such as
+// *tfprotov6.ReadResourceRequest, and can be modified in place. Returning
+// error diagnostics skips the RPC handling and returns the diagnostics.
+type RequestFunc func(ctx context.Context, rpc string, req any) diag.Diagnostics
+
+// processRequest calls all server RequestFuncs with the request, in order,
+// and returns their diagnostics. Remaining RequestFuncs are not called after
+// error diagnostics.
+func processRequest(ctx context.Context, s *Server, rpc string, req any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(s.RequestFuncs) == 0 {
+		return diags
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined RequestFuncs")
+
+	for _, requestFunc := range s.RequestFuncs {
+		diags.Append(requestFunc(ctx, rpc, req)...)
+
+		if diags.HasError() {
+			break
+		}
+	}
+
+	logging.FrameworkTrace(ctx, "Called provider defined RequestFuncs")
+
+	return diags
+}
@@ -0,0 +1,113 @@
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerRequestFuncs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		requestFuncs      []RequestFunc
+		expectedConfigure bool
+		expected          *tfprotov6.ConfigureProviderResponse
+	}{
+		"none": {
+			expectedConfigure: true,
+			expected:          &tfprotov6.ConfigureProviderResponse{},
+		},
+		"warning": {
+			requestFuncs: []RequestFunc{
+				func(_ context.Context, rpc string, req any) diag.Diagnostics {
+					var diags diag.Diagnostics
+
+					if _, ok := req.(*tfprotov6.ConfigureProviderRequest); !ok {
+						diags.AddError("unexpected request type", rpc)
+					}
+
+					diags.AddWarning("test summary", "test detail")
+
+					return diags
+				},
+			},
+			expectedConfigure: true,
+			expected: &tfprotov6.ConfigureProviderResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "test summary",
+						Detail:   "test detail",
+					},
+				},
+			},
+		},
+		"error": {
+			requestFuncs: []RequestFunc{
+				func(_ context.Context, _ string, _ any) diag.Diagnostics {
+					return diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					}
+				},
+				func(_ context.Context, _ string, _ any) diag.Diagnostics {
+					return diag.Diagnostics{
+						diag.NewErrorDiagnostic("unexpected call", "remaining request functions should not be called after an error"),
+					}
+				},
+			},
+			expectedConfigure: false,
+			expected: &tfprotov6.ConfigureProviderResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "test summary",
+						Detail:   "test detail",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var configured bool
+
+			testServer := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+							configured = true
+						},
+					},
+				},
+				RequestFuncs: testCase.requestFuncs,
+			}
+
+			got, err := testServer.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if configured != testCase.expectedConfigure {
+				t.Errorf("expected Configure call to be %t, got: %t", testCase.expectedConfigure, configured)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
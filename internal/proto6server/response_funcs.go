@@ -0,0 +1,51 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// ResponseFunc is a final transformation of an outgoing protocol version 6
+// response for the given RPC. The response is the tfprotov6 response pointer
+// type of the RPC, such as *tfprotov6.ReadResourceResponse, and can be
+// modified in place. Any response data reused from the request is a copy, so
+// modifying it in place does not modify the request.
+type ResponseFunc func(ctx context.Context, rpc string, resp any)
+
+// processResponse appends any RPC timeout diagnostic to the response, then
+// calls all server ResponseFuncs with the response, in order, and returns
+// the response.
+func processResponse[T any](ctx context.Context, s *Server, rpc string, resp T) T {
+	appendRPCTimeoutDiagnostics(ctx, rpc, resp)
+
+	if len(s.ResponseFuncs) == 0 {
+		return resp
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined ResponseFuncs")
+
+	for _, responseFunc := range s.ResponseFuncs {
+		responseFunc(ctx, rpc, resp)
+	}
+
+	logging.FrameworkTrace(ctx, "Called provider defined ResponseFuncs")
+
+	return resp
+}
+
+// responseDynamicValue returns the request data for reuse in a response.
+// ResponseFuncs can modify the response in place, so a copy is returned if
+// any are set, which prevents them from modifying the request data.
+func responseDynamicValue(s *Server, reqValue *tfprotov6.DynamicValue) *tfprotov6.DynamicValue {
+	if reqValue == nil || len(s.ResponseFuncs) == 0 {
+		return reqValue
+	}
+
+	return &tfprotov6.DynamicValue{
+		JSON:    append([]byte(nil), reqValue.JSON...),
+		MsgPack: append([]byte(nil), reqValue.MsgPack...),
+	}
+}
@@ -0,0 +1,142 @@
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestServerResponseFuncs(t *testing.T) {
+	t.Parallel()
+
+	var gotRPCs []string
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{},
+		},
+		ResponseFuncs: []ResponseFunc{
+			func(_ context.Context, rpc string, _ any) {
+				gotRPCs = append(gotRPCs, rpc)
+			},
+			func(_ context.Context, _ string, resp any) {
+				validateResp, ok := resp.(*tfprotov6.ValidateProviderConfigResponse)
+
+				if !ok {
+					return
+				}
+
+				validateResp.Diagnostics = append(validateResp.Diagnostics, &tfprotov6.Diagnostic{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				})
+			},
+		},
+	}
+
+	got, err := testServer.ValidateProviderConfig(context.Background(), &tfprotov6.ValidateProviderConfigRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov6.ValidateProviderConfigResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "test summary",
+				Detail:   "test detail",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected response difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(gotRPCs, []string{"ValidateProviderConfig"}); diff != "" {
+		t.Errorf("unexpected RPCs difference: %s", diff)
+	}
+}
+
+func TestServerResponseFuncs_RequestData(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+		ResponseFuncs: []ResponseFunc{
+			func(_ context.Context, _ string, resp any) {
+				readResp, ok := resp.(*tfprotov6.ReadResourceResponse)
+
+				if !ok || readResp.NewState == nil {
+					return
+				}
+
+				// Modify the response data in place.
+				for idx := range readResp.NewState.JSON {
+					readResp.NewState.JSON[idx] = ' '
+				}
+			},
+		},
+	}
+
+	// The request data is JSON encoded, so the unchanged state response
+	// reuses the request data rather than marshaling MessagePack data.
+	req := &tfprotov6.ReadResourceRequest{
+		CurrentState: &tfprotov6.DynamicValue{
+			JSON: []byte(`{"test_computed":"test-value"}`),
+		},
+		TypeName: "test_resource",
+	}
+
+	got, err := testServer.ReadResource(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", got.Diagnostics)
+	}
+
+	if got.NewState == req.CurrentState {
+		t.Error("expected response NewState to not be the request CurrentState")
+	}
+
+	if diff := cmp.Diff(req.CurrentState.JSON, []byte(`{"test_computed":"test-value"}`)); diff != "" {
+		t.Errorf("unexpected request CurrentState difference: %s", diff)
+	}
+}
@@ -0,0 +1,47 @@
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+)
+
+// appendRPCTimeoutDiagnostics appends an error diagnostic to the response
+// if the RPC context was canceled by the FrameworkServer RPCTimeout. The
+// response is the tfprotov6 response pointer type of the RPC, such as
+// *tfprotov6.ReadResourceResponse.
+func appendRPCTimeoutDiagnostics(ctx context.Context, rpc string, resp any) {
+	diags := toproto6.Diagnostics(ctx, fwserver.RPCTimeoutDiagnostics(ctx, rpc))
+
+	if len(diags) == 0 {
+		return
+	}
+
+	switch resp := resp.(type) {
+	case *tfprotov6.ApplyResourceChangeResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ConfigureProviderResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.GetProviderSchemaResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ImportResourceStateResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.PlanResourceChangeResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ReadDataSourceResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ReadResourceResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.UpgradeResourceStateResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ValidateDataResourceConfigResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ValidateProviderConfigResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	case *tfprotov6.ValidateResourceConfigResponse:
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	}
+}
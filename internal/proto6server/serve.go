@@ -2,9 +2,10 @@ package proto6server
 
 import (
 	"context"
-	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -14,30 +15,34 @@ var _ tfprotov6.ProviderServer = &Server{}
 type Server struct {
 	FrameworkServer fwserver.Server
 
-	contextCancels   []context.CancelFunc
-	contextCancelsMu sync.Mutex
-}
+	// DisableDescriptionDerivation prevents deriving schema plain text or
+	// Markdown descriptions from the other when only one is set.
+	DisableDescriptionDerivation bool
 
-func (s *Server) registerContext(in context.Context) context.Context {
-	ctx, cancel := context.WithCancel(in)
-	s.contextCancelsMu.Lock()
-	defer s.contextCancelsMu.Unlock()
-	s.contextCancels = append(s.contextCancels, cancel)
-	return ctx
-}
+	// RequestFuncs are called in order with each incoming request, except
+	// StopProvider, before conversion into the framework server request.
+	RequestFuncs []RequestFunc
+
+	// ResponseFuncs are called in order with each outgoing response, after
+	// conversion from the framework server response.
+	ResponseFuncs []ResponseFunc
 
-func (s *Server) cancelRegisteredContexts(_ context.Context) {
-	s.contextCancelsMu.Lock()
-	defer s.contextCancelsMu.Unlock()
-	for _, cancel := range s.contextCancels {
-		cancel()
-	}
-	s.contextCancels = nil
+	// RPCContexts tracks the contexts of in-flight RPCs, which are canceled
+	// by StopProvider.
+	fwserver.RPCContexts
 }
 
 // StopProvider satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) StopProvider(ctx context.Context, _ *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
-	s.cancelRegisteredContexts(ctx)
+	s.FrameworkServer.EmitEvent(ctx, provider.EventTypeStopRequested, 0)
+
+	fwResp := &fwserver.StopProviderResponse{}
+
+	// The provider is given a chance to gracefully stop before in-flight
+	// RPCs are canceled.
+	s.FrameworkServer.StopProvider(ctx, fwResp)
+
+	s.CancelRegisteredContexts(ctx, &s.FrameworkServer)
 
-	return &tfprotov6.StopProviderResponse{}, nil
+	return processResponse(ctx, s, "StopProvider", toproto6.StopProviderResponse(ctx, fwResp)), nil
 }
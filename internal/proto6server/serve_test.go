@@ -1,14 +1,30 @@
 package proto6server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/instrumentation"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
 )
 
 func TestServerCancelInFlightContexts(t *testing.T) {
@@ -26,8 +42,8 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			ctx := context.Background()
-			ctx = s.registerContext(ctx)
+			ctx, cancel := s.RegisterContext(context.Background(), &s.FrameworkServer, "Test")
+			defer cancel()
 			select {
 			case <-time.After(time.Second * 10):
 				t.Error("timed out waiting to be canceled")
@@ -44,7 +60,7 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// sending us requests after it told us to stop
 	time.Sleep(200 * time.Millisecond)
 
-	s.cancelRegisteredContexts(testCtx)
+	s.CancelRegisteredContexts(testCtx, &s.FrameworkServer)
 
 	wg.Wait()
 	// if we got here, that means that either all our contexts have been
@@ -76,3 +92,629 @@ func testNewRawState(t *testing.T, jsonMap map[string]interface{}) *tfprotov6.Ra
 		JSON: rawStateJSON,
 	}
 }
+
+func TestServerStopProvider_Event(t *testing.T) {
+	t.Parallel()
+
+	var eventTypes []provider.EventType
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			EventListeners: []provider.EventListener{
+				func(_ context.Context, event provider.Event) {
+					eventTypes = append(eventTypes, event.Type)
+				},
+			},
+		},
+	}
+
+	_, err := s.StopProvider(context.Background(), &tfprotov6.StopProviderRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(eventTypes) != 1 || eventTypes[0] != provider.EventTypeStopRequested {
+		t.Errorf("expected stop requested event, got: %v", eventTypes)
+	}
+}
+
+func TestServerStopProvider_ProviderWithStop(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stopMethod       func(context.Context, context.Context) diag.Diagnostics
+		stopTimeout      time.Duration
+		expectedResponse *tfprotov6.StopProviderResponse
+	}{
+		"stop-before-cancel": {
+			stopMethod: func(_ context.Context, rpcCtx context.Context) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				if rpcCtx.Err() != nil {
+					diags.AddError("Unexpected Context", "expected in-flight RPC context to not be canceled during Stop")
+				}
+
+				return diags
+			},
+			expectedResponse: &tfprotov6.StopProviderResponse{},
+		},
+		"error": {
+			stopMethod: func(_ context.Context, _ context.Context) diag.Diagnostics {
+				return diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning detail"),
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				}
+			},
+			expectedResponse: &tfprotov6.StopProviderResponse{
+				Error: "test error summary: test error detail",
+			},
+		},
+		"timeout": {
+			stopMethod: func(ctx context.Context, _ context.Context) diag.Diagnostics {
+				<-ctx.Done()
+
+				// Simulate a provider ignoring the deadline.
+				time.Sleep(100 * time.Millisecond)
+
+				return nil
+			},
+			stopTimeout: 10 * time.Millisecond,
+			expectedResponse: &tfprotov6.StopProviderResponse{
+				Error: "Provider Stop Timeout: The provider did not finish stopping within 10ms. In-flight operations are canceled regardless.",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var rpcCtx context.Context
+
+			s := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithStop{
+						Provider: &testprovider.Provider{},
+						StopMethod: func(ctx context.Context) diag.Diagnostics {
+							return testCase.stopMethod(ctx, rpcCtx)
+						},
+					},
+					StopTimeout: testCase.stopTimeout,
+				},
+			}
+
+			rpcCtx, rpcCancel := s.RegisterContext(context.Background(), &s.FrameworkServer, "ReadResource")
+			defer rpcCancel()
+
+			got, err := s.StopProvider(context.Background(), &tfprotov6.StopProviderRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+
+			if rpcCtx.Err() == nil {
+				t.Error("expected in-flight RPC context to be canceled after Stop")
+			}
+		})
+	}
+}
+
+func TestServerRegisterContext_RPCTimeout(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		rpcTimeout       time.Duration
+		expectedResponse *tfprotov6.ValidateResourceConfigResponse
+	}{
+		"unset": {
+			expectedResponse: &tfprotov6.ValidateResourceConfigResponse{},
+		},
+		"not-exceeded": {
+			rpcTimeout:       time.Minute,
+			expectedResponse: &tfprotov6.ValidateResourceConfigResponse{},
+		},
+		"exceeded": {
+			rpcTimeout: 10 * time.Millisecond,
+			expectedResponse: &tfprotov6.ValidateResourceConfigResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Unable to Validate",
+						Detail:   "context deadline exceeded",
+					},
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Provider Operation Timeout",
+						Detail: "The provider did not finish the ValidateResourceConfig operation within the provider RPC timeout of 10ms, so the operation was aborted. " +
+							"Other errors, such as context deadline exceeded errors, may be caused by the aborted operation. " +
+							"Retry the operation or report this issue to the provider developers if it persists.",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.ResourceWithValidateConfig{
+										Resource: &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = testSchema
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+										},
+										ValidateConfigMethod: func(ctx context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+											// Simulate a remote call which is aborted by the deadline.
+											select {
+											case <-ctx.Done():
+												resp.Diagnostics.AddError("Unable to Validate", ctx.Err().Error())
+											case <-time.After(100 * time.Millisecond):
+											}
+										},
+									}
+								},
+							}
+						},
+					},
+					RPCTimeout: testCase.rpcTimeout,
+				},
+			}
+
+			got, err := s.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+				Config: testNewDynamicValue(t, testType, map[string]tftypes.Value{
+					"test_attribute": tftypes.NewValue(tftypes.String, nil),
+				}),
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testNormalizeRequestLogEntries verifies all log entries have the same
+// non-empty framework request ID, then replaces the request ID and any
+// request duration with static values for comparison.
+func testNormalizeRequestLogEntries(t *testing.T, entries []map[string]interface{}) {
+	t.Helper()
+
+	var requestID interface{}
+
+	for _, entry := range entries {
+		if requestID == nil {
+			requestID = entry["tf_framework_req_id"]
+		}
+
+		if requestID == "" || entry["tf_framework_req_id"] != requestID {
+			t.Errorf("expected request ID %q, got: %v", requestID, entry["tf_framework_req_id"])
+		}
+
+		entry["tf_framework_req_id"] = "test-request-id"
+
+		if _, ok := entry["tf_req_duration_ms"]; ok {
+			entry["tf_req_duration_ms"] = float64(0)
+		}
+	}
+}
+
+func TestServerReadResource_RequestLogging(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = tflogtest.RootLogger(ctx, &output)
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								ReadMethod: func(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+									tflog.Debug(ctx, "provider log")
+
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	_, err := testServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		CurrentState: testNewDynamicValue(t, testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	testNormalizeRequestLogEntries(t, entries)
+
+	expectedProviderEntry := map[string]interface{}{
+		"@level":              "debug",
+		"@message":            "provider log",
+		"@module":             "provider",
+		"tf_framework_req_id": "test-request-id",
+		"tf_resource_type":    "test_resource",
+		"tf_rpc":              "ReadResource",
+	}
+
+	expectedSummaryEntry := map[string]interface{}{
+		"@level":                   "debug",
+		"@message":                 "Completed framework request",
+		"@module":                  "sdk.framework",
+		"diagnostic_error_count":   float64(0),
+		"diagnostic_warning_count": float64(1),
+		"tf_framework_req_id":      "test-request-id",
+		"tf_req_duration_ms":       float64(0),
+		"tf_resource_type":         "test_resource",
+		"tf_rpc":                   "ReadResource",
+	}
+
+	var gotProviderEntry bool
+
+	for _, entry := range entries {
+		if entry["@message"] == "provider log" {
+			gotProviderEntry = true
+
+			if diff := cmp.Diff(entry, expectedProviderEntry); diff != "" {
+				t.Errorf("unexpected provider entry difference: %s", diff)
+			}
+		}
+	}
+
+	if !gotProviderEntry {
+		t.Error("expected provider log entry")
+	}
+
+	if diff := cmp.Diff(entries[len(entries)-1], expectedSummaryEntry); diff != "" {
+		t.Errorf("unexpected summary entry difference: %s", diff)
+	}
+}
+
+// testInstrumentationKey is the context key of the testInstrumentation
+// method name, which verifies the BeforeProviderMethod context is used.
+type testInstrumentationKey struct{}
+
+// testInstrumentationCall is a recorded testInstrumentation callback.
+type testInstrumentationCall struct {
+	Callback string
+	Method   instrumentation.Method
+}
+
+// testInstrumentation records each instrumentation callback.
+type testInstrumentation struct {
+	calls []testInstrumentationCall
+	mutex sync.Mutex
+}
+
+func (i *testInstrumentation) BeforeProviderMethod(ctx context.Context, method instrumentation.Method) context.Context {
+	i.record("before", method)
+
+	return context.WithValue(ctx, testInstrumentationKey{}, method.Name)
+}
+
+func (i *testInstrumentation) AfterProviderMethod(ctx context.Context, method instrumentation.Method, _ time.Duration) {
+	if ctx.Value(testInstrumentationKey{}) != method.Name {
+		panic("unexpected AfterProviderMethod context")
+	}
+
+	i.record("after", method)
+}
+
+func (i *testInstrumentation) record(callback string, method instrumentation.Method) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.calls = append(i.calls, testInstrumentationCall{
+		Callback: callback,
+		Method:   method,
+	})
+}
+
+func TestServerValidateResourceConfig_Instrumentation(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attribute": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, _ validator.StringRequest, resp *validator.StringResponse) {
+							if ctx.Value(testInstrumentationKey{}) != "validator.String" {
+								resp.Diagnostics.AddError("unexpected context", "expected BeforeProviderMethod context")
+							}
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testInstr := &testInstrumentation{}
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Instrumentation: testInstr,
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.ResourceWithValidateConfig{
+								Resource: &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = testSchema
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+								},
+								ValidateConfigMethod: func(_ context.Context, _ resource.ValidateConfigRequest, _ *resource.ValidateConfigResponse) {},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	resp, err := testServer.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		Config: testNewDynamicValue(t, testType, map[string]tftypes.Value{
+			"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	testMethod := func(name string) instrumentation.Method {
+		return instrumentation.Method{
+			RPC:      "ValidateResourceConfig",
+			Name:     name,
+			TypeName: "test_resource",
+		}
+	}
+
+	expectedCalls := []testInstrumentationCall{
+		{Callback: "before", Method: testMethod("")},
+		{Callback: "before", Method: testMethod("Provider Resources")},
+		{Callback: "after", Method: testMethod("Provider Resources")},
+		{Callback: "before", Method: testMethod("Provider Metadata")},
+		{Callback: "after", Method: testMethod("Provider Metadata")},
+		{Callback: "before", Method: testMethod("Resource Schema")},
+		{Callback: "after", Method: testMethod("Resource Schema")},
+		{Callback: "before", Method: testMethod("Resource ValidateConfig")},
+		{Callback: "after", Method: testMethod("Resource ValidateConfig")},
+		{Callback: "before", Method: testMethod("validator.String")},
+		{Callback: "after", Method: testMethod("validator.String")},
+		{Callback: "after", Method: testMethod("")},
+	}
+
+	if diff := cmp.Diff(testInstr.calls, expectedCalls); diff != "" {
+		t.Errorf("unexpected calls difference: %s", diff)
+	}
+}
+
+func TestServerConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testDynamicValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	// Provider methods sleep to widen the window for concurrent RPCs to race
+	// on the lazily initialized server caches and provider configuration.
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+					time.Sleep(10 * time.Millisecond)
+
+					resp.DataSourceData = "test-provider-data"
+					resp.ResourceData = "test-provider-data"
+				},
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSource{
+								SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+									time.Sleep(10 * time.Millisecond)
+
+									resp.Schema = datasourceschema.Schema{
+										Attributes: map[string]datasourceschema.Attribute{
+											"test": datasourceschema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+									resp.TypeName = "test_data_source"
+								},
+							}
+						},
+					}
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									time.Sleep(10 * time.Millisecond)
+
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					time.Sleep(10 * time.Millisecond)
+				},
+			},
+		},
+	}
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 101)
+	diags := make(chan []*tfprotov6.Diagnostic, 101)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		resp, err := server.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{
+			Config: testNewDynamicValue(t, tftypes.Object{}, nil),
+		})
+
+		errs <- err
+		diags <- resp.Diagnostics
+	}()
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if i%2 == 0 {
+				resp, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+					Config:   testDynamicValue,
+					TypeName: "test_data_source",
+				})
+
+				errs <- err
+				diags <- resp.Diagnostics
+
+				return
+			}
+
+			resp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: testDynamicValue,
+				TypeName:     "test_resource",
+			})
+
+			errs <- err
+			diags <- resp.Diagnostics
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+	close(diags)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+
+	for d := range diags {
+		if len(d) > 0 {
+			t.Errorf("unexpected diagnostics: %v", d)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,31 @@ import (
 
 // ApplyResourceChange satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterApplyContext(ctx, &s.FrameworkServer, "ApplyResourceChange")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ApplyResourceChange", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ApplyResourceChange", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +45,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +53,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ApplyResourceChangeRequest(ctx, proto6Req, resource, resourceSchema, providerMetaSchema)
@@ -46,10 +61,16 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataConfig(ctx, "ApplyResourceChange", logging.ProtocolDataMessageRequest, "Config", fwReq.Config)
+	logProtocolDataPlan(ctx, "ApplyResourceChange", logging.ProtocolDataMessageRequest, "PlannedState", fwReq.PlannedState)
+	logProtocolDataState(ctx, "ApplyResourceChange", logging.ProtocolDataMessageRequest, "PriorState", fwReq.PriorState)
+
 	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
 
-	return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "ApplyResourceChange", logging.ProtocolDataMessageResponse, "NewState", fwResp.NewState)
+
+	return processResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 }
@@ -53,6 +53,32 @@ func TestServerApplyResourceChange(t *testing.T) {
 		},
 	}
 
+	// Update implementations in these tests do not call resp.State.Set(),
+	// so the new state does not match the planned state or configuration.
+	testUpdateInconsistentDiagnostics := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Provider Produced Inconsistent Result",
+			Detail: "After Update, the provider returned a value which does not match the planned value. " +
+				"Known planned values must not change and all values must be known after applying changes. " +
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+				"Planned Value: \"test-plannedstate-value\"\n" +
+				"Applied Value: <null>",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("test_computed"),
+		},
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Provider Set Non-Computed Attribute",
+			Detail: "After Update, the provider returned a value which does not match the configuration for an attribute which is not Computed. " +
+				"Only Computed attributes may be set by the provider. " +
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+				"Either mark the attribute as Computed in the schema or return the configuration value.\n\n" +
+				"Configuration Value: \"test-new-value\"\n" +
+				"State Value: \"test-old-value\"",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("test_required"),
+		},
+	}
+
 	testProviderMetaValue := testNewDynamicValue(t, testProviderMetaType, map[string]tftypes.Value{
 		"test_provider_meta_attribute": tftypes.NewValue(tftypes.String, "test-provider-meta-value"),
 	})
@@ -785,6 +811,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
 					"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
@@ -845,6 +872,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
 					"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
@@ -890,11 +918,11 @@ func TestServerApplyResourceChange(t *testing.T) {
 			request: &tfprotov6.ApplyResourceChangeRequest{
 				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
-					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
 				}),
 				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
-					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
 				}),
 				PriorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -904,6 +932,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
 					"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
@@ -969,6 +998,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
 					"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
@@ -1021,6 +1051,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			request: &tfprotov6.ApplyResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+				}),
 				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
 					"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
@@ -1038,6 +1072,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
 				// Intentionally old, Update implementation does not call resp.State.Set()
+				Diagnostics: testUpdateInconsistentDiagnostics,
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
 					"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
@@ -2,8 +2,10 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -12,17 +14,30 @@ import (
 
 // ConfigureProvider satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ConfigureProvider")
+	defer cancel()
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ConfigureProvider", "")
+	defer afterRPC()
+
 	fwResp := &provider.ConfigureResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ConfigureProvider", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
+	}
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ConfigureProviderRequest(ctx, proto6Req, providerSchema)
@@ -30,10 +45,10 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.Con
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
 
-	return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
 }
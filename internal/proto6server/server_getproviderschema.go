@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,13 +13,34 @@ import (
 
 // GetProviderSchema satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) GetProviderSchema(ctx context.Context, proto6Req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "GetProviderSchema")
+	defer cancel()
+
 	ctx = logging.InitContext(ctx)
 
-	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "GetProviderSchema", "")
+	defer afterRPC()
+
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "GetProviderSchema", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "GetProviderSchema", toproto6.GetProviderSchemaResponse(ctx, fwResp, s.schemaOptions())), nil
+	}
+
+	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
-	return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "GetProviderSchema", toproto6.GetProviderSchemaResponse(ctx, fwResp, s.schemaOptions())), nil
+}
+
+// schemaOptions returns the schema conversion options of the server.
+func (s *Server) schemaOptions() toproto6.SchemaOptions {
+	return toproto6.SchemaOptions{
+		DisableDescriptionDerivation: s.DisableDescriptionDerivation,
+	}
 }
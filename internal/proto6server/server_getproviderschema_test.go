@@ -513,71 +513,130 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 		t.Fatalf("unable to read multiple line JSON: %s", err)
 	}
 
+	testNormalizeRequestLogEntries(t, entries)
+
 	expectedEntries := []map[string]interface{}{
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Metadata",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ProviderTypeName lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider Metadata",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider Metadata",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "trace",
+			"@message":            "Checking ProviderSchema lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
+		},
+		{
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider Schema",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Metadata",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider Schema",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking ProviderSchema lock",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ResourceSchemas lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Schema",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ResourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Schema",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider Resources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking ResourceSchemas lock",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider Resources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking ResourceTypes lock",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking DataSourceSchemas lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Resources",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking DataSourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Resources",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Calling provider defined Provider DataSources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking DataSourceSchemas lock",
-			"@module":  "sdk.framework",
+			"@level":              "debug",
+			"@message":            "Called provider defined Provider DataSources",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "trace",
-			"@message": "Checking DataSourceTypes lock",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking DataSourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider DataSources",
-			"@module":  "sdk.framework",
+			"@level":              "trace",
+			"@message":            "Checking ResourceTypes lock",
+			"@module":             "sdk.framework",
+			"tf_framework_req_id": "test-request-id",
+			"tf_rpc":              "GetProviderSchema",
 		},
 		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider DataSources",
-			"@module":  "sdk.framework",
+			"@level":                   "debug",
+			"@message":                 "Completed framework request",
+			"@module":                  "sdk.framework",
+			"diagnostic_error_count":   float64(0),
+			"diagnostic_warning_count": float64(0),
+			"tf_framework_req_id":      "test-request-id",
+			"tf_req_duration_ms":       float64(0),
+			"tf_rpc":                   "GetProviderSchema",
 		},
 	}
 
@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,31 @@ import (
 
 // ImportResourceState satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ImportResourceState")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ImportResourceState", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ImportResourceState", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +45,7 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ImportResourceStateRequest(ctx, proto6Req, resource, resourceSchema)
@@ -38,10 +53,10 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
 
-	return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 }
@@ -2,27 +2,43 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 // PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "PlanResourceChange")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "PlanResourceChange", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "PlanResourceChange", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +46,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +54,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.PlanResourceChangeRequest(ctx, proto6Req, resource, resourceSchema, providerMetaSchema)
@@ -46,10 +62,27 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataConfig(ctx, "PlanResourceChange", logging.ProtocolDataMessageRequest, "Config", fwReq.Config)
+	logProtocolDataState(ctx, "PlanResourceChange", logging.ProtocolDataMessageRequest, "PriorState", fwReq.PriorState)
+	logProtocolDataPlan(ctx, "PlanResourceChange", logging.ProtocolDataMessageRequest, "ProposedNewState", fwReq.ProposedNewState)
+
 	s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
 
-	return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "PlanResourceChange", logging.ProtocolDataMessageResponse, "PlannedState", fwResp.PlannedState)
+
+	// Reuse the request data when the state is unchanged, such as during
+	// refresh-only plans, rather than marshaling an equal value.
+	if fwResp.PlannedState != nil && fwReq.ProposedNewState != nil && tfvalue.Equal(fwResp.PlannedState.Raw, fwReq.ProposedNewState.Raw) {
+		fwResp.PlannedState = nil
+
+		proto6Resp := toproto6.PlanResourceChangeResponse(ctx, fwResp)
+		proto6Resp.PlannedState = responseDynamicValue(s, proto6Req.ProposedNewState)
+
+		return processResponse(ctx, s, "PlanResourceChange", proto6Resp), nil
+	}
+
+	return processResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 }
@@ -392,9 +392,6 @@ func TestServerPlanResourceChange(t *testing.T) {
 					"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
 				}),
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test_required"),
-				},
 			},
 		},
 		"delete-request-priorstate": {
@@ -637,9 +634,6 @@ func TestServerPlanResourceChange(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.PlanResourceChangeResponse{
 				PlannedState: &testEmptyDynamicValue,
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test_required"),
-				},
 			},
 		},
 		"update-request-config": {
@@ -979,6 +973,49 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"update-response-plannedstate-unchanged": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			// The request data is JSON encoded to verify it is reused, as
+			// marshaling the plan would instead result in MessagePack data.
+			request: &tfprotov6.PlanResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				ProposedNewState: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"test_computed":"test-computed-value","test_required":"test-value"}`),
+				},
+				PriorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"test_computed":"test-computed-value","test_required":"test-value"}`),
+				},
+			},
+		},
 		"update-response-requiresreplace": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -1052,3 +1089,107 @@ func TestServerPlanResourceChange(t *testing.T) {
 		})
 	}
 }
+
+func TestServerPlanResourceChange_LegacyStateNormalization(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                 tftypes.String,
+			"optional_attribute": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"optional_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.ResourceWithLegacyStateNormalization{
+								Resource: &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = testSchema
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+								},
+								LegacyStateNormalizationPathsMethod: func(_ context.Context) path.Expressions {
+									return path.Expressions{
+										path.MatchRoot("optional_attribute"),
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	// State previously written by terraform-plugin-sdk.
+	upgradeResp, err := server.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+		RawState: testNewRawState(t, map[string]interface{}{
+			"id":                 "test-id-value",
+			"optional_attribute": "",
+		}),
+		TypeName: "test_resource",
+		Version:  0,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected UpgradeResourceState error: %s", err)
+	}
+
+	if len(upgradeResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected UpgradeResourceState diagnostics: %v", upgradeResp.Diagnostics)
+	}
+
+	expectedState := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+		"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	if diff := cmp.Diff(expectedState, upgradeResp.UpgradedState); diff != "" {
+		t.Errorf("unexpected upgraded state difference: %s", diff)
+	}
+
+	// Without normalization, the prior state empty string would differ from
+	// the null configuration and cause the computed id to become unknown.
+	planResp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, nil),
+			"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+		}),
+		PriorState: upgradeResp.UpgradedState,
+		ProposedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+			"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+		}),
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected PlanResourceChange error: %s", err)
+	}
+
+	expectedPlanResp := &tfprotov6.PlanResourceChangeResponse{
+		PlannedState: expectedState,
+	}
+
+	if diff := cmp.Diff(expectedPlanResp, planResp); diff != "" {
+		t.Errorf("unexpected PlanResourceChange response difference: %s", diff)
+	}
+}
@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,31 @@ import (
 
 // ReadDataSource satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ReadDataSource")
+	defer cancel()
+
+	ctx = logging.DataSourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ReadDataSource", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ReadDataSource", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ReadDataSource", toproto6.ReadDataSourceResponse(ctx, fwResp)), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadDataSource", toproto6.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +45,7 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadDataSource", toproto6.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +53,7 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadDataSource", toproto6.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ReadDataSourceRequest(ctx, proto6Req, dataSource, dataSourceSchema, providerMetaSchema)
@@ -46,10 +61,14 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadDataSource", toproto6.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataConfig(ctx, "ReadDataSource", logging.ProtocolDataMessageRequest, "Config", fwReq.Config)
+
 	s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
 
-	return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "ReadDataSource", logging.ProtocolDataMessageResponse, "State", fwResp.State)
+
+	return processResponse(ctx, s, "ReadDataSource", toproto6.ReadDataSourceResponse(ctx, fwResp)), nil
 }
@@ -2,27 +2,43 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 // ReadResource satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ReadResource")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ReadResource", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ReadResource", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ReadResource", toproto6.ReadResourceResponse(ctx, fwResp)), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadResource", toproto6.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +46,7 @@ func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadResource", toproto6.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -38,7 +54,7 @@ func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadResource", toproto6.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ReadResourceRequest(ctx, proto6Req, resource, resourceSchema, providerMetaSchema)
@@ -46,10 +62,25 @@ func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ReadResourceResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ReadResource", toproto6.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
+	logProtocolDataState(ctx, "ReadResource", logging.ProtocolDataMessageRequest, "CurrentState", fwReq.CurrentState)
+
 	s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
 
-	return toproto6.ReadResourceResponse(ctx, fwResp), nil
+	logProtocolDataState(ctx, "ReadResource", logging.ProtocolDataMessageResponse, "NewState", fwResp.NewState)
+
+	// Reuse the request data when the state is unchanged, such as during
+	// refresh-only plans, rather than marshaling an equal value.
+	if fwResp.NewState != nil && fwReq.CurrentState != nil && tfvalue.Equal(fwResp.NewState.Raw, fwReq.CurrentState.Raw) {
+		fwResp.NewState = nil
+
+		proto6Resp := toproto6.ReadResourceResponse(ctx, fwResp)
+		proto6Resp.NewState = responseDynamicValue(s, proto6Req.CurrentState)
+
+		return processResponse(ctx, s, "ReadResource", proto6Resp), nil
+	}
+
+	return processResponse(ctx, s, "ReadResource", toproto6.ReadResourceResponse(ctx, fwResp)), nil
 }
@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,13 +13,33 @@ import (
 
 // UpgradeResourceState satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "UpgradeResourceState")
+	defer cancel()
+
+	var typeName string
+
+	if proto6Req != nil {
+		typeName = proto6Req.TypeName
+		ctx = logging.ResourceContext(ctx, typeName)
+	}
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "UpgradeResourceState", typeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.UpgradeResourceStateResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "UpgradeResourceState", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
+	}
+
 	if proto6Req == nil {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
@@ -26,7 +47,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -34,7 +55,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.UpgradeResourceStateRequest(ctx, proto6Req, resource, resourceSchema)
@@ -42,10 +63,10 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
 
-	return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 }
@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,31 @@ import (
 
 // ValidateDataResourceConfig satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ValidateDataResourceConfig")
+	defer cancel()
+
+	ctx = logging.DataSourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ValidateDataResourceConfig", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ValidateDataResourceConfig", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +45,7 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ValidateDataSourceConfigRequest(ctx, proto6Req, dataSource, dataSourceSchema)
@@ -38,10 +53,10 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
 
-	return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 }
@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,30 @@ import (
 
 // ValidateProviderConfig satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ValidateProviderConfig")
+	defer cancel()
+
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ValidateProviderConfig", "")
+	defer afterRPC()
+
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ValidateProviderConfig", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
+	}
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ValidateProviderConfigRequest(ctx, proto6Req, providerSchema)
@@ -30,10 +44,10 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
 
-	return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
 }
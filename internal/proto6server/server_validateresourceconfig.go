@@ -2,6 +2,7 @@ package proto6server
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -12,17 +13,31 @@ import (
 
 // ValidateResourceConfig satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, cancel := s.RegisterContext(ctx, &s.FrameworkServer, "ValidateResourceConfig")
+	defer cancel()
+
+	ctx = logging.ResourceContext(ctx, proto6Req.TypeName)
 	ctx = logging.InitContext(ctx)
 
+	ctx, afterRPC := s.FrameworkServer.InstrumentRPC(ctx, "ValidateResourceConfig", proto6Req.TypeName)
+	defer afterRPC()
+
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	defer fwserver.LogRequestCompletion(ctx, time.Now(), &fwResp.Diagnostics)
+
+	fwResp.Diagnostics.Append(processRequest(ctx, s, "ValidateResourceConfig", proto6Req)...)
+
+	if fwResp.Diagnostics.HasError() {
+		return processResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -30,7 +45,7 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ValidateResourceConfigRequest(ctx, proto6Req, resource, resourceSchema)
@@ -38,10 +53,10 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+		return processResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
 
-	return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+	return processResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 }
@@ -5,10 +5,13 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -97,6 +100,48 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ValidateResourceConfigResponse{},
 		},
+		"request-config-invalid-diagnostics-detail-suffix": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					DiagnosticsDetailSuffix: "Report this issue at: https://example.com/issues",
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ValidateResourceConfigRequest{
+				Config: &tfprotov6.DynamicValue{
+					JSON: []byte(`{`),
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov6.ValidateResourceConfigResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Unable to Convert Configuration",
+						Detail: "An unexpected error was encountered when converting the configuration from the protocol type. " +
+							"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n" +
+							"Please report this to the provider developer:\n\n" +
+							"Unable to unmarshal DynamicValue: error reading object attribute key token: unexpected end of JSON input\n\n" +
+							"Report this issue at: https://example.com/issues",
+					},
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -163,3 +208,156 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServerValidateResourceConfig_NestedAttributeValidatorPaths(t *testing.T) {
+	t.Parallel()
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: testNestedObjectType},
+			"test_set":  tftypes.Set{ElementType: testNestedObjectType},
+		},
+	}
+
+	testListElementValue := tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+		"test_string": tftypes.NewValue(tftypes.String, "list-value"),
+	})
+
+	testSetElementValue := tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+		"test_string": tftypes.NewValue(tftypes.String, "set-value"),
+	})
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_list": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+			tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, nil),
+			}),
+			testListElementValue,
+		}),
+		"test_set": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+			testSetElementValue,
+		}),
+	})
+
+	testDynamicValue, err := tfprotov6.NewDynamicValue(testType, testValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+	}
+
+	testValidator := testvalidator.String{
+		ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeWarning(req.Path, "warning summary", "warning detail")
+			resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+		},
+	}
+
+	testNestedObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"test_string": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{testValidator},
+			},
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list": schema.ListNestedAttribute{
+				NestedObject: testNestedObject,
+				Optional:     true,
+			},
+			"test_set": schema.SetNestedAttribute{
+				NestedObject: testNestedObject,
+				Optional:     true,
+			},
+		},
+	}
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	got, err := server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		Config:   &testDynamicValue,
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testListPath := tftypes.NewAttributePath().
+		WithAttributeName("test_list").
+		WithElementKeyInt(1).
+		WithAttributeName("test_string")
+	testSetPath := tftypes.NewAttributePath().
+		WithAttributeName("test_set").
+		WithElementKeyValue(testSetElementValue).
+		WithAttributeName("test_string")
+
+	expected := &tfprotov6.ValidateResourceConfigResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Attribute: testListPath,
+				Severity:  tfprotov6.DiagnosticSeverityWarning,
+				Summary:   "warning summary",
+				Detail:    "warning detail",
+			},
+			{
+				Attribute: testListPath,
+				Severity:  tfprotov6.DiagnosticSeverityError,
+				Summary:   "error summary",
+				Detail:    "error detail",
+			},
+			{
+				Attribute: testSetPath,
+				Severity:  tfprotov6.DiagnosticSeverityWarning,
+				Summary:   "warning summary",
+				Detail:    "warning detail",
+			},
+			{
+				Attribute: testSetPath,
+				Severity:  tfprotov6.DiagnosticSeverityError,
+				Summary:   "error summary",
+				Detail:    "error detail",
+			},
+		},
+	}
+
+	// Attribute validation order is not guaranteed.
+	sortDiagnostics := cmpopts.SortSlices(func(a, b *tfprotov6.Diagnostic) bool {
+		return a.Attribute.String()+a.Summary < b.Attribute.String()+b.Summary
+	})
+
+	if diff := cmp.Diff(expected, got, sortDiagnostics); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
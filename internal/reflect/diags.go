@@ -13,7 +13,7 @@ import (
 func toTerraform5ValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
 	return diag.NewAttributeErrorDiagnostic(
 		path,
-		"Value Conversion Error",
+		diag.SummaryValueConversionError,
 		"An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 	)
 }
@@ -21,7 +21,7 @@ func toTerraform5ValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPa
 func toTerraformValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
 	return diag.NewAttributeErrorDiagnostic(
 		path,
-		"Value Conversion Error",
+		diag.SummaryValueConversionError,
 		"An unexpected error was encountered trying to convert the Attribute value into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 	)
 }
@@ -29,7 +29,7 @@ func toTerraformValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPat
 func validateValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
 	return diag.NewAttributeErrorDiagnostic(
 		path,
-		"Value Conversion Error",
+		diag.SummaryValueConversionError,
 		"An unexpected error was encountered trying to validate the Terraform value type. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 	)
 }
@@ -37,7 +37,7 @@ func validateValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
 func valueFromTerraformErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
 	return diag.NewAttributeErrorDiagnostic(
 		path,
-		"Value Conversion Error",
+		diag.SummaryValueConversionError,
 		"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 	)
 }
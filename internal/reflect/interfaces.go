@@ -33,7 +33,7 @@ func NewUnknownable(ctx context.Context, typ attr.Type, val tftypes.Value, targe
 		err := fmt.Errorf("cannot find SetUnknown method on type %s", receiver.Type().String())
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return target, diags
@@ -54,7 +54,7 @@ func NewUnknownable(ctx context.Context, typ attr.Type, val tftypes.Value, targe
 		underlyingErr = fmt.Errorf("reflection error: %w", underlyingErr)
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+underlyingErr.Error(),
 		)
 		return target, diags
@@ -127,7 +127,7 @@ func NewNullable(ctx context.Context, typ attr.Type, val tftypes.Value, target r
 		err := fmt.Errorf("cannot find SetNull method on type %s", receiver.Type().String())
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return target, diags
@@ -148,7 +148,7 @@ func NewNullable(ctx context.Context, typ attr.Type, val tftypes.Value, target r
 		underlyingErr = fmt.Errorf("reflection error: %w", underlyingErr)
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+underlyingErr.Error(),
 		)
 		return target, diags
@@ -214,7 +214,7 @@ func NewValueConverter(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 		err := fmt.Errorf("could not find FromTerraform5Type method on type %s", receiver.Type().String())
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return target, diags
@@ -232,7 +232,7 @@ func NewValueConverter(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 		underlyingErr = fmt.Errorf("reflection error: %w", underlyingErr)
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+underlyingErr.Error(),
 		)
 		return target, diags
@@ -31,7 +31,7 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 		err := fmt.Errorf("target must be a pointer, got %T, which is a %s", target, v.Kind())
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			fmt.Sprintf("An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\nPath: %s\nError: %s", path.String(), err.Error()),
 		)
 		return diags
@@ -52,13 +52,15 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	traceInto(ctx, val, target, path)
+
 	// if this isn't a valid reflect.Value, bail before we accidentally
 	// panic
 	if !target.IsValid() {
 		err := fmt.Errorf("invalid target")
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return target, diags
@@ -113,7 +115,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		if !opts.UnhandledUnknownAsEmpty {
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 					"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
 					fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested Type: %s", path.String(), target.Type(), reflect.TypeOf(typ.ValueType(ctx))),
@@ -137,7 +139,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 				"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
 				fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested `types` Type: %s\nSuggested Pointer Type: *%s", path.String(), target.Type(), reflect.TypeOf(typ.ValueType(ctx)), target.Type()),
@@ -187,7 +189,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		err := fmt.Errorf("don't know how to reflect %s into %s", val.Type(), target.Type())
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return target, diags
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
@@ -113,7 +114,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 		if err != nil {
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
@@ -129,7 +130,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 			err := fmt.Errorf("map keys must be strings, got %s", key.Type())
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert into a Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
@@ -176,7 +177,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 	if err != nil {
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert to map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return nil, diags
@@ -184,3 +185,128 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 
 	return attrVal, diags
 }
+
+// FromObjectMap returns an attr.Value, as produced by `typ`, from a map with
+// string keys. Each map key must match an attribute name of `typ` and each
+// attribute name of `typ` must have a map key.
+func FromObjectMap(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tfType := typ.TerraformType(ctx)
+
+	if val.IsNil() {
+		tfVal := tftypes.NewValue(tfType, nil)
+
+		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
+
+		if err != nil {
+			return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+		}
+
+		return attrVal, diags
+	}
+
+	attrTypes := typ.AttributeTypes()
+	objTypes := make(map[string]tftypes.Type, len(attrTypes))
+	objValues := make(map[string]tftypes.Value, len(attrTypes))
+
+	// Sort the keys so any diagnostics are deterministic.
+	keys := make([]string, 0, val.Len())
+
+	for _, key := range val.MapKeys() {
+		keys = append(keys, key.String())
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := attrTypes[key]; !ok {
+			err := fmt.Errorf("map key %q does not match any attribute in supplied attr.Type %T", key, typ)
+			diags.AddAttributeError(
+				path,
+				diag.SummaryValueConversionError,
+				"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+		}
+	}
+
+	attrNames := make([]string, 0, len(attrTypes))
+
+	for name := range attrTypes {
+		attrNames = append(attrNames, name)
+	}
+
+	sort.Strings(attrNames)
+
+	for _, name := range attrNames {
+		if !val.MapIndex(reflect.ValueOf(name).Convert(val.Type().Key())).IsValid() {
+			err := fmt.Errorf("map is missing key %q for attribute in supplied attr.Type %T", name, typ)
+			diags.AddAttributeError(
+				path,
+				diag.SummaryValueConversionError,
+				"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, name := range attrNames {
+		attrType := attrTypes[name]
+		attrPath := path.AtName(name)
+		mapValue := val.MapIndex(reflect.ValueOf(name).Convert(val.Type().Key()))
+
+		attrVal, attrValDiags := FromValue(ctx, attrType, mapValue.Interface(), attrPath)
+		diags.Append(attrValDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tfAttrVal, err := attrVal.ToTerraformValue(ctx)
+
+		if err != nil {
+			return nil, append(diags, toTerraformValueErrorDiag(err, attrPath))
+		}
+
+		if typeWithValidate, ok := attrType.(xattr.TypeWithValidate); ok {
+			diags.Append(typeWithValidate.Validate(ctx, tfAttrVal, attrPath)...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		objTypes[name] = attrType.TerraformType(ctx)
+		objValues[name] = tfAttrVal
+	}
+
+	tfVal := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: objTypes,
+	}, objValues)
+
+	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	ret, err := typ.ValueFromTerraform(ctx, tfVal)
+
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return ret, diags
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwnumber"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -38,10 +39,10 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 		}))
 		return target, diags
 	}
-	roundingError := fmt.Errorf("cannot store %s in %s", result.String(), target.Type())
+	roundingError := fmt.Errorf("cannot store %s in %s", diag.FormatNumber(result), target.Type())
 	roundingErrorDiag := diag.NewAttributeErrorDiagnostic(
 		path,
-		"Value Conversion Error",
+		diag.SummaryValueConversionError,
 		"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+roundingError.Error(),
 	)
 
@@ -176,13 +177,18 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			err := fmt.Errorf("unsure how to round %s and %f", acc, floatResult)
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return target, diags
 		}
 		return reflect.ValueOf(floatResult), diags
 	case reflect.Float64:
+		// Decimal values without an exact binary representation, such as
+		// 0.1, are converted the same as the Float64 type.
+		if floatResult, err := fwnumber.Float64(result); err == nil {
+			return reflect.ValueOf(floatResult), diags
+		}
 		floatResult, acc := result.Float64()
 		if acc != big.Exact && !opts.AllowRoundingNumbers {
 			return target, append(diags, roundingErrorDiag)
@@ -196,7 +202,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 				err := fmt.Errorf("not sure how to round %s and %f", acc, floatResult)
 				diags.AddAttributeError(
 					path,
-					"Value Conversion Error",
+					diag.SummaryValueConversionError,
 					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 				)
 				return target, diags
@@ -210,7 +216,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 				err := fmt.Errorf("not sure how to round %s and %f", acc, floatResult)
 				diags.AddAttributeError(
 					path,
-					"Value Conversion Error",
+					diag.SummaryValueConversionError,
 					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 				)
 				return target, diags
@@ -219,7 +225,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			err := fmt.Errorf("not sure how to round %s and %f", acc, floatResult)
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return target, diags
@@ -229,7 +235,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 	err = fmt.Errorf("cannot convert number to %s", target.Type())
 	diags.AddAttributeError(
 		path,
-		"Value Conversion Error",
+		diag.SummaryValueConversionError,
 		"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 	)
 	return target, diags
@@ -148,7 +148,7 @@ func TestNumber_intOverflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store "+overflowInt.String()+" in int",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store "+diag.FormatNumber(overflowInt)+" in int",
 		),
 	}
 
@@ -186,7 +186,7 @@ func TestNumber_intUnderflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store "+underflowInt.String()+" in int",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store "+diag.FormatNumber(underflowInt)+" in int",
 		),
 	}
 
@@ -476,7 +476,7 @@ func TestNumber_int64OverflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 9.223372037e+18 in int64",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 9223372036854775808 in int64",
 		),
 	}
 
@@ -487,6 +487,45 @@ func TestNumber_int64OverflowError(t *testing.T) {
 	}
 }
 
+func TestNumber_int64LargeError(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+	large, _, _ := big.ParseFloat("1e100", 10, 512, big.ToNearestEven)
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1e+100 in int64",
+		),
+	}
+
+	_, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, large), reflect.ValueOf(n), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNumber_int64FractionalError(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1.5 in int64",
+		),
+	}
+
+	_, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 1.5), reflect.ValueOf(n), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
 func TestNumber_int64Underflow(t *testing.T) {
 	t.Parallel()
 
@@ -512,7 +551,7 @@ func TestNumber_int64UnderflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store -9.223372037e+18 in int64",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store -9223372036854777856 in int64",
 		),
 	}
 
@@ -565,7 +604,7 @@ func TestNumber_uintOverflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store "+overflowUint.String()+" in uint",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store "+diag.FormatNumber(overflowUint)+" in uint",
 		),
 	}
 
@@ -891,7 +930,7 @@ func TestNumber_uint64OverflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1.844674407e+19 in uint64",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 18446744073709551616 in uint64",
 		),
 	}
 
@@ -967,7 +1006,7 @@ func TestNumber_float32OverflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1.797693135e+308 in float32",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1.7976931348623157e+308 in float32",
 		),
 	}
 
@@ -1003,7 +1042,7 @@ func TestNumber_float32UnderflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 4.940656458e-324 in float32",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 4.940656458412465e-324 in float32",
 		),
 	}
 
@@ -1029,6 +1068,42 @@ func TestNumber_float64(t *testing.T) {
 	}
 }
 
+func TestNumber_float64Decimal(t *testing.T) {
+	t.Parallel()
+
+	var n float64
+	decimal, _, _ := big.ParseFloat("0.1", 10, 512, big.ToNearestEven)
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, decimal), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 0.1 {
+		t.Errorf("Expected %v, got %v", 0.1, n)
+	}
+}
+
+func TestNumber_float64PrecisionLossError(t *testing.T) {
+	t.Parallel()
+
+	var n float64
+	value, _, _ := big.ParseFloat("9007199254740993", 10, 512, big.ToNearestEven)
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 9007199254740993 in float64",
+		),
+	}
+
+	_, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, value), reflect.ValueOf(n), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
 func TestNumber_float64Overflow(t *testing.T) {
 	t.Parallel()
 
@@ -1054,7 +1129,7 @@ func TestNumber_float64OverflowError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1e+10000 in float64",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1.0000000000000001e+10000 in float64",
 		),
 	}
 
@@ -1162,7 +1237,7 @@ func TestNumber_float64UnderflowNegativeError(t *testing.T) {
 		diag.NewAttributeErrorDiagnostic(
 			path.Empty(),
 			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store -1e-1000 in float64",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store -1.0000000000000000001e-1000 in float64",
 		),
 	}
 
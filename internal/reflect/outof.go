@@ -19,6 +19,8 @@ import (
 func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	traceFromValue(ctx, typ, val, path)
+
 	if v, ok := val.(attr.Value); ok {
 		return FromAttributeValue(ctx, typ, v, path)
 	}
@@ -46,7 +48,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 			err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithAttributeTypes to hold %T", val, typ, typ, val)
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
@@ -67,12 +69,16 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	case reflect.Slice:
 		return FromSlice(ctx, typ, value, path)
 	case reflect.Map:
+		if t, ok := typ.(attr.TypeWithAttributeTypes); ok && value.Type().Key().Kind() == reflect.String {
+			return FromObjectMap(ctx, t, value, path)
+		}
+
 		t, ok := typ.(attr.TypeWithElementType)
 		if !ok {
 			err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
@@ -84,7 +90,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 		err := fmt.Errorf("cannot construct attr.Type from %T (%s)", val, kind)
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return nil, diags
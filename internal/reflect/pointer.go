@@ -82,7 +82,7 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 		err := fmt.Errorf("cannot use type %s as a pointer", value.Type())
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert from pointer value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return nil, diags
@@ -103,7 +103,7 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 		if err != nil {
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert from pointer value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
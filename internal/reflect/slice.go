@@ -72,7 +72,7 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 			if err != nil {
 				diags.AddAttributeError(
 					path,
-					"Value Conversion Error",
+					diag.SummaryValueConversionError,
 					"An unexpected error was encountered trying to convert to slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 				)
 				return target, diags
@@ -126,7 +126,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 		if err != nil {
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
@@ -140,7 +140,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 		err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return nil, diags
@@ -202,7 +202,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 	if err != nil {
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return nil, diags
@@ -126,6 +126,8 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			}))
 			return target, diags
 		}
+		traceStructField(ctx, target.Type(), structFieldPos, path.AtName(field))
+
 		structField := result.Field(structFieldPos)
 		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.AtName(field))
 		diags.Append(fieldValDiags...)
@@ -157,7 +159,7 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		err = fmt.Errorf("error retrieving field names from struct tags: %w", err)
 		diags.AddAttributeError(
 			path,
-			"Value Conversion Error",
+			diag.SummaryValueConversionError,
 			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return nil, diags
@@ -168,6 +170,8 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		path := path.AtName(name)
 		fieldValue := val.Field(fieldNo)
 
+		traceStructField(ctx, val.Type(), fieldNo, path)
+
 		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
 		diags.Append(attrValDiags...)
 
@@ -180,7 +184,7 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 			err := fmt.Errorf("couldn't find type information for attribute at %s in supplied attr.Type %T", path, typ)
 			diags.AddAttributeError(
 				path,
-				"Value Conversion Error",
+				diag.SummaryValueConversionError,
 				"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 			)
 			return nil, diags
@@ -0,0 +1,70 @@
+package reflect
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// traceInto logs a reflection step of converting a Terraform value into a Go
+// value, if enabled by the TF_LOG_SDK_FRAMEWORK_REFLECT environment variable.
+func traceInto(ctx context.Context, val tftypes.Value, target reflect.Value, path path.Path) {
+	if !logging.FrameworkReflectTraceEnabled() {
+		return
+	}
+
+	fields := map[string]interface{}{
+		logging.KeyAttributePath: path.String(),
+	}
+
+	if val.Type() != nil {
+		fields[logging.KeyReflectSourceType] = val.Type().String()
+	}
+
+	if target.IsValid() {
+		fields[logging.KeyReflectTargetType] = target.Type().String()
+	}
+
+	logging.FrameworkTrace(ctx, "Converting Terraform value into Go value", fields)
+}
+
+// traceFromValue logs a reflection step of converting a Go value into a
+// framework value, if enabled by the TF_LOG_SDK_FRAMEWORK_REFLECT environment
+// variable.
+func traceFromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) {
+	if !logging.FrameworkReflectTraceEnabled() {
+		return
+	}
+
+	fields := map[string]interface{}{
+		logging.KeyAttributePath: path.String(),
+	}
+
+	if val != nil {
+		fields[logging.KeyReflectSourceType] = reflect.TypeOf(val).String()
+	}
+
+	if typ != nil {
+		fields[logging.KeyReflectTargetType] = typ.String()
+	}
+
+	logging.FrameworkTrace(ctx, "Converting Go value into framework value", fields)
+}
+
+// traceStructField logs the Go struct field associated with an attribute
+// path, if enabled by the TF_LOG_SDK_FRAMEWORK_REFLECT environment variable.
+func traceStructField(ctx context.Context, structType reflect.Type, fieldNo int, path path.Path) {
+	if !logging.FrameworkReflectTraceEnabled() {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Mapping Go struct field to attribute", map[string]interface{}{
+		logging.KeyAttributePath:  path.String(),
+		logging.KeyReflectGoField: structType.String() + "." + structType.Field(fieldNo).Name,
+	})
+}
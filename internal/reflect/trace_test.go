@@ -0,0 +1,174 @@
+package reflect_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Tests in this file cannot be parallel as they set the
+// TF_LOG_SDK_FRAMEWORK_REFLECT environment variable.
+
+type testTraceModel struct {
+	Name string `tfsdk:"name"`
+}
+
+var testTraceType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+	},
+}
+
+func TestInto_trace(t *testing.T) {
+	testCases := map[string]struct {
+		env             string
+		expectedEntries []map[string]interface{}
+	}{
+		"disabled": {
+			env:             "",
+			expectedEntries: nil,
+		},
+		"enabled": {
+			env: "1",
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":                 "trace",
+					"@message":               "Converting Terraform value into Go value",
+					"@module":                "sdk.framework",
+					"tf_attribute_path":      "",
+					"tf_reflect_source_type": `tftypes.Object["name":tftypes.String]`,
+					"tf_reflect_target_type": "reflect_test.testTraceModel",
+				},
+				{
+					"@level":              "trace",
+					"@message":            "Mapping Go struct field to attribute",
+					"@module":             "sdk.framework",
+					"tf_attribute_path":   "name",
+					"tf_reflect_go_field": "reflect_test.testTraceModel.Name",
+				},
+				{
+					"@level":                 "trace",
+					"@message":               "Converting Terraform value into Go value",
+					"@module":                "sdk.framework",
+					"tf_attribute_path":      "name",
+					"tf_reflect_source_type": "tftypes.String",
+					"tf_reflect_target_type": "string",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(logging.EnvTfLogSdkFrameworkReflect, testCase.env)
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			val := tftypes.NewValue(testTraceType.TerraformType(ctx), map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			})
+
+			var target testTraceModel
+
+			diags := refl.Into(ctx, testTraceType, val, &target, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expectedEntries); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_trace(t *testing.T) {
+	testCases := map[string]struct {
+		env             string
+		expectedEntries []map[string]interface{}
+	}{
+		"disabled": {
+			env:             "",
+			expectedEntries: nil,
+		},
+		"enabled": {
+			env: "1",
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":                 "trace",
+					"@message":               "Converting Go value into framework value",
+					"@module":                "sdk.framework",
+					"tf_attribute_path":      "",
+					"tf_reflect_source_type": "reflect_test.testTraceModel",
+					"tf_reflect_target_type": "types.ObjectType[\"name\":basetypes.StringType]",
+				},
+				{
+					"@level":              "trace",
+					"@message":            "Mapping Go struct field to attribute",
+					"@module":             "sdk.framework",
+					"tf_attribute_path":   "name",
+					"tf_reflect_go_field": "reflect_test.testTraceModel.Name",
+				},
+				{
+					"@level":                 "trace",
+					"@message":               "Converting Go value into framework value",
+					"@module":                "sdk.framework",
+					"tf_attribute_path":      "name",
+					"tf_reflect_source_type": "string",
+					"tf_reflect_target_type": "basetypes.StringType",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(logging.EnvTfLogSdkFrameworkReflect, testCase.env)
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			_, diags := refl.FromValue(ctx, testTraceType, testTraceModel{Name: "test"}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expectedEntries); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
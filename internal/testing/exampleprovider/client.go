@@ -0,0 +1,106 @@
+package exampleprovider
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// client is an in-memory remote API for things, which enables the provider
+// to be exercised without network access.
+type client struct {
+	endpoint string
+
+	mutex  sync.Mutex
+	nextID int
+	things map[string]thing
+}
+
+// thing is the remote API representation of a thing.
+type thing struct {
+	ID          string
+	Name        string
+	Description string
+	Tags        map[string]string
+	Settings    *thingSettings
+	Rules       []thingRule
+}
+
+// thingSettings is the remote API representation of thing settings.
+type thingSettings struct {
+	Enabled bool
+	Level   int64
+}
+
+// thingRule is the remote API representation of a thing rule.
+type thingRule struct {
+	Port     int64
+	Protocol string
+}
+
+// newClient returns a client with no things.
+func newClient(endpoint string) *client {
+	return &client{
+		endpoint: endpoint,
+		things:   make(map[string]thing),
+	}
+}
+
+// CreateThing stores the thing with a new identifier, which is returned.
+func (c *client) CreateThing(t thing) thing {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.nextID++
+
+	t.ID = "thing-" + strconv.Itoa(c.nextID)
+	c.things[t.ID] = t
+
+	return t
+}
+
+// ReadThing returns the thing with the identifier and whether it exists.
+func (c *client) ReadThing(id string) (thing, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t, ok := c.things[id]
+
+	return t, ok
+}
+
+// FindThing returns the thing with the name and whether it exists.
+func (c *client) FindThing(name string) (thing, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, t := range c.things {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return thing{}, false
+}
+
+// UpdateThing replaces the existing thing with the same identifier.
+func (c *client) UpdateThing(t thing) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.things[t.ID]; !ok {
+		return fmt.Errorf("thing %q not found", t.ID)
+	}
+
+	c.things[t.ID] = t
+
+	return nil
+}
+
+// DeleteThing removes the thing with the identifier, if it exists.
+func (c *client) DeleteThing(id string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.things, id)
+}
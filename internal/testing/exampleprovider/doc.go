@@ -0,0 +1,17 @@
+// Package exampleprovider contains a complete example provider which is
+// implemented only with public framework packages, similar to how providers
+// are developed. It doubles as an integration test fixture, where the tests
+// serve the provider with providerserver and exercise it through the
+// protocol, so framework changes are validated against realistic usage.
+//
+// The provider manages remote "things" via an in-memory client, so no
+// network access is required. It includes:
+//
+//   - A provider schema with a custom endpoint attribute
+//   - The example_thing resource, with nested attributes, defaults, plan
+//     modifiers, import, and a state upgrader from a prior schema version
+//   - The example_thing data source
+//
+// Provider-defined functions and ephemeral resources are not included, as
+// they are not supported by this version of the framework.
+package exampleprovider
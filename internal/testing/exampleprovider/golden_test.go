@@ -0,0 +1,533 @@
+package exampleprovider_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Tests in this file compare protocol responses of the example provider with
+// a corpus of golden files, which guards against accidental changes to the
+// protocol wire behavior of the framework, such as when rebasing on
+// upstream.
+//
+// Each testdata/golden/NAME.request.json file is converted into a protocol
+// request, where values are encoded with MessagePack like Terraform, and
+// served by a configured provider server. The response is normalized into
+// JSON and compared with testdata/golden/NAME.response.json. After an
+// intentional behavior change, review and regenerate the response files
+// with:
+//
+//	go test ./internal/testing/exampleprovider -run TestGolden -update
+
+var update = flag.Bool("update", false, "update golden response files")
+
+// goldenUnknown represents an unknown value in golden files.
+const goldenUnknown = "<unknown>"
+
+// goldenRequest is the format of golden request files. Value fields are
+// JSON representations of the schema data, where omitted object attributes
+// are null and goldenUnknown is an unknown value.
+type goldenRequest struct {
+	RPC              string          `json:"rpc"`
+	TypeName         string          `json:"type_name"`
+	ID               string          `json:"id"`
+	Version          int64           `json:"version"`
+	RawState         json.RawMessage `json:"raw_state"`
+	Config           json.RawMessage `json:"config"`
+	CurrentState     json.RawMessage `json:"current_state"`
+	PlannedState     json.RawMessage `json:"planned_state"`
+	PriorState       json.RawMessage `json:"prior_state"`
+	ProposedNewState json.RawMessage `json:"proposed_new_state"`
+}
+
+func TestGolden(t *testing.T) {
+	t.Parallel()
+
+	requestFiles, err := filepath.Glob(filepath.Join("testdata", "golden", "*.request.json"))
+
+	if err != nil {
+		t.Fatalf("unable to list golden request files: %s", err)
+	}
+
+	if len(requestFiles) == 0 {
+		t.Fatal("expected golden request files")
+	}
+
+	for _, requestFile := range requestFiles {
+		requestFile := requestFile
+		name := strings.TrimSuffix(filepath.Base(requestFile), ".request.json")
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			requestBytes, err := os.ReadFile(requestFile)
+
+			if err != nil {
+				t.Fatalf("unable to read request file: %s", err)
+			}
+
+			var request goldenRequest
+
+			if err := json.Unmarshal(requestBytes, &request); err != nil {
+				t.Fatalf("unable to unmarshal request file: %s", err)
+			}
+
+			got := testGoldenResponse(t, request)
+
+			responseFile := strings.TrimSuffix(requestFile, ".request.json") + ".response.json"
+
+			if *update {
+				if err := os.WriteFile(responseFile, got, 0o644); err != nil {
+					t.Fatalf("unable to write response file: %s", err)
+				}
+
+				return
+			}
+
+			expected, err := os.ReadFile(responseFile)
+
+			if err != nil {
+				t.Fatalf("unable to read response file, which can be created with -update: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), string(expected)); diff != "" {
+				t.Errorf("unexpected response difference, review and regenerate with -update if intentional: %s", diff)
+			}
+		})
+	}
+}
+
+// testGoldenResponse serves the golden request and returns the normalized
+// JSON response.
+func testGoldenResponse(t *testing.T, request goldenRequest) []byte {
+	t.Helper()
+
+	ctx := context.Background()
+	server := testConfiguredServer(t)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected GetProviderSchema error: %s", err)
+	}
+
+	var valueType tftypes.Type
+	var resp interface{}
+
+	switch request.RPC {
+	case "GetProviderSchema":
+		resp = schemaResp
+	case "ValidateProviderConfig":
+		valueType = schemaResp.Provider.ValueType()
+		resp, err = server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{
+			Config: testGoldenDynamicValue(t, valueType, request.Config),
+		})
+	case "ValidateResourceConfig":
+		valueType = testGoldenSchema(t, schemaResp.ResourceSchemas, request.TypeName).ValueType()
+		resp, err = server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			Config:   testGoldenDynamicValue(t, valueType, request.Config),
+			TypeName: request.TypeName,
+		})
+	case "ValidateDataResourceConfig":
+		valueType = testGoldenSchema(t, schemaResp.DataSourceSchemas, request.TypeName).ValueType()
+		resp, err = server.ValidateDataResourceConfig(ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+			Config:   testGoldenDynamicValue(t, valueType, request.Config),
+			TypeName: request.TypeName,
+		})
+	case "PlanResourceChange":
+		valueType = testGoldenSchema(t, schemaResp.ResourceSchemas, request.TypeName).ValueType()
+		resp, err = server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			Config:           testGoldenDynamicValue(t, valueType, request.Config),
+			PriorState:       testGoldenDynamicValue(t, valueType, request.PriorState),
+			ProposedNewState: testGoldenDynamicValue(t, valueType, request.ProposedNewState),
+			TypeName:         request.TypeName,
+		})
+	case "ApplyResourceChange":
+		valueType = testGoldenSchema(t, schemaResp.ResourceSchemas, request.TypeName).ValueType()
+		resp, err = server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+			Config:       testGoldenDynamicValue(t, valueType, request.Config),
+			PlannedState: testGoldenDynamicValue(t, valueType, request.PlannedState),
+			PriorState:   testGoldenDynamicValue(t, valueType, request.PriorState),
+			TypeName:     request.TypeName,
+		})
+	case "ReadResource":
+		valueType = testGoldenSchema(t, schemaResp.ResourceSchemas, request.TypeName).ValueType()
+		resp, err = server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+			CurrentState: testGoldenDynamicValue(t, valueType, request.CurrentState),
+			TypeName:     request.TypeName,
+		})
+	case "UpgradeResourceState":
+		valueType = testGoldenSchema(t, schemaResp.ResourceSchemas, request.TypeName).ValueType()
+		resp, err = server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+			RawState: &tfprotov6.RawState{
+				JSON: request.RawState,
+			},
+			TypeName: request.TypeName,
+			Version:  request.Version,
+		})
+	case "ImportResourceState":
+		valueType = testGoldenSchema(t, schemaResp.ResourceSchemas, request.TypeName).ValueType()
+		resp, err = server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+			ID:       request.ID,
+			TypeName: request.TypeName,
+		})
+	case "ReadDataSource":
+		valueType = testGoldenSchema(t, schemaResp.DataSourceSchemas, request.TypeName).ValueType()
+		resp, err = server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+			Config:   testGoldenDynamicValue(t, valueType, request.Config),
+			TypeName: request.TypeName,
+		})
+	default:
+		t.Fatalf("unsupported golden request RPC: %q", request.RPC)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected %s error: %s", request.RPC, err)
+	}
+
+	normalized, err := testGoldenNormalize(reflect.ValueOf(resp), valueType)
+
+	if err != nil {
+		t.Fatalf("unable to normalize response: %s", err)
+	}
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(normalized); err != nil {
+		t.Fatalf("unable to marshal response: %s", err)
+	}
+
+	return buf.Bytes()
+}
+
+// testGoldenSchema returns the schema of the type name.
+func testGoldenSchema(t *testing.T, schemas map[string]*tfprotov6.Schema, typeName string) *tfprotov6.Schema {
+	t.Helper()
+
+	schema, ok := schemas[typeName]
+
+	if !ok {
+		t.Fatalf("missing schema for type: %q", typeName)
+	}
+
+	return schema
+}
+
+// testGoldenDynamicValue returns a MessagePack encoded DynamicValue of the
+// golden JSON value. A missing JSON value is an entirely null value.
+func testGoldenDynamicValue(t *testing.T, typ tftypes.Type, data json.RawMessage) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	var decoded interface{}
+
+	if len(data) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&decoded); err != nil {
+			t.Fatalf("unable to decode value: %s", err)
+		}
+	}
+
+	value, err := testGoldenToValue(typ, decoded)
+
+	if err != nil {
+		t.Fatalf("unable to convert value: %s", err)
+	}
+
+	return testDynamicValue(t, typ, value)
+}
+
+// testGoldenToValue converts a decoded golden JSON value into a
+// tftypes.Value.
+func testGoldenToValue(typ tftypes.Type, in interface{}) (tftypes.Value, error) {
+	if in == goldenUnknown {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if in == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		object, ok := in.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected object for %s, got: %T", typ, in)
+		}
+
+		attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+		for name, attributeType := range typ.AttributeTypes {
+			attribute, err := testGoldenToValue(attributeType, object[name])
+
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	case tftypes.Map:
+		object, ok := in.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected object for %s, got: %T", typ, in)
+		}
+
+		elements := make(map[string]tftypes.Value, len(object))
+
+		for key, element := range object {
+			elementValue, err := testGoldenToValue(typ.ElementType, element)
+
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", key, err)
+			}
+
+			elements[key] = elementValue
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.List, tftypes.Set:
+		array, ok := in.([]interface{})
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected array for %s, got: %T", typ, in)
+		}
+
+		var elementType tftypes.Type
+
+		if list, ok := typ.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = typ.(tftypes.Set).ElementType
+		}
+
+		elements := make([]tftypes.Value, 0, len(array))
+
+		for index, element := range array {
+			elementValue, err := testGoldenToValue(elementType, element)
+
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%d]: %w", index, err)
+			}
+
+			elements = append(elements, elementValue)
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, in), nil
+	case typ.Is(tftypes.Number):
+		number, ok := in.(json.Number)
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected number, got: %T", in)
+		}
+
+		f, _, err := big.ParseFloat(number.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, in), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unsupported type: %s", typ)
+}
+
+// testGoldenFromValue converts a tftypes.Value into a golden JSON value.
+// Null object attributes are omitted.
+func testGoldenFromValue(in tftypes.Value) (interface{}, error) {
+	if !in.IsKnown() {
+		return goldenUnknown, nil
+	}
+
+	if in.IsNull() {
+		return nil, nil
+	}
+
+	typ := in.Type()
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		return b, in.As(&b)
+	case typ.Is(tftypes.Number):
+		var f big.Float
+
+		if err := in.As(&f); err != nil {
+			return nil, err
+		}
+
+		return json.Number(f.Text('g', -1)), nil
+	case typ.Is(tftypes.String):
+		var s string
+
+		return s, in.As(&s)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := in.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make([]interface{}, 0, len(elements))
+
+		for _, element := range elements {
+			value, err := testGoldenFromValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, value)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := in.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]interface{}, len(elements))
+
+		for key, element := range elements {
+			if typ.Is(tftypes.Object{}) && element.IsNull() {
+				continue
+			}
+
+			value, err := testGoldenFromValue(element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = value
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", typ)
+}
+
+// testGoldenNormalize converts a protocol response into a value which is
+// deterministically marshaled into JSON. DynamicValue are decoded with the
+// given type, zero values are omitted, and types with a String method, such
+// as attribute paths and enumerations, use that representation.
+func testGoldenNormalize(in reflect.Value, valueType tftypes.Type) (interface{}, error) {
+	if !in.IsValid() {
+		return nil, nil
+	}
+
+	if in.Kind() == reflect.Ptr || in.Kind() == reflect.Interface {
+		if in.IsNil() {
+			return nil, nil
+		}
+	}
+
+	switch value := in.Interface().(type) {
+	case *tfprotov6.DynamicValue:
+		tfValue, err := value.Unmarshal(valueType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return testGoldenFromValue(tfValue)
+	case tftypes.Type:
+		return value.String(), nil
+	case []byte:
+		return string(value), nil
+	case fmt.Stringer:
+		return value.String(), nil
+	}
+
+	switch in.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return testGoldenNormalize(in.Elem(), valueType)
+	case reflect.Struct:
+		result := make(map[string]interface{})
+
+		for i := 0; i < in.NumField(); i++ {
+			field := in.Type().Field(i)
+
+			if !field.IsExported() || in.Field(i).IsZero() {
+				continue
+			}
+
+			value, err := testGoldenNormalize(in.Field(i), valueType)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field.Name, err)
+			}
+
+			result[field.Name] = value
+		}
+
+		return result, nil
+	case reflect.Slice:
+		result := make([]interface{}, 0, in.Len())
+
+		for i := 0; i < in.Len(); i++ {
+			value, err := testGoldenNormalize(in.Index(i), valueType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, value)
+		}
+
+		return result, nil
+	case reflect.Map:
+		result := make(map[string]interface{}, in.Len())
+		keys := in.MapKeys()
+
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+		for _, key := range keys {
+			value, err := testGoldenNormalize(in.MapIndex(key), valueType)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			result[key.String()] = value
+		}
+
+		return result, nil
+	}
+
+	return in.Interface(), nil
+}
@@ -0,0 +1,84 @@
+package exampleprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/endpoint"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// defaultEndpoint is the endpoint when the provider endpoint attribute is
+// not configured.
+const defaultEndpoint = "https://api.example.com"
+
+var _ provider.Provider = &exampleProvider{}
+
+// exampleProvider is the provider implementation.
+type exampleProvider struct {
+	version string
+}
+
+// exampleProviderModel describes the provider configuration data.
+type exampleProviderModel struct {
+	Endpoint endpoint.Value `tfsdk:"endpoint"`
+}
+
+// New returns the example provider with the given version.
+func New(version string) provider.Provider {
+	return &exampleProvider{
+		version: version,
+	}
+}
+
+// Metadata returns the provider type name and version.
+func (p *exampleProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "example"
+	resp.Version = p.version
+}
+
+// Schema returns the provider schema.
+func (p *exampleProvider) Schema(ctx context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages things with the example API.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint": endpoint.Attribute(ctx, endpoint.Opts{
+				Description: "Example API endpoint URL. Defaults to " + defaultEndpoint + ".",
+				Schemes:     []string{"http", "https"},
+			}),
+		},
+	}
+}
+
+// Configure creates the client, which is shared by resources and data
+// sources.
+func (p *exampleProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data exampleProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := newClient(data.Endpoint.Endpoint(defaultEndpoint))
+
+	resp.DataSourceData = c
+	resp.ResourceData = c
+}
+
+// DataSources returns the provider data sources.
+func (p *exampleProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newThingDataSource,
+	}
+}
+
+// Resources returns the provider resources.
+func (p *exampleProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newThingResource,
+	}
+}
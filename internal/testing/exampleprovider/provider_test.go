@@ -0,0 +1,563 @@
+package exampleprovider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/interfacecheck"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/exampleprovider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+)
+
+var (
+	testProviderType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"endpoint": tftypes.String,
+		},
+	}
+
+	testSettingsType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"level":   tftypes.Number,
+		},
+	}
+
+	testRuleType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"port":     tftypes.Number,
+			"protocol": tftypes.String,
+		},
+	}
+
+	testThingType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":          tftypes.String,
+			"name":        tftypes.String,
+			"description": tftypes.String,
+			"tags":        tftypes.Map{ElementType: tftypes.String},
+			"settings":    testSettingsType,
+			"rules":       tftypes.List{ElementType: testRuleType},
+		},
+	}
+)
+
+func TestProviderInterfaces(t *testing.T) {
+	t.Parallel()
+
+	interfacecheck.Provider(context.Background(), t, exampleprovider.New("test"))
+}
+
+func TestProviderGetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	server := providerserver.NewProtocol6(exampleprovider.New("test"))()
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testNoDiagnostics(t, resp.Diagnostics)
+
+	thingResourceSchema, ok := resp.ResourceSchemas["example_thing"]
+
+	if !ok {
+		t.Fatalf("expected example_thing resource schema, got: %v", resp.ResourceSchemas)
+	}
+
+	if thingResourceSchema.Version != 1 {
+		t.Errorf("expected example_thing resource schema version 1, got: %d", thingResourceSchema.Version)
+	}
+
+	if _, ok := resp.DataSourceSchemas["example_thing"]; !ok {
+		t.Errorf("expected example_thing data source schema, got: %v", resp.DataSourceSchemas)
+	}
+}
+
+func TestProviderValidateProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		endpoint      tftypes.Value
+		expectedError bool
+	}{
+		"null": {
+			endpoint: tftypes.NewValue(tftypes.String, nil),
+		},
+		"http": {
+			endpoint: tftypes.NewValue(tftypes.String, "http://localhost:8080/"),
+		},
+		"invalid-scheme": {
+			endpoint:      tftypes.NewValue(tftypes.String, "ftp://localhost"),
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := providerserver.NewProtocol6(exampleprovider.New("test"))()
+
+			resp, err := server.ValidateProviderConfig(context.Background(), &tfprotov6.ValidateProviderConfigRequest{
+				Config: testDynamicValue(t, testProviderType, map[string]tftypes.Value{
+					"endpoint": testCase.endpoint,
+				}),
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := testHasError(resp.Diagnostics); got != testCase.expectedError {
+				t.Errorf("expected error diagnostics %t, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderThingLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := testConfiguredServer(t)
+
+	config := tftypes.NewValue(testThingType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, nil),
+		"name":        tftypes.NewValue(tftypes.String, "one"),
+		"description": tftypes.NewValue(tftypes.String, nil),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		"settings": tftypes.NewValue(testSettingsType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, true),
+			"level":   tftypes.NewValue(tftypes.Number, nil),
+		}),
+		"rules": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+			tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+				"port":     tftypes.NewValue(tftypes.Number, 443),
+				"protocol": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	})
+
+	// Create plan with defaults and unknown computed values.
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		Config:           testDynamicValue(t, testThingType, config),
+		PriorState:       testDynamicValue(t, testThingType, nil),
+		ProposedNewState: testDynamicValue(t, testThingType, config),
+		TypeName:         "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected PlanResourceChange error: %s", err)
+	}
+
+	testNoDiagnostics(t, planResp.Diagnostics)
+
+	expectedPlan := testThingValue(tftypes.UnknownValue, "one")
+
+	testValueEqual(t, planResp.PlannedState, expectedPlan)
+
+	if len(planResp.RequiresReplace) > 0 {
+		t.Errorf("unexpected create RequiresReplace: %v", planResp.RequiresReplace)
+	}
+
+	// Create apply.
+	applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		Config:         testDynamicValue(t, testThingType, config),
+		PlannedPrivate: planResp.PlannedPrivate,
+		PlannedState:   planResp.PlannedState,
+		PriorState:     testDynamicValue(t, testThingType, nil),
+		TypeName:       "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ApplyResourceChange error: %s", err)
+	}
+
+	testNoDiagnostics(t, applyResp.Diagnostics)
+
+	expectedState := testThingValue("thing-1", "one")
+
+	testValueEqual(t, applyResp.NewState, expectedState)
+
+	// Refresh.
+	readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		CurrentState: applyResp.NewState,
+		Private:      applyResp.Private,
+		TypeName:     "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadResource error: %s", err)
+	}
+
+	testNoDiagnostics(t, readResp.Diagnostics)
+	testValueEqual(t, readResp.NewState, expectedState)
+
+	// Update plan which requires replacement and preserves the identifier.
+	updatedConfig := testWithAttribute(config, "name", tftypes.NewValue(tftypes.String, "two"))
+	updatedProposedNewState := testWithAttribute(
+		testWithAttribute(updatedConfig, "id", tftypes.NewValue(tftypes.String, "thing-1")),
+		"description", tftypes.NewValue(tftypes.String, ""),
+	)
+
+	updatePlanResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		Config:           testDynamicValue(t, testThingType, updatedConfig),
+		PriorPrivate:     readResp.Private,
+		PriorState:       readResp.NewState,
+		ProposedNewState: testDynamicValue(t, testThingType, updatedProposedNewState),
+		TypeName:         "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected PlanResourceChange error: %s", err)
+	}
+
+	testNoDiagnostics(t, updatePlanResp.Diagnostics)
+	testValueEqual(t, updatePlanResp.PlannedState, testThingValue("thing-1", "two"))
+
+	expectedRequiresReplace := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("name"),
+	}
+
+	if diff := cmp.Diff(updatePlanResp.RequiresReplace, expectedRequiresReplace); diff != "" {
+		t.Errorf("unexpected RequiresReplace difference: %s", diff)
+	}
+
+	// Data source lookup by name.
+	dataSourceConfig := tftypes.NewValue(testThingType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, nil),
+		"name":        tftypes.NewValue(tftypes.String, "one"),
+		"description": tftypes.NewValue(tftypes.String, nil),
+		"tags":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"settings":    tftypes.NewValue(testSettingsType, nil),
+		"rules":       tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+	})
+
+	readDataSourceResp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		Config:   testDynamicValue(t, testThingType, dataSourceConfig),
+		TypeName: "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadDataSource error: %s", err)
+	}
+
+	testNoDiagnostics(t, readDataSourceResp.Diagnostics)
+	testValueEqual(t, readDataSourceResp.State, expectedState)
+
+	// Import and refresh.
+	importResp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		ID:       "thing-1",
+		TypeName: "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ImportResourceState error: %s", err)
+	}
+
+	testNoDiagnostics(t, importResp.Diagnostics)
+
+	if len(importResp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got: %d", len(importResp.ImportedResources))
+	}
+
+	importReadResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		CurrentState: importResp.ImportedResources[0].State,
+		Private:      importResp.ImportedResources[0].Private,
+		TypeName:     "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadResource error: %s", err)
+	}
+
+	testNoDiagnostics(t, importReadResp.Diagnostics)
+	testValueEqual(t, importReadResp.NewState, expectedState)
+
+	// Delete apply.
+	deleteResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		Config:       testDynamicValue(t, testThingType, nil),
+		PlannedState: testDynamicValue(t, testThingType, nil),
+		PriorState:   readResp.NewState,
+		TypeName:     "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ApplyResourceChange error: %s", err)
+	}
+
+	testNoDiagnostics(t, deleteResp.Diagnostics)
+
+	// Refresh after deletion removes the resource.
+	deletedReadResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		CurrentState: readResp.NewState,
+		TypeName:     "example_thing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadResource error: %s", err)
+	}
+
+	testNoDiagnostics(t, deletedReadResp.Diagnostics)
+	testValueEqual(t, deletedReadResp.NewState, tftypes.NewValue(testThingType, nil))
+}
+
+func TestProviderThingUpgradeResourceState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawState []byte
+		expected tftypes.Value
+	}{
+		"enabled": {
+			rawState: []byte(`{"id":"thing-1","name":"one","enabled":false}`),
+			expected: tftypes.NewValue(testThingType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "thing-1"),
+				"name":        tftypes.NewValue(tftypes.String, "one"),
+				"description": tftypes.NewValue(tftypes.String, ""),
+				"tags":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"settings": tftypes.NewValue(testSettingsType, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, false),
+					"level":   tftypes.NewValue(tftypes.Number, 1),
+				}),
+				"rules": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+			}),
+		},
+		"enabled-null": {
+			rawState: []byte(`{"id":"thing-1","name":"one","enabled":null}`),
+			expected: tftypes.NewValue(testThingType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "thing-1"),
+				"name":        tftypes.NewValue(tftypes.String, "one"),
+				"description": tftypes.NewValue(tftypes.String, ""),
+				"tags":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"settings":    tftypes.NewValue(testSettingsType, nil),
+				"rules":       tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testConfiguredServer(t)
+
+			resp, err := server.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					JSON: testCase.rawState,
+				},
+				TypeName: "example_thing",
+				Version:  0,
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testNoDiagnostics(t, resp.Diagnostics)
+			testValueEqual(t, resp.UpgradedState, testCase.expected)
+		})
+	}
+}
+
+func TestProviderThingValidateDataResourceConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id            tftypes.Value
+		name          tftypes.Value
+		expectedError bool
+	}{
+		"id": {
+			id:   tftypes.NewValue(tftypes.String, "thing-1"),
+			name: tftypes.NewValue(tftypes.String, nil),
+		},
+		"name": {
+			id:   tftypes.NewValue(tftypes.String, nil),
+			name: tftypes.NewValue(tftypes.String, "one"),
+		},
+		"neither": {
+			id:            tftypes.NewValue(tftypes.String, nil),
+			name:          tftypes.NewValue(tftypes.String, nil),
+			expectedError: true,
+		},
+		"both": {
+			id:            tftypes.NewValue(tftypes.String, "thing-1"),
+			name:          tftypes.NewValue(tftypes.String, "one"),
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := providerserver.NewProtocol6(exampleprovider.New("test"))()
+
+			config := tftypes.NewValue(testThingType, map[string]tftypes.Value{
+				"id":          testCase.id,
+				"name":        testCase.name,
+				"description": tftypes.NewValue(tftypes.String, nil),
+				"tags":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"settings":    tftypes.NewValue(testSettingsType, nil),
+				"rules":       tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+			})
+
+			resp, err := server.ValidateDataResourceConfig(context.Background(), &tfprotov6.ValidateDataResourceConfigRequest{
+				Config:   testDynamicValue(t, testThingType, config),
+				TypeName: "example_thing",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := testHasError(resp.Diagnostics); got != testCase.expectedError {
+				t.Errorf("expected error diagnostics %t, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testConfiguredServer returns a protocol version 6 server for the example
+// provider which has been configured.
+func testConfiguredServer(t *testing.T) tfprotov6.ProviderServer {
+	t.Helper()
+
+	server := providerserver.NewProtocol6(exampleprovider.New("test"))()
+
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{
+		Config: testDynamicValue(t, testProviderType, map[string]tftypes.Value{
+			"endpoint": tftypes.NewValue(tftypes.String, "http://localhost:8080/"),
+		}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ConfigureProvider error: %s", err)
+	}
+
+	testNoDiagnostics(t, resp.Diagnostics)
+
+	return server
+}
+
+// testThingValue returns the example_thing value matching the configuration
+// in TestProviderThingLifecycle, with defaults applied.
+func testThingValue(id interface{}, name string) tftypes.Value {
+	return tftypes.NewValue(testThingType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, id),
+		"name":        tftypes.NewValue(tftypes.String, name),
+		"description": tftypes.NewValue(tftypes.String, ""),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		"settings": tftypes.NewValue(testSettingsType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, true),
+			"level":   tftypes.NewValue(tftypes.Number, 1),
+		}),
+		"rules": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+			tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+				"port":     tftypes.NewValue(tftypes.Number, 443),
+				"protocol": tftypes.NewValue(tftypes.String, "tcp"),
+			}),
+		}),
+	})
+}
+
+// testWithAttribute returns a copy of the object value with the attribute
+// value replaced.
+func testWithAttribute(value tftypes.Value, name string, attribute tftypes.Value) tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+
+	if err := value.As(&attributes); err != nil {
+		panic(err)
+	}
+
+	result := make(map[string]tftypes.Value, len(attributes))
+
+	for k, v := range attributes {
+		result[k] = v
+	}
+
+	result[name] = attribute
+
+	return tftypes.NewValue(value.Type(), result)
+}
+
+// testDynamicValue returns a DynamicValue of the value, which can be a
+// tftypes.Value or the value argument to tftypes.NewValue.
+func testDynamicValue(t *testing.T, typ tftypes.Type, value interface{}) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	tfValue, ok := value.(tftypes.Value)
+
+	if !ok {
+		tfValue = tftypes.NewValue(typ, value)
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(typ, tfValue)
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	return &dynamicValue
+}
+
+// testValueEqual compares the DynamicValue with the expected value.
+func testValueEqual(t *testing.T, got *tfprotov6.DynamicValue, expected tftypes.Value) {
+	t.Helper()
+
+	if got == nil {
+		t.Fatalf("expected value %s, got nil", expected)
+	}
+
+	gotValue, err := got.Unmarshal(expected.Type())
+
+	if err != nil {
+		t.Fatalf("unable to unmarshal DynamicValue: %s", err)
+	}
+
+	if diff := cmp.Diff(gotValue, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
+
+// testNoDiagnostics fails the test if there are any diagnostics.
+func testNoDiagnostics(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	if t.Failed() {
+		t.FailNow()
+	}
+}
+
+// testHasError returns true if there are any error diagnostics.
+func testHasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
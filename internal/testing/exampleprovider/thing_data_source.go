@@ -0,0 +1,197 @@
+package exampleprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &thingDataSource{}
+	_ datasource.DataSourceWithConfigure        = &thingDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &thingDataSource{}
+)
+
+// thingDataSource is the example_thing data source implementation.
+type thingDataSource struct {
+	client *client
+}
+
+// newThingDataSource returns a new example_thing data source.
+func newThingDataSource() datasource.DataSource {
+	return &thingDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *thingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thing"
+}
+
+// Schema returns the data source schema.
+func (d *thingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a thing by identifier or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Thing identifier.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Thing name.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Thing description.",
+			},
+			"tags": schema.MapAttribute{
+				Computed:    true,
+				Description: "Thing tags.",
+				ElementType: types.StringType,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the thing is enabled.",
+					},
+					"level": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Thing level.",
+					},
+				},
+				Computed:    true,
+				Description: "Thing settings.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Thing rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Rule port.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule protocol.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators returns validators which require exactly one of the id
+// or name attributes.
+func (d *thingDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		exactlyOneOfValidator{
+			paths: []path.Path{
+				path.Root("id"),
+				path.Root("name"),
+			},
+		},
+	}
+}
+
+// Configure saves the provider client.
+func (d *thingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client)
+
+	if !ok {
+		resp.Diagnostics.Append(datasource.NewUnexpectedProviderDataTypeDiagnostic(&client{}, req.ProviderData))
+
+		return
+	}
+
+	d.client = c
+}
+
+// Read reads the thing and saves its data into state.
+func (d *thingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data thingModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var t thing
+	var ok bool
+
+	if !data.ID.IsNull() {
+		t, ok = d.client.ReadThing(data.ID.ValueString())
+	} else {
+		t, ok = d.client.FindThing(data.Name.ValueString())
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Thing Not Found",
+			fmt.Sprintf("No thing was found with id %s or name %s.", data.ID, data.Name),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, t)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// exactlyOneOfValidator is a data source configuration validator which
+// requires exactly one of the paths to be configured.
+type exactlyOneOfValidator struct {
+	paths []path.Path
+}
+
+// Description returns a plaintext description of the validator.
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Exactly one of these attributes must be configured: %s", v.paths)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateDataSource returns an error if not exactly one of the paths is
+// configured. Unknown values are considered configured.
+func (v exactlyOneOfValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var configured int
+
+	for _, p := range v.paths {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &value)...)
+
+		if !value.IsNull() {
+			configured++
+		}
+	}
+
+	if configured != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			v.Description(ctx),
+		)
+	}
+}
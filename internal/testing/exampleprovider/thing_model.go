@@ -0,0 +1,143 @@
+package exampleprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// thingModel describes the example_thing resource and data source data.
+type thingModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+	Settings    types.Object `tfsdk:"settings"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+// thingSettingsModel describes the settings nested attribute data.
+type thingSettingsModel struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Level   types.Int64 `tfsdk:"level"`
+}
+
+// thingRuleModel describes the rules nested attribute data.
+type thingRuleModel struct {
+	Port     types.Int64  `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+// thingSettingsAttributeTypes are the attribute types of the settings nested
+// attribute.
+var thingSettingsAttributeTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+	"level":   types.Int64Type,
+}
+
+// thingRuleAttributeTypes are the attribute types of each rules nested
+// attribute object.
+var thingRuleAttributeTypes = map[string]attr.Type{
+	"port":     types.Int64Type,
+	"protocol": types.StringType,
+}
+
+// toAPI returns the remote API representation of the model. Null
+// collections and objects are converted to nil, while empty collections are
+// preserved, so the data can be round-tripped.
+func (m thingModel) toAPI(ctx context.Context) (thing, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := thing{
+		ID:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		result.Tags = make(map[string]string, len(m.Tags.Elements()))
+
+		diags.Append(m.Tags.ElementsAs(ctx, &result.Tags, false)...)
+	}
+
+	if !m.Settings.IsNull() && !m.Settings.IsUnknown() {
+		var settings thingSettingsModel
+
+		diags.Append(m.Settings.As(ctx, &settings, basetypes.ObjectAsOptions{})...)
+
+		result.Settings = &thingSettings{
+			Enabled: settings.Enabled.ValueBool(),
+			Level:   settings.Level.ValueInt64(),
+		}
+	}
+
+	if !m.Rules.IsNull() && !m.Rules.IsUnknown() {
+		var rules []thingRuleModel
+
+		diags.Append(m.Rules.ElementsAs(ctx, &rules, false)...)
+
+		result.Rules = make([]thingRule, 0, len(rules))
+
+		for _, rule := range rules {
+			result.Rules = append(result.Rules, thingRule{
+				Port:     rule.Port.ValueInt64(),
+				Protocol: rule.Protocol.ValueString(),
+			})
+		}
+	}
+
+	return result, diags
+}
+
+// fromAPI sets the model data from the remote API representation.
+func (m *thingModel) fromAPI(ctx context.Context, t thing) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(t.ID)
+	m.Name = types.StringValue(t.Name)
+	m.Description = types.StringValue(t.Description)
+	m.Tags = types.MapNull(types.StringType)
+	m.Settings = types.ObjectNull(thingSettingsAttributeTypes)
+	m.Rules = types.ListNull(types.ObjectType{AttrTypes: thingRuleAttributeTypes})
+
+	if t.Tags != nil {
+		tags, tagsDiags := types.MapValueFrom(ctx, types.StringType, t.Tags)
+
+		diags.Append(tagsDiags...)
+
+		m.Tags = tags
+	}
+
+	if t.Settings != nil {
+		settings, settingsDiags := types.ObjectValueFrom(ctx, thingSettingsAttributeTypes, thingSettingsModel{
+			Enabled: types.BoolValue(t.Settings.Enabled),
+			Level:   types.Int64Value(t.Settings.Level),
+		})
+
+		diags.Append(settingsDiags...)
+
+		m.Settings = settings
+	}
+
+	if t.Rules != nil {
+		rules := make([]thingRuleModel, 0, len(t.Rules))
+
+		for _, rule := range t.Rules {
+			rules = append(rules, thingRuleModel{
+				Port:     types.Int64Value(rule.Port),
+				Protocol: types.StringValue(rule.Protocol),
+			})
+		}
+
+		rulesValue, rulesDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: thingRuleAttributeTypes}, rules)
+
+		diags.Append(rulesDiags...)
+
+		m.Rules = rulesValue
+	}
+
+	return diags
+}
@@ -0,0 +1,298 @@
+package exampleprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &thingResource{}
+	_ resource.ResourceWithConfigure    = &thingResource{}
+	_ resource.ResourceWithImportState  = &thingResource{}
+	_ resource.ResourceWithUpgradeState = &thingResource{}
+)
+
+// thingResource is the example_thing resource implementation.
+type thingResource struct {
+	client *client
+}
+
+// newThingResource returns a new example_thing resource.
+func newThingResource() resource.Resource {
+	return &thingResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *thingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thing"
+}
+
+// Schema returns the resource schema. Version 1 moved the version 0 enabled
+// attribute into the settings nested attribute.
+func (r *thingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a thing.",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Thing identifier.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Thing name. Changing this replaces the thing.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Thing description.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Thing tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether the thing is enabled.",
+						Optional:    true,
+					},
+					"level": schema.Int64Attribute{
+						Computed:    true,
+						Default:     int64default.StaticInt64(1),
+						Description: "Thing level.",
+						Optional:    true,
+					},
+				},
+				Description: "Thing settings.",
+				Optional:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Thing rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Description: "Rule port.",
+							Required:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Computed:    true,
+							Default:     stringdefault.StaticString("tcp"),
+							Description: "Rule protocol.",
+							Optional:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure saves the provider client.
+func (r *thingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client)
+
+	if !ok {
+		resp.Diagnostics.Append(resource.NewUnexpectedProviderDataTypeDiagnostic(&client{}, req.ProviderData))
+
+		return
+	}
+
+	r.client = c
+}
+
+// Create creates the thing and saves its data into state.
+func (r *thingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data thingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, diags := data.toAPI(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, r.client.CreateThing(t))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the thing data in state, removing the thing from state if
+// it no longer exists.
+func (r *thingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data thingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, ok := r.client.ReadThing(data.ID.ValueString())
+
+	if !ok {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPI(ctx, t)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the thing and saves its data into state.
+func (r *thingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data thingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, diags := data.toAPI(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateThing(t); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Thing",
+			fmt.Sprintf("An unexpected error occurred while updating the thing: %s", err),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the thing.
+func (r *thingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data thingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DeleteThing(data.ID.ValueString())
+}
+
+// ImportState imports the thing by its identifier.
+func (r *thingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// thingResourceModelV0 describes the version 0 example_thing resource data.
+type thingResourceModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// UpgradeState returns the state upgraders from prior schema versions.
+func (r *thingResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+			StateUpgrader: r.upgradeStateV0,
+		},
+	}
+}
+
+// upgradeStateV0 moves the version 0 enabled attribute into the settings
+// nested attribute.
+func (r *thingResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorData thingResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgradedData := thingModel{
+		ID:          priorData.ID,
+		Name:        priorData.Name,
+		Description: types.StringValue(""),
+		Tags:        types.MapNull(types.StringType),
+		Settings:    types.ObjectNull(thingSettingsAttributeTypes),
+		Rules:       types.ListNull(types.ObjectType{AttrTypes: thingRuleAttributeTypes}),
+	}
+
+	if !priorData.Enabled.IsNull() {
+		settings, diags := types.ObjectValueFrom(ctx, thingSettingsAttributeTypes, thingSettingsModel{
+			Enabled: priorData.Enabled,
+			Level:   types.Int64Value(1),
+		})
+
+		resp.Diagnostics.Append(diags...)
+
+		upgradedData.Settings = settings
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgradedData)...)
+}
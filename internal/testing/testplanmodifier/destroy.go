@@ -0,0 +1,27 @@
+package testplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.String = &StringWithDestroy{}
+var _ planmodifier.Destroy = &StringWithDestroy{}
+
+// Declarative planmodifier.String with planmodifier.Destroy for unit testing.
+type StringWithDestroy struct {
+	String
+
+	// Destroy interface methods
+	PlanModifyDestroyMethod func(context.Context, planmodifier.DestroyRequest, *planmodifier.DestroyResponse)
+}
+
+// PlanModifyDestroy satisfies the planmodifier.Destroy interface.
+func (v StringWithDestroy) PlanModifyDestroy(ctx context.Context, req planmodifier.DestroyRequest, resp *planmodifier.DestroyResponse) {
+	if v.PlanModifyDestroyMethod == nil {
+		return
+	}
+
+	v.PlanModifyDestroyMethod(ctx, req, resp)
+}
@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var _ datasource.DataSource = &DataSourceWithAttributeRequirements{}
+var _ datasource.DataSourceWithAttributeRequirements = &DataSourceWithAttributeRequirements{}
+
+// Declarative datasource.DataSourceWithAttributeRequirements for unit testing.
+type DataSourceWithAttributeRequirements struct {
+	*DataSource
+
+	// DataSourceWithAttributeRequirements interface methods
+	AttributeRequirementsMethod func(context.Context) []capability.AttributeRequirement
+}
+
+// AttributeRequirements satisfies the datasource.DataSourceWithAttributeRequirements interface.
+func (p *DataSourceWithAttributeRequirements) AttributeRequirements(ctx context.Context) []capability.AttributeRequirement {
+	if p.AttributeRequirementsMethod == nil {
+		return nil
+	}
+
+	return p.AttributeRequirementsMethod(ctx)
+}
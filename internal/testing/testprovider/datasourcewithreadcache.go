@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var _ datasource.DataSource = &DataSourceWithReadCache{}
+var _ datasource.DataSourceWithReadCache = &DataSourceWithReadCache{}
+
+// Declarative datasource.DataSourceWithReadCache for unit testing.
+type DataSourceWithReadCache struct {
+	*DataSource
+
+	// DataSourceWithReadCache interface methods
+	ReadCacheMethod func(context.Context) *datasource.ReadCache
+}
+
+// ReadCache satisfies the datasource.DataSourceWithReadCache interface.
+func (d *DataSourceWithReadCache) ReadCache(ctx context.Context) *datasource.ReadCache {
+	if d.ReadCacheMethod == nil {
+		return nil
+	}
+
+	return d.ReadCacheMethod(ctx)
+}
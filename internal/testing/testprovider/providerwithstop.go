@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithStop{}
+var _ provider.ProviderWithStop = &ProviderWithStop{}
+
+// Declarative provider.ProviderWithStop for unit testing.
+type ProviderWithStop struct {
+	*Provider
+
+	// ProviderWithStop interface methods
+	StopMethod func(context.Context) diag.Diagnostics
+}
+
+// Stop satisfies the provider.ProviderWithStop interface.
+func (p *ProviderWithStop) Stop(ctx context.Context) diag.Diagnostics {
+	if p.StopMethod == nil {
+		return nil
+	}
+
+	return p.StopMethod(ctx)
+}
@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithAttributeRequirements{}
+var _ resource.ResourceWithAttributeRequirements = &ResourceWithAttributeRequirements{}
+
+// Declarative resource.ResourceWithAttributeRequirements for unit testing.
+type ResourceWithAttributeRequirements struct {
+	*Resource
+
+	// ResourceWithAttributeRequirements interface methods
+	AttributeRequirementsMethod func(context.Context) []capability.AttributeRequirement
+}
+
+// AttributeRequirements satisfies the resource.ResourceWithAttributeRequirements interface.
+func (p *ResourceWithAttributeRequirements) AttributeRequirements(ctx context.Context) []capability.AttributeRequirement {
+	if p.AttributeRequirementsMethod == nil {
+		return nil
+	}
+
+	return p.AttributeRequirementsMethod(ctx)
+}
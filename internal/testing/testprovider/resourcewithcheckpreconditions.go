@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithCheckPreconditions{}
+var _ resource.ResourceWithCheckPreconditions = &ResourceWithCheckPreconditions{}
+
+// Declarative resource.ResourceWithCheckPreconditions for unit testing.
+type ResourceWithCheckPreconditions struct {
+	*Resource
+
+	// ResourceWithCheckPreconditions interface methods
+	CheckPreconditionsMethod func(context.Context, resource.CheckPreconditionsRequest, *resource.CheckPreconditionsResponse)
+}
+
+// CheckPreconditions satisfies the resource.ResourceWithCheckPreconditions interface.
+func (p *ResourceWithCheckPreconditions) CheckPreconditions(ctx context.Context, req resource.CheckPreconditionsRequest, resp *resource.CheckPreconditionsResponse) {
+	if p.CheckPreconditionsMethod == nil {
+		return
+	}
+
+	p.CheckPreconditionsMethod(ctx, req, resp)
+}
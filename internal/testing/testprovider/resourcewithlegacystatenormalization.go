@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithLegacyStateNormalization{}
+var _ resource.ResourceWithLegacyStateNormalization = &ResourceWithLegacyStateNormalization{}
+
+// Declarative resource.ResourceWithLegacyStateNormalization for unit testing.
+type ResourceWithLegacyStateNormalization struct {
+	*Resource
+
+	// ResourceWithLegacyStateNormalization interface methods
+	LegacyStateNormalizationPathsMethod func(context.Context) path.Expressions
+}
+
+// LegacyStateNormalizationPaths satisfies the resource.ResourceWithLegacyStateNormalization interface.
+func (p *ResourceWithLegacyStateNormalization) LegacyStateNormalizationPaths(ctx context.Context) path.Expressions {
+	if p.LegacyStateNormalizationPathsMethod == nil {
+		return nil
+	}
+
+	return p.LegacyStateNormalizationPathsMethod(ctx)
+}
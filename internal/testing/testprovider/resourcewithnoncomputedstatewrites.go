@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithNonComputedStateWrites{}
+var _ resource.ResourceWithNonComputedStateWrites = &ResourceWithNonComputedStateWrites{}
+
+// Declarative resource.ResourceWithNonComputedStateWrites for unit testing.
+type ResourceWithNonComputedStateWrites struct {
+	*Resource
+
+	// ResourceWithNonComputedStateWrites interface methods
+	AllowNonComputedStateWritesMethod func(context.Context) bool
+}
+
+// AllowNonComputedStateWrites satisfies the resource.ResourceWithNonComputedStateWrites interface.
+func (p *ResourceWithNonComputedStateWrites) AllowNonComputedStateWrites(ctx context.Context) bool {
+	if p.AllowNonComputedStateWritesMethod == nil {
+		return false
+	}
+
+	return p.AllowNonComputedStateWritesMethod(ctx)
+}
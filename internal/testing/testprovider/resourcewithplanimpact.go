@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithPlanImpact{}
+var _ resource.ResourceWithPlanImpact = &ResourceWithPlanImpact{}
+
+// Declarative resource.ResourceWithPlanImpact for unit testing.
+type ResourceWithPlanImpact struct {
+	*Resource
+
+	// ResourceWithPlanImpact interface methods
+	PlanImpactMethod func(context.Context, resource.PlanImpactRequest, *resource.PlanImpactResponse)
+}
+
+// PlanImpact satisfies the resource.ResourceWithPlanImpact interface.
+func (p *ResourceWithPlanImpact) PlanImpact(ctx context.Context, req resource.PlanImpactRequest, resp *resource.PlanImpactResponse) {
+	if p.PlanImpactMethod == nil {
+		return
+	}
+
+	p.PlanImpactMethod(ctx, req, resp)
+}
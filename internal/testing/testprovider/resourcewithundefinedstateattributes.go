@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithUndefinedStateAttributes{}
+var _ resource.ResourceWithUndefinedStateAttributes = &ResourceWithUndefinedStateAttributes{}
+
+// Declarative resource.ResourceWithUndefinedStateAttributes for unit testing.
+type ResourceWithUndefinedStateAttributes struct {
+	*Resource
+
+	// ResourceWithUndefinedStateAttributes interface methods
+	UndefinedStateAttributesMethod func(context.Context) resource.UndefinedStateAttributesBehavior
+}
+
+// UndefinedStateAttributes satisfies the resource.ResourceWithUndefinedStateAttributes interface.
+func (p *ResourceWithUndefinedStateAttributes) UndefinedStateAttributes(ctx context.Context) resource.UndefinedStateAttributesBehavior {
+	if p.UndefinedStateAttributesMethod == nil {
+		return resource.UndefinedStateAttributesBehaviorIgnore
+	}
+
+	return p.UndefinedStateAttributesMethod(ctx)
+}
@@ -3,20 +3,25 @@ package testschema
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwschema.Attribute = Attribute{}
+var (
+	_ fwschema.Attribute                           = Attribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = Attribute{}
+)
 
 type Attribute struct {
-	Computed            bool
-	DeprecationMessage  string
-	Description         string
-	MarkdownDescription string
-	Optional            bool
-	Required            bool
-	Sensitive           bool
-	Type                attr.Type
+	Computed               bool
+	DeprecationMessage     string
+	DeprecationReplacement path.Expression
+	Description            string
+	MarkdownDescription    string
+	Optional               bool
+	Required               bool
+	Sensitive              bool
+	Type                   attr.Type
 }
 
 // ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
@@ -40,6 +45,12 @@ func (a Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement satisfies the
+// fwschema.AttributeWithDeprecationReplacement interface.
+func (a Attribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription satisfies the fwschema.Attribute interface.
 func (a Attribute) GetDescription() string {
 	return a.Description
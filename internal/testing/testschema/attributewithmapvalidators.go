@@ -9,13 +9,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwxschema.AttributeWithMapValidators = AttributeWithMapValidators{}
+var (
+	_ fwxschema.AttributeWithMapKeyValidators = AttributeWithMapValidators{}
+	_ fwxschema.AttributeWithMapValidators    = AttributeWithMapValidators{}
+)
 
 type AttributeWithMapValidators struct {
 	Computed            bool
 	DeprecationMessage  string
 	Description         string
 	ElementType         attr.Type
+	KeyValidators       []validator.String
 	MarkdownDescription string
 	Optional            bool
 	Required            bool
@@ -81,6 +85,11 @@ func (a AttributeWithMapValidators) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// MapKeyValidators satisfies the fwxschema.AttributeWithMapKeyValidators interface.
+func (a AttributeWithMapValidators) MapKeyValidators() []validator.String {
+	return a.KeyValidators
+}
+
 // MapValidators satisfies the fwxschema.AttributeWithMapValidators interface.
 func (a AttributeWithMapValidators) MapValidators() []validator.Map {
 	return a.Validators
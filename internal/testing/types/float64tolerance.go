@@ -0,0 +1,92 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.Float64Typable                    = Float64ToleranceType{}
+	_ basetypes.Float64ValuableWithSemanticEquals = Float64Tolerance{}
+)
+
+// Float64ToleranceTolerance is the maximum absolute difference between
+// semantically equal Float64Tolerance values.
+const Float64ToleranceTolerance = 1e-9
+
+// Float64ToleranceType is a float64 type with values that are semantically
+// equal within Float64ToleranceTolerance.
+type Float64ToleranceType struct {
+	basetypes.Float64Type
+}
+
+func (t Float64ToleranceType) Equal(o attr.Type) bool {
+	_, ok := o.(Float64ToleranceType)
+
+	return ok
+}
+
+func (t Float64ToleranceType) String() string {
+	return "testtypes.Float64ToleranceType"
+}
+
+func (t Float64ToleranceType) ValueFromFloat64(_ context.Context, in basetypes.Float64Value) (basetypes.Float64Valuable, diag.Diagnostics) {
+	return Float64Tolerance{Float64Value: in}, nil
+}
+
+func (t Float64ToleranceType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Float64Type.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	float64Value, ok := attrValue.(basetypes.Float64Value)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Float64Tolerance{Float64Value: float64Value}, nil
+}
+
+// ValueType returns the Value type.
+func (t Float64ToleranceType) ValueType(_ context.Context) attr.Value {
+	return Float64Tolerance{}
+}
+
+// Float64Tolerance is a float64 value which is semantically equal to other
+// values within Float64ToleranceTolerance.
+type Float64Tolerance struct {
+	basetypes.Float64Value
+}
+
+func (f Float64Tolerance) Equal(o attr.Value) bool {
+	other, ok := o.(Float64Tolerance)
+
+	if !ok {
+		return false
+	}
+
+	return f.Float64Value.Equal(other.Float64Value)
+}
+
+func (f Float64Tolerance) Float64SemanticEquals(ctx context.Context, o basetypes.Float64Valuable) (bool, diag.Diagnostics) {
+	other, diags := o.ToFloat64Value(ctx)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return math.Abs(f.ValueFloat64()-other.ValueFloat64()) <= Float64ToleranceTolerance, diags
+}
+
+func (f Float64Tolerance) Type(_ context.Context) attr.Type {
+	return Float64ToleranceType{}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable  = CaseInsensitiveStringType{}
+	_ basetypes.StringValuable = CaseInsensitiveString{}
+)
+
+// CaseInsensitiveStringType is a string type with values that are
+// semantically equal regardless of letter case.
+type CaseInsensitiveStringType struct {
+	basetypes.StringType
+}
+
+func (t CaseInsensitiveStringType) Equal(o attr.Type) bool {
+	_, ok := o.(CaseInsensitiveStringType)
+
+	return ok
+}
+
+func (t CaseInsensitiveStringType) String() string {
+	return "testtypes.CaseInsensitiveStringType"
+}
+
+func (t CaseInsensitiveStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CaseInsensitiveString{StringValue: in}, nil
+}
+
+func (t CaseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return CaseInsensitiveString{StringValue: stringValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t CaseInsensitiveStringType) ValueType(_ context.Context) attr.Value {
+	return CaseInsensitiveString{}
+}
+
+// CaseInsensitiveString is a string value which is equal to other values
+// regardless of letter case.
+type CaseInsensitiveString struct {
+	basetypes.StringValue
+}
+
+func (s CaseInsensitiveString) Equal(o attr.Value) bool {
+	other, ok := o.(CaseInsensitiveString)
+
+	if !ok {
+		return false
+	}
+
+	if s.IsNull() || s.IsUnknown() || other.IsNull() || other.IsUnknown() {
+		return s.StringValue.Equal(other.StringValue)
+	}
+
+	return strings.EqualFold(s.ValueString(), other.ValueString())
+}
+
+func (s CaseInsensitiveString) Type(_ context.Context) attr.Type {
+	return CaseInsensitiveStringType{}
+}
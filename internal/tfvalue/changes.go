@@ -0,0 +1,143 @@
+package tfvalue
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ChangeKind describes how a value changed.
+type ChangeKind string
+
+const (
+	// ChangeKindAdded is a value which was null or missing and is now set.
+	ChangeKindAdded ChangeKind = "added"
+
+	// ChangeKindChanged is a value which was set and is now set to a
+	// different value.
+	ChangeKindChanged ChangeKind = "changed"
+
+	// ChangeKindRemoved is a value which was set and is now null or missing.
+	ChangeKindRemoved ChangeKind = "removed"
+)
+
+// Change is a difference between two values at a path.
+type Change struct {
+	// Kind describes how the value at Path changed.
+	Kind ChangeKind
+
+	// Path is the path of the changed value, relative to the values given
+	// to Changes.
+	Path *tftypes.AttributePath
+}
+
+// Changes returns the paths where the values differ, such as between a
+// prior state and a refreshed state. Changes in lists, maps, objects, and
+// tuples are returned at the deepest path possible, including added and
+// removed elements. Changes within sets are returned at the set path, so
+// that paths never contain values, which may be sensitive.
+func Changes(prior, current tftypes.Value) []Change {
+	return changes(tftypes.NewAttributePath(), prior, current, nil)
+}
+
+// changes is the recursive implementation of Changes, which appends to and
+// returns the result.
+func changes(p *tftypes.AttributePath, prior, current tftypes.Value, result []Change) []Change {
+	switch {
+	case prior.IsNull() && current.IsNull():
+		return result
+	case prior.IsNull():
+		return append(result, Change{Kind: ChangeKindAdded, Path: p})
+	case current.IsNull():
+		return append(result, Change{Kind: ChangeKindRemoved, Path: p})
+	case !prior.IsKnown() || !current.IsKnown() || prior.Type() == nil || current.Type() == nil || !prior.Type().Equal(current.Type()):
+		if !Equal(prior, current) {
+			return append(result, Change{Kind: ChangeKindChanged, Path: p})
+		}
+
+		return result
+	}
+
+	valueType := prior.Type()
+
+	switch {
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Tuple{}):
+		var priorElements, currentElements []tftypes.Value
+
+		if prior.As(&priorElements) != nil || current.As(&currentElements) != nil {
+			return append(result, Change{Kind: ChangeKindChanged, Path: p})
+		}
+
+		for index := 0; index < len(priorElements) || index < len(currentElements); index++ {
+			indexPath := p.WithElementKeyInt(index)
+
+			switch {
+			case index >= len(currentElements):
+				result = append(result, Change{Kind: ChangeKindRemoved, Path: indexPath})
+			case index >= len(priorElements):
+				result = append(result, Change{Kind: ChangeKindAdded, Path: indexPath})
+			default:
+				result = changes(indexPath, priorElements[index], currentElements[index], result)
+			}
+		}
+
+		return result
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var priorAttributes, currentAttributes map[string]tftypes.Value
+
+		if prior.As(&priorAttributes) != nil || current.As(&currentAttributes) != nil {
+			return append(result, Change{Kind: ChangeKindChanged, Path: p})
+		}
+
+		isObject := valueType.Is(tftypes.Object{})
+		names := sortedKeys(priorAttributes)
+
+		for name := range currentAttributes {
+			if _, ok := priorAttributes[name]; !ok {
+				names = append(names, name)
+			}
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			var namePath *tftypes.AttributePath
+
+			if isObject {
+				namePath = p.WithAttributeName(name)
+			} else {
+				namePath = p.WithElementKeyString(name)
+			}
+
+			priorAttribute, priorOk := priorAttributes[name]
+			currentAttribute, currentOk := currentAttributes[name]
+
+			switch {
+			case !currentOk:
+				result = append(result, Change{Kind: ChangeKindRemoved, Path: namePath})
+			case !priorOk:
+				result = append(result, Change{Kind: ChangeKindAdded, Path: namePath})
+			default:
+				result = changes(namePath, priorAttribute, currentAttribute, result)
+			}
+		}
+
+		return result
+	case valueType.Is(tftypes.Set{}):
+		if prior.IsFullyKnown() && current.IsFullyKnown() && setsConsistent(prior, current) {
+			return result
+		}
+
+		if !Equal(prior, current) {
+			return append(result, Change{Kind: ChangeKindChanged, Path: p})
+		}
+
+		return result
+	default:
+		if !Equal(prior, current) {
+			return append(result, Change{Kind: ChangeKindChanged, Path: p})
+		}
+
+		return result
+	}
+}
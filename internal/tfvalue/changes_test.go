@@ -0,0 +1,200 @@
+package tfvalue_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
+)
+
+func TestChanges(t *testing.T) {
+	t.Parallel()
+
+	settingsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"level":   tftypes.Number,
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list":     tftypes.List{ElementType: tftypes.String},
+			"map":      tftypes.Map{ElementType: tftypes.String},
+			"set":      tftypes.Set{ElementType: tftypes.String},
+			"settings": settingsType,
+		},
+	}
+
+	stringsValue := func(valueType tftypes.Type, values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(valueType, elements)
+	}
+	testValue := func(attributes map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"list": stringsValue(tftypes.List{ElementType: tftypes.String}, "one", "two"),
+			"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "value"),
+			}),
+			"set": stringsValue(tftypes.Set{ElementType: tftypes.String}, "one", "two"),
+			"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"level":   tftypes.NewValue(tftypes.Number, 1),
+			}),
+		}
+
+		for name, value := range attributes {
+			values[name] = value
+		}
+
+		return tftypes.NewValue(objectType, values)
+	}
+
+	testCases := map[string]struct {
+		prior    tftypes.Value
+		current  tftypes.Value
+		expected []tfvalue.Change
+	}{
+		"equal": {
+			prior:    testValue(nil),
+			current:  testValue(nil),
+			expected: nil,
+		},
+		"list-element-added": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"list": stringsValue(tftypes.List{ElementType: tftypes.String}, "one", "two", "three"),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindAdded,
+					Path: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(2),
+				},
+			},
+		},
+		"list-element-changed": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"list": stringsValue(tftypes.List{ElementType: tftypes.String}, "one", "changed"),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindChanged,
+					Path: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+				},
+			},
+		},
+		"list-element-removed": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"list": stringsValue(tftypes.List{ElementType: tftypes.String}, "one"),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindRemoved,
+					Path: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+				},
+			},
+		},
+		"map-keys": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"added": tftypes.NewValue(tftypes.String, "value"),
+				}),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindAdded,
+					Path: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("added"),
+				},
+				{
+					Kind: tfvalue.ChangeKindRemoved,
+					Path: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key"),
+				},
+			},
+		},
+		"nested-object-changed": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, nil),
+					"level":   tftypes.NewValue(tftypes.Number, 2),
+				}),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindRemoved,
+					Path: tftypes.NewAttributePath().WithAttributeName("settings").WithAttributeName("enabled"),
+				},
+				{
+					Kind: tfvalue.ChangeKindChanged,
+					Path: tftypes.NewAttributePath().WithAttributeName("settings").WithAttributeName("level"),
+				},
+			},
+		},
+		"nested-object-added": {
+			prior: testValue(map[string]tftypes.Value{
+				"settings": tftypes.NewValue(settingsType, nil),
+			}),
+			current: testValue(nil),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindAdded,
+					Path: tftypes.NewAttributePath().WithAttributeName("settings"),
+				},
+			},
+		},
+		"set-reordered": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"set": stringsValue(tftypes.Set{ElementType: tftypes.String}, "two", "one"),
+			}),
+			expected: nil,
+		},
+		"set-changed": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"set": stringsValue(tftypes.Set{ElementType: tftypes.String}, "one", "secret"),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindChanged,
+					Path: tftypes.NewAttributePath().WithAttributeName("set"),
+				},
+			},
+		},
+		"unknown": {
+			prior: testValue(nil),
+			current: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			}),
+			expected: []tfvalue.Change{
+				{
+					Kind: tfvalue.ChangeKindChanged,
+					Path: tftypes.NewAttributePath().WithAttributeName("list"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfvalue.Changes(testCase.prior, testCase.current)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package tfvalue implements helpers for terraform-plugin-go tftypes.Value.
+package tfvalue
@@ -0,0 +1,108 @@
+package tfvalue
+
+import (
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Equal returns true if the values are equal. Unlike tftypes.Value.Equal,
+// which computes every difference between the values, Equal returns on the
+// first difference and does not allocate for string and bool values, which
+// makes it suitable for comparing large values, such as entire resource
+// states.
+//
+// Set elements are compared in order, so sets with equal elements in a
+// different order are considered not equal.
+func Equal(a, b tftypes.Value) bool {
+	if a.Type() == nil || b.Type() == nil {
+		return a.Type() == nil && b.Type() == nil && a.IsNull() == b.IsNull()
+	}
+
+	if !a.Type().Equal(b.Type()) {
+		return false
+	}
+
+	return equal(a, b)
+}
+
+// equal is the recursive implementation of Equal. The value types are not
+// compared, as they are already equal or are verified when unmarshaling.
+func equal(a, b tftypes.Value) bool {
+	if a.IsKnown() != b.IsKnown() || a.IsNull() != b.IsNull() {
+		return false
+	}
+
+	if !a.IsKnown() || a.IsNull() {
+		return true
+	}
+
+	valueType := a.Type()
+
+	switch {
+	case valueType.Is(tftypes.Bool):
+		var aBool, bBool bool
+
+		if a.As(&aBool) != nil || b.As(&bBool) != nil {
+			return false
+		}
+
+		return aBool == bBool
+	case valueType.Is(tftypes.Number):
+		var aNumber, bNumber big.Float
+
+		if a.As(&aNumber) != nil || b.As(&bNumber) != nil {
+			return false
+		}
+
+		return aNumber.Cmp(&bNumber) == 0
+	case valueType.Is(tftypes.String):
+		var aString, bString string
+
+		if a.As(&aString) != nil || b.As(&bString) != nil {
+			return false
+		}
+
+		return aString == bString
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var aElements, bElements []tftypes.Value
+
+		if a.As(&aElements) != nil || b.As(&bElements) != nil {
+			return false
+		}
+
+		if len(aElements) != len(bElements) {
+			return false
+		}
+
+		for index := range aElements {
+			if !equal(aElements[index], bElements[index]) {
+				return false
+			}
+		}
+
+		return true
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var aAttributes, bAttributes map[string]tftypes.Value
+
+		if a.As(&aAttributes) != nil || b.As(&bAttributes) != nil {
+			return false
+		}
+
+		if len(aAttributes) != len(bAttributes) {
+			return false
+		}
+
+		for name, aAttribute := range aAttributes {
+			bAttribute, ok := bAttributes[name]
+
+			if !ok || !equal(aAttribute, bAttribute) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return a.Equal(b)
+	}
+}
@@ -0,0 +1,176 @@
+package tfvalue_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool":   tftypes.Bool,
+			"list":   tftypes.List{ElementType: tftypes.String},
+			"map":    tftypes.Map{ElementType: tftypes.String},
+			"number": tftypes.Number,
+			"set":    tftypes.Set{ElementType: tftypes.String},
+			"string": tftypes.String,
+		},
+	}
+
+	testValue := func(attributes map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"bool": tftypes.NewValue(tftypes.Bool, true),
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "value"),
+			}),
+			"number": tftypes.NewValue(tftypes.Number, 1.5),
+			"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			"string": tftypes.NewValue(tftypes.String, "value"),
+		}
+
+		for name, value := range attributes {
+			values[name] = value
+		}
+
+		return tftypes.NewValue(objectType, values)
+	}
+
+	testCases := map[string]struct {
+		a        tftypes.Value
+		b        tftypes.Value
+		expected bool
+	}{
+		"empty": {
+			a:        tftypes.Value{},
+			b:        tftypes.Value{},
+			expected: true,
+		},
+		"empty-null": {
+			a:        tftypes.Value{},
+			b:        tftypes.NewValue(tftypes.String, nil),
+			expected: false,
+		},
+		"type-mismatch": {
+			a:        tftypes.NewValue(tftypes.String, nil),
+			b:        tftypes.NewValue(tftypes.Number, nil),
+			expected: false,
+		},
+		"null": {
+			a:        tftypes.NewValue(objectType, nil),
+			b:        tftypes.NewValue(objectType, nil),
+			expected: true,
+		},
+		"null-known": {
+			a:        tftypes.NewValue(objectType, nil),
+			b:        testValue(nil),
+			expected: false,
+		},
+		"unknown": {
+			a:        tftypes.NewValue(objectType, tftypes.UnknownValue),
+			b:        tftypes.NewValue(objectType, tftypes.UnknownValue),
+			expected: true,
+		},
+		"unknown-known": {
+			a:        tftypes.NewValue(objectType, tftypes.UnknownValue),
+			b:        testValue(nil),
+			expected: false,
+		},
+		"equal": {
+			a:        testValue(nil),
+			b:        testValue(nil),
+			expected: true,
+		},
+		"bool-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"bool": tftypes.NewValue(tftypes.Bool, false),
+			}),
+			expected: false,
+		},
+		"list-element-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "three"),
+				}),
+			}),
+			expected: false,
+		},
+		"list-length-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+				}),
+			}),
+			expected: false,
+		},
+		"map-key-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"other": tftypes.NewValue(tftypes.String, "value"),
+				}),
+			}),
+			expected: false,
+		},
+		"number-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"number": tftypes.NewValue(tftypes.Number, 2.5),
+			}),
+			expected: false,
+		},
+		"set-order-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "two"),
+					tftypes.NewValue(tftypes.String, "one"),
+				}),
+			}),
+			expected: false,
+		},
+		"string-different": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "other"),
+			}),
+			expected: false,
+		},
+		"string-unknown": {
+			a: testValue(nil),
+			b: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfvalue.Equal(testCase.a, testCase.b)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
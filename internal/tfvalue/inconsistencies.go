@@ -0,0 +1,225 @@
+package tfvalue
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Inconsistency is a difference between a planned value and the value after
+// applying the plan.
+type Inconsistency struct {
+	// Path is the path of the values, relative to the values given to
+	// Inconsistencies.
+	Path *tftypes.AttributePath
+
+	// Planned is the planned value at Path. If Applied is unexpectedly
+	// unknown within an unknown planned value, this is an unknown value of
+	// the Applied value type.
+	Planned tftypes.Value
+
+	// Applied is the applied value at Path.
+	Applied tftypes.Value
+}
+
+// Inconsistencies returns the differences between the planned and applied
+// values which Terraform considers invalid after applying a plan. Known
+// planned values must be equal to the applied value, while unknown planned
+// values may be replaced by any known value. Applied values must always be
+// known.
+//
+// Differences in lists, maps, objects, and tuples are returned at the deepest
+// path possible. Differences between known sets are returned at the set path,
+// as set elements are identified by their value.
+func Inconsistencies(planned, applied tftypes.Value) []Inconsistency {
+	return inconsistencies(tftypes.NewAttributePath(), planned, applied, nil)
+}
+
+// inconsistencies is the recursive implementation of Inconsistencies, which
+// appends to and returns the result.
+func inconsistencies(p *tftypes.AttributePath, planned, applied tftypes.Value, result []Inconsistency) []Inconsistency {
+	if !planned.IsKnown() {
+		return unknownInconsistencies(p, applied, result)
+	}
+
+	inconsistent := Inconsistency{
+		Path:    p,
+		Planned: planned,
+		Applied: applied,
+	}
+
+	if !applied.IsKnown() || planned.IsNull() != applied.IsNull() || planned.Type() == nil || applied.Type() == nil || !planned.Type().Equal(applied.Type()) {
+		return append(result, inconsistent)
+	}
+
+	if planned.IsNull() {
+		return result
+	}
+
+	plannedType := planned.Type()
+
+	switch {
+	case plannedType.Is(tftypes.List{}), plannedType.Is(tftypes.Tuple{}):
+		var plannedElements, appliedElements []tftypes.Value
+
+		if planned.As(&plannedElements) != nil || applied.As(&appliedElements) != nil || len(plannedElements) != len(appliedElements) {
+			return append(result, inconsistent)
+		}
+
+		for index := range plannedElements {
+			result = inconsistencies(p.WithElementKeyInt(index), plannedElements[index], appliedElements[index], result)
+		}
+
+		return result
+	case plannedType.Is(tftypes.Map{}), plannedType.Is(tftypes.Object{}):
+		var plannedAttributes, appliedAttributes map[string]tftypes.Value
+
+		if planned.As(&plannedAttributes) != nil || applied.As(&appliedAttributes) != nil || len(plannedAttributes) != len(appliedAttributes) {
+			return append(result, inconsistent)
+		}
+
+		for name := range plannedAttributes {
+			if _, ok := appliedAttributes[name]; !ok {
+				return append(result, inconsistent)
+			}
+		}
+
+		isObject := plannedType.Is(tftypes.Object{})
+
+		for _, name := range sortedKeys(plannedAttributes) {
+			var namePath *tftypes.AttributePath
+
+			if isObject {
+				namePath = p.WithAttributeName(name)
+			} else {
+				namePath = p.WithElementKeyString(name)
+			}
+
+			result = inconsistencies(namePath, plannedAttributes[name], appliedAttributes[name], result)
+		}
+
+		return result
+	case plannedType.Is(tftypes.Set{}):
+		// Elements containing unknown values cannot be correlated with
+		// applied elements, which may have fewer elements if the unknown
+		// values become equal to other elements.
+		if !planned.IsFullyKnown() {
+			return unknownInconsistencies(p, applied, result)
+		}
+
+		if !setsConsistent(planned, applied) {
+			return append(result, inconsistent)
+		}
+
+		return result
+	default:
+		if !Equal(planned, applied) {
+			return append(result, inconsistent)
+		}
+
+		return result
+	}
+}
+
+// setsConsistent returns true if every planned set element has a consistent
+// applied set element, regardless of ordering, and the sets have the same
+// number of elements.
+func setsConsistent(planned, applied tftypes.Value) bool {
+	var plannedElements, appliedElements []tftypes.Value
+
+	if planned.As(&plannedElements) != nil || applied.As(&appliedElements) != nil || len(plannedElements) != len(appliedElements) {
+		return false
+	}
+
+	matched := make([]bool, len(appliedElements))
+
+	for _, plannedElement := range plannedElements {
+		found := false
+
+		for index, appliedElement := range appliedElements {
+			if matched[index] {
+				continue
+			}
+
+			if len(inconsistencies(tftypes.NewAttributePath(), plannedElement, appliedElement, nil)) == 0 {
+				matched[index] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unknownInconsistencies appends an Inconsistency for each unknown value in
+// the applied value to the result.
+func unknownInconsistencies(p *tftypes.AttributePath, applied tftypes.Value, result []Inconsistency) []Inconsistency {
+	if !applied.IsKnown() {
+		return append(result, Inconsistency{
+			Path:    p,
+			Planned: tftypes.NewValue(applied.Type(), tftypes.UnknownValue),
+			Applied: applied,
+		})
+	}
+
+	if applied.IsNull() || applied.IsFullyKnown() {
+		return result
+	}
+
+	appliedType := applied.Type()
+
+	switch {
+	case appliedType.Is(tftypes.List{}), appliedType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		_ = applied.As(&elements)
+
+		for index, element := range elements {
+			result = unknownInconsistencies(p.WithElementKeyInt(index), element, result)
+		}
+	case appliedType.Is(tftypes.Set{}):
+		var elements []tftypes.Value
+
+		_ = applied.As(&elements)
+
+		for _, element := range elements {
+			result = unknownInconsistencies(p.WithElementKeyValue(element), element, result)
+		}
+	case appliedType.Is(tftypes.Map{}), appliedType.Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+
+		_ = applied.As(&attributes)
+
+		isObject := appliedType.Is(tftypes.Object{})
+
+		for _, name := range sortedKeys(attributes) {
+			if isObject {
+				result = unknownInconsistencies(p.WithAttributeName(name), attributes[name], result)
+			} else {
+				result = unknownInconsistencies(p.WithElementKeyString(name), attributes[name], result)
+			}
+		}
+	}
+
+	return result
+}
+
+// sortedKeys returns the map keys in lexical order, so results are
+// deterministic.
+func sortedKeys(m map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,347 @@
+package tfvalue_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfvalue"
+)
+
+func TestInconsistencies(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"tags": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": tftypes.List{ElementType: nestedType},
+			"map":  tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}},
+			"set":  tftypes.Set{ElementType: nestedType},
+		},
+	}
+
+	tagsValue := func(tags ...interface{}) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(tags))
+
+		for _, tag := range tags {
+			elements = append(elements, tftypes.NewValue(tftypes.String, tag))
+		}
+
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+	}
+	nestedValue := func(id interface{}, tags tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, id),
+			"tags": tags,
+		})
+	}
+	numbersValue := func(numbers ...interface{}) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(numbers))
+
+		for _, number := range numbers {
+			elements = append(elements, tftypes.NewValue(tftypes.Number, number))
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, elements)
+	}
+	testValue := func(attributes map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+				nestedValue("one", tagsValue("a", "b")),
+				nestedValue("two", tagsValue()),
+			}),
+			"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+				"key": numbersValue(1, 2),
+			}),
+			"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+				nestedValue("one", tagsValue("a")),
+				nestedValue("two", tagsValue("b")),
+			}),
+		}
+
+		for name, value := range attributes {
+			values[name] = value
+		}
+
+		return tftypes.NewValue(objectType, values)
+	}
+
+	testCases := map[string]struct {
+		planned  tftypes.Value
+		applied  tftypes.Value
+		expected []tfvalue.Inconsistency
+	}{
+		"equal": {
+			planned:  testValue(nil),
+			applied:  testValue(nil),
+			expected: nil,
+		},
+		"null": {
+			planned:  tftypes.NewValue(objectType, nil),
+			applied:  tftypes.NewValue(objectType, nil),
+			expected: nil,
+		},
+		"null-planned": {
+			planned: tftypes.NewValue(objectType, nil),
+			applied: testValue(nil),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath(),
+					Planned: tftypes.NewValue(objectType, nil),
+					Applied: testValue(nil),
+				},
+			},
+		},
+		"unknown-planned-known-applied": {
+			planned:  tftypes.NewValue(objectType, tftypes.UnknownValue),
+			applied:  testValue(nil),
+			expected: nil,
+		},
+		"unknown-planned-unknown-applied": {
+			planned: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue(tftypes.UnknownValue, tagsValue("a", "b")),
+					nestedValue("two", tagsValue()),
+				}),
+			}),
+			applied: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue(tftypes.UnknownValue, tagsValue("a", "b")),
+					nestedValue("two", tagsValue()),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(0).WithAttributeName("id"),
+					Planned: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					Applied: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+		},
+		"unknown-planned-nested-unknown-applied": {
+			planned: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, tftypes.UnknownValue),
+			}),
+			applied: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"key": numbersValue(1, tftypes.UnknownValue),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key").WithElementKeyInt(1),
+					Planned: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+					Applied: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				},
+			},
+		},
+		"known-planned-unknown-applied": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"key": numbersValue(1, tftypes.UnknownValue),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key").WithElementKeyInt(1),
+					Planned: tftypes.NewValue(tftypes.Number, 2),
+					Applied: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				},
+			},
+		},
+		"list-nested-value": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("one", tagsValue("a", "b")),
+					nestedValue("changed", tagsValue()),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("id"),
+					Planned: tftypes.NewValue(tftypes.String, "two"),
+					Applied: tftypes.NewValue(tftypes.String, "changed"),
+				},
+			},
+		},
+		"list-nested-set": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("one", tagsValue("a", "c")),
+					nestedValue("two", tagsValue()),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(0).WithAttributeName("tags"),
+					Planned: tagsValue("a", "b"),
+					Applied: tagsValue("a", "c"),
+				},
+			},
+		},
+		"list-length": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("one", tagsValue("a", "b")),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path: tftypes.NewAttributePath().WithAttributeName("list"),
+					Planned: tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+						nestedValue("one", tagsValue("a", "b")),
+						nestedValue("two", tagsValue()),
+					}),
+					Applied: tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+						nestedValue("one", tagsValue("a", "b")),
+					}),
+				},
+			},
+		},
+		"map-keys": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"other": numbersValue(1, 2),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path: tftypes.NewAttributePath().WithAttributeName("map"),
+					Planned: tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+						"key": numbersValue(1, 2),
+					}),
+					Applied: tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+						"other": numbersValue(1, 2),
+					}),
+				},
+			},
+		},
+		"map-nested-value": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"key": numbersValue(1, 3),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key").WithElementKeyInt(1),
+					Planned: tftypes.NewValue(tftypes.Number, 2),
+					Applied: tftypes.NewValue(tftypes.Number, 3),
+				},
+			},
+		},
+		"map-multiple": {
+			planned: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"a": numbersValue(1),
+					"b": numbersValue(2),
+				}),
+			}),
+			applied: testValue(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"a": numbersValue(3),
+					"b": numbersValue(4),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("a").WithElementKeyInt(0),
+					Planned: tftypes.NewValue(tftypes.Number, 1),
+					Applied: tftypes.NewValue(tftypes.Number, 3),
+				},
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("b").WithElementKeyInt(0),
+					Planned: tftypes.NewValue(tftypes.Number, 2),
+					Applied: tftypes.NewValue(tftypes.Number, 4),
+				},
+			},
+		},
+		"set-reordered": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("two", tagsValue("b")),
+					nestedValue("one", tagsValue("a")),
+				}),
+			}),
+			expected: nil,
+		},
+		"set-nested-value": {
+			planned: testValue(nil),
+			applied: testValue(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("one", tagsValue("a")),
+					nestedValue("two", tagsValue("c")),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path: tftypes.NewAttributePath().WithAttributeName("set"),
+					Planned: tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+						nestedValue("one", tagsValue("a")),
+						nestedValue("two", tagsValue("b")),
+					}),
+					Applied: tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+						nestedValue("one", tagsValue("a")),
+						nestedValue("two", tagsValue("c")),
+					}),
+				},
+			},
+		},
+		"set-unknown-planned-element": {
+			planned: testValue(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("one", tagsValue("a")),
+					nestedValue(tftypes.UnknownValue, tagsValue("b")),
+				}),
+			}),
+			applied:  testValue(nil),
+			expected: nil,
+		},
+		"set-unknown-planned-element-unknown-applied": {
+			planned: testValue(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue(tftypes.UnknownValue, tagsValue("a")),
+				}),
+			}),
+			applied: testValue(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue(tftypes.UnknownValue, tagsValue("a")),
+				}),
+			}),
+			expected: []tfvalue.Inconsistency{
+				{
+					Path:    tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(nestedValue(tftypes.UnknownValue, tagsValue("a"))).WithAttributeName("id"),
+					Planned: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					Applied: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfvalue.Inconsistencies(testCase.planned, testCase.applied)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -20,13 +20,41 @@ func DiagnosticSeverity(s diag.Severity) tfprotov5.DiagnosticSeverity {
 	}
 }
 
+// DiagnosticDetail returns the detail of the diagnostic, with a trailer
+// containing the code if the diagnostic implements diag.DiagnosticWithCode
+// with a non-empty code. The protocol has no dedicated field for codes.
+func DiagnosticDetail(diagnostic diag.Diagnostic) string {
+	detail := diagnostic.Detail()
+
+	diagWithCode, ok := diagnostic.(diag.DiagnosticWithCode)
+
+	if !ok || diagWithCode.Code() == "" {
+		return detail
+	}
+
+	var trailer string
+
+	switch diagnostic.Severity() {
+	case diag.SeverityWarning:
+		trailer = "Warning code: " + diagWithCode.Code()
+	default:
+		trailer = "Error code: " + diagWithCode.Code()
+	}
+
+	if detail == "" {
+		return trailer
+	}
+
+	return detail + "\n\n" + trailer
+}
+
 // Diagnostics converts the diagnostics into the tfprotov5 collection type.
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov5.Diagnostic {
 	var results []*tfprotov5.Diagnostic
 
 	for _, diagnostic := range diagnostics {
 		tfprotov5Diagnostic := &tfprotov5.Diagnostic{
-			Detail:   diagnostic.Detail(),
+			Detail:   DiagnosticDetail(diagnostic),
 			Severity: DiagnosticSeverity(diagnostic.Severity()),
 			Summary:  diagnostic.Summary(),
 		}
@@ -89,6 +89,44 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithCode": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnosticWithCode("TEST_QUOTA_EXCEEDED", "two summary", "two detail"),
+				diag.WithPath(path.Root("test"), diag.NewWarningDiagnosticWithCode("TEST_DEPRECATED", "three summary", "three detail")),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "four summary", "four detail"),
+				diag.NewErrorDiagnosticWithCode("TEST_NO_DETAIL", "five summary", ""),
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Detail:   "one detail",
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+				{
+					Detail:   "two detail\n\nError code: TEST_QUOTA_EXCEEDED",
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "two summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "three detail\n\nWarning code: TEST_DEPRECATED",
+					Severity:  tfprotov5.DiagnosticSeverityWarning,
+					Summary:   "three summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "four detail",
+					Severity:  tfprotov5.DiagnosticSeverityWarning,
+					Summary:   "four summary",
+				},
+				{
+					Detail:   "Error code: TEST_NO_DETAIL",
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "five summary",
+				},
+			},
+		},
 		"DiagnosticWithPath": {
 			diags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(path.Empty(), "one summary", "one detail"),
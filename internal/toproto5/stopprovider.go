@@ -0,0 +1,38 @@
+package toproto5
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// StopProviderResponse returns the *tfprotov5.StopProviderResponse
+// equivalent of a *fwserver.StopProviderResponse. The protocol response does
+// not support diagnostics, so the summary and detail of each error
+// diagnostic are joined into the Error field. Warning diagnostics are
+// omitted.
+func StopProviderResponse(_ context.Context, fw *fwserver.StopProviderResponse) *tfprotov5.StopProviderResponse {
+	if fw == nil {
+		return nil
+	}
+
+	proto5 := &tfprotov5.StopProviderResponse{}
+
+	errorDiags := fw.Diagnostics.Errors()
+
+	if len(errorDiags) == 0 {
+		return proto5
+	}
+
+	messages := make([]string, 0, len(errorDiags))
+
+	for _, errorDiag := range errorDiags {
+		messages = append(messages, errorDiag.Summary()+": "+errorDiag.Detail())
+	}
+
+	proto5.Error = strings.Join(messages, "\n")
+
+	return proto5
+}
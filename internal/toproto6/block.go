@@ -12,7 +12,7 @@ import (
 // Block returns the *tfprotov6.SchemaNestedBlock equivalent of a Block.
 // Errors will be tftypes.AttributePathErrors based on `path`. `name` is the
 // name of the attribute.
-func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwschema.Block) (*tfprotov6.SchemaNestedBlock, error) {
+func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwschema.Block, opts SchemaOptions) (*tfprotov6.SchemaNestedBlock, error) {
 	schemaNestedBlock := &tfprotov6.SchemaNestedBlock{
 		Block: &tfprotov6.SchemaBlock{
 			Deprecated: b.GetDeprecationMessage() != "",
@@ -20,14 +20,8 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 		TypeName: name,
 	}
 
-	if b.GetDescription() != "" {
-		schemaNestedBlock.Block.Description = b.GetDescription()
-		schemaNestedBlock.Block.DescriptionKind = tfprotov6.StringKindPlain
-	}
-
-	if b.GetMarkdownDescription() != "" {
-		schemaNestedBlock.Block.Description = b.GetMarkdownDescription()
-		schemaNestedBlock.Block.DescriptionKind = tfprotov6.StringKindMarkdown
+	if b.GetDescription() != "" || b.GetMarkdownDescription() != "" {
+		schemaNestedBlock.Block.Description, schemaNestedBlock.Block.DescriptionKind = SchemaDescription(b.GetDescription(), b.GetMarkdownDescription(), opts)
 	}
 
 	nm := b.GetNestingMode()
@@ -46,7 +40,7 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 
 	for attrName, attr := range nestedBlockObject.GetAttributes() {
 		attrPath := path.WithAttributeName(attrName)
-		attrProto6, err := SchemaAttribute(ctx, attrName, attrPath, attr)
+		attrProto6, err := SchemaAttribute(ctx, attrName, attrPath, attr, opts)
 
 		if err != nil {
 			return nil, err
@@ -57,7 +51,7 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 
 	for blockName, block := range nestedBlockObject.GetBlocks() {
 		blockPath := path.WithAttributeName(blockName)
-		blockProto6, err := Block(ctx, blockName, blockPath, block)
+		blockProto6, err := Block(ctx, blockName, blockPath, block, opts)
 
 		if err != nil {
 			return nil, err
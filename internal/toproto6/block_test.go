@@ -20,6 +20,7 @@ func TestBlock(t *testing.T) {
 		name        string
 		block       fwschema.Block
 		path        *tftypes.AttributePath
+		opts        toproto6.SchemaOptions
 		expected    *tfprotov6.SchemaNestedBlock
 		expectedErr string
 	}
@@ -539,6 +540,74 @@ func TestBlock(t *testing.T) {
 						},
 					},
 					Description:     "test description",
+					DescriptionKind: tfprotov6.StringKindPlain,
+				},
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"markdowndescription-disable-derivation": {
+			name: "test",
+			block: testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"sub_test": testschema.Attribute{
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+				MarkdownDescription: "test description",
+				NestingMode:         fwschema.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			opts: toproto6.SchemaOptions{
+				DisableDescriptionDerivation: true,
+			},
+			expected: &tfprotov6.SchemaNestedBlock{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfprotov6.StringKindMarkdown,
+				},
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"markdowndescription-formatted": {
+			name: "test",
+			block: testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"sub_test": testschema.Attribute{
+							Type:                types.StringType,
+							Optional:            true,
+							MarkdownDescription: "test **sub** description",
+						},
+					},
+				},
+				MarkdownDescription: "test *description*",
+				NestingMode:         fwschema.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaNestedBlock{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:            "sub_test",
+							Optional:        true,
+							Type:            tftypes.String,
+							Description:     "test **sub** description",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+						},
+					},
+					Description:     "test *description*",
 					DescriptionKind: tfprotov6.StringKindMarkdown,
 				},
 				Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
@@ -552,7 +621,7 @@ func TestBlock(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := toproto6.Block(context.Background(), tc.name, tc.path, tc.block)
+			got, err := toproto6.Block(context.Background(), tc.name, tc.path, tc.block, tc.opts)
 			if err != nil {
 				if tc.expectedErr == "" {
 					t.Errorf("Unexpected error: %s", err)
@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -89,6 +93,44 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithCode": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnosticWithCode("TEST_QUOTA_EXCEEDED", "two summary", "two detail"),
+				diag.WithPath(path.Root("test"), diag.NewWarningDiagnosticWithCode("TEST_DEPRECATED", "three summary", "three detail")),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "four summary", "four detail"),
+				diag.NewErrorDiagnosticWithCode("TEST_NO_DETAIL", "five summary", ""),
+			},
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Detail:   "one detail",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+				{
+					Detail:   "two detail\n\nError code: TEST_QUOTA_EXCEEDED",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "two summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "three detail\n\nWarning code: TEST_DEPRECATED",
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "three summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "four detail",
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "four summary",
+				},
+				{
+					Detail:   "Error code: TEST_NO_DETAIL",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "five summary",
+				},
+			},
+		},
 		"DiagnosticWithPath": {
 			diags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(path.Empty(), "one summary", "one detail"),
@@ -109,6 +151,53 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"Diagnostics-Append-duplicates": {
+			diags: func() diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("other"), "one summary", "one detail"),
+				)
+
+				return diags
+			}(),
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "one detail",
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "one summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("other"),
+					Detail:    "one detail",
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "one summary",
+				},
+			},
+		},
+		"Diagnostics-append-duplicates": {
+			diags: append(
+				diag.Diagnostics{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+				},
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			),
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Detail:   "one detail",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+				{
+					Detail:   "one detail",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -150,3 +239,160 @@ func (d invalidSeverityDiagnostic) Severity() diag.Severity {
 func (d invalidSeverityDiagnostic) Summary() string {
 	return "summary for invalid severity diagnostic"
 }
+
+func TestDiagnostics_AttributePathRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"test_map": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_list": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"test_set": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"test_nested_block": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"test_string": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSetElementType := map[string]attr.Type{
+		"test_string": types.StringType,
+	}
+	testSetElementValue := types.ObjectValueMust(
+		testSetElementType,
+		map[string]attr.Value{
+			"test_string": types.StringValue("test-value"),
+		},
+	)
+	testSetElementTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string": tftypes.String,
+		},
+	}
+	testSetElementTfValue := tftypes.NewValue(testSetElementTfType, map[string]tftypes.Value{
+		"test_string": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testCases := map[string]struct {
+		path              path.Path
+		expectedAttribute *tftypes.AttributePath
+	}{
+		"list-index-attribute": {
+			path: path.Root("test_list").AtListIndex(1).AtName("test_string"),
+			expectedAttribute: tftypes.NewAttributePath().
+				WithAttributeName("test_list").
+				WithElementKeyInt(1).
+				WithAttributeName("test_string"),
+		},
+		"map-key-attribute-list-index": {
+			path: path.Root("test_map").AtMapKey("test-key").AtName("test_list").AtListIndex(0),
+			expectedAttribute: tftypes.NewAttributePath().
+				WithAttributeName("test_map").
+				WithElementKeyString("test-key").
+				WithAttributeName("test_list").
+				WithElementKeyInt(0),
+		},
+		"set-value-attribute": {
+			path: path.Root("test_set").AtSetValue(testSetElementValue).AtName("test_string"),
+			expectedAttribute: tftypes.NewAttributePath().
+				WithAttributeName("test_set").
+				WithElementKeyValue(testSetElementTfValue).
+				WithAttributeName("test_string"),
+		},
+		"block-list-index-set-value-attribute": {
+			path: path.Root("test_block").AtListIndex(0).AtName("test_nested_block").AtSetValue(testSetElementValue).AtName("test_string"),
+			expectedAttribute: tftypes.NewAttributePath().
+				WithAttributeName("test_block").
+				WithElementKeyInt(0).
+				WithAttributeName("test_nested_block").
+				WithElementKeyValue(testSetElementTfValue).
+				WithAttributeName("test_string"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(tc.path, "warning summary", "warning detail"),
+				diag.NewAttributeErrorDiagnostic(tc.path, "error summary", "error detail"),
+			}
+
+			expected := []*tfprotov6.Diagnostic{
+				{
+					Attribute: tc.expectedAttribute,
+					Detail:    "warning detail",
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "warning summary",
+				},
+				{
+					Attribute: tc.expectedAttribute,
+					Detail:    "error detail",
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "error summary",
+				},
+			}
+
+			got := toproto6.Diagnostics(context.Background(), diags)
+
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Fatalf("Unexpected response (+wanted, -got): %s", diff)
+			}
+
+			for _, gotDiagnostic := range got {
+				gotPath, gotDiags := fromtftypes.AttributePath(context.Background(), gotDiagnostic.Attribute, testSchema)
+
+				if gotDiags.HasError() {
+					t.Fatalf("unexpected path conversion diagnostics: %v", gotDiags)
+				}
+
+				if !gotPath.Equal(tc.path) {
+					t.Errorf("expected round trip path %s, got: %s", tc.path, gotPath)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -29,13 +30,12 @@ func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov6.Dyna
 	proto6, err := tfprotov6.NewDynamicValue(data.Schema.Type().TerraformType(ctx), data.TerraformValue)
 
 	if err != nil {
-		diags.AddError(
+		diags.Append(fwdiag.FrameworkErrorDiag(
+			ctx,
 			"Unable to Convert "+data.Description.Title(),
-			"An unexpected error was encountered when converting the "+data.Description.String()+" to the protocol type. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Unable to create DynamicValue: "+err.Error(),
-		)
+			"converting the "+data.Description.String()+" to the protocol type",
+			"Unable to create DynamicValue: "+err.Error(),
+		))
 
 		return nil, diags
 	}
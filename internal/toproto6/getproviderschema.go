@@ -9,7 +9,7 @@ import (
 
 // GetProviderSchemaResponse returns the *tfprotov6.GetProviderSchemaResponse
 // equivalent of a *fwserver.GetProviderSchemaResponse.
-func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSchemaResponse) *tfprotov6.GetProviderSchemaResponse {
+func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSchemaResponse, opts SchemaOptions) *tfprotov6.GetProviderSchemaResponse {
 	if fw == nil {
 		return nil
 	}
@@ -23,7 +23,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 
 	var err error
 
-	protov6.Provider, err = Schema(ctx, fw.Provider)
+	protov6.Provider, err = Schema(ctx, fw.Provider, opts)
 
 	if err != nil {
 		protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
@@ -35,7 +35,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 		return protov6
 	}
 
-	protov6.ProviderMeta, err = Schema(ctx, fw.ProviderMeta)
+	protov6.ProviderMeta, err = Schema(ctx, fw.ProviderMeta, opts)
 
 	if err != nil {
 		protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
@@ -48,7 +48,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	}
 
 	for dataSourceType, dataSourceSchema := range fw.DataSourceSchemas {
-		protov6.DataSourceSchemas[dataSourceType], err = Schema(ctx, dataSourceSchema)
+		protov6.DataSourceSchemas[dataSourceType], err = Schema(ctx, dataSourceSchema, opts)
 
 		if err != nil {
 			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
@@ -62,7 +62,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	}
 
 	for resourceType, resourceSchema := range fw.ResourceSchemas {
-		protov6.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema)
+		protov6.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema, opts)
 
 		if err != nil {
 			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
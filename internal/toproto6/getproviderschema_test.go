@@ -140,6 +140,130 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
 		},
+		"data-source-attribute-missing-behavior": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Attributes: map[string]datasourceschema.Attribute{
+							"test_attribute": datasourceschema.SingleNestedAttribute{
+								Attributes: map[string]datasourceschema.Attribute{
+									"test_nested_attribute": datasourceschema.BoolAttribute{},
+								},
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": nil,
+				},
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Error converting data source schema",
+						Detail:   "The schema for the data source \"test_data_source\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\").AttributeName(\"test_nested_attribute\"): must have Required, Optional, or Computed set",
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{},
+			},
+		},
+		"data-source-attribute-nested-behaviors": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Attributes: map[string]datasourceschema.Attribute{
+							"test_attribute": datasourceschema.ListNestedAttribute{
+								NestedObject: datasourceschema.NestedAttributeObject{
+									Attributes: map[string]datasourceschema.Attribute{
+										"test_computed": datasourceschema.StringAttribute{
+											Computed: true,
+										},
+										"test_optional_computed": datasourceschema.StringAttribute{
+											Computed: true,
+											Optional: true,
+										},
+										"test_required": datasourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+						},
+						Blocks: map[string]datasourceschema.Block{
+							"test_block": datasourceschema.SingleNestedBlock{
+								Attributes: map[string]datasourceschema.Attribute{
+									"test_computed": datasourceschema.StringAttribute{
+										Computed: true,
+									},
+									"test_optional": datasourceschema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name: "test_attribute",
+									NestedType: &tfprotov6.SchemaObject{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Computed: true,
+												Name:     "test_computed",
+												Type:     tftypes.String,
+											},
+											{
+												Computed: true,
+												Name:     "test_optional_computed",
+												Optional: true,
+												Type:     tftypes.String,
+											},
+											{
+												Name:     "test_required",
+												Required: true,
+												Type:     tftypes.String,
+											},
+										},
+										Nesting: tfprotov6.SchemaObjectNestingModeList,
+									},
+									Optional: true,
+								},
+							},
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									Block: &tfprotov6.SchemaBlock{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Computed: true,
+												Name:     "test_computed",
+												Type:     tftypes.String,
+											},
+											{
+												Name:     "test_optional",
+												Optional: true,
+												Type:     tftypes.String,
+											},
+										},
+									},
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{},
+			},
+		},
 		"data-source-attribute-optional": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -3371,7 +3495,7 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got := toproto6.GetProviderSchemaResponse(context.Background(), testCase.input)
+			got := toproto6.GetProviderSchemaResponse(context.Background(), testCase.input, toproto6.SchemaOptions{})
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
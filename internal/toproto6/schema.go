@@ -10,7 +10,7 @@ import (
 )
 
 // Schema returns the *tfprotov6.Schema equivalent of a Schema.
-func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov6.Schema, error) {
+func Schema(ctx context.Context, s fwschema.Schema, opts SchemaOptions) (*tfprotov6.Schema, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -23,7 +23,7 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov6.Schema, error) {
 	var blocks []*tfprotov6.SchemaNestedBlock
 
 	for name, attr := range s.GetAttributes() {
-		a, err := SchemaAttribute(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), attr)
+		a, err := SchemaAttribute(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), attr, opts)
 
 		if err != nil {
 			return nil, err
@@ -33,7 +33,7 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov6.Schema, error) {
 	}
 
 	for name, block := range s.GetBlocks() {
-		proto6, err := Block(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), block)
+		proto6, err := Block(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), block, opts)
 
 		if err != nil {
 			return nil, err
@@ -74,14 +74,8 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov6.Schema, error) {
 		Deprecated: s.GetDeprecationMessage() != "",
 	}
 
-	if s.GetDescription() != "" {
-		result.Block.Description = s.GetDescription()
-		result.Block.DescriptionKind = tfprotov6.StringKindPlain
-	}
-
-	if s.GetMarkdownDescription() != "" {
-		result.Block.Description = s.GetMarkdownDescription()
-		result.Block.DescriptionKind = tfprotov6.StringKindMarkdown
+	if s.GetDescription() != "" || s.GetMarkdownDescription() != "" {
+		result.Block.Description, result.Block.DescriptionKind = SchemaDescription(s.GetDescription(), s.GetMarkdownDescription(), opts)
 	}
 
 	return result, nil
@@ -12,7 +12,17 @@ import (
 // SchemaAttribute returns the *tfprotov6.SchemaAttribute equivalent of an
 // Attribute. Errors will be tftypes.AttributePathErrors based on `path`.
 // `name` is the name of the attribute.
-func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePath, a fwschema.Attribute) (*tfprotov6.SchemaAttribute, error) {
+//
+// Sensitive is propagated from nested attributes to all of their nested
+// attributes, except those which opt out via NonSensitive, so Terraform does
+// not display nested values.
+func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePath, a fwschema.Attribute, opts SchemaOptions) (*tfprotov6.SchemaAttribute, error) {
+	return schemaAttributeWithParentSensitive(ctx, name, path, a, opts, false)
+}
+
+// schemaAttributeWithParentSensitive is the recursive implementation of SchemaAttribute, where
+// parentSensitive is true if any parent nested attribute is sensitive.
+func schemaAttributeWithParentSensitive(ctx context.Context, name string, path *tftypes.AttributePath, a fwschema.Attribute, opts SchemaOptions, parentSensitive bool) (*tfprotov6.SchemaAttribute, error) {
 	if !a.IsRequired() && !a.IsOptional() && !a.IsComputed() {
 		return nil, path.NewErrorf("must have Required, Optional, or Computed set")
 	}
@@ -22,7 +32,7 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		Required:  a.IsRequired(),
 		Optional:  a.IsOptional(),
 		Computed:  a.IsComputed(),
-		Sensitive: a.IsSensitive(),
+		Sensitive: fwschema.AttributeSensitiveValue(a, parentSensitive),
 		Type:      a.GetType().TerraformType(ctx),
 	}
 
@@ -30,14 +40,8 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		schemaAttribute.Deprecated = true
 	}
 
-	if a.GetDescription() != "" {
-		schemaAttribute.Description = a.GetDescription()
-		schemaAttribute.DescriptionKind = tfprotov6.StringKindPlain
-	}
-
-	if a.GetMarkdownDescription() != "" {
-		schemaAttribute.Description = a.GetMarkdownDescription()
-		schemaAttribute.DescriptionKind = tfprotov6.StringKindMarkdown
+	if a.GetDescription() != "" || a.GetMarkdownDescription() != "" {
+		schemaAttribute.Description, schemaAttribute.DescriptionKind = SchemaDescription(a.GetDescription(), a.GetMarkdownDescription(), opts)
 	}
 
 	nestedAttribute, ok := a.(fwschema.NestedAttribute)
@@ -62,7 +66,7 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 	}
 
 	for nestedName, nestedA := range nestedAttribute.GetNestedObject().GetAttributes() {
-		nestedSchemaAttribute, err := SchemaAttribute(ctx, nestedName, path.WithAttributeName(nestedName), nestedA)
+		nestedSchemaAttribute, err := schemaAttributeWithParentSensitive(ctx, nestedName, path.WithAttributeName(nestedName), nestedA, opts, fwschema.AttributeSensitive(a, parentSensitive))
 
 		if err != nil {
 			return nil, err
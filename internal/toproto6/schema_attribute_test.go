@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -21,6 +22,7 @@ func TestSchemaAttribute(t *testing.T) {
 		name        string
 		attr        fwschema.Attribute
 		path        *tftypes.AttributePath
+		opts        toproto6.SchemaOptions
 		expected    *tfprotov6.SchemaAttribute
 		expectedErr string
 	}
@@ -70,6 +72,89 @@ func TestSchemaAttribute(t *testing.T) {
 				Type:            tftypes.String,
 				Optional:        true,
 				Description:     "A string attribute",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"description-markdown-disable-derivation": {
+			name: "string",
+			attr: testschema.Attribute{
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A string attribute",
+			},
+			path: tftypes.NewAttributePath(),
+			opts: toproto6.SchemaOptions{
+				DisableDescriptionDerivation: true,
+			},
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Description:     "A string attribute",
+				DescriptionKind: tfprotov6.StringKindMarkdown,
+			},
+		},
+		"description-markdown-formatted": {
+			name: "string",
+			attr: testschema.Attribute{
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A **string** attribute",
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Description:     "A **string** attribute",
+				DescriptionKind: tfprotov6.StringKindMarkdown,
+			},
+		},
+		"description-nested-attributes-markdown": {
+			name: "single_nested",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"formatted": testschema.Attribute{
+							Type:                types.StringType,
+							Optional:            true,
+							MarkdownDescription: "A `formatted` attribute",
+						},
+						"unformatted": testschema.Attribute{
+							Type:                types.StringType,
+							Optional:            true,
+							MarkdownDescription: "An unformatted attribute",
+						},
+					},
+				},
+				NestingMode:         fwschema.NestingModeSingle,
+				Optional:            true,
+				MarkdownDescription: "A [nested](https://example.com) attribute",
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name: "single_nested",
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:            "formatted",
+							Type:            tftypes.String,
+							Optional:        true,
+							Description:     "A `formatted` attribute",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+						},
+						{
+							Name:            "unformatted",
+							Type:            tftypes.String,
+							Optional:        true,
+							Description:     "An unformatted attribute",
+							DescriptionKind: tfprotov6.StringKindPlain,
+						},
+					},
+				},
+				Optional:        true,
+				Description:     "A [nested](https://example.com) attribute",
 				DescriptionKind: tfprotov6.StringKindMarkdown,
 			},
 		},
@@ -259,6 +344,137 @@ func TestSchemaAttribute(t *testing.T) {
 				Sensitive: true,
 			},
 		},
+		"sensitive-nested-attr-single": {
+			name: "single_nested",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"computed": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+						"optional": testschema.Attribute{
+							Type:     types.NumberType,
+							Optional: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name: "single_nested",
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:      "computed",
+							Type:      tftypes.String,
+							Computed:  true,
+							Sensitive: true,
+						},
+						{
+							Name:      "optional",
+							Type:      tftypes.Number,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		"sensitive-nested-attr-list-nested-attr-single": {
+			name: "list_nested",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"single_nested": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"string": testschema.Attribute{
+										Type:     types.StringType,
+										Optional: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name: "list_nested",
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeList,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name: "single_nested",
+							NestedType: &tfprotov6.SchemaObject{
+								Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:      "string",
+										Type:      tftypes.String,
+										Optional:  true,
+										Sensitive: true,
+									},
+								},
+							},
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		"sensitive-nested-attr-single-non-sensitive": {
+			name: "single_nested",
+			attr: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:     true,
+						NonSensitive: true,
+					},
+					"secret": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Computed:  true,
+				Sensitive: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name: "single_nested",
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Computed: true,
+						},
+						{
+							Name:      "secret",
+							Type:      tftypes.String,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
 		"nested-attr-single": {
 			name: "single_nested",
 			attr: testschema.NestedAttribute{
@@ -438,7 +654,7 @@ func TestSchemaAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := toproto6.SchemaAttribute(context.Background(), tc.name, tc.path, tc.attr)
+			got, err := toproto6.SchemaAttribute(context.Background(), tc.name, tc.path, tc.attr, tc.opts)
 			if err != nil {
 				if tc.expectedErr == "" {
 					t.Errorf("Unexpected error: %s", err)
@@ -0,0 +1,40 @@
+package toproto6
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// SchemaOptions are options for converting schemas.
+type SchemaOptions struct {
+	// DisableDescriptionDerivation prevents deriving the plain text or
+	// Markdown description from the other when only one is set, which
+	// otherwise determines the description kind. When disabled, the Markdown
+	// description is used if set, otherwise the plain text description.
+	DisableDescriptionDerivation bool
+}
+
+// SchemaDescription returns the tfprotov6 description and description kind
+// equivalent of the given plain text and Markdown descriptions.
+//
+// By default, the descriptions are resolved with fwschema.ResolveDescriptions
+// and the Markdown description is only returned if it differs from the plain
+// text description, such as when it contains formatting. Otherwise, the plain
+// text description is returned.
+func SchemaDescription(description string, markdownDescription string, opts SchemaOptions) (string, tfprotov6.StringKind) {
+	if opts.DisableDescriptionDerivation {
+		if markdownDescription != "" {
+			return markdownDescription, tfprotov6.StringKindMarkdown
+		}
+
+		return description, tfprotov6.StringKindPlain
+	}
+
+	description, markdownDescription = fwschema.ResolveDescriptions(description, markdownDescription)
+
+	if markdownDescription != description {
+		return markdownDescription, tfprotov6.StringKindMarkdown
+	}
+
+	return description, tfprotov6.StringKindPlain
+}
@@ -19,6 +19,7 @@ func TestSchema(t *testing.T) {
 
 	type testCase struct {
 		input       fwschema.Schema
+		opts        toproto6.SchemaOptions
 		expected    *tfprotov6.Schema
 		expectedErr string
 	}
@@ -566,6 +567,61 @@ func TestSchema(t *testing.T) {
 						},
 					},
 					Description:     "a test resource",
+					DescriptionKind: tfprotov6.StringKindPlain,
+				},
+			},
+		},
+		"markdown-description-disable-derivation": {
+			input: testschema.Schema{
+				Version: 1,
+				Attributes: map[string]fwschema.Attribute{
+					"string": testschema.Attribute{
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				MarkdownDescription: "a test resource",
+			},
+			opts: toproto6.SchemaOptions{
+				DisableDescriptionDerivation: true,
+			},
+			expected: &tfprotov6.Schema{
+				Version: 1,
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "string",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+					Description:     "a test resource",
+					DescriptionKind: tfprotov6.StringKindMarkdown,
+				},
+			},
+		},
+		"markdown-description-formatted": {
+			input: testschema.Schema{
+				Version: 1,
+				Attributes: map[string]fwschema.Attribute{
+					"string": testschema.Attribute{
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				MarkdownDescription: "a *test* resource",
+			},
+			expected: &tfprotov6.Schema{
+				Version: 1,
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "string",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+					Description:     "a *test* resource",
 					DescriptionKind: tfprotov6.StringKindMarkdown,
 				},
 			},
@@ -628,7 +684,7 @@ func TestSchema(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := toproto6.Schema(context.Background(), tc.input)
+			got, err := toproto6.Schema(context.Background(), tc.input, tc.opts)
 			if err != nil {
 				if tc.expectedErr == "" {
 					t.Errorf("Unexpected error: %s", err)
@@ -0,0 +1,21 @@
+package validatordiag
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// InvalidAttributeValueDiagnostic returns an error diagnostic for an
+// attribute value which does not pass validation. The description should be
+// the validator description, such as "list must contain at least 2 elements",
+// and the value should be the offending value or value property, such as the
+// number of elements.
+func InvalidAttributeValueDiagnostic(p path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", p, description, value),
+	)
+}
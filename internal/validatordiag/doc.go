@@ -0,0 +1,3 @@
+// Package validatordiag contains diagnostics shared by the framework defined
+// schema validators.
+package validatordiag
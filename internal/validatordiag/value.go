@@ -0,0 +1,37 @@
+package validatordiag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// SensitiveValue is the diagnostic value of sensitive attributes.
+const SensitiveValue = "(sensitive value)"
+
+// AttributeValueString returns the given string representation of the
+// attribute value for diagnostics, or SensitiveValue if the attribute at the
+// path of the configuration schema is sensitive, including Sensitive
+// inherited from parent nested attributes. SensitiveValue is also returned if
+// the configuration has no schema or the path cannot be converted, since it
+// is then unknown whether the attribute is sensitive.
+func AttributeValueString(ctx context.Context, config tfsdk.Config, p path.Path, value string) string {
+	if config.Schema == nil {
+		return SensitiveValue
+	}
+
+	tfPath, diags := totftypes.AttributePath(ctx, p)
+
+	if diags.HasError() {
+		return SensitiveValue
+	}
+
+	if fwschema.SchemaSensitiveAtTerraformPath(ctx, config.Schema, tfPath) {
+		return SensitiveValue
+	}
+
+	return value
+}
@@ -0,0 +1,69 @@
+package validatordiag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttributeValueString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_sensitive": testschema.Attribute{
+				Type:      types.StringType,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"test_string": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		path     path.Path
+		expected string
+	}{
+		"not-sensitive": {
+			config:   tfsdk.Config{Schema: testSchema},
+			path:     path.Root("test_string"),
+			expected: "test-value",
+		},
+		"sensitive": {
+			config:   tfsdk.Config{Schema: testSchema},
+			path:     path.Root("test_sensitive"),
+			expected: validatordiag.SensitiveValue,
+		},
+		"schema-missing": {
+			config:   tfsdk.Config{},
+			path:     path.Root("test_string"),
+			expected: validatordiag.SensitiveValue,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatordiag.AttributeValueString(context.Background(), testCase.config, testCase.path, "test-value")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
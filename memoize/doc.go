@@ -0,0 +1,11 @@
+// Package memoize implements an operation-scoped, concurrency-safe
+// memoization primitive, which providers can use so many resources and data
+// sources needing the same expensive remote system lookup, such as resolving
+// a network identifier by name, share a single API call.
+//
+// A Group is typically created in the provider Configure method, which
+// Terraform calls once per operation such as plan or apply, attached to the
+// API client, and passed to resources and data sources via the ResourceData
+// and DataSourceData fields of the ConfigureResponse. This scopes memoized
+// results to a single Terraform operation.
+package memoize
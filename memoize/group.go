@@ -0,0 +1,122 @@
+package memoize
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// errLoaderPanicked is returned to callers waiting on a loader call which
+// panicked.
+var errLoaderPanicked = errors.New("memoize: loader panicked")
+
+// Group memoizes the results of loader functions by key. Concurrent calls
+// for the same key share a single loader call. Successful results are kept
+// until Forget or Reset is called, while errors are not kept so later calls
+// retry the loader. The zero value is ready to use and a Group is safe for
+// concurrent use.
+type Group[K comparable, V any] struct {
+	mutex sync.Mutex
+	calls map[K]*call[V]
+}
+
+// call is an in-flight or completed loader call.
+type call[V any] struct {
+	// done is closed when the loader returns.
+	done chan struct{}
+
+	value V
+	err   error
+}
+
+// Do returns the memoized value for the given key, calling the loader if
+// there is no successful result yet. If another call for the same key is in
+// flight, Do waits for its result instead of calling the loader again. It
+// returns the context error if the context is done before a result is
+// available.
+//
+// The loader receives the context of the call which started it, so callers
+// waiting on that call also receive any error caused by its cancellation.
+func (g *Group[K, V]) Do(ctx context.Context, key K, loader func(context.Context) (V, error)) (V, error) {
+	var zero V
+
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	g.mutex.Lock()
+
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+
+	c, ok := g.calls[key]
+
+	if !ok {
+		c = &call[V]{
+			done: make(chan struct{}),
+		}
+
+		g.calls[key] = c
+		g.mutex.Unlock()
+
+		g.load(ctx, key, c, loader)
+
+		return c.value, c.err
+	}
+
+	g.mutex.Unlock()
+
+	select {
+	case <-c.done:
+		return c.value, c.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// Forget removes the memoized result for the given key, so the next call to
+// Do calls the loader. Calls already in flight are not affected.
+func (g *Group[K, V]) Forget(key K) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	delete(g.calls, key)
+}
+
+// Reset removes all memoized results.
+func (g *Group[K, V]) Reset() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.calls = nil
+}
+
+// load calls the loader and records its result. Failed calls, including
+// those where the loader panics, are removed so they are not memoized.
+func (g *Group[K, V]) load(ctx context.Context, key K, c *call[V], loader func(context.Context) (V, error)) {
+	returned := false
+
+	defer func() {
+		if !returned {
+			c.err = errLoaderPanicked
+		}
+
+		if c.err != nil {
+			g.mutex.Lock()
+
+			// Only remove the call if it was not already replaced via Forget
+			// or Reset and a later Do.
+			if g.calls[key] == c {
+				delete(g.calls, key)
+			}
+
+			g.mutex.Unlock()
+		}
+
+		close(c.done)
+	}()
+
+	c.value, c.err = loader(ctx)
+	returned = true
+}
@@ -0,0 +1,213 @@
+package memoize_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/memoize"
+)
+
+func TestGroupDo(t *testing.T) {
+	t.Parallel()
+
+	var group memoize.Group[string, int]
+	var calls int32
+
+	loader := func(_ context.Context) (int, error) {
+		return int(atomic.AddInt32(&calls, 1)), nil
+	}
+
+	for i := 0; i < 3; i++ {
+		got, err := group.Do(context.Background(), "test", loader)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != 1 {
+			t.Errorf("expected memoized value 1, got: %d", got)
+		}
+	}
+
+	got, err := group.Do(context.Background(), "other", loader)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 2 {
+		t.Errorf("expected value 2 for different key, got: %d", got)
+	}
+}
+
+func TestGroupDo_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	var group memoize.Group[string, string]
+	var calls int32
+
+	release := make(chan struct{})
+
+	loader := func(_ context.Context) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+
+		return "test-value", nil
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			got, err := group.Do(context.Background(), "test", loader)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if got != "test-value" {
+				t.Errorf("unexpected value: %s", got)
+			}
+		}()
+	}
+
+	// Give all goroutines a chance to wait on the in-flight call.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 loader call, got: %d", got)
+	}
+}
+
+func TestGroupDo_Error(t *testing.T) {
+	t.Parallel()
+
+	var group memoize.Group[string, int]
+	var calls int
+
+	testErr := errors.New("test error")
+
+	loader := func(_ context.Context) (int, error) {
+		calls++
+
+		if calls == 1 {
+			return 0, testErr
+		}
+
+		return calls, nil
+	}
+
+	_, err := group.Do(context.Background(), "test", loader)
+
+	if !errors.Is(err, testErr) {
+		t.Fatalf("expected test error, got: %v", err)
+	}
+
+	got, err := group.Do(context.Background(), "test", loader)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 2 {
+		t.Errorf("expected errors to not be memoized and value 2, got: %d", got)
+	}
+}
+
+func TestGroupDo_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	var group memoize.Group[string, int]
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	go func() {
+		_, _ = group.Do(context.Background(), "test", func(_ context.Context) (int, error) {
+			close(started)
+			<-release
+
+			return 1, nil
+		})
+	}()
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := group.Do(ctx, "test", func(_ context.Context) (int, error) {
+		t.Error("unexpected loader call")
+
+		return 0, nil
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	close(release)
+}
+
+func TestGroupForgetReset(t *testing.T) {
+	t.Parallel()
+
+	var group memoize.Group[string, int]
+	var calls int
+
+	loader := func(_ context.Context) (int, error) {
+		calls++
+
+		return calls, nil
+	}
+
+	_, _ = group.Do(context.Background(), "test", loader)
+
+	group.Forget("test")
+
+	if got, _ := group.Do(context.Background(), "test", loader); got != 2 {
+		t.Errorf("expected value 2 after Forget, got: %d", got)
+	}
+
+	group.Reset()
+
+	if got, _ := group.Do(context.Background(), "test", loader); got != 3 {
+		t.Errorf("expected value 3 after Reset, got: %d", got)
+	}
+}
+
+func TestGroupDo_Panic(t *testing.T) {
+	t.Parallel()
+
+	var group memoize.Group[string, int]
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic")
+			}
+		}()
+
+		_, _ = group.Do(context.Background(), "test", func(_ context.Context) (int, error) {
+			panic("test panic")
+		})
+	}()
+
+	got, err := group.Do(context.Background(), "test", func(_ context.Context) (int, error) {
+		return 1, nil
+	})
+
+	if err != nil || got != 1 {
+		t.Errorf("expected panicked call to not be memoized, got: %d, %v", got, err)
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/capability"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -28,6 +29,13 @@ type ConfigureRequest struct {
 // an argument to the provider's Configure function, in which the provider
 // should set values on the ConfigureResponse as appropriate.
 type ConfigureResponse struct {
+	// Capabilities describes the versions and features supported by the
+	// remote system, such as an API endpoint, the provider is configured
+	// against. When set, the framework validates resource and data source
+	// attribute requirements against it. When nil, attribute requirements
+	// are not checked.
+	Capabilities *capability.Capabilities
+
 	// DataSourceData is provider-defined data, clients, etc. that is passed
 	// to [datasource.ConfigureRequest.ProviderData] for each DataSource type
 	// that implements the Configure method.
@@ -0,0 +1,16 @@
+// Package endpoint implements consistently validated endpoint URL attributes,
+// such as those commonly implemented by providers to support custom API
+// endpoints for testing or private deployments.
+//
+// Providers add an endpoint to the provider schema with Attribute, which
+// returns an Optional string attribute that must be an absolute URL with a
+// scheme in an allow-list, defaulting to "https". Reachability of the
+// endpoint during validation can be opted into with Opts.
+//
+// Within the provider Configure method, the Value type is read from the
+// configuration and its Endpoint method returns the configured URL without
+// trailing slashes, so paths can be consistently appended.
+//
+// The URL and Reachable validators can also be used directly with other
+// string attributes.
+package endpoint
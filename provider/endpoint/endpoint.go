@@ -0,0 +1,137 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable  = Type{}
+	_ basetypes.StringValuable = Value{}
+)
+
+// Type is the attribute type of the endpoint attribute returned by
+// Attribute, which enables reading data models with the Value type.
+type Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Type) Equal(o attr.Type) bool {
+	_, ok := o.(Type)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t Type) String() string {
+	return "endpoint.Type"
+}
+
+// ValueFromString returns a Value given a basetypes.StringValue.
+func (t Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Value{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Value{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t Type) ValueType(_ context.Context) attr.Value {
+	return Value{}
+}
+
+// Value is the value type of the endpoint attribute returned by Attribute.
+// Use it in provider data models, such as:
+//
+//	type ExampleProviderModel struct {
+//	  Endpoint endpoint.Value `tfsdk:"endpoint"`
+//	}
+//
+// The Endpoint and URL methods return the configured endpoint, or the given
+// default if the value is null or unknown.
+type Value struct {
+	basetypes.StringValue
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns a Type.
+func (v Value) Type(_ context.Context) attr.Type {
+	return Type{}
+}
+
+// ToStringValue returns the underlying basetypes.StringValue.
+func (v Value) ToStringValue(_ context.Context) (basetypes.StringValue, diag.Diagnostics) {
+	return v.StringValue, nil
+}
+
+// Endpoint returns the configured endpoint or the given default, without
+// trailing slashes. This ensures paths can be appended consistently whether
+// or not practitioners configure a trailing slash.
+func (v Value) Endpoint(defaultEndpoint string) string {
+	endpoint := defaultEndpoint
+
+	if !v.IsNull() && !v.IsUnknown() {
+		endpoint = v.ValueString()
+	}
+
+	return strings.TrimRight(endpoint, "/")
+}
+
+// URL returns the parsed result of Endpoint. An error diagnostic is returned
+// if the endpoint cannot be parsed, which should only occur with an invalid
+// default since configured values are validated.
+func (v Value) URL(_ context.Context, defaultEndpoint string) (*url.URL, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpoint := v.Endpoint(defaultEndpoint)
+
+	result, err := url.Parse(endpoint)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Endpoint URL",
+			fmt.Sprintf("Unable to parse endpoint %q: %s", endpoint, err),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,129 @@
+package endpoint_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/endpoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value           endpoint.Value
+		defaultEndpoint string
+		expected        string
+	}{
+		"null": {
+			value: endpoint.Value{
+				StringValue: types.StringNull(),
+			},
+			defaultEndpoint: "https://api.example.com/",
+			expected:        "https://api.example.com",
+		},
+		"unknown": {
+			value: endpoint.Value{
+				StringValue: types.StringUnknown(),
+			},
+			defaultEndpoint: "https://api.example.com",
+			expected:        "https://api.example.com",
+		},
+		"value": {
+			value: endpoint.Value{
+				StringValue: types.StringValue("https://custom.example.com/v1"),
+			},
+			defaultEndpoint: "https://api.example.com",
+			expected:        "https://custom.example.com/v1",
+		},
+		"value-trailing-slashes": {
+			value: endpoint.Value{
+				StringValue: types.StringValue("https://custom.example.com/v1//"),
+			},
+			defaultEndpoint: "https://api.example.com",
+			expected:        "https://custom.example.com/v1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Endpoint(testCase.defaultEndpoint)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value               endpoint.Value
+		defaultEndpoint     string
+		expected            *url.URL
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null": {
+			value: endpoint.Value{
+				StringValue: types.StringNull(),
+			},
+			defaultEndpoint: "https://api.example.com",
+			expected: &url.URL{
+				Scheme: "https",
+				Host:   "api.example.com",
+			},
+		},
+		"value": {
+			value: endpoint.Value{
+				StringValue: types.StringValue("http://localhost:8080/v1/"),
+			},
+			defaultEndpoint: "https://api.example.com",
+			expected: &url.URL{
+				Scheme: "http",
+				Host:   "localhost:8080",
+				Path:   "/v1",
+			},
+		},
+		"default-invalid": {
+			value: endpoint.Value{
+				StringValue: types.StringNull(),
+			},
+			defaultEndpoint: "https://api.example.com:port",
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Endpoint URL",
+					`Unable to parse endpoint "https://api.example.com:port": parse "https://api.example.com:port": invalid port ":port" after host`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.URL(context.Background(), testCase.defaultEndpoint)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Opts is used as an argument to Attribute to customize the endpoint
+// attribute.
+type Opts struct {
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about the endpoint attribute.
+	Description string
+
+	// MarkdownDescription is the Markdown equivalent of Description.
+	MarkdownDescription string
+
+	// Schemes are the allowed URL schemes, such as "https". Defaults to only
+	// "https".
+	Schemes []string
+
+	// CheckReachability enables a connection attempt to the endpoint host
+	// during validation, which returns a warning diagnostic if the endpoint
+	// is not reachable. Defaults to false, since validation may run without
+	// network access.
+	CheckReachability bool
+
+	// ReachabilityTimeout is the connection timeout when CheckReachability
+	// is enabled. Defaults to 5 seconds.
+	ReachabilityTimeout time.Duration
+}
+
+// Attribute returns a provider schema.StringAttribute which is Optional and
+// validates the configured value is an endpoint URL according to opts. For
+// example:
+//
+//	provider "example" {
+//	  endpoint = "https://api.example.com/v1"
+//	}
+//
+// Read the configured value into the Value type in the provider Configure
+// method.
+func Attribute(ctx context.Context, opts Opts) schema.Attribute {
+	validators := []validator.String{
+		URL(opts.Schemes...),
+	}
+
+	if opts.CheckReachability {
+		validators = append(validators, Reachable(opts.ReachabilityTimeout))
+	}
+
+	return schema.StringAttribute{
+		CustomType:          Type{},
+		Description:         opts.Description,
+		MarkdownDescription: opts.MarkdownDescription,
+		Optional:            true,
+		Validators:          validators,
+	}
+}
@@ -0,0 +1,186 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// defaultReachabilityTimeout is the connection timeout of the Reachable
+// validator when none is given.
+const defaultReachabilityTimeout = 5 * time.Second
+
+var (
+	_ validator.String = urlValidator{}
+	_ validator.String = reachableValidator{}
+)
+
+// URL returns a validator which ensures string values are absolute URLs with
+// a host and one of the given schemes, compared case insensitively. Defaults
+// to only allowing the "https" scheme. Query strings and fragments are not
+// allowed, since they cannot be consistently combined with request paths.
+// Null and unknown values are skipped.
+func URL(schemes ...string) validator.String {
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+
+	return urlValidator{
+		schemes: schemes,
+	}
+}
+
+// urlValidator is the validator returned by URL.
+type urlValidator struct {
+	schemes []string
+}
+
+// Description returns a plaintext description of the validator.
+func (v urlValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string must be a URL with a host and scheme of: %s", strings.Join(v.schemes, ", "))
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v urlValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("string must be a URL with a host and scheme of: `%s`", strings.Join(v.schemes, "`, `"))
+}
+
+// ValidateString returns an error if the configured value is not a valid
+// endpoint URL.
+func (v urlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	parsed, err := url.Parse(value)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Endpoint URL",
+			fmt.Sprintf("%q %s, got parsing error: %s", value, v.Description(ctx), err),
+		)
+
+		return
+	}
+
+	if !v.allowedScheme(parsed.Scheme) || parsed.Host == "" || parsed.Opaque != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Endpoint URL",
+			fmt.Sprintf("%q %s", value, v.Description(ctx)),
+		)
+
+		return
+	}
+
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Endpoint URL",
+			fmt.Sprintf("%q must not contain a query string or fragment", value),
+		)
+	}
+}
+
+// allowedScheme returns true if the scheme is in the allow-list.
+func (v urlValidator) allowedScheme(scheme string) bool {
+	for _, allowed := range v.schemes {
+		if strings.EqualFold(scheme, allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reachable returns a validator which attempts a TCP connection to the host
+// of string values which are URLs, returning a warning diagnostic if the
+// connection fails within the given timeout. Defaults to a 5 second timeout.
+// The port defaults to 80 for the "http" scheme and 443 for the "https"
+// scheme. Null and unknown values, and values which are not valid URLs, are
+// skipped, so this should be combined with the URL validator.
+//
+// Validation can occur without network access, such as the terraform
+// validate command in continuous integration, which is why failures are
+// warnings rather than errors.
+func Reachable(timeout time.Duration) validator.String {
+	if timeout <= 0 {
+		timeout = defaultReachabilityTimeout
+	}
+
+	return reachableValidator{
+		timeout: timeout,
+	}
+}
+
+// reachableValidator is the validator returned by Reachable.
+type reachableValidator struct {
+	timeout time.Duration
+}
+
+// Description returns a plaintext description of the validator.
+func (v reachableValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("URL host should accept connections within %s", v.timeout)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v reachableValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString returns a warning if the host of the configured value does
+// not accept a connection.
+func (v reachableValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	parsed, err := url.Parse(value)
+
+	if err != nil || parsed.Hostname() == "" {
+		return
+	}
+
+	port := parsed.Port()
+
+	if port == "" {
+		switch strings.ToLower(parsed.Scheme) {
+		case "http":
+			port = "80"
+		case "https":
+			port = "443"
+		default:
+			return
+		}
+	}
+
+	dialer := net.Dialer{
+		Timeout: v.timeout,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(parsed.Hostname(), port))
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Endpoint Unreachable",
+			fmt.Sprintf("The endpoint %q was not reachable during validation, which may cause errors when the provider connects to it. "+
+				"Verify the endpoint is correct and accessible from this network.\n\n"+
+				"Error: %s", value, err),
+		)
+
+		return
+	}
+
+	conn.Close()
+}
@@ -0,0 +1,190 @@
+package endpoint_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/endpoint"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestURLValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemes             []string
+		value               types.String
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"https": {
+			value: types.StringValue("https://api.example.com/v1/"),
+		},
+		"https-uppercase": {
+			value: types.StringValue("HTTPS://api.example.com"),
+		},
+		"http-default-schemes": {
+			value: types.StringValue("http://api.example.com"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("endpoint"),
+					"Invalid Attribute Value Endpoint URL",
+					`"http://api.example.com" string must be a URL with a host and scheme of: https`,
+				),
+			},
+		},
+		"http-schemes": {
+			schemes: []string{"http", "https"},
+			value:   types.StringValue("http://localhost:8080"),
+		},
+		"missing-scheme": {
+			value: types.StringValue("api.example.com"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("endpoint"),
+					"Invalid Attribute Value Endpoint URL",
+					`"api.example.com" string must be a URL with a host and scheme of: https`,
+				),
+			},
+		},
+		"missing-host": {
+			value: types.StringValue("https:///v1"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("endpoint"),
+					"Invalid Attribute Value Endpoint URL",
+					`"https:///v1" string must be a URL with a host and scheme of: https`,
+				),
+			},
+		},
+		"query": {
+			value: types.StringValue("https://api.example.com?region=us"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("endpoint"),
+					"Invalid Attribute Value Endpoint URL",
+					`"https://api.example.com?region=us" must not contain a query string or fragment`,
+				),
+			},
+		},
+		"unparseable": {
+			value: types.StringValue("https://api.example.com:port"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("endpoint"),
+					"Invalid Attribute Value Endpoint URL",
+					`"https://api.example.com:port" string must be a URL with a host and scheme of: https, `+
+						`got parsing error: parse "https://api.example.com:port": invalid port ":port" after host`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue: testCase.value,
+				Path:        path.Root("endpoint"),
+			}
+			resp := &validator.StringResponse{}
+
+			endpoint.URL(testCase.schemes...).ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReachableValidateString(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("unable to create listener: %s", err)
+	}
+
+	t.Cleanup(func() { listener.Close() })
+
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("unable to create listener: %s", err)
+	}
+
+	closedAddress := closedListener.Addr().String()
+	closedListener.Close()
+
+	testCases := map[string]struct {
+		value           types.String
+		expectedWarning bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"invalid-url": {
+			value: types.StringValue("api.example.com"),
+		},
+		"reachable": {
+			value: types.StringValue("http://" + listener.Addr().String()),
+		},
+		"unreachable": {
+			value:           types.StringValue("http://" + closedAddress),
+			expectedWarning: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue: testCase.value,
+				Path:        path.Root("endpoint"),
+			}
+			resp := &validator.StringResponse{}
+
+			endpoint.Reachable(time.Second).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !testCase.expectedWarning {
+				if len(resp.Diagnostics) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+
+				return
+			}
+
+			if len(resp.Diagnostics) != 1 || !strings.Contains(resp.Diagnostics[0].Detail(), closedAddress) {
+				t.Fatalf("expected unreachable warning diagnostic, got: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// EventType is the kind of a provider Event.
+type EventType string
+
+const (
+	// EventTypeServingStarted is emitted when the provider server is about
+	// to begin serving Terraform requests.
+	EventTypeServingStarted EventType = "serving_started"
+
+	// EventTypeSchemaServed is emitted each time the provider schema,
+	// including all data source and resource schemas, is successfully
+	// returned to Terraform. The Event Duration is the time taken to gather
+	// the schemas.
+	EventTypeSchemaServed EventType = "schema_served"
+
+	// EventTypeFirstConfigure is emitted once, after the first call of the
+	// provider Configure method. The Event Duration is the time taken by
+	// the Configure method.
+	EventTypeFirstConfigure EventType = "first_configure"
+
+	// EventTypeStopRequested is emitted when Terraform requests the provider
+	// to stop, such as when a practitioner interrupts a Terraform command.
+	EventTypeStopRequested EventType = "stop_requested"
+
+	// EventTypeAttributeValidationFailed is emitted once per error
+	// diagnostic with an attribute path returned by schema-based validation
+	// of a provider, data source, or resource configuration, which enables
+	// counting validation failures per attribute. The Event AttributePath is
+	// the path of the diagnostic and the Event TypeName is the data source or
+	// resource type name, or empty for provider configuration.
+	EventTypeAttributeValidationFailed EventType = "attribute_validation_failed"
+)
+
+// Event is a provider event, which enables provider telemetry such as
+// measuring cold start and Configure latency or counting validation failures.
+type Event struct {
+	// Type is the kind of event.
+	Type EventType
+
+	// Time is when the event occurred.
+	Time time.Time
+
+	// Duration is the time taken by the operation which caused the event, if
+	// applicable to the event type.
+	Duration time.Duration
+
+	// AttributePath is the attribute path associated with the event, if
+	// applicable to the event type.
+	AttributePath path.Path
+
+	// TypeName is the data source or resource type name associated with the
+	// event, if applicable to the event type.
+	TypeName string
+}
+
+// EventListener is a function which receives provider events. Listeners are called synchronously as events occur, so they
+// should return quickly and must be safe for concurrent use.
+type EventListener func(context.Context, Event)
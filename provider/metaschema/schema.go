@@ -2,6 +2,7 @@ package metaschema
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -35,18 +36,32 @@ func (s Schema) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep)
 
 // AttributeAtPath returns the Attribute at the passed path. If the path points
 // to an element or attribute of a complex type, rather than to an Attribute,
-// it will return an ErrPathInsideAtomicAttribute error.
+// it will return an ErrPathInsideAtomicAttribute error. Paths to attributes
+// within nested attribute or block objects return the nested Attribute.
 func (s Schema) AttributeAtPath(ctx context.Context, p path.Path) (fwschema.Attribute, diag.Diagnostics) {
 	return fwschema.SchemaAttributeAtPath(ctx, s, p)
 }
 
-// AttributeAtPath returns the Attribute at the passed path. If the path points
-// to an element or attribute of a complex type, rather than to an Attribute,
-// it will return an ErrPathInsideAtomicAttribute error.
+// AttributeAtTerraformPath returns the Attribute at the passed path. If the
+// path points to an element or attribute of a complex type, rather than to an
+// Attribute, it will return an ErrPathInsideAtomicAttribute error. Paths to
+// attributes within nested attribute or block objects return the nested
+// Attribute.
+//
+// Deprecated: Use the AttributeAtPath method instead. A *tftypes.AttributePath
+// can be converted with the tfsdk.FromTerraformPath function.
 func (s Schema) AttributeAtTerraformPath(ctx context.Context, p *tftypes.AttributePath) (fwschema.Attribute, error) {
 	return fwschema.SchemaAttributeAtTerraformPath(ctx, s, p)
 }
 
+// AttributePaths returns the path expressions of all attributes in the
+// schema, including those nested under other attributes. List, map, and
+// set nesting is represented by the AtAnyListIndex, AtAnyMapKey, and
+// AtAnySetValue expression steps. The paths are in Walk order.
+func (s Schema) AttributePaths(ctx context.Context) path.Expressions {
+	return fwschema.SchemaAttributePaths(ctx, s)
+}
+
 // GetAttributes returns the Attributes field value.
 func (s Schema) GetAttributes() map[string]fwschema.Attribute {
 	return schemaAttributes(s.Attributes)
@@ -119,11 +134,66 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 		}
 
 		diags.Append(fwschema.ValidateAttributeImplementation(ctx, attribute, req)...)
+		diags.Append(validateMetaAttribute(attribute, req.Path)...)
 	}
 
 	return diags
 }
 
+// Walk calls the given function for every attribute in the schema,
+// depth-first, with the full path expression of the attribute. Attributes are
+// visited before their nested attributes and each level is visited in name
+// order. Returning error diagnostics from the function stops the walk.
+func (s Schema) Walk(ctx context.Context, fn func(path.Expression, Attribute) diag.Diagnostics) diag.Diagnostics {
+	return fwschema.SchemaWalk(ctx, s, func(p path.Expression, a fwschema.Attribute, _ fwschema.Block) diag.Diagnostics {
+		return fn(p, a)
+	})
+}
+
+// validateMetaAttribute returns error diagnostics if the attribute or any
+// nested attributes are Computed or Sensitive, which Terraform does not
+// support for provider_meta configuration. Custom Attribute implementations
+// are otherwise not prevented from returning true for these behaviors.
+func validateMetaAttribute(attribute fwschema.Attribute, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if attribute.IsComputed() {
+		diags.Append(metaAttributeUnsupportedBehaviorDiag(attributePath, "Computed"))
+	}
+
+	if attribute.IsSensitive() {
+		diags.Append(metaAttributeUnsupportedBehaviorDiag(attributePath, "Sensitive"))
+	}
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok || nestedAttribute.GetNestedObject() == nil {
+		return diags
+	}
+
+	for name, nested := range nestedAttribute.GetNestedObject().GetAttributes() {
+		diags.Append(validateMetaAttribute(nested, attributePath.AtName(name))...)
+	}
+
+	return diags
+}
+
+// metaAttributeUnsupportedBehaviorDiag returns an error diagnostic to provider
+// developers about a provider meta schema attribute with an unsupported
+// behavior, such as Computed.
+func metaAttributeUnsupportedBehaviorDiag(attributePath path.Path, behavior string) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		diag.SummaryInvalidAttributeImplementation,
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is %s, which is not supported in provider meta schemas. ", attributePath, behavior)+
+			"Provider meta attributes can only be Optional or Required.",
+	)
+}
+
 // schemaAttributes is a provider to fwschema type conversion function.
 func schemaAttributes(attributes map[string]Attribute) map[string]fwschema.Attribute {
 	result := make(map[string]fwschema.Attribute, len(attributes))
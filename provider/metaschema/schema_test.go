@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -327,7 +328,7 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := tc.schema.AttributeAtTerraformPath(context.Background(), tc.path)
+			got, err := tc.schema.AttributeAtTerraformPath(context.Background(), tc.path) //nolint:staticcheck // Testing deprecated method
 
 			if err != nil {
 				if tc.expectedErr == "" {
@@ -354,6 +355,66 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   metaschema.Schema
+		expected path.Expressions
+	}{
+		"no-attributes": {
+			schema:   metaschema.Schema{},
+			expected: nil,
+		},
+		"nested-sets": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test_attribute": metaschema.StringAttribute{
+						Optional: true,
+					},
+					"test_set_nested": metaschema.SetNestedAttribute{
+						NestedObject: metaschema.NestedAttributeObject{
+							Attributes: map[string]metaschema.Attribute{
+								"test_set_nested": metaschema.SetNestedAttribute{
+									NestedObject: metaschema.NestedAttributeObject{
+										Attributes: map[string]metaschema.Attribute{
+											"test_leaf": metaschema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("test_attribute"),
+				path.MatchRoot("test_set_nested"),
+				path.MatchRoot("test_set_nested").AtAnySetValue().AtName("test_set_nested"),
+				path.MatchRoot("test_set_nested").AtAnySetValue().AtName("test_set_nested").AtAnySetValue().AtName("test_leaf"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.AttributePaths(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -821,7 +882,9 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -864,7 +927,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-invalid-field-name": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -881,8 +946,11 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"single_nested_attribute": metaschema.SingleNestedAttribute{
+						Optional: true,
 						Attributes: map[string]metaschema.Attribute{
-							"^": metaschema.BoolAttribute{},
+							"^": metaschema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -897,6 +965,104 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-missing-behavior": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test": metaschema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is missing the Computed, Optional, or Required field. "+
+						"One of these fields is required to describe how the attribute is configured.",
+				),
+			},
+		},
+		"attribute-required-optional": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test": metaschema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" sets the Required field with the Computed or Optional field. "+
+						"Required attributes cannot also be Computed or Optional.",
+				),
+			},
+		},
+		"attribute-computed": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test": testschema.Attribute{
+						Computed: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is Computed, which is not supported in provider meta schemas. "+
+						"Provider meta attributes can only be Optional or Required.",
+				),
+			},
+		},
+		"attribute-sensitive": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test": testschema.Attribute{
+						Optional:  true,
+						Sensitive: true,
+						Type:      types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is Sensitive, which is not supported in provider meta schemas. "+
+						"Provider meta attributes can only be Optional or Required.",
+				),
+			},
+		},
+		"nested-attribute-computed": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"single_nested_attribute": metaschema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]metaschema.Attribute{
+							"test": testschema.Attribute{
+								Computed: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" is Computed, which is not supported in provider meta schemas. "+
+						"Provider meta attributes can only be Optional or Required.",
+				),
+			},
+		},
 		"attribute-with-validate-attribute-implementation-error": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
@@ -919,6 +1085,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"list_nested_attribute": metaschema.ListNestedAttribute{
+						Optional: true,
 						NestedObject: metaschema.NestedAttributeObject{
 							Attributes: map[string]metaschema.Attribute{
 								"test": metaschema.ListAttribute{
@@ -955,3 +1122,77 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaWalk(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   metaschema.Schema
+		expected []string
+	}{
+		"no-attributes": {
+			schema:   metaschema.Schema{},
+			expected: nil,
+		},
+		"nested-sets": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test_attribute": metaschema.StringAttribute{
+						Optional: true,
+					},
+					"test_set_nested": metaschema.SetNestedAttribute{
+						NestedObject: metaschema.NestedAttributeObject{
+							Attributes: map[string]metaschema.Attribute{
+								"test_set_nested": metaschema.SetNestedAttribute{
+									NestedObject: metaschema.NestedAttributeObject{
+										Attributes: map[string]metaschema.Attribute{
+											"test_leaf": metaschema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: []string{
+				"test_attribute",
+				"test_set_nested",
+				"test_set_nested[Value(*)].test_set_nested",
+				"test_set_nested[Value(*)].test_set_nested[Value(*)].test_leaf",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := testCase.schema.Walk(context.Background(), func(p path.Expression, a metaschema.Attribute) diag.Diagnostics {
+				if a == nil {
+					t.Fatalf("expected attribute for %s", p)
+				}
+
+				got = append(got, p.String())
+
+				return nil
+			})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
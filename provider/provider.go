@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -14,6 +15,7 @@ import (
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Graceful Stop: ProviderWithStop
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -86,6 +88,24 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithStop is an interface type that extends Provider to include
+// graceful handling of Terraform stopping the provider, such as when a
+// practitioner interrupts an operation.
+//
+// Stop is called before the contexts of all in-flight operations are
+// canceled, which enables the provider to flush or finish in-flight writes
+// to non-idempotent remote APIs instead of aborting them mid-write.
+type ProviderWithStop interface {
+	Provider
+
+	// Stop is called when Terraform stops the provider. The context has a
+	// deadline, which is configured by the providerserver.ServeOpts type
+	// StopProviderTimeout field, after which in-flight operations are
+	// canceled regardless of whether Stop has returned. Error diagnostics
+	// are returned to Terraform as the stop error.
+	Stop(context.Context) diag.Diagnostics
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
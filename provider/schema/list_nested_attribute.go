@@ -15,6 +15,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                       = ListNestedAttribute{}
+	_ fwschema.AttributeWithNonSensitive    = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListValidators = ListNestedAttribute{}
 )
 
@@ -70,8 +71,18 @@ type ListNestedAttribute struct {
 	// in CLI output. Sensitive does not impact how values are stored, and
 	// practitioners are encouraged to store their state as if the entire
 	// file is sensitive.
+	//
+	// Setting it to true also marks all nested attributes as sensitive,
+	// unless they set NonSensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -196,6 +207,11 @@ func (a ListNestedAttribute) IsComputed() bool {
 	return false
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a ListNestedAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a ListNestedAttribute) IsOptional() bool {
 	return a.Optional
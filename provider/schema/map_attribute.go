@@ -15,8 +15,10 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                    = MapAttribute{}
+	_ fwschema.AttributeWithNonSensitive           = MapAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
 	_ fwxschema.AttributeWithMapValidators         = MapAttribute{}
+	_ fwxschema.AttributeWithMapKeyValidators      = MapAttribute{}
 )
 
 // MapAttribute represents a schema attribute that is a list with a single
@@ -67,6 +69,13 @@ type MapAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -126,6 +135,15 @@ type MapAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
+
+	// KeyValidators define validation functionality for each key of the
+	// map. All elements of the slice of validators are run for each key,
+	// regardless of any previous error diagnostics. Validators are only run
+	// for known map values.
+	//
+	// Use this field to validate map keys, such as requiring a naming
+	// pattern, without implementing a custom type.
+	KeyValidators []validator.String
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a map
@@ -175,6 +193,11 @@ func (a MapAttribute) IsComputed() bool {
 	return false
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a MapAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a MapAttribute) IsOptional() bool {
 	return a.Optional
@@ -190,6 +213,11 @@ func (a MapAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// MapKeyValidators returns the KeyValidators field value.
+func (a MapAttribute) MapKeyValidators() []validator.String {
+	return a.KeyValidators
+}
+
 // MapValidators returns the Validators field value.
 func (a MapAttribute) MapValidators() []validator.Map {
 	return a.Validators
@@ -389,6 +389,40 @@ func TestMapAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestMapAttributeMapKeyValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.MapAttribute
+		expected  []validator.String
+	}{
+		"no-validators": {
+			attribute: schema.MapAttribute{ElementType: types.StringType},
+			expected:  nil,
+		},
+		"validators": {
+			attribute: schema.MapAttribute{
+				KeyValidators: []validator.String{},
+			},
+			expected: []validator.String{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.MapKeyValidators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapAttributeMapValidators(t *testing.T) {
 	t.Parallel()
 
@@ -15,8 +15,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                      = MapNestedAttribute{}
-	_ fwxschema.AttributeWithMapValidators = MapNestedAttribute{}
+	_ NestedAttribute                         = MapNestedAttribute{}
+	_ fwschema.AttributeWithNonSensitive      = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapValidators    = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapKeyValidators = MapNestedAttribute{}
 )
 
 // MapNestedAttribute represents an attribute that is a set of objects where
@@ -71,8 +73,18 @@ type MapNestedAttribute struct {
 	// in CLI output. Sensitive does not impact how values are stored, and
 	// practitioners are encouraged to store their state as if the entire
 	// file is sensitive.
+	//
+	// Setting it to true also marks all nested attributes as sensitive,
+	// unless they set NonSensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -132,6 +144,15 @@ type MapNestedAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
+
+	// KeyValidators define validation functionality for each key of the
+	// map. All elements of the slice of validators are run for each key,
+	// regardless of any previous error diagnostics. Validators are only run
+	// for known map values.
+	//
+	// Use this field to validate map keys, such as requiring a naming
+	// pattern, without implementing a custom type.
+	KeyValidators []validator.String
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -197,6 +218,11 @@ func (a MapNestedAttribute) IsComputed() bool {
 	return false
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a MapNestedAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a MapNestedAttribute) IsOptional() bool {
 	return a.Optional
@@ -212,6 +238,11 @@ func (a MapNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// MapKeyValidators returns the KeyValidators field value.
+func (a MapNestedAttribute) MapKeyValidators() []validator.String {
+	return a.KeyValidators
+}
+
 // MapValidators returns the Validators field value.
 func (a MapNestedAttribute) MapValidators() []validator.Map {
 	return a.Validators
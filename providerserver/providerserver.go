@@ -17,7 +17,12 @@ import (
 // NewProtocol5 returns a protocol version 5 ProviderServer implementation
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server.Serve()
-// function and various terraform-plugin-mux functions.
+// function and various terraform-plugin-mux functions, such as muxing with
+// a terraform-plugin-sdk/v2 provider.
+//
+// Protocol version 5 schemas cannot use nested attributes. The
+// GetProviderSchema RPC returns an error diagnostic naming each nested
+// attribute path and the data source or resource type.
 func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 	return func() tfprotov5.ProviderServer {
 		return &proto5server.Server{
@@ -88,16 +93,31 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 			tf5serverOpts = append(tf5serverOpts, tf5server.WithManagedDebug())
 		}
 
+		tf5serverOpts = append(tf5serverOpts, opts.Protocol5ServeOpts...)
+
 		return tf5server.Serve(
 			opts.Address,
 			func() tfprotov5.ProviderServer {
-				provider := providerFunc()
-
-				return &proto5server.Server{
+				server := &proto5server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						AuditLabels:             opts.AuditLabels,
+						AuditSink:               opts.AuditSink,
+						DiagnosticsDetailSuffix: opts.DiagnosticsDetailSuffix,
+						EventListeners:          opts.EventListeners,
+						Instrumentation:         opts.Instrumentation,
+						Provider:                providerFunc(),
+						ReadResourceConcurrency: opts.ReadResourceConcurrency,
+						RPCTimeout:              opts.RPCTimeout,
+						StopApplyGracePeriod:    opts.StopProviderApplyGracePeriod,
+						StopApplyMode:           opts.stopApplyMode(),
+						StopTimeout:             opts.StopProviderTimeout,
+						StrictMode:              opts.StrictMode,
 					},
 				}
+
+				server.FrameworkServer.EmitEvent(ctx, provider.EventTypeServingStarted, 0)
+
+				return server
 			},
 			tf5serverOpts...,
 		)
@@ -108,16 +128,34 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 			tf6serverOpts = append(tf6serverOpts, tf6server.WithManagedDebug())
 		}
 
+		tf6serverOpts = append(tf6serverOpts, opts.Protocol6ServeOpts...)
+
 		return tf6server.Serve(
 			opts.Address,
 			func() tfprotov6.ProviderServer {
-				provider := providerFunc()
-
-				return &proto6server.Server{
+				server := &proto6server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						AuditLabels:             opts.AuditLabels,
+						AuditSink:               opts.AuditSink,
+						DiagnosticsDetailSuffix: opts.DiagnosticsDetailSuffix,
+						EventListeners:          opts.EventListeners,
+						Instrumentation:         opts.Instrumentation,
+						Provider:                providerFunc(),
+						ReadResourceConcurrency: opts.ReadResourceConcurrency,
+						RPCTimeout:              opts.RPCTimeout,
+						StopApplyGracePeriod:    opts.StopProviderApplyGracePeriod,
+						StopApplyMode:           opts.stopApplyMode(),
+						StopTimeout:             opts.StopProviderTimeout,
+						StrictMode:              opts.StrictMode,
 					},
+					DisableDescriptionDerivation: opts.DisableDescriptionDerivation,
+					RequestFuncs:                 opts.protocol6RequestFuncs(),
+					ResponseFuncs:                opts.protocol6ResponseFuncs(),
 				}
+
+				server.FrameworkServer.EmitEvent(ctx, provider.EventTypeServingStarted, 0)
+
+				return server
 			},
 			tf6serverOpts...,
 		)
@@ -4,6 +4,17 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+
+	"github.com/hashicorp/terraform-plugin-framework/audit"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/instrumentation"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
 // ServeOpts are options for serving the provider.
@@ -15,12 +26,56 @@ type ServeOpts struct {
 	// For example: registry.terraform.io/hashicorp/random.
 	Address string
 
+	// AuditLabels are caller-supplied labels, such as an environment or team
+	// name, which are included in every audit record. Requires AuditSink.
+	AuditLabels map[string]string
+
+	// AuditSink enables an audit trail of resource operations. When set, an
+	// audit.Record with the resource type, action, AuditLabels, outcome, and
+	// duration is written to the sink after each resource Create, Read,
+	// Update, and Delete operation. The audit package provides file and
+	// callback sink implementations.
+	AuditSink audit.Sink
+
+	// DiagnosticsDetailSuffix is appended to the detail of error diagnostics
+	// raised by the framework for provider or framework issues, which
+	// practitioners are asked to report to the provider developers. Set this
+	// to direct practitioners to the provider issue tracker, such as:
+	//
+	//     "Report this issue at: https://github.com/example/terraform-provider-example/issues"
+	//
+	// Diagnostics returned by provider-defined logic are not modified.
+	DiagnosticsDetailSuffix string
+
+	// EventListeners receive provider process lifecycle events, such as when
+	// the provider begins serving and when the provider is first configured,
+	// which enables provider telemetry without modifying the server.
+	EventListeners []provider.EventListener
+
 	// Debug runs the provider in a mode acceptable for debugging and testing
 	// processes, such as delve, by managing the process lifecycle. Information
 	// needed for Terraform CLI to connect to the provider is output to stdout.
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
 
+	// DisableDescriptionDerivation prevents deriving schema descriptions for
+	// protocol version 6. By default, when only one of the Description or
+	// MarkdownDescription fields of a schema, attribute, or block is set, the
+	// other is derived by stripping Markdown formatting or passing plain text
+	// through as Markdown. The Markdown description is then sent to Terraform
+	// only if it differs from the plain text description, such as when it
+	// contains formatting. Set this for providers with descriptions that
+	// intentionally differ. Only valid with protocol version 6.
+	DisableDescriptionDerivation bool
+
+	// Instrumentation is called around the handling of each RPC and each
+	// provider-defined method call within the RPC, such as Schema, Create,
+	// Read, ModifyPlan, and validators, which enables profiling or tracing
+	// where time is spent. The instrumentation package provides a Logging
+	// implementation which emits timing logs. Defaults to no
+	// instrumentation.
+	Instrumentation instrumentation.Instrumentation
+
 	// ProtocolVersion is the protocol version that should be used when serving
 	// the provider. Either protocol version 5 or protocol version 6 can be
 	// used. Defaults to protocol version 6.
@@ -28,11 +83,129 @@ type ServeOpts struct {
 	// Protocol version 5 has the following functionality limitations, which
 	// will raise an error during the GetProviderSchema or other RPCs:
 	//
-	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
+	//     - Schemas cannot use nested attributes, such as
+	//       schema.SingleNestedAttribute. Use blocks instead. The
+	//       GetProviderSchema RPC returns an error diagnostic naming each
+	//       nested attribute path and the data source or resource type.
 	//
 	ProtocolVersion int
+
+	// Protocol5ServeOpts are additional protocol version 5 server options,
+	// which are passed to tf5server.Serve after any options from other
+	// fields, such as Debug. This enables customizing the underlying
+	// go-plugin server configuration, such as with tf5server.WithGoPluginLogger
+	// or tf5server.WithManagedDebugStopSignals. Only valid with protocol
+	// version 5.
+	Protocol5ServeOpts []tf5server.ServeOpt
+
+	// Protocol6RequestFuncs are called in order with each incoming protocol
+	// version 6 request, except StopProvider, before framework handling,
+	// which enables telemetry, request limits, or compatibility adjustments
+	// without modifying the framework. Only valid with protocol version 6.
+	Protocol6RequestFuncs []Protocol6RequestFunc
+
+	// Protocol6ResponseFuncs are called in order with each outgoing protocol
+	// version 6 response, which enables final response transformations, such
+	// as trimming descriptions or redacting diagnostics, without modifying
+	// the framework. Only valid with protocol version 6.
+	Protocol6ResponseFuncs []Protocol6ResponseFunc
+
+	// Protocol6ServeOpts are additional protocol version 6 server options,
+	// which are passed to tf6server.Serve after any options from other
+	// fields, such as Debug. This enables customizing the underlying
+	// go-plugin server configuration, such as with tf6server.WithGoPluginLogger
+	// or tf6server.WithManagedDebugStopSignals. Only valid with protocol
+	// version 6.
+	Protocol6ServeOpts []tf6server.ServeOpt
+
+	// ReadResourceConcurrency is the maximum number of ReadResource RPCs,
+	// which Terraform calls when refreshing resource instances, that the
+	// provider processes concurrently. Additional requests wait and are
+	// served fairly in round-robin order per resource type, which protects
+	// remote APIs when refreshing large states. Defaults to 0, which does not
+	// limit concurrency.
+	ReadResourceConcurrency int
+
+	// RPCTimeout is the default deadline of each Terraform request to the
+	// provider, after which the context of provider-defined logic, such as
+	// resource Create or Read methods, is canceled and an error diagnostic
+	// explains the timeout. Defaults to 0, which does not apply a deadline.
+	RPCTimeout time.Duration
+
+	// StopProviderApplyMode determines how a Terraform request to stop the
+	// provider affects in-flight resource apply operations. Defaults to
+	// StopProviderApplyModeImmediate, which cancels the context of in-flight
+	// apply operations immediately and may leave remote resources partially
+	// configured if the provider does not handle cancellation.
+	StopProviderApplyMode StopProviderApplyMode
+
+	// StopProviderApplyGracePeriod is the delay before the context of
+	// in-flight resource apply operations is canceled, when
+	// StopProviderApplyMode is StopProviderApplyModeGracePeriod. It must be
+	// greater than 0 with that mode and unset otherwise.
+	StopProviderApplyGracePeriod time.Duration
+
+	// StopProviderTimeout is the deadline of the provider Stop method, if
+	// the provider implements provider.ProviderWithStop, when Terraform
+	// requests to stop the provider. The context of in-flight operations is
+	// canceled after Stop returns or the deadline passes. Defaults to 5
+	// seconds.
+	StopProviderTimeout time.Duration
+
+	// StrictMode enables development time checks, which harden provider
+	// development and testing, such as in continuous integration. Defaults
+	// to false and should not be enabled for production releases. When
+	// enabled:
+	//
+	//     - Panics in provider defined methods are returned as error
+	//       diagnostics with the stack trace, rather than crashing the
+	//       provider process.
+	//     - Schemas which are missing descriptions return warning
+	//       diagnostics.
+	//     - Resource and data source states with unknown values after
+	//       Create, Read, or Update return error diagnostics with the
+	//       attribute path.
+	//
+	// Additional checks may be enabled in the future.
+	StrictMode bool
 }
 
+// Protocol6RequestFunc is called with an incoming protocol version 6 request
+// for the given RPC, such as "ReadResource". The request is the tfprotov6
+// request pointer type of the RPC, such as *tfprotov6.ReadResourceRequest,
+// and can be modified in place. Returning error diagnostics skips the RPC
+// handling and returns the diagnostics to Terraform.
+type Protocol6RequestFunc func(ctx context.Context, rpc string, req any) diag.Diagnostics
+
+// Protocol6ResponseFunc is a final transformation of an outgoing protocol
+// version 6 response for the given RPC, such as "ReadResource". The response
+// is the tfprotov6 response pointer type of the RPC, such as
+// *tfprotov6.ReadResourceResponse, and can be modified in place.
+type Protocol6ResponseFunc func(ctx context.Context, rpc string, resp any)
+
+// StopProviderApplyMode determines how a Terraform request to stop the
+// provider, such as when a practitioner interrupts a Terraform command,
+// affects in-flight resource apply operations (Create, Update, and Delete).
+// Other in-flight operations are always canceled immediately.
+type StopProviderApplyMode int
+
+const (
+	// StopProviderApplyModeImmediate cancels the context of in-flight apply
+	// operations immediately. This is the default.
+	StopProviderApplyModeImmediate StopProviderApplyMode = 0
+
+	// StopProviderApplyModeGracePeriod cancels the context of in-flight
+	// apply operations after ServeOpts.StopProviderApplyGracePeriod, which
+	// gives remote API calls a chance to complete.
+	StopProviderApplyModeGracePeriod StopProviderApplyMode = 1
+
+	// StopProviderApplyModeFutureOnly never cancels the context of
+	// in-flight apply operations. Instead, apply operations started after the
+	// stop request receive an already canceled context. Other operations
+	// started after the stop request are unaffected.
+	StopProviderApplyModeFutureOnly StopProviderApplyMode = 2
+)
+
 // Validate a given provider address. This is only used for the Address field
 // to preserve backwards compatibility for the Name field.
 //
@@ -61,7 +234,19 @@ func (opts ServeOpts) validateAddress(_ context.Context) error {
 //
 //   - If Address is not set
 //   - Address is a valid full provider address
+//   - AuditLabels is not set without AuditSink
 //   - ProtocolVersion, if set, is 5 or 6
+//   - DisableDescriptionDerivation is not set with ProtocolVersion 5
+//   - Protocol5ServeOpts is only set with ProtocolVersion 5
+//   - Protocol6RequestFuncs is not set with ProtocolVersion 5
+//   - Protocol6ResponseFuncs is not set with ProtocolVersion 5
+//   - Protocol6ServeOpts is not set with ProtocolVersion 5
+//   - ReadResourceConcurrency is not negative
+//   - RPCTimeout is not negative
+//   - StopProviderApplyMode is a known mode
+//   - StopProviderApplyGracePeriod is greater than 0 if and only if
+//     StopProviderApplyMode is StopProviderApplyModeGracePeriod
+//   - StopProviderTimeout is not negative
 func (opts ServeOpts) validate(ctx context.Context) error {
 	if opts.Address == "" {
 		return fmt.Errorf("Address must be provided")
@@ -73,6 +258,10 @@ func (opts ServeOpts) validate(ctx context.Context) error {
 		return fmt.Errorf("unable to validate Address: %w", err)
 	}
 
+	if len(opts.AuditLabels) > 0 && opts.AuditSink == nil {
+		return fmt.Errorf("AuditLabels can only be set when AuditSink is set")
+	}
+
 	switch opts.ProtocolVersion {
 	// 0 represents unset, which Serve will use default.
 	case 0, 5, 6:
@@ -80,5 +269,95 @@ func (opts ServeOpts) validate(ctx context.Context) error {
 		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
 	}
 
+	if opts.ProtocolVersion == 5 && opts.DisableDescriptionDerivation {
+		return fmt.Errorf("DisableDescriptionDerivation can only be set when ProtocolVersion is 6")
+	}
+
+	if opts.ProtocolVersion != 5 && len(opts.Protocol5ServeOpts) > 0 {
+		return fmt.Errorf("Protocol5ServeOpts can only be set when ProtocolVersion is 5")
+	}
+
+	if opts.ProtocolVersion == 5 && len(opts.Protocol6RequestFuncs) > 0 {
+		return fmt.Errorf("Protocol6RequestFuncs can only be set when ProtocolVersion is 6")
+	}
+
+	if opts.ProtocolVersion == 5 && len(opts.Protocol6ResponseFuncs) > 0 {
+		return fmt.Errorf("Protocol6ResponseFuncs can only be set when ProtocolVersion is 6")
+	}
+
+	if opts.ProtocolVersion == 5 && len(opts.Protocol6ServeOpts) > 0 {
+		return fmt.Errorf("Protocol6ServeOpts can only be set when ProtocolVersion is 6")
+	}
+
+	if opts.ReadResourceConcurrency < 0 {
+		return fmt.Errorf("ReadResourceConcurrency, if set, must be greater than 0")
+	}
+
+	if opts.RPCTimeout < 0 {
+		return fmt.Errorf("RPCTimeout, if set, must be greater than 0")
+	}
+
+	switch opts.StopProviderApplyMode {
+	case StopProviderApplyModeGracePeriod:
+		if opts.StopProviderApplyGracePeriod <= 0 {
+			return fmt.Errorf("StopProviderApplyGracePeriod must be greater than 0 when StopProviderApplyMode is StopProviderApplyModeGracePeriod")
+		}
+	case StopProviderApplyModeImmediate, StopProviderApplyModeFutureOnly:
+		if opts.StopProviderApplyGracePeriod != 0 {
+			return fmt.Errorf("StopProviderApplyGracePeriod can only be set when StopProviderApplyMode is StopProviderApplyModeGracePeriod")
+		}
+	default:
+		return fmt.Errorf("StopProviderApplyMode, if set, must be a known StopProviderApplyMode value")
+	}
+
+	if opts.StopProviderTimeout < 0 {
+		return fmt.Errorf("StopProviderTimeout, if set, must be greater than 0")
+	}
+
 	return nil
 }
+
+// stopApplyMode returns the framework server equivalent of the
+// StopProviderApplyMode.
+func (opts ServeOpts) stopApplyMode() fwserver.StopApplyMode {
+	switch opts.StopProviderApplyMode {
+	case StopProviderApplyModeGracePeriod:
+		return fwserver.StopApplyModeGracePeriod
+	case StopProviderApplyModeFutureOnly:
+		return fwserver.StopApplyModeFutureOnly
+	default:
+		return fwserver.StopApplyModeImmediate
+	}
+}
+
+// protocol6RequestFuncs returns the protocol version 6 server equivalent of
+// the Protocol6RequestFuncs.
+func (opts ServeOpts) protocol6RequestFuncs() []proto6server.RequestFunc {
+	if len(opts.Protocol6RequestFuncs) == 0 {
+		return nil
+	}
+
+	result := make([]proto6server.RequestFunc, 0, len(opts.Protocol6RequestFuncs))
+
+	for _, requestFunc := range opts.Protocol6RequestFuncs {
+		result = append(result, proto6server.RequestFunc(requestFunc))
+	}
+
+	return result
+}
+
+// protocol6ResponseFuncs returns the protocol version 6 server equivalent of
+// the Protocol6ResponseFuncs.
+func (opts ServeOpts) protocol6ResponseFuncs() []proto6server.ResponseFunc {
+	if len(opts.Protocol6ResponseFuncs) == 0 {
+		return nil
+	}
+
+	result := make([]proto6server.ResponseFunc, 0, len(opts.Protocol6ResponseFuncs))
+
+	for _, responseFunc := range opts.Protocol6ResponseFuncs {
+		result = append(result, proto6server.ResponseFunc(responseFunc))
+	}
+
+	return result
+}
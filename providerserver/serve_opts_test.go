@@ -5,6 +5,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+
+	"github.com/hashicorp/terraform-plugin-framework/audit"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 func TestServeOptsValidate(t *testing.T) {
@@ -35,6 +42,20 @@ func TestServeOptsValidate(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("unable to validate Address: expected hostname/namespace/type format, got: hashicorp/testing"),
 		},
+		"DisableDescriptionDerivation": {
+			serveOpts: ServeOpts{
+				Address:                      "registry.terraform.io/hashicorp/testing",
+				DisableDescriptionDerivation: true,
+			},
+		},
+		"DisableDescriptionDerivation-ProtocolVersion-5": {
+			serveOpts: ServeOpts{
+				Address:                      "registry.terraform.io/hashicorp/testing",
+				DisableDescriptionDerivation: true,
+				ProtocolVersion:              5,
+			},
+			expectedError: fmt.Errorf("DisableDescriptionDerivation can only be set when ProtocolVersion is 6"),
+		},
 		"ProtocolVersion-invalid": {
 			serveOpts: ServeOpts{
 				Address:         "registry.terraform.io/hashicorp/testing",
@@ -54,6 +75,171 @@ func TestServeOptsValidate(t *testing.T) {
 				ProtocolVersion: 6,
 			},
 		},
+		"Protocol5ServeOpts": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol5ServeOpts: []tf5server.ServeOpt{
+					tf5server.WithoutLogLocation(),
+				},
+				ProtocolVersion: 5,
+			},
+		},
+		"Protocol5ServeOpts-ProtocolVersion-6": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol5ServeOpts: []tf5server.ServeOpt{
+					tf5server.WithoutLogLocation(),
+				},
+			},
+			expectedError: fmt.Errorf("Protocol5ServeOpts can only be set when ProtocolVersion is 5"),
+		},
+		"Protocol6RequestFuncs": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol6RequestFuncs: []Protocol6RequestFunc{
+					func(_ context.Context, _ string, _ any) diag.Diagnostics { return nil },
+				},
+			},
+		},
+		"Protocol6RequestFuncs-ProtocolVersion-5": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol6RequestFuncs: []Protocol6RequestFunc{
+					func(_ context.Context, _ string, _ any) diag.Diagnostics { return nil },
+				},
+				ProtocolVersion: 5,
+			},
+			expectedError: fmt.Errorf("Protocol6RequestFuncs can only be set when ProtocolVersion is 6"),
+		},
+		"Protocol6ResponseFuncs": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol6ResponseFuncs: []Protocol6ResponseFunc{
+					func(_ context.Context, _ string, _ any) {},
+				},
+			},
+		},
+		"Protocol6ResponseFuncs-ProtocolVersion-5": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol6ResponseFuncs: []Protocol6ResponseFunc{
+					func(_ context.Context, _ string, _ any) {},
+				},
+				ProtocolVersion: 5,
+			},
+			expectedError: fmt.Errorf("Protocol6ResponseFuncs can only be set when ProtocolVersion is 6"),
+		},
+		"Protocol6ServeOpts": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol6ServeOpts: []tf6server.ServeOpt{
+					tf6server.WithoutLogLocation(),
+				},
+			},
+		},
+		"Protocol6ServeOpts-ProtocolVersion-5": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				Protocol6ServeOpts: []tf6server.ServeOpt{
+					tf6server.WithoutLogLocation(),
+				},
+				ProtocolVersion: 5,
+			},
+			expectedError: fmt.Errorf("Protocol6ServeOpts can only be set when ProtocolVersion is 6"),
+		},
+		"ReadResourceConcurrency": {
+			serveOpts: ServeOpts{
+				Address:                 "registry.terraform.io/hashicorp/testing",
+				ReadResourceConcurrency: 10,
+			},
+		},
+		"ReadResourceConcurrency-invalid": {
+			serveOpts: ServeOpts{
+				Address:                 "registry.terraform.io/hashicorp/testing",
+				ReadResourceConcurrency: -1,
+			},
+			expectedError: fmt.Errorf("ReadResourceConcurrency, if set, must be greater than 0"),
+		},
+		"RPCTimeout": {
+			serveOpts: ServeOpts{
+				Address:    "registry.terraform.io/hashicorp/testing",
+				RPCTimeout: 30 * time.Minute,
+			},
+		},
+		"RPCTimeout-invalid": {
+			serveOpts: ServeOpts{
+				Address:    "registry.terraform.io/hashicorp/testing",
+				RPCTimeout: -1,
+			},
+			expectedError: fmt.Errorf("RPCTimeout, if set, must be greater than 0"),
+		},
+		"AuditLabels-missing-AuditSink": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				AuditLabels: map[string]string{
+					"environment": "test",
+				},
+			},
+			expectedError: fmt.Errorf("AuditLabels can only be set when AuditSink is set"),
+		},
+		"AuditSink": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				AuditLabels: map[string]string{
+					"environment": "test",
+				},
+				AuditSink: audit.SinkFunc(func(_ context.Context, _ audit.Record) error {
+					return nil
+				}),
+			},
+		},
+		"StopProviderApplyMode-FutureOnly": {
+			serveOpts: ServeOpts{
+				Address:               "registry.terraform.io/hashicorp/testing",
+				StopProviderApplyMode: StopProviderApplyModeFutureOnly,
+			},
+		},
+		"StopProviderApplyMode-GracePeriod": {
+			serveOpts: ServeOpts{
+				Address:                      "registry.terraform.io/hashicorp/testing",
+				StopProviderApplyMode:        StopProviderApplyModeGracePeriod,
+				StopProviderApplyGracePeriod: 30 * time.Second,
+			},
+		},
+		"StopProviderApplyMode-GracePeriod-missing": {
+			serveOpts: ServeOpts{
+				Address:               "registry.terraform.io/hashicorp/testing",
+				StopProviderApplyMode: StopProviderApplyModeGracePeriod,
+			},
+			expectedError: fmt.Errorf("StopProviderApplyGracePeriod must be greater than 0 when StopProviderApplyMode is StopProviderApplyModeGracePeriod"),
+		},
+		"StopProviderApplyMode-Immediate-GracePeriod": {
+			serveOpts: ServeOpts{
+				Address:                      "registry.terraform.io/hashicorp/testing",
+				StopProviderApplyGracePeriod: 30 * time.Second,
+			},
+			expectedError: fmt.Errorf("StopProviderApplyGracePeriod can only be set when StopProviderApplyMode is StopProviderApplyModeGracePeriod"),
+		},
+		"StopProviderTimeout": {
+			serveOpts: ServeOpts{
+				Address:             "registry.terraform.io/hashicorp/testing",
+				StopProviderTimeout: 30 * time.Second,
+			},
+		},
+		"StopProviderTimeout-invalid": {
+			serveOpts: ServeOpts{
+				Address:             "registry.terraform.io/hashicorp/testing",
+				StopProviderTimeout: -1,
+			},
+			expectedError: fmt.Errorf("StopProviderTimeout, if set, must be greater than 0"),
+		},
+		"StopProviderApplyMode-invalid": {
+			serveOpts: ServeOpts{
+				Address:               "registry.terraform.io/hashicorp/testing",
+				StopProviderApplyMode: 999,
+			},
+			expectedError: fmt.Errorf("StopProviderApplyMode, if set, must be a known StopProviderApplyMode value"),
+		},
 	}
 
 	for name, testCase := range testCases {
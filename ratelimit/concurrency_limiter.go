@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+)
+
+// ConcurrencyLimiter limits the number of simultaneous in-flight requests.
+// It is safe for concurrent use.
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+}
+
+// NewConcurrencyLimiter returns a ConcurrencyLimiter which allows up to the
+// given number of simultaneous requests. A limit less than 1 is treated as 1.
+func NewConcurrencyLimiter(limit int) *ConcurrencyLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &ConcurrencyLimiter{
+		slots: make(chan struct{}, limit),
+	}
+}
+
+// Acquire blocks until a request slot is available or the context is done.
+// It returns the context error if the context is done before a slot is
+// available. Every successful Acquire must be paired with a Release.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release returns a request slot acquired with Acquire.
+func (l *ConcurrencyLimiter) Release() {
+	select {
+	case <-l.slots:
+	default:
+		panic("ratelimit: ConcurrencyLimiter Release called without matching Acquire")
+	}
+}
+
+// InFlight returns the number of currently acquired request slots.
+func (l *ConcurrencyLimiter) InFlight() int {
+	return len(l.slots)
+}
@@ -0,0 +1,49 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ratelimit"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.NewConcurrencyLimiter(1)
+
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := limiter.InFlight(); got != 1 {
+		t.Fatalf("expected 1 in-flight, got: %d", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	limiter.Release()
+
+	if err := limiter.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error after release: %s", err)
+	}
+}
+
+func TestConcurrencyLimiterRelease_panic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+
+	ratelimit.NewConcurrencyLimiter(1).Release()
+}
@@ -0,0 +1,10 @@
+// Package ratelimit implements context-aware rate and concurrency limiting
+// primitives, which providers can use to consistently enforce remote system
+// request caps across resources and data sources sharing an API client.
+//
+// Limiters are typically created in the provider Configure method, attached
+// to the API client, and passed to resources and data sources via the
+// ResourceData and DataSourceData fields of the ConfigureResponse. Every
+// method accepts the context of the current operation, so waiting is stopped
+// when Terraform cancels the operation.
+package ratelimit
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+)
+
+// Group manages rate and concurrency limits per remote service, such as per
+// API endpoint or product area, so that all resources and data sources sharing
+// a Group enforce the same caps. It is safe for concurrent use.
+//
+// Services without explicit configuration use the defaults given to
+// NewGroup.
+type Group struct {
+	concurrencyLimiters map[string]*ConcurrencyLimiter
+	defaults            GroupLimits
+	limiters            map[string]*Limiter
+	limits              map[string]GroupLimits
+	mu                  sync.Mutex
+}
+
+// GroupLimits are the rate and concurrency limits for a single service in
+// a Group.
+type GroupLimits struct {
+	// RequestsPerSecond is the sustained request rate. Zero disables rate
+	// limiting.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests allowed at once when tokens
+	// have accumulated. Values less than 1 are treated as 1.
+	Burst int
+
+	// MaxConcurrency is the maximum number of simultaneous in-flight
+	// requests. Zero disables concurrency limiting.
+	MaxConcurrency int
+}
+
+// NewGroup returns a Group using the given defaults for services without
+// explicit limits.
+func NewGroup(defaults GroupLimits) *Group {
+	return &Group{
+		concurrencyLimiters: make(map[string]*ConcurrencyLimiter),
+		defaults:            defaults,
+		limiters:            make(map[string]*Limiter),
+		limits:              make(map[string]GroupLimits),
+	}
+}
+
+// SetLimits configures the limits for a service. It must be called before
+// the first Do call for that service to take effect.
+func (g *Group) SetLimits(service string, limits GroupLimits) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.limits[service] = limits
+}
+
+// Do waits for both the rate and concurrency limits of the service, calls
+// the given function, then releases any concurrency slot. It returns the
+// context error without calling the function if the context is done while
+// waiting.
+func (g *Group) Do(ctx context.Context, service string, f func(context.Context) error) error {
+	limiter, concurrencyLimiter := g.get(service)
+
+	if concurrencyLimiter != nil {
+		if err := concurrencyLimiter.Acquire(ctx); err != nil {
+			return err
+		}
+
+		defer concurrencyLimiter.Release()
+	}
+
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return f(ctx)
+}
+
+// get returns the limiters for a service, creating them if necessary.
+func (g *Group) get(service string) (*Limiter, *ConcurrencyLimiter) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	limits, ok := g.limits[service]
+
+	if !ok {
+		limits = g.defaults
+	}
+
+	limiter, ok := g.limiters[service]
+
+	if !ok && limits.RequestsPerSecond > 0 {
+		limiter = NewLimiter(limits.RequestsPerSecond, limits.Burst)
+		g.limiters[service] = limiter
+	}
+
+	concurrencyLimiter, ok := g.concurrencyLimiters[service]
+
+	if !ok && limits.MaxConcurrency > 0 {
+		concurrencyLimiter = NewConcurrencyLimiter(limits.MaxConcurrency)
+		g.concurrencyLimiters[service] = concurrencyLimiter
+	}
+
+	return limiter, concurrencyLimiter
+}
@@ -0,0 +1,99 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ratelimit"
+)
+
+func TestGroupDo(t *testing.T) {
+	t.Parallel()
+
+	group := ratelimit.NewGroup(ratelimit.GroupLimits{
+		MaxConcurrency: 2,
+	})
+
+	var current, max int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := group.Do(context.Background(), "compute", func(context.Context) error {
+				n := atomic.AddInt32(&current, 1)
+				defer atomic.AddInt32(&current, -1)
+
+				for {
+					m := atomic.LoadInt32(&max)
+
+					if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+						break
+					}
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if max > 2 {
+		t.Errorf("expected at most 2 concurrent calls, got: %d", max)
+	}
+}
+
+func TestGroupDo_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	group := ratelimit.NewGroup(ratelimit.GroupLimits{})
+	group.SetLimits("storage", ratelimit.GroupLimits{
+		RequestsPerSecond: 1,
+		Burst:             1,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+
+	err := group.Do(ctx, "storage", func(context.Context) error {
+		called = true
+
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	if called {
+		t.Fatal("expected function to not be called")
+	}
+}
+
+func TestGroupDo_error(t *testing.T) {
+	t.Parallel()
+
+	expected := errors.New("test error")
+	group := ratelimit.NewGroup(ratelimit.GroupLimits{})
+
+	err := group.Do(context.Background(), "compute", func(context.Context) error {
+		return expected
+	})
+
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected test error, got: %v", err)
+	}
+}
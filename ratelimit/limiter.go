@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter. Tokens are added to the bucket at
+// a constant rate, up to the burst size, and each request consumes one token.
+// It is safe for concurrent use.
+type Limiter struct {
+	// burst is the maximum number of tokens in the bucket.
+	burst float64
+
+	// last is the time tokens were last calculated.
+	last time.Time
+
+	// mu protects all other fields.
+	mu sync.Mutex
+
+	// now returns the current time, which is overridable for testing.
+	now func() time.Time
+
+	// rate is the number of tokens added to the bucket per second.
+	rate float64
+
+	// tokens is the number of available tokens, which can be negative when
+	// there are outstanding reservations.
+	tokens float64
+}
+
+// NewLimiter returns a Limiter which allows the given number of requests per
+// second with the given burst size. The bucket is initially full. A burst less
+// than 1 is treated as 1.
+func NewLimiter(requestsPerSecond float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Limiter{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   requestsPerSecond,
+		tokens: float64(burst),
+	}
+}
+
+// Allow reports whether a request may happen now, consuming a token if so.
+// Use this method when requests should be dropped rather than delayed.
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance()
+
+	if l.tokens < 1 {
+		return false
+	}
+
+	l.tokens--
+
+	return true
+}
+
+// Wait blocks until a request may happen or the context is done, consuming
+// a token. It returns the context error if the context is done before a token
+// is available, in which case no token is consumed.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+
+	l.advance()
+
+	l.tokens--
+
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+
+		return nil
+	}
+
+	if l.rate <= 0 {
+		l.tokens++
+		l.mu.Unlock()
+
+		return fmt.Errorf("rate limiter does not allow requests: rate is %g requests per second", l.rate)
+	}
+
+	delay := time.Duration(math.Ceil(-l.tokens / l.rate * float64(time.Second)))
+	deadline, hasDeadline := ctx.Deadline()
+
+	if hasDeadline && l.now().Add(delay).After(deadline) {
+		l.tokens++
+		l.mu.Unlock()
+
+		return context.DeadlineExceeded
+	}
+
+	l.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// advance adds tokens accumulated since the last calculation. The caller
+// must hold the lock.
+func (l *Limiter) advance() {
+	now := l.now()
+
+	if l.last.IsZero() {
+		l.last = now
+
+		return
+	}
+
+	elapsed := now.Sub(l.last)
+
+	if elapsed <= 0 {
+		return
+	}
+
+	l.last = now
+	l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(1, 2)
+	limiter.now = func() time.Time { return now }
+
+	if !limiter.Allow() {
+		t.Fatal("expected first request to be allowed")
+	}
+
+	if !limiter.Allow() {
+		t.Fatal("expected second request to be allowed by burst")
+	}
+
+	if limiter.Allow() {
+		t.Fatal("expected third request to be limited")
+	}
+
+	now = now.Add(time.Second)
+
+	if !limiter.Allow() {
+		t.Fatal("expected request to be allowed after refill")
+	}
+
+	if limiter.Allow() {
+		t.Fatal("expected request to be limited after consuming refill")
+	}
+}
+
+func TestLimiterAllow_burstCap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(10, 1)
+	limiter.now = func() time.Time { return now }
+
+	limiter.Allow()
+
+	now = now.Add(time.Hour)
+
+	if !limiter.Allow() {
+		t.Fatal("expected request to be allowed after refill")
+	}
+
+	if limiter.Allow() {
+		t.Fatal("expected tokens to be capped at burst")
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(1000, 1)
+
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func TestLimiterWait_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	limiter := NewLimiter(1, 1)
+
+	err := limiter.Wait(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	if !limiter.Allow() {
+		t.Fatal("expected token to not be consumed")
+	}
+}
+
+func TestLimiterWait_contextDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	limiter := NewLimiter(0.001, 1)
+	limiter.Allow()
+
+	err := limiter.Wait(ctx)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestLimiterWait_zeroRate(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(0, 1)
+	limiter.Allow()
+
+	if err := limiter.Wait(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
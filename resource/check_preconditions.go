@@ -0,0 +1,39 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// CheckPreconditionsRequest represents a request for the provider to check
+// remote system preconditions, such as quotas or name availability, before
+// the resource plan is applied. An instance of this request struct is
+// supplied as an argument to the Resource type CheckPreconditions method.
+type CheckPreconditionsRequest struct {
+	// Config is the configuration the user supplied for the resource. All
+	// values are known.
+	Config tfsdk.Config
+
+	// State is the current state of the resource. This will contain a null
+	// value when the resource is planned for creation.
+	State tfsdk.State
+
+	// Plan is the final planned new state for the resource, after all
+	// framework and provider plan modifications. Computed attributes may
+	// contain unknown values.
+	Plan tfsdk.Plan
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+}
+
+// CheckPreconditionsResponse represents a response to a
+// CheckPreconditionsRequest. An instance of this response struct is supplied
+// as an argument to the Resource type CheckPreconditions method.
+type CheckPreconditionsResponse struct {
+	// Diagnostics report errors or warnings related to checking the
+	// preconditions. Returning an error diagnostic fails the plan. An empty
+	// slice indicates a successful operation with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+}
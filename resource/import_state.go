@@ -2,6 +2,9 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -57,3 +60,72 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
+
+// ParseImportIDs splits an import identifier into multiple identifiers, such
+// as when a resource requires multiple attributes to refresh. The import
+// identifier may either be a JSON array of strings, such as ["id-1","id-2"],
+// or a list of identifiers separated by the given separator, such as
+// id-1,id-2. Surrounding whitespace is removed from each identifier and empty
+// identifiers return an error diagnostic.
+//
+// All identifiers belong to the single resource being imported. Terraform
+// CLI only supports one imported resource per import operation, so the
+// identifiers should be written into the response State rather than used to
+// import multiple resources.
+func ParseImportIDs(id string, separator string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ids []string
+
+	trimmedID := strings.TrimSpace(id)
+
+	switch {
+	case strings.HasPrefix(trimmedID, "["):
+		if err := json.Unmarshal([]byte(trimmedID), &ids); err != nil {
+			diags.AddError(
+				"Invalid Import Identifier",
+				"The import identifier could not be parsed as a JSON array of strings. "+
+					"Please provide either a JSON array of strings or identifiers separated by "+fmt.Sprintf("%q", separator)+".\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return nil, diags
+		}
+	case separator == "":
+		ids = []string{trimmedID}
+	default:
+		ids = strings.Split(trimmedID, separator)
+	}
+
+	result := make([]string, 0, len(ids))
+
+	for i, rawID := range ids {
+		parsedID := strings.TrimSpace(rawID)
+
+		if parsedID == "" {
+			diags.AddError(
+				"Invalid Import Identifier",
+				fmt.Sprintf("The import identifier at position %d is empty. ", i)+
+					"Please provide a non-empty value for each identifier.",
+			)
+
+			continue
+		}
+
+		result = append(result, parsedID)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(result) == 0 {
+		diags.AddError(
+			"Invalid Import Identifier",
+			"The import identifier must contain at least one identifier.",
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,92 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestParseImportIDs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id            string
+		separator     string
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"single": {
+			id:        "test-id",
+			separator: ",",
+			expected:  []string{"test-id"},
+		},
+		"separator": {
+			id:        "test-id, test-id-2",
+			separator: ",",
+			expected:  []string{"test-id", "test-id-2"},
+		},
+		"separator-empty": {
+			id:        "test-id,test-id-2",
+			separator: "",
+			expected:  []string{"test-id,test-id-2"},
+		},
+		"json": {
+			id:        `["test-id","test-id-2"]`,
+			separator: ",",
+			expected:  []string{"test-id", "test-id-2"},
+		},
+		"json-invalid": {
+			id:        `["test-id",`,
+			separator: ",",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Import Identifier",
+					"The import identifier could not be parsed as a JSON array of strings. "+
+						"Please provide either a JSON array of strings or identifiers separated by \",\".\n\n"+
+						"Error: unexpected end of JSON input",
+				),
+			},
+		},
+		"empty-id": {
+			id:        "test-id,,test-id-2",
+			separator: ",",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Import Identifier",
+					"The import identifier at position 1 is empty. Please provide a non-empty value for each identifier.",
+				),
+			},
+		},
+		"empty-json": {
+			id:        `[]`,
+			separator: ",",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Import Identifier",
+					"The import identifier must contain at least one identifier.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resource.ParseImportIDs(testCase.id, testCase.separator)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
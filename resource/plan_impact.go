@@ -0,0 +1,104 @@
+package resource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// PlanImpactClassification describes the expected impact of applying a
+// resource plan on the remote system.
+type PlanImpactClassification uint8
+
+const (
+	// PlanImpactClassificationUnspecified is the default value and indicates
+	// the provider did not classify the impact of the plan.
+	PlanImpactClassificationUnspecified PlanImpactClassification = 0
+
+	// PlanImpactClassificationNone indicates that applying the plan is not
+	// expected to affect the remote object, such as state-only changes.
+	PlanImpactClassificationNone PlanImpactClassification = 1
+
+	// PlanImpactClassificationNonDisruptive indicates that applying the plan
+	// is expected to change the remote object without interrupting its
+	// availability.
+	PlanImpactClassificationNonDisruptive PlanImpactClassification = 2
+
+	// PlanImpactClassificationDisruptive indicates that applying the plan is
+	// expected to temporarily interrupt the availability of the remote
+	// object, such as a restart.
+	PlanImpactClassificationDisruptive PlanImpactClassification = 3
+
+	// PlanImpactClassificationDestructive indicates that applying the plan
+	// is expected to destroy the remote object or its data, such as a
+	// replacement or deletion.
+	PlanImpactClassificationDestructive PlanImpactClassification = 4
+)
+
+// String returns a lowercase, machine-readable representation of the
+// PlanImpactClassification.
+func (c PlanImpactClassification) String() string {
+	switch c {
+	case PlanImpactClassificationNone:
+		return "none"
+	case PlanImpactClassificationNonDisruptive:
+		return "non-disruptive"
+	case PlanImpactClassificationDisruptive:
+		return "disruptive"
+	case PlanImpactClassificationDestructive:
+		return "destructive"
+	default:
+		return "unspecified"
+	}
+}
+
+// PlanImpactRequest represents a request for the provider to describe the
+// expected impact of applying the resource plan. An instance of this request
+// struct is supplied as an argument to the Resource type PlanImpact method.
+type PlanImpactRequest struct {
+	// Config is the configuration the user supplied for the resource.
+	//
+	// This configuration may contain unknown values if a user uses
+	// interpolation or other functionality that would prevent Terraform
+	// from knowing the value at request time.
+	Config tfsdk.Config
+
+	// State is the current state of the resource. This will contain a null
+	// value when the resource is planned for creation.
+	State tfsdk.State
+
+	// Plan is the final planned new state for the resource, after all
+	// framework and provider plan modifications. This will contain a null
+	// value when the resource is planned for destruction.
+	Plan tfsdk.Plan
+
+	// RequiresReplace is the final list of attribute paths which require the
+	// resource to be replaced.
+	RequiresReplace path.Paths
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+}
+
+// PlanImpactResponse represents a response to a PlanImpactRequest. An
+// instance of this response struct is supplied as an argument to the
+// Resource type PlanImpact method.
+type PlanImpactResponse struct {
+	// Classification is the expected impact of applying the plan.
+	Classification PlanImpactClassification
+
+	// EstimatedDuration is the expected duration of applying the plan. A
+	// zero value indicates no estimate.
+	EstimatedDuration time.Duration
+
+	// Metadata is any additional provider-defined information about the
+	// impact of applying the plan, such as cost estimates.
+	Metadata map[string]string
+
+	// Diagnostics report errors or warnings related to describing the plan
+	// impact. An empty slice indicates a successful operation with no
+	// warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -1,8 +1,11 @@
 package resource
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -47,4 +50,26 @@ type ReadResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// RefreshedPaths declares that only the values at these paths were
+	// refreshed during the Read operation, such as when expensive secondary
+	// API calls are skipped. When non-empty, the framework preserves all
+	// other values from ReadRequest.State, regardless of the values set in
+	// State, so Read implementations can set an entire data model without
+	// overwriting untouched attributes. This has no effect when the resource
+	// is removed from State.
+	RefreshedPaths path.Paths
+}
+
+// NewWarningResourceNotFound returns a warning diagnostic for Read
+// implementations which remove a resource from state, via
+// ReadResponse.State.RemoveResource(), because it was not found in the remote
+// system. Terraform will then propose to recreate the resource, so the warning
+// explains the drift to practitioners.
+func NewWarningResourceNotFound(typeName string, id string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Resource Not Found",
+		fmt.Sprintf("The %s resource with identifier %q was not found and has been removed from the Terraform state. ", typeName, id)+
+			"This can occur if the resource was deleted outside of Terraform.",
+	)
 }
@@ -2,6 +2,9 @@ package resource
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/capability"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Resource represents an instance of a managed resource type. This is the core
@@ -10,12 +13,18 @@ import (
 // Resources can optionally implement these additional concepts:
 //
 //   - Configure: Include provider-level data or clients.
+//   - Preconditions: ResourceWithCheckPreconditions
+//   - Attribute Requirements: ResourceWithAttributeRequirements
 //   - Import: ResourceWithImportState
 //   - Validation: Schema-based or entire configuration
 //     via ResourceWithConfigValidators or ResourceWithValidateConfig.
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
+//   - Plan Impact: ResourceWithPlanImpact
 //   - State Upgrades: ResourceWithUpgradeState
+//   - Legacy State Normalization: ResourceWithLegacyStateNormalization
+//   - Undefined State Attributes: ResourceWithUndefinedStateAttributes
+//   - Non-Computed State Writes: ResourceWithNonComputedStateWrites
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -51,6 +60,38 @@ type Resource interface {
 	Delete(context.Context, DeleteRequest, *DeleteResponse)
 }
 
+// ResourceWithAttributeRequirements is an interface type that extends
+// Resource to include declarative remote system requirements for attributes,
+// such as a minimum API version.
+//
+// Requirements are checked during planning against the Capabilities set in
+// the provider Configure method. Attributes configured with a known, non-null
+// value that do not meet their requirements return an error diagnostic. If
+// the provider does not set Capabilities, requirements are not checked.
+type ResourceWithAttributeRequirements interface {
+	Resource
+
+	// AttributeRequirements returns a list of attribute requirements.
+	AttributeRequirements(context.Context) []capability.AttributeRequirement
+}
+
+// ResourceWithCheckPreconditions is an interface type that extends Resource
+// to include a method which checks remote system preconditions, such as
+// quotas or name availability, during planning. This enables failures which
+// would otherwise only occur during apply to be reported in the plan.
+//
+// The framework only calls this method when the resource is not planned for
+// destruction, the configuration does not contain unknown values, and no
+// prior errors have occurred during planning. Provider-level data or clients
+// are available if the resource also implements ResourceWithConfigure.
+type ResourceWithCheckPreconditions interface {
+	Resource
+
+	// CheckPreconditions is called after all plan modifications have
+	// completed.
+	CheckPreconditions(context.Context, CheckPreconditionsRequest, *CheckPreconditionsResponse)
+}
+
 // ResourceWithConfigure is an interface type that extends Resource to
 // include a method which the framework will automatically call so provider
 // developers have the opportunity to setup any necessary provider-level data
@@ -98,6 +139,25 @@ type ResourceWithImportState interface {
 	ImportState(context.Context, ImportStateRequest, *ImportStateResponse)
 }
 
+// ResourceWithLegacyStateNormalization is an interface type that extends
+// Resource to include attribute paths where prior state zero values, such
+// as empty strings, zero numbers, and false bools, are converted to null
+// values. This is intended for resources migrated from terraform-plugin-sdk,
+// which stored zero values where the framework expects null values. Without
+// normalization, these can cause plan differences for Optional and Computed
+// attributes after migrating.
+//
+// The framework normalizes the prior state during the UpgradeResourceState
+// and ReadResource RPCs, before calling any provider-defined logic.
+type ResourceWithLegacyStateNormalization interface {
+	Resource
+
+	// LegacyStateNormalizationPaths returns path expressions of the
+	// attributes to normalize. Expressions can match attributes within
+	// nested attributes and blocks, such as with the AtAnyListIndex method.
+	LegacyStateNormalizationPaths(context.Context) path.Expressions
+}
+
 // ResourceWithModifyPlan represents a resource instance with a ModifyPlan
 // function.
 type ResourceWithModifyPlan interface {
@@ -124,6 +184,60 @@ type ResourceWithModifyPlan interface {
 	ModifyPlan(context.Context, ModifyPlanRequest, *ModifyPlanResponse)
 }
 
+// ResourceWithPlanImpact is an interface type that extends Resource to
+// include a method which describes the expected impact of applying a plan,
+// such as an estimated duration or whether the change is destructive.
+//
+// The framework does not act on this information. It is emitted as
+// structured INFO level logs after planning so that platforms wrapping
+// Terraform can consume it for change review.
+type ResourceWithPlanImpact interface {
+	Resource
+
+	// PlanImpact is called after all plan modifications have completed
+	// without errors, including when the resource is planned for
+	// destruction.
+	PlanImpact(context.Context, PlanImpactRequest, *PlanImpactResponse)
+}
+
+// ResourceWithUndefinedStateAttributes is an interface type that extends
+// Resource to choose how the framework handles attributes in the prior state
+// which are not defined in the current or prior schema. This commonly occurs
+// after manual state modifications or provider downgrades.
+//
+// The framework checks the prior state during the UpgradeResourceState RPC,
+// before calling any provider-defined state upgrade logic. The protocol does
+// not support private state in that RPC, so undefined attributes cannot be
+// preserved. Resources which need the undefined attribute values can
+// increment the schema version and read them from the
+// UpgradeStateRequest type RawState field.
+type ResourceWithUndefinedStateAttributes interface {
+	Resource
+
+	// UndefinedStateAttributes returns the behavior for undefined prior
+	// state attributes. The default behavior without this interface is
+	// UndefinedStateAttributesBehaviorIgnore.
+	UndefinedStateAttributes(context.Context) UndefinedStateAttributesBehavior
+}
+
+// ResourceWithNonComputedStateWrites is an interface type that extends
+// Resource to allow the new state after create or update to contain values
+// which differ from the configuration for attributes which are not Computed.
+//
+// By default, the framework returns an error diagnostic for each of these
+// values, since Terraform will reject them after apply. This interface is
+// only intended for legacy resources with intentional divergence, such as
+// resources migrated from terraform-plugin-sdk/v2 which relied on its
+// legacy type system. New resources should mark these attributes as
+// Computed instead.
+type ResourceWithNonComputedStateWrites interface {
+	Resource
+
+	// AllowNonComputedStateWrites should return true to skip the framework
+	// check for non-Computed attribute values in the new state.
+	AllowNonComputedStateWrites(context.Context) bool
+}
+
 // Optional interface on top of Resource that enables provider control over
 // the UpgradeResourceState RPC. This RPC is automatically called by Terraform
 // when the current Schema type Version field is greater than the stored state.
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,6 +19,8 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = BoolAttribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = BoolAttribute{}
+	_ fwschema.AttributeWithNonSensitive           = BoolAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = BoolAttribute{}
 	_ fwschema.AttributeWithBoolDefaultValue       = BoolAttribute{}
 	_ fwxschema.AttributeWithBoolPlanModifiers     = BoolAttribute{}
@@ -65,6 +68,13 @@ type BoolAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -113,6 +123,13 @@ type BoolAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement is the path expression of the attribute which
+	// practitioners should use instead of this deprecated Attribute. When
+	// set alongside DeprecationMessage, the warning diagnostic details
+	// include the replacement attribute path. Relative expressions are
+	// resolved from the path of this Attribute.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -187,6 +204,11 @@ func (a BoolAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a BoolAttribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a BoolAttribute) GetDescription() string {
 	return a.Description
@@ -211,6 +233,11 @@ func (a BoolAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a BoolAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a BoolAttribute) IsOptional() bool {
 	return a.Optional
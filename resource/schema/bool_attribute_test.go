@@ -236,6 +236,40 @@ func TestBoolAttributeGetDeprecationMessage(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeGetDeprecationReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  path.Expression
+	}{
+		"no-deprecation-replacement": {
+			attribute: schema.BoolAttribute{},
+			expected:  path.Expression{},
+		},
+		"deprecation-replacement": {
+			attribute: schema.BoolAttribute{
+				DeprecationReplacement: path.MatchRoot("test"),
+			},
+			expected: path.MatchRoot("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDeprecationReplacement()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeEqual(t *testing.T) {
 	t.Parallel()
 
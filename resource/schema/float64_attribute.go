@@ -2,12 +2,14 @@ package schema
 
 import (
 	"context"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,6 +20,9 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = Float64Attribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = Float64Attribute{}
+	_ fwschema.AttributeWithFloat64Equality        = Float64Attribute{}
+	_ fwschema.AttributeWithNonSensitive           = Float64Attribute{}
 	_ fwschema.AttributeWithValidateImplementation = Float64Attribute{}
 	_ fwschema.AttributeWithFloat64DefaultValue    = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64PlanModifiers  = Float64Attribute{}
@@ -68,6 +73,13 @@ type Float64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -116,6 +128,38 @@ type Float64Attribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement is the path expression of the attribute which
+	// practitioners should use instead of this deprecated Attribute. When
+	// set alongside DeprecationMessage, the warning diagnostic details
+	// include the replacement attribute path. Relative expressions are
+	// resolved from the path of this Attribute.
+	DeprecationReplacement path.Expression
+
+	// EqualityEpsilon is the maximum absolute difference between two values
+	// of this attribute which are considered equal. This is useful when the
+	// remote system returns rounded values, which would otherwise cause
+	// differences after every refresh.
+	//
+	// When the Read method returns a value within the tolerance of the prior
+	// state value, or the Create or Update methods return a value within the
+	// tolerance of the planned value, the framework keeps the prior state or
+	// planned value instead.
+	//
+	// Zero, the default, requires values to be exactly equal unless
+	// EqualitySignificantDigits is set. Values are considered equal if
+	// either tolerance is met.
+	EqualityEpsilon float64
+
+	// EqualitySignificantDigits is the number of significant digits which
+	// must match for two values of this attribute to be considered equal.
+	// For example, 3 considers 1.2345 and 1.2349 equal. Refer to
+	// EqualityEpsilon for more information about how the tolerance is
+	// applied.
+	//
+	// Zero, the default, requires values to be exactly equal unless
+	// EqualityEpsilon is set.
+	EqualitySignificantDigits int
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -190,6 +234,22 @@ func (a Float64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a Float64Attribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
+// GetEqualityEpsilon returns the EqualityEpsilon field value.
+func (a Float64Attribute) GetEqualityEpsilon() float64 {
+	return a.EqualityEpsilon
+}
+
+// GetEqualitySignificantDigits returns the EqualitySignificantDigits field
+// value.
+func (a Float64Attribute) GetEqualitySignificantDigits() int {
+	return a.EqualitySignificantDigits
+}
+
 // GetDescription returns the Description field value.
 func (a Float64Attribute) GetDescription() string {
 	return a.Description
@@ -214,6 +274,11 @@ func (a Float64Attribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a Float64Attribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a Float64Attribute) IsOptional() bool {
 	return a.Optional
@@ -237,4 +302,12 @@ func (a Float64Attribute) ValidateImplementation(ctx context.Context, req fwsche
 	if !a.IsComputed() && a.Float64DefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if a.EqualityEpsilon < 0 || math.IsNaN(a.EqualityEpsilon) || math.IsInf(a.EqualityEpsilon, 0) {
+		resp.Diagnostics.Append(invalidFloat64EqualityDiag(req.Path, "EqualityEpsilon must be a finite number greater than or equal to zero."))
+	}
+
+	if a.EqualitySignificantDigits < 0 {
+		resp.Diagnostics.Append(invalidFloat64EqualityDiag(req.Path, "EqualitySignificantDigits must be greater than or equal to zero."))
+	}
 }
@@ -3,6 +3,7 @@ package schema_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 
@@ -235,6 +236,40 @@ func TestFloat64AttributeGetDeprecationMessage(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeGetDeprecationReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  path.Expression
+	}{
+		"no-deprecation-replacement": {
+			attribute: schema.Float64Attribute{},
+			expected:  path.Expression{},
+		},
+		"deprecation-replacement": {
+			attribute: schema.Float64Attribute{
+				DeprecationReplacement: path.MatchRoot("test"),
+			},
+			expected: path.MatchRoot("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDeprecationReplacement()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeEqual(t *testing.T) {
 	t.Parallel()
 
@@ -270,6 +305,74 @@ func TestFloat64AttributeEqual(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeGetEqualityEpsilon(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  float64
+	}{
+		"no-equality-epsilon": {
+			attribute: schema.Float64Attribute{},
+			expected:  0,
+		},
+		"equality-epsilon": {
+			attribute: schema.Float64Attribute{
+				EqualityEpsilon: 0.001,
+			},
+			expected: 0.001,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEqualityEpsilon()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64AttributeGetEqualitySignificantDigits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  int
+	}{
+		"no-equality-significant-digits": {
+			attribute: schema.Float64Attribute{},
+			expected:  0,
+		},
+		"equality-significant-digits": {
+			attribute: schema.Float64Attribute{
+				EqualitySignificantDigits: 3,
+			},
+			expected: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetEqualitySignificantDigits()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeGetDescription(t *testing.T) {
 	t.Parallel()
 
@@ -555,6 +658,77 @@ func TestFloat64AttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"equality-epsilon": {
+			attribute: schema.Float64Attribute{
+				EqualityEpsilon: 0.001,
+				Optional:        true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"equality-epsilon-negative": {
+			attribute: schema.Float64Attribute{
+				EqualityEpsilon: -0.001,
+				Optional:        true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Attribute \"test\" EqualityEpsilon must be a finite number greater than or equal to zero.",
+					),
+				},
+			},
+		},
+		"equality-epsilon-infinity": {
+			attribute: schema.Float64Attribute{
+				EqualityEpsilon: math.Inf(1),
+				Optional:        true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Attribute \"test\" EqualityEpsilon must be a finite number greater than or equal to zero.",
+					),
+				},
+			},
+		},
+		"equality-significant-digits-negative": {
+			attribute: schema.Float64Attribute{
+				EqualitySignificantDigits: -1,
+				Optional:                  true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Attribute \"test\" EqualitySignificantDigits must be greater than or equal to zero.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
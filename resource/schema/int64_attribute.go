@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,6 +19,8 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = Int64Attribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = Int64Attribute{}
+	_ fwschema.AttributeWithNonSensitive           = Int64Attribute{}
 	_ fwschema.AttributeWithValidateImplementation = Int64Attribute{}
 	_ fwschema.AttributeWithInt64DefaultValue      = Int64Attribute{}
 	_ fwxschema.AttributeWithInt64PlanModifiers    = Int64Attribute{}
@@ -68,6 +71,13 @@ type Int64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -116,6 +126,13 @@ type Int64Attribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement is the path expression of the attribute which
+	// practitioners should use instead of this deprecated Attribute. When
+	// set alongside DeprecationMessage, the warning diagnostic details
+	// include the replacement attribute path. Relative expressions are
+	// resolved from the path of this Attribute.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -175,6 +192,11 @@ func (a Int64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a Int64Attribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a Int64Attribute) GetDescription() string {
 	return a.Description
@@ -214,6 +236,11 @@ func (a Int64Attribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a Int64Attribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a Int64Attribute) IsOptional() bool {
 	return a.Optional
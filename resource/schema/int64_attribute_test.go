@@ -120,6 +120,40 @@ func TestInt64AttributeGetDeprecationMessage(t *testing.T) {
 	}
 }
 
+func TestInt64AttributeGetDeprecationReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Int64Attribute
+		expected  path.Expression
+	}{
+		"no-deprecation-replacement": {
+			attribute: schema.Int64Attribute{},
+			expected:  path.Expression{},
+		},
+		"deprecation-replacement": {
+			attribute: schema.Int64Attribute{
+				DeprecationReplacement: path.MatchRoot("test"),
+			},
+			expected: path.MatchRoot("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDeprecationReplacement()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64AttributeEqual(t *testing.T) {
 	t.Parallel()
 
@@ -121,6 +121,40 @@ func TestListAttributeGetDeprecationMessage(t *testing.T) {
 	}
 }
 
+func TestListAttributeGetDeprecationReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  path.Expression
+	}{
+		"no-deprecation-replacement": {
+			attribute: schema.ListAttribute{},
+			expected:  path.Expression{},
+		},
+		"deprecation-replacement": {
+			attribute: schema.ListAttribute{
+				DeprecationReplacement: path.MatchRoot("test"),
+			},
+			expected: path.MatchRoot("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDeprecationReplacement()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeEqual(t *testing.T) {
 	t.Parallel()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +20,8 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                              = ListNestedAttribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = ListNestedAttribute{}
+	_ fwschema.AttributeWithNonSensitive           = ListNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListNestedAttribute{}
 	_ fwschema.AttributeWithListDefaultValue       = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListPlanModifiers     = ListNestedAttribute{}
@@ -84,8 +87,18 @@ type ListNestedAttribute struct {
 	// in CLI output. Sensitive does not impact how values are stored, and
 	// practitioners are encouraged to store their state as if the entire
 	// file is sensitive.
+	//
+	// Setting it to true also marks all nested attributes as sensitive,
+	// unless they set NonSensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -134,6 +147,13 @@ type ListNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement is the path expression of the attribute which
+	// practitioners should use instead of this deprecated Attribute. When
+	// set alongside DeprecationMessage, the warning diagnostic details
+	// include the replacement attribute path. Relative expressions are
+	// resolved from the path of this Attribute.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -199,6 +219,11 @@ func (a ListNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a ListNestedAttribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a ListNestedAttribute) GetDescription() string {
 	return a.Description
@@ -235,6 +260,11 @@ func (a ListNestedAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a ListNestedAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a ListNestedAttribute) IsOptional() bool {
 	return a.Optional
@@ -154,6 +154,40 @@ func TestListNestedAttributeGetDeprecationMessage(t *testing.T) {
 	}
 }
 
+func TestListNestedAttributeGetDeprecationReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  path.Expression
+	}{
+		"no-deprecation-replacement": {
+			attribute: schema.ListNestedAttribute{},
+			expected:  path.Expression{},
+		},
+		"deprecation-replacement": {
+			attribute: schema.ListNestedAttribute{
+				DeprecationReplacement: path.MatchRoot("test"),
+			},
+			expected: path.MatchRoot("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDeprecationReplacement()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListNestedAttributeEqual(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,10 +19,13 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = MapAttribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = MapAttribute{}
+	_ fwschema.AttributeWithNonSensitive           = MapAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
 	_ fwschema.AttributeWithMapDefaultValue        = MapAttribute{}
 	_ fwxschema.AttributeWithMapPlanModifiers      = MapAttribute{}
 	_ fwxschema.AttributeWithMapValidators         = MapAttribute{}
+	_ fwxschema.AttributeWithMapKeyValidators      = MapAttribute{}
 )
 
 // MapAttribute represents a schema attribute that is a list with a single
@@ -79,6 +83,13 @@ type MapAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// NonSensitive prevents this attribute from inheriting Sensitive from a
+	// parent nested attribute, so its value remains visible in CLI output.
+	// This should only be set for values which are never sensitive, such as
+	// identifiers. Parent nested attributes are then not marked as sensitive
+	// themselves, while their other nested attributes remain sensitive.
+	NonSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -127,6 +138,13 @@ type MapAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement is the path expression of the attribute which
+	// practitioners should use instead of this deprecated Attribute. When
+	// set alongside DeprecationMessage, the warning diagnostic details
+	// include the replacement attribute path. Relative expressions are
+	// resolved from the path of this Attribute.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -139,6 +157,15 @@ type MapAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
 
+	// KeyValidators define validation functionality for each key of the
+	// map. All elements of the slice of validators are run for each key,
+	// regardless of any previous error diagnostics. Validators are only run
+	// for known map values.
+	//
+	// Use this field to validate map keys, such as requiring a naming
+	// pattern, without implementing a custom type.
+	KeyValidators []validator.String
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -186,6 +213,11 @@ func (a MapAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a MapAttribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a MapAttribute) GetDescription() string {
 	return a.Description
@@ -212,6 +244,11 @@ func (a MapAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNonSensitive returns the NonSensitive field value.
+func (a MapAttribute) IsNonSensitive() bool {
+	return a.NonSensitive
+}
+
 // IsOptional returns the Optional field value.
 func (a MapAttribute) IsOptional() bool {
 	return a.Optional
@@ -237,6 +274,11 @@ func (a MapAttribute) MapPlanModifiers() []planmodifier.Map {
 	return a.PlanModifiers
 }
 
+// MapKeyValidators returns the KeyValidators field value.
+func (a MapAttribute) MapKeyValidators() []validator.String {
+	return a.KeyValidators
+}
+
 // MapValidators returns the Validators field value.
 func (a MapAttribute) MapValidators() []validator.Map {
 	return a.Validators
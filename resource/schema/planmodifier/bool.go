@@ -28,19 +28,27 @@ type BoolRequest struct {
 	// of the attribute for modification.
 	PathExpression path.Expression
 
-	// Config contains the entire configuration of the resource.
+	// Config contains the entire configuration of the resource, such as for
+	// reading sibling attribute values. It is read-only, as modifications are
+	// not returned to Terraform.
 	Config tfsdk.Config
 
 	// ConfigValue contains the value of the attribute for modification from the configuration.
 	ConfigValue types.Bool
 
-	// Plan contains the entire proposed new state of the resource.
+	// Plan contains the entire proposed new state of the resource, including
+	// modifications from the plan modifiers of prior root attributes and
+	// blocks. Root attributes are modified before root blocks, each in
+	// lexical name order. Modifications within the same root attribute or
+	// block are not included. It is read-only, use the response PlanValue
+	// to modify the plan.
 	Plan tfsdk.Plan
 
 	// PlanValue contains the value of the attribute for modification from the proposed new state.
 	PlanValue types.Bool
 
-	// State contains the entire prior state of the resource.
+	// State contains the entire prior state of the resource. It is
+	// read-only, as modifications are not returned to Terraform.
 	State tfsdk.State
 
 	// StateValue contains the value of the attribute for modification from the prior state.
@@ -0,0 +1,63 @@
+package planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Destroy is an optional interface for schema plan modifiers which should
+// also be called when the resource is planned for destruction. Plan
+// modifiers are otherwise skipped for destroy plans, as the planned new
+// state is always null. This is typically used to return warning
+// diagnostics, such as the remote object not being deleted by the API.
+//
+// Only plan modifiers of root attributes and blocks are called.
+type Destroy interface {
+	// PlanModifyDestroy should perform any destroy plan logic. The planned
+	// new state cannot be modified.
+	PlanModifyDestroy(context.Context, DestroyRequest, *DestroyResponse)
+}
+
+// DestroyRequest is a request for destroy plan modification.
+type DestroyRequest struct {
+	// Path contains the path of the attribute for modification. Use this path
+	// for any response diagnostics.
+	Path path.Path
+
+	// PathExpression contains the expression matching the exact path
+	// of the attribute for modification.
+	PathExpression path.Expression
+
+	// State contains the entire prior state of the resource.
+	State tfsdk.State
+
+	// StateValue contains the value of the attribute for modification from
+	// the prior state.
+	StateValue attr.Value
+
+	// Private is provider-defined resource private state data which was previously
+	// stored with the resource state. Any existing data is copied to
+	// DestroyResponse.Private to prevent accidental private state data loss.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// DestroyResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
+}
+
+// DestroyResponse is a response to a DestroyRequest.
+type DestroyResponse struct {
+	// Private is the private state resource data following the
+	// PlanModifyDestroy operation. This field is pre-populated from
+	// DestroyRequest.Private and can be modified during the operation.
+	Private *privatestate.ProviderData
+
+	// Diagnostics report errors or warnings related to planning the
+	// destruction of the resource. An empty slice indicates success, with no
+	// warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
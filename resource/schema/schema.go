@@ -77,18 +77,32 @@ func (s Schema) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep)
 
 // AttributeAtPath returns the Attribute at the passed path. If the path points
 // to an element or attribute of a complex type, rather than to an Attribute,
-// it will return an ErrPathInsideAtomicAttribute error.
+// it will return an ErrPathInsideAtomicAttribute error. Paths to attributes
+// within nested attribute or block objects return the nested Attribute.
 func (s Schema) AttributeAtPath(ctx context.Context, p path.Path) (fwschema.Attribute, diag.Diagnostics) {
 	return fwschema.SchemaAttributeAtPath(ctx, s, p)
 }
 
-// AttributeAtPath returns the Attribute at the passed path. If the path points
-// to an element or attribute of a complex type, rather than to an Attribute,
-// it will return an ErrPathInsideAtomicAttribute error.
+// AttributeAtTerraformPath returns the Attribute at the passed path. If the
+// path points to an element or attribute of a complex type, rather than to an
+// Attribute, it will return an ErrPathInsideAtomicAttribute error. Paths to
+// attributes within nested attribute or block objects return the nested
+// Attribute.
+//
+// Deprecated: Use the AttributeAtPath method instead. A *tftypes.AttributePath
+// can be converted with the tfsdk.FromTerraformPath function.
 func (s Schema) AttributeAtTerraformPath(ctx context.Context, p *tftypes.AttributePath) (fwschema.Attribute, error) {
 	return fwschema.SchemaAttributeAtTerraformPath(ctx, s, p)
 }
 
+// AttributePaths returns the path expressions of all attributes in the
+// schema, including those nested under other attributes and blocks. List, map, and
+// set nesting is represented by the AtAnyListIndex, AtAnyMapKey, and
+// AtAnySetValue expression steps. The paths are in Walk order.
+func (s Schema) AttributePaths(ctx context.Context) path.Expressions {
+	return fwschema.SchemaAttributePaths(ctx, s)
+}
+
 // GetAttributes returns the Attributes field value.
 func (s Schema) GetAttributes() map[string]fwschema.Attribute {
 	return schemaAttributes(s.Attributes)
@@ -149,7 +163,11 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	for attributeName, attribute := range s.GetAttributes() {
+	attributes := s.GetAttributes()
+
+	for _, attributeName := range fwschema.SortedNames(attributes) {
+		attribute := attributes[attributeName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
 			Path: path.Root(attributeName),
@@ -159,12 +177,20 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 		diags.Append(fwschema.ValidateAttributeImplementation(ctx, attribute, req)...)
 	}
 
-	for blockName, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, blockName := range fwschema.SortedNames(blocks) {
+		block := blocks[blockName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: blockName,
 			Path: path.Root(blockName),
 		}
 
+		if _, ok := s.Attributes[blockName]; ok {
+			diags.Append(fwschema.AttributeBlockNameConflictDiag(req.Path))
+		}
+
 		diags.Append(fwschema.IsReservedResourceAttributeName(req.Name, req.Path)...)
 		diags.Append(fwschema.ValidateBlockImplementation(ctx, block, req)...)
 	}
@@ -172,6 +198,18 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	return diags
 }
 
+// Walk calls the given function for every attribute and block in the schema,
+// depth-first, with the full path expression of the attribute or block.
+// Exactly one of the Attribute or Block parameters is non-nil, depending on
+// what is being visited. Parents are visited before their nested attributes
+// and blocks and each level is visited in name order. Returning error
+// diagnostics from the function stops the walk.
+func (s Schema) Walk(ctx context.Context, fn func(path.Expression, Attribute, Block) diag.Diagnostics) diag.Diagnostics {
+	return fwschema.SchemaWalk(ctx, s, func(p path.Expression, a fwschema.Attribute, b fwschema.Block) diag.Diagnostics {
+		return fn(p, a, b)
+	})
+}
+
 // schemaAttributes is a resource to fwschema type conversion function.
 func schemaAttributes(attributes map[string]Attribute) map[string]fwschema.Attribute {
 	result := make(map[string]fwschema.Attribute, len(attributes))
@@ -194,6 +232,20 @@ func schemaBlocks(blocks map[string]Block) map[string]fwschema.Block {
 	return result
 }
 
+// invalidFloat64EqualityDiag returns a diagnostic for use when a Float64
+// attribute has an invalid equality tolerance.
+func invalidFloat64EqualityDiag(path path.Path, detail string) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		diag.SummaryInvalidAttributeImplementation,
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Attribute %q %s", path, detail),
+	)
+}
+
 // nonComputedAttributeWithDefaultDiag returns a diagnostic for use when a non-computed
 // attribute is using a default value.
 func nonComputedAttributeWithDefaultDiag(path path.Path) diag.Diagnostic {
@@ -0,0 +1,15 @@
+// Package timeouts implements configurable resource operation timeouts, such
+// as those commonly implemented by providers migrating from the
+// terraform-plugin-sdk timeouts functionality.
+//
+// Resources add the timeouts to the resource schema with Block, which
+// preserves the SDK configuration syntax, or with Attributes, which uses the
+// newer nested attribute syntax. Each enabled operation is an Optional string
+// attribute which practitioners configure with a Go duration string, such as
+// "30s" or "2h45m". Invalid duration strings are rejected during validation.
+//
+// Within resource operations, the Value type is read from the plan or state
+// and its Create, Read, Update, and Delete methods return the configured
+// duration or a provider-defined default, which is typically used with
+// context.WithTimeout.
+package timeouts
@@ -0,0 +1,194 @@
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// AttributeName is the conventional name of the timeouts block or
+	// attribute in resource schemas.
+	AttributeName = "timeouts"
+
+	attributeNameCreate = "create"
+	attributeNameRead   = "read"
+	attributeNameUpdate = "update"
+	attributeNameDelete = "delete"
+)
+
+// Opts is used as an argument to Block and Attributes to indicate which
+// operation timeouts can be configured.
+type Opts struct {
+	// Create enables the create operation timeout.
+	Create bool
+
+	// Read enables the read operation timeout.
+	Read bool
+
+	// Update enables the update operation timeout.
+	Update bool
+
+	// Delete enables the delete operation timeout.
+	Delete bool
+}
+
+// Block returns a schema.SingleNestedBlock containing an Optional string
+// attribute for each operation enabled in opts. This preserves the
+// terraform-plugin-sdk timeouts block configuration syntax, such as:
+//
+//	timeouts {
+//	  create = "60m"
+//	}
+//
+// The block should be added to the resource schema Blocks with the
+// AttributeName key.
+func Block(ctx context.Context, opts Opts) schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: attributesMap(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypesMap(opts),
+			},
+		},
+	}
+}
+
+// Attributes returns a schema.SingleNestedAttribute containing an Optional
+// string attribute for each operation enabled in opts. This uses the nested
+// attribute configuration syntax, such as:
+//
+//	timeouts = {
+//	  create = "60m"
+//	}
+//
+// The attribute should be added to the resource schema Attributes with the
+// AttributeName key.
+func Attributes(ctx context.Context, opts Opts) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: attributesMap(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypesMap(opts),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// ProviderBlock returns a provider schema.SingleNestedBlock containing an
+// Optional string attribute for each operation enabled in opts, which enables
+// practitioners to configure default timeouts for all resources, such as:
+//
+//	provider "example" {
+//	  timeouts {
+//	    create = "2h"
+//	  }
+//	}
+//
+// The block should be added to the provider schema Blocks with the
+// AttributeName key. Read the configured Value in the provider Configure
+// method and use its Inherit method to resolve the provider Durations, which
+// resources then inherit with their own Value Inherit method.
+func ProviderBlock(ctx context.Context, opts Opts) providerschema.Block {
+	return providerschema.SingleNestedBlock{
+		Attributes: providerAttributesMap(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypesMap(opts),
+			},
+		},
+	}
+}
+
+// ProviderAttributes returns a provider schema.SingleNestedAttribute
+// containing an Optional string attribute for each operation enabled in opts.
+// This is the nested attribute syntax equivalent of ProviderBlock.
+//
+// The attribute should be added to the provider schema Attributes with the
+// AttributeName key.
+func ProviderAttributes(ctx context.Context, opts Opts) providerschema.Attribute {
+	return providerschema.SingleNestedAttribute{
+		Attributes: providerAttributesMap(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypesMap(opts),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// attributesMap returns the schema attributes for each operation enabled in
+// opts.
+func attributesMap(opts Opts) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{}
+
+	for _, name := range operationNames(opts) {
+		attributes[name] = schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				timeDuration{},
+			},
+		}
+	}
+
+	return attributes
+}
+
+// providerAttributesMap returns the provider schema attributes for each
+// operation enabled in opts.
+func providerAttributesMap(opts Opts) map[string]providerschema.Attribute {
+	attributes := map[string]providerschema.Attribute{}
+
+	for _, name := range operationNames(opts) {
+		attributes[name] = providerschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				timeDuration{},
+			},
+		}
+	}
+
+	return attributes
+}
+
+// attributeTypesMap returns the attribute types for each operation enabled
+// in opts.
+func attributeTypesMap(opts Opts) map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{}
+
+	for _, name := range operationNames(opts) {
+		attributeTypes[name] = types.StringType
+	}
+
+	return attributeTypes
+}
+
+// operationNames returns the attribute names for each operation enabled in
+// opts.
+func operationNames(opts Opts) []string {
+	var names []string
+
+	if opts.Create {
+		names = append(names, attributeNameCreate)
+	}
+
+	if opts.Read {
+		names = append(names, attributeNameRead)
+	}
+
+	if opts.Update {
+		names = append(names, attributeNameUpdate)
+	}
+
+	if opts.Delete {
+		names = append(names, attributeNameDelete)
+	}
+
+	return names
+}
@@ -0,0 +1,226 @@
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.ObjectTypable  = Type{}
+	_ basetypes.ObjectValuable = Value{}
+)
+
+// Type is the attribute type of the timeouts block or attribute returned by
+// Block and Attributes, which enables reading data models with the Value
+// type.
+type Type struct {
+	basetypes.ObjectType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+// String returns a human readable string of the type name.
+func (t Type) String() string {
+	return "timeouts.Type"
+}
+
+// ValueFromObject returns a Value given a basetypes.ObjectValue.
+func (t Type) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return Value{
+		Object: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ObjectType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	objectValue, ok := attrValue.(basetypes.ObjectValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Value{
+		Object: objectValue,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t Type) ValueType(_ context.Context) attr.Value {
+	return Value{}
+}
+
+// Value is the value type of the timeouts block or attribute returned by
+// Block and Attributes. Use it in resource data models, such as:
+//
+//	type ThingResourceModel struct {
+//	  Timeouts timeouts.Value `tfsdk:"timeouts"`
+//	}
+//
+// The Create, Read, Update, and Delete methods return the configured
+// duration of each operation, or the given default if the value is null,
+// unknown, or the operation is not enabled.
+type Value struct {
+	types.Object
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+
+	if !ok {
+		return false
+	}
+
+	return v.Object.Equal(other.Object)
+}
+
+// Type returns a Type with the same attribute types as the value.
+func (v Value) Type(ctx context.Context) attr.Type {
+	return Type{
+		ObjectType: basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+// ToObjectValue returns the underlying basetypes.ObjectValue.
+func (v Value) ToObjectValue(_ context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	return v.Object, nil
+}
+
+// Create returns the configured create operation timeout or the given
+// default.
+func (v Value) Create(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.getTimeout(ctx, attributeNameCreate, defaultTimeout)
+}
+
+// Read returns the configured read operation timeout or the given default.
+func (v Value) Read(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.getTimeout(ctx, attributeNameRead, defaultTimeout)
+}
+
+// Update returns the configured update operation timeout or the given
+// default.
+func (v Value) Update(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.getTimeout(ctx, attributeNameUpdate, defaultTimeout)
+}
+
+// Delete returns the configured delete operation timeout or the given
+// default.
+func (v Value) Delete(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return v.getTimeout(ctx, attributeNameDelete, defaultTimeout)
+}
+
+// Durations contains a timeout for each operation, such as the provider
+// defaults which resource timeouts inherit.
+type Durations struct {
+	// Create is the create operation timeout.
+	Create time.Duration
+
+	// Read is the read operation timeout.
+	Read time.Duration
+
+	// Update is the update operation timeout.
+	Update time.Duration
+
+	// Delete is the delete operation timeout.
+	Delete time.Duration
+}
+
+// Inherit returns the inherited durations, overridden by each operation
+// timeout configured in the value. Null, unknown, or missing operation
+// timeouts keep the inherited duration.
+//
+// Providers typically call Inherit on the provider timeouts, configured with
+// ProviderBlock or ProviderAttributes, with hardcoded defaults. Resources then
+// call Inherit on the resource timeouts with the provider Durations, so
+// resource configuration overrides provider configuration, which overrides
+// the hardcoded defaults.
+func (v Value) Inherit(ctx context.Context, inherited Durations) (Durations, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := Durations{}
+
+	for _, operation := range []struct {
+		attributeName string
+		inherited     time.Duration
+		result        *time.Duration
+	}{
+		{attributeNameCreate, inherited.Create, &result.Create},
+		{attributeNameRead, inherited.Read, &result.Read},
+		{attributeNameUpdate, inherited.Update, &result.Update},
+		{attributeNameDelete, inherited.Delete, &result.Delete},
+	} {
+		duration, timeoutDiags := v.getTimeout(ctx, operation.attributeName, operation.inherited)
+
+		diags.Append(timeoutDiags...)
+
+		*operation.result = duration
+	}
+
+	return result, diags
+}
+
+// getTimeout returns the parsed duration of the given attribute or the
+// default if the value is null, unknown, or the attribute is missing.
+func (v Value) getTimeout(_ context.Context, attributeName string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, ok := v.Object.Attributes()[attributeName]
+
+	if !ok {
+		return defaultTimeout, diags
+	}
+
+	stringValue, ok := value.(types.String)
+
+	if !ok {
+		diags.AddError(
+			"Timeout Value Conversion Error",
+			fmt.Sprintf("Expected the %s timeout value to be a string, got: %T. ", attributeName, value)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return defaultTimeout, diags
+	}
+
+	if stringValue.IsNull() || stringValue.IsUnknown() {
+		return defaultTimeout, diags
+	}
+
+	duration, err := time.ParseDuration(stringValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("Unable to parse the %s timeout value %q: %s", attributeName, stringValue.ValueString(), err),
+		)
+
+		return defaultTimeout, diags
+	}
+
+	return duration, diags
+}
@@ -0,0 +1,450 @@
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueCreate(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"create": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		value               timeouts.Value
+		expected            time.Duration
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null": {
+			value: timeouts.Value{
+				Object: types.ObjectNull(attributeTypes),
+			},
+			expected: 20 * time.Minute,
+		},
+		"unknown": {
+			value: timeouts.Value{
+				Object: types.ObjectUnknown(attributeTypes),
+			},
+			expected: 20 * time.Minute,
+		},
+		"attribute-missing": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"delete": types.StringType,
+					},
+					map[string]attr.Value{
+						"delete": types.StringValue("10m"),
+					},
+				),
+			},
+			expected: 20 * time.Minute,
+		},
+		"attribute-null": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					attributeTypes,
+					map[string]attr.Value{
+						"create": types.StringNull(),
+					},
+				),
+			},
+			expected: 20 * time.Minute,
+		},
+		"attribute-unknown": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					attributeTypes,
+					map[string]attr.Value{
+						"create": types.StringUnknown(),
+					},
+				),
+			},
+			expected: 20 * time.Minute,
+		},
+		"attribute-value": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					attributeTypes,
+					map[string]attr.Value{
+						"create": types.StringValue("2h45m"),
+					},
+				),
+			},
+			expected: 2*time.Hour + 45*time.Minute,
+		},
+		"attribute-value-invalid": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					attributeTypes,
+					map[string]attr.Value{
+						"create": types.StringValue("60"),
+					},
+				),
+			},
+			expected: 20 * time.Minute,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`Unable to parse the create timeout value "60": time: missing unit in duration "60"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.Create(context.Background(), 20*time.Minute)
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueReadUpdateDelete(t *testing.T) {
+	t.Parallel()
+
+	value := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"read":   types.StringValue("1m"),
+				"update": types.StringValue("2m"),
+				"delete": types.StringValue("3m"),
+			},
+		),
+	}
+
+	read, diags := value.Read(context.Background(), time.Hour)
+
+	if diags.HasError() || read != time.Minute {
+		t.Errorf("unexpected read timeout: %s, diagnostics: %v", read, diags)
+	}
+
+	update, diags := value.Update(context.Background(), time.Hour)
+
+	if diags.HasError() || update != 2*time.Minute {
+		t.Errorf("unexpected update timeout: %s, diagnostics: %v", update, diags)
+	}
+
+	del, diags := value.Delete(context.Background(), time.Hour)
+
+	if diags.HasError() || del != 3*time.Minute {
+		t.Errorf("unexpected delete timeout: %s, diagnostics: %v", del, diags)
+	}
+}
+
+func TestValueInherit(t *testing.T) {
+	t.Parallel()
+
+	defaults := timeouts.Durations{
+		Create: 20 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 20 * time.Minute,
+		Delete: 20 * time.Minute,
+	}
+
+	providerTimeouts := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("1h"),
+				"delete": types.StringValue("2h"),
+			},
+		),
+	}
+
+	testCases := map[string]struct {
+		value               timeouts.Value
+		expected            timeouts.Durations
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null": {
+			value: timeouts.Value{
+				Object: types.ObjectNull(map[string]attr.Type{
+					"create": types.StringType,
+				}),
+			},
+			expected: timeouts.Durations{
+				Create: time.Hour,
+				Read:   5 * time.Minute,
+				Update: 20 * time.Minute,
+				Delete: 2 * time.Hour,
+			},
+		},
+		"override": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+						"read":   types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("3h"),
+						"read":   types.StringNull(),
+					},
+				),
+			},
+			expected: timeouts.Durations{
+				Create: 3 * time.Hour,
+				Read:   5 * time.Minute,
+				Update: 20 * time.Minute,
+				Delete: 2 * time.Hour,
+			},
+		},
+		"invalid": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"update": types.StringType,
+					},
+					map[string]attr.Value{
+						"update": types.StringValue("not-a-duration"),
+					},
+				),
+			},
+			expected: timeouts.Durations{
+				Create: time.Hour,
+				Read:   5 * time.Minute,
+				Update: 20 * time.Minute,
+				Delete: 2 * time.Hour,
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`Unable to parse the update timeout value "not-a-duration": time: invalid duration "not-a-duration"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			inherited, diags := providerTimeouts.Inherit(ctx, defaults)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected provider timeouts diagnostics: %v", diags)
+			}
+
+			got, diags := testCase.value.Inherit(ctx, inherited)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueGetSet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		schemaTimeouts func(context.Context, timeouts.Opts) schema.Schema
+	}{
+		"Attributes": {
+			schemaTimeouts: func(ctx context.Context, opts timeouts.Opts) schema.Schema {
+				return schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						timeouts.AttributeName: timeouts.Attributes(ctx, opts),
+					},
+				}
+			},
+		},
+		"Block": {
+			schemaTimeouts: func(ctx context.Context, opts timeouts.Opts) schema.Schema {
+				return schema.Schema{
+					Blocks: map[string]schema.Block{
+						timeouts.AttributeName: timeouts.Block(ctx, opts),
+					},
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testSchema := testCase.schemaTimeouts(ctx, timeouts.Opts{Create: true, Delete: true})
+			testType := testSchema.Type().TerraformType(ctx)
+			timeoutsType := tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+					"delete": tftypes.String,
+				},
+			}
+
+			plan := tfsdk.Plan{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "30s"),
+						"delete": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				}),
+				Schema: testSchema,
+			}
+
+			var data struct {
+				Timeouts timeouts.Value `tfsdk:"timeouts"`
+			}
+
+			diags := plan.Get(ctx, &data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected Get diagnostics: %v", diags)
+			}
+
+			create, diags := data.Timeouts.Create(ctx, time.Hour)
+
+			if diags.HasError() || create != 30*time.Second {
+				t.Errorf("unexpected create timeout: %s, diagnostics: %v", create, diags)
+			}
+
+			del, diags := data.Timeouts.Delete(ctx, time.Hour)
+
+			if diags.HasError() || del != time.Hour {
+				t.Errorf("unexpected delete timeout: %s, diagnostics: %v", del, diags)
+			}
+
+			state := tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			}
+
+			diags = state.Set(ctx, &data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected Set diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(state.Raw, plan.Raw); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBlockValidateResourceConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			timeouts.AttributeName: timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+	testType := testSchema.Type().TerraformType(ctx)
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		create              tftypes.Value
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null": {
+			create: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			create: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"valid": {
+			create: tftypes.NewValue(tftypes.String, "10m"),
+		},
+		"invalid": {
+			create: tftypes.NewValue(tftypes.String, "ten minutes"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Attribute Value Time Duration",
+					`"ten minutes" string must be a valid time duration, such as "30s" or "2h45m"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			req := &fwserver.ValidateResourceConfigRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+							"create": testCase.create,
+						}),
+					}),
+					Schema: testSchema,
+				},
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+				},
+			}
+			resp := &fwserver.ValidateResourceConfigResponse{}
+
+			server.ValidateResourceConfig(ctx, req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = timeDuration{}
+
+// timeDuration is a validator which ensures string values can be parsed with
+// time.ParseDuration.
+type timeDuration struct{}
+
+// Description returns a plaintext description of the validator.
+func (v timeDuration) Description(_ context.Context) string {
+	return `string must be a valid time duration, such as "30s" or "2h45m"`
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v timeDuration) MarkdownDescription(_ context.Context) string {
+	return "string must be a valid time duration, such as `30s` or `2h45m`"
+}
+
+// ValidateString returns an error if the configured value is not a valid
+// time duration. Null and unknown values are skipped.
+func (v timeDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, err := time.ParseDuration(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Time Duration",
+			fmt.Sprintf("%q %s", value, v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,378 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// TypedResource is the Create, Read, Update, and Delete logic of a resource
+// which embeds Typed. The ModelT type is the resource data model, such as a
+// struct with tfsdk field tags, which is automatically read from the
+// configuration, plan, and state and written to the new state.
+type TypedResource[ModelT any] interface {
+	// TypedCreate is called when the provider must create a new resource.
+	// The TypedCreateResponse State is initially the planned state, which
+	// should be updated with any values known after creation.
+	TypedCreate(context.Context, TypedCreateRequest[ModelT], *TypedCreateResponse[ModelT])
+
+	// TypedRead is called when the provider must read resource values in
+	// order to update state. The TypedReadResponse State is initially the
+	// prior state, which should be updated with the refreshed values.
+	TypedRead(context.Context, TypedReadRequest[ModelT], *TypedReadResponse[ModelT])
+
+	// TypedUpdate is called to update the state of the resource. The
+	// TypedUpdateResponse State is initially the planned state, which should
+	// be updated with any values known after the update.
+	TypedUpdate(context.Context, TypedUpdateRequest[ModelT], *TypedUpdateResponse[ModelT])
+
+	// TypedDelete is called when the provider must delete the resource.
+	TypedDelete(context.Context, TypedDeleteRequest[ModelT], *TypedDeleteResponse)
+}
+
+// Typed implements the Resource interface Create, Read, Update, and Delete
+// methods by reading the configuration, plan, and state data into the ModelT
+// data model, calling the TypedResource methods, and writing the returned
+// ModelT data model into the new state. This removes the need to call the
+// Get and Set methods of request and response data in each method.
+//
+// Embed Typed in the resource type, which must also implement the Metadata
+// and Schema methods of the Resource interface, and set it with NewTyped.
+// Optional interfaces, such as ResourceWithImportState, are implemented on
+// the resource type as usual. For example:
+//
+//	type ThingResource struct {
+//		resource.Typed[ThingResourceModel]
+//	}
+//
+//	func NewThingResource() resource.Resource {
+//		r := &ThingResource{}
+//		r.Typed = resource.NewTyped[ThingResourceModel](r)
+//
+//		return r
+//	}
+//
+// The framework types, such as types.String, should be used for ModelT
+// fields, since the configuration and plan may contain null or unknown
+// values.
+type Typed[ModelT any] struct {
+	resource TypedResource[ModelT]
+}
+
+// NewTyped returns a Typed which calls the given TypedResource methods.
+func NewTyped[ModelT any](r TypedResource[ModelT]) Typed[ModelT] {
+	return Typed[ModelT]{
+		resource: r,
+	}
+}
+
+// Create reads the CreateRequest configuration and plan into the ModelT
+// data model, calls the TypedResource TypedCreate method, and sets the
+// CreateResponse State from the returned data model if there are no errors.
+func (t Typed[ModelT]) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	if t.resource == nil {
+		resp.Diagnostics.Append(typedMissingResourceDiag())
+
+		return
+	}
+
+	typedReq := TypedCreateRequest[ModelT]{
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &typedReq.Config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &typedReq.Plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedResp := TypedCreateResponse[ModelT]{
+		State:   typedReq.Plan,
+		Private: resp.Private,
+	}
+
+	t.resource.TypedCreate(ctx, typedReq, &typedResp)
+
+	resp.Diagnostics.Append(typedResp.Diagnostics...)
+	resp.Private = typedResp.Private
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, typedResp.State)...)
+}
+
+// Read reads the ReadRequest state into the ModelT data model, calls the
+// TypedResource TypedRead method, and sets the ReadResponse State from the
+// returned data model or removes the resource if there are no errors.
+func (t Typed[ModelT]) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	if t.resource == nil {
+		resp.Diagnostics.Append(typedMissingResourceDiag())
+
+		return
+	}
+
+	typedReq := TypedReadRequest[ModelT]{
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &typedReq.State)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedResp := TypedReadResponse[ModelT]{
+		State:          typedReq.State,
+		Private:        resp.Private,
+		RefreshedPaths: resp.RefreshedPaths,
+	}
+
+	t.resource.TypedRead(ctx, typedReq, &typedResp)
+
+	resp.Diagnostics.Append(typedResp.Diagnostics...)
+	resp.Private = typedResp.Private
+	resp.RefreshedPaths = typedResp.RefreshedPaths
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if typedResp.removeResource {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, typedResp.State)...)
+}
+
+// Update reads the UpdateRequest configuration, plan, and state into the
+// ModelT data model, calls the TypedResource TypedUpdate method, and sets the
+// UpdateResponse State from the returned data model if there are no errors.
+func (t Typed[ModelT]) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	if t.resource == nil {
+		resp.Diagnostics.Append(typedMissingResourceDiag())
+
+		return
+	}
+
+	typedReq := TypedUpdateRequest[ModelT]{
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &typedReq.Config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &typedReq.Plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &typedReq.State)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedResp := TypedUpdateResponse[ModelT]{
+		State:   typedReq.Plan,
+		Private: resp.Private,
+	}
+
+	t.resource.TypedUpdate(ctx, typedReq, &typedResp)
+
+	resp.Diagnostics.Append(typedResp.Diagnostics...)
+	resp.Private = typedResp.Private
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, typedResp.State)...)
+}
+
+// Delete reads the DeleteRequest state into the ModelT data model and calls
+// the TypedResource TypedDelete method.
+func (t Typed[ModelT]) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	if t.resource == nil {
+		resp.Diagnostics.Append(typedMissingResourceDiag())
+
+		return
+	}
+
+	typedReq := TypedDeleteRequest[ModelT]{
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &typedReq.State)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedResp := TypedDeleteResponse{}
+
+	t.resource.TypedDelete(ctx, typedReq, &typedResp)
+
+	resp.Diagnostics.Append(typedResp.Diagnostics...)
+}
+
+// TypedCreateRequest represents a request for the provider to create a
+// resource, with the data read into the ModelT data model. An instance of
+// this request struct is supplied as an argument to the TypedResource
+// TypedCreate method.
+type TypedCreateRequest[ModelT any] struct {
+	// Config is the configuration the user supplied for the resource.
+	Config ModelT
+
+	// Plan is the planned state for the resource.
+	Plan ModelT
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+}
+
+// TypedCreateResponse represents a response to a TypedCreateRequest. An
+// instance of this response struct is supplied as an argument to the
+// TypedResource TypedCreate method.
+type TypedCreateResponse[ModelT any] struct {
+	// State is the state of the resource following the Create operation,
+	// which is initially the planned state.
+	State ModelT
+
+	// Private is the private state resource data following the Create
+	// operation.
+	Private *privatestate.ProviderData
+
+	// Diagnostics report errors or warnings related to creating the
+	// resource. If any errors are present, the State is not saved.
+	Diagnostics diag.Diagnostics
+}
+
+// TypedReadRequest represents a request for the provider to read a resource,
+// with the prior state read into the ModelT data model. An instance of this
+// request struct is supplied as an argument to the TypedResource TypedRead
+// method.
+type TypedReadRequest[ModelT any] struct {
+	// State is the current state of the resource prior to the Read
+	// operation.
+	State ModelT
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state.
+	Private *privatestate.ProviderData
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+}
+
+// TypedReadResponse represents a response to a TypedReadRequest. An instance
+// of this response struct is supplied as an argument to the TypedResource
+// TypedRead method.
+type TypedReadResponse[ModelT any] struct {
+	// State is the state of the resource following the Read operation,
+	// which is initially the prior state.
+	State ModelT
+
+	// Private is the private state resource data following the Read
+	// operation.
+	Private *privatestate.ProviderData
+
+	// Diagnostics report errors or warnings related to reading the
+	// resource. If any errors are present, the State is not saved.
+	Diagnostics diag.Diagnostics
+
+	// RefreshedPaths are the paths of attributes which were refreshed from
+	// the remote system. Refer to the ReadResponse type RefreshedPaths field
+	// for more information.
+	RefreshedPaths path.Paths
+
+	// removeResource is set by RemoveResource.
+	removeResource bool
+}
+
+// RemoveResource removes the resource from state, such as when the remote
+// resource no longer exists, instead of saving the State.
+func (r *TypedReadResponse[ModelT]) RemoveResource() {
+	r.removeResource = true
+}
+
+// TypedUpdateRequest represents a request for the provider to update a
+// resource, with the data read into the ModelT data model. An instance of
+// this request struct is supplied as an argument to the TypedResource
+// TypedUpdate method.
+type TypedUpdateRequest[ModelT any] struct {
+	// Config is the configuration the user supplied for the resource.
+	Config ModelT
+
+	// Plan is the planned state for the resource.
+	Plan ModelT
+
+	// State is the current state of the resource prior to the Update
+	// operation.
+	State ModelT
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state.
+	Private *privatestate.ProviderData
+}
+
+// TypedUpdateResponse represents a response to a TypedUpdateRequest. An
+// instance of this response struct is supplied as an argument to the
+// TypedResource TypedUpdate method.
+type TypedUpdateResponse[ModelT any] struct {
+	// State is the state of the resource following the Update operation,
+	// which is initially the planned state.
+	State ModelT
+
+	// Private is the private state resource data following the Update
+	// operation.
+	Private *privatestate.ProviderData
+
+	// Diagnostics report errors or warnings related to updating the
+	// resource. If any errors are present, the State is not saved.
+	Diagnostics diag.Diagnostics
+}
+
+// TypedDeleteRequest represents a request for the provider to delete a
+// resource, with the prior state read into the ModelT data model. An
+// instance of this request struct is supplied as an argument to the
+// TypedResource TypedDelete method.
+type TypedDeleteRequest[ModelT any] struct {
+	// State is the current state of the resource prior to the Delete
+	// operation.
+	State ModelT
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state.
+	Private *privatestate.ProviderData
+}
+
+// TypedDeleteResponse represents a response to a TypedDeleteRequest. An
+// instance of this response struct is supplied as an argument to the
+// TypedResource TypedDelete method.
+type TypedDeleteResponse struct {
+	// Diagnostics report errors or warnings related to deleting the
+	// resource. If no errors are present, the resource is removed from
+	// state.
+	Diagnostics diag.Diagnostics
+}
+
+// typedMissingResourceDiag returns an error diagnostic for a Typed which was
+// not created with NewTyped.
+func typedMissingResourceDiag() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Missing Typed Resource Implementation",
+		"The resource embeds resource.Typed without setting it with resource.NewTyped, so there is no implementation to call. "+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
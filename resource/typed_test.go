@@ -0,0 +1,369 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testTypedModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type testTypedResource struct {
+	resource.Typed[testTypedModel]
+
+	createMethod func(context.Context, resource.TypedCreateRequest[testTypedModel], *resource.TypedCreateResponse[testTypedModel])
+	readMethod   func(context.Context, resource.TypedReadRequest[testTypedModel], *resource.TypedReadResponse[testTypedModel])
+	updateMethod func(context.Context, resource.TypedUpdateRequest[testTypedModel], *resource.TypedUpdateResponse[testTypedModel])
+	deleteMethod func(context.Context, resource.TypedDeleteRequest[testTypedModel], *resource.TypedDeleteResponse)
+}
+
+func newTestTypedResource(r *testTypedResource) *testTypedResource {
+	r.Typed = resource.NewTyped[testTypedModel](r)
+
+	return r
+}
+
+func (r *testTypedResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "test_resource"
+}
+
+func (r *testTypedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = testTypedSchema
+}
+
+func (r *testTypedResource) TypedCreate(ctx context.Context, req resource.TypedCreateRequest[testTypedModel], resp *resource.TypedCreateResponse[testTypedModel]) {
+	r.createMethod(ctx, req, resp)
+}
+
+func (r *testTypedResource) TypedRead(ctx context.Context, req resource.TypedReadRequest[testTypedModel], resp *resource.TypedReadResponse[testTypedModel]) {
+	r.readMethod(ctx, req, resp)
+}
+
+func (r *testTypedResource) TypedUpdate(ctx context.Context, req resource.TypedUpdateRequest[testTypedModel], resp *resource.TypedUpdateResponse[testTypedModel]) {
+	r.updateMethod(ctx, req, resp)
+}
+
+func (r *testTypedResource) TypedDelete(ctx context.Context, req resource.TypedDeleteRequest[testTypedModel], resp *resource.TypedDeleteResponse) {
+	r.deleteMethod(ctx, req, resp)
+}
+
+var (
+	_ resource.Resource                      = &testTypedResource{}
+	_ resource.TypedResource[testTypedModel] = &testTypedResource{}
+
+	testTypedSchema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testTypedType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+)
+
+func testTypedValue(id interface{}, name interface{}) tftypes.Value {
+	return tftypes.NewValue(testTypedType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, id),
+		"name": tftypes.NewValue(tftypes.String, name),
+	})
+}
+
+func TestTypedCreate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource      resource.Resource
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"state": {
+			resource: newTestTypedResource(&testTypedResource{
+				createMethod: func(_ context.Context, req resource.TypedCreateRequest[testTypedModel], resp *resource.TypedCreateResponse[testTypedModel]) {
+					if req.Config.Name.ValueString() != "test-name" {
+						resp.Diagnostics.AddError("unexpected req.Config value", req.Config.Name.String())
+					}
+
+					if !req.Plan.ID.IsUnknown() {
+						resp.Diagnostics.AddError("unexpected req.Plan value", req.Plan.ID.String())
+					}
+
+					resp.State.ID = types.StringValue("test-id")
+				},
+			}),
+			expected: testTypedValue("test-id", "test-name"),
+		},
+		"diagnostics": {
+			resource: newTestTypedResource(&testTypedResource{
+				createMethod: func(_ context.Context, _ resource.TypedCreateRequest[testTypedModel], resp *resource.TypedCreateResponse[testTypedModel]) {
+					resp.State.ID = types.StringValue("test-id")
+					resp.Diagnostics.AddError("error summary", "error detail")
+				},
+			}),
+			expected: tftypes.NewValue(testTypedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"missing-NewTyped": {
+			resource: &testTypedResource{},
+			expected: tftypes.NewValue(testTypedType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Typed Resource Implementation",
+					"The resource embeds resource.Typed without setting it with resource.NewTyped, so there is no implementation to call. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.CreateRequest{
+				Config: tfsdk.Config{
+					Raw:    testTypedValue(nil, "test-name"),
+					Schema: testTypedSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testTypedValue(tftypes.UnknownValue, "test-name"),
+					Schema: testTypedSchema,
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testTypedType, nil),
+					Schema: testTypedSchema,
+				},
+			}
+
+			testCase.resource.Create(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypedRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource      resource.Resource
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"state": {
+			resource: newTestTypedResource(&testTypedResource{
+				readMethod: func(_ context.Context, req resource.TypedReadRequest[testTypedModel], resp *resource.TypedReadResponse[testTypedModel]) {
+					if req.State.ID.ValueString() != "test-id" {
+						resp.Diagnostics.AddError("unexpected req.State value", req.State.ID.String())
+					}
+
+					resp.State.Name = types.StringValue("test-name-refreshed")
+				},
+			}),
+			expected: testTypedValue("test-id", "test-name-refreshed"),
+		},
+		"state-unmodified": {
+			resource: newTestTypedResource(&testTypedResource{
+				readMethod: func(_ context.Context, _ resource.TypedReadRequest[testTypedModel], _ *resource.TypedReadResponse[testTypedModel]) {
+				},
+			}),
+			expected: testTypedValue("test-id", "test-name"),
+		},
+		"RemoveResource": {
+			resource: newTestTypedResource(&testTypedResource{
+				readMethod: func(_ context.Context, _ resource.TypedReadRequest[testTypedModel], resp *resource.TypedReadResponse[testTypedModel]) {
+					resp.RemoveResource()
+				},
+			}),
+			expected: tftypes.NewValue(testTypedType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    testTypedValue("test-id", "test-name"),
+					Schema: testTypedSchema,
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    testTypedValue("test-id", "test-name"),
+					Schema: testTypedSchema,
+				},
+			}
+
+			testCase.resource.Read(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypedUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource      resource.Resource
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"state": {
+			resource: newTestTypedResource(&testTypedResource{
+				updateMethod: func(_ context.Context, req resource.TypedUpdateRequest[testTypedModel], resp *resource.TypedUpdateResponse[testTypedModel]) {
+					if req.Config.Name.ValueString() != "test-name-new" {
+						resp.Diagnostics.AddError("unexpected req.Config value", req.Config.Name.String())
+					}
+
+					if req.State.Name.ValueString() != "test-name" {
+						resp.Diagnostics.AddError("unexpected req.State value", req.State.Name.String())
+					}
+				},
+			}),
+			expected: testTypedValue("test-id", "test-name-new"),
+		},
+		"diagnostics": {
+			resource: newTestTypedResource(&testTypedResource{
+				updateMethod: func(_ context.Context, _ resource.TypedUpdateRequest[testTypedModel], resp *resource.TypedUpdateResponse[testTypedModel]) {
+					resp.Diagnostics.AddError("error summary", "error detail")
+				},
+			}),
+			expected: testTypedValue("test-id", "test-name"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.UpdateRequest{
+				Config: tfsdk.Config{
+					Raw:    testTypedValue(nil, "test-name-new"),
+					Schema: testTypedSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testTypedValue("test-id", "test-name-new"),
+					Schema: testTypedSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testTypedValue("test-id", "test-name"),
+					Schema: testTypedSchema,
+				},
+			}
+			resp := &resource.UpdateResponse{
+				State: tfsdk.State{
+					Raw:    testTypedValue("test-id", "test-name"),
+					Schema: testTypedSchema,
+				},
+			}
+
+			testCase.resource.Update(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypedDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource      resource.Resource
+		expectedDiags diag.Diagnostics
+	}{
+		"state": {
+			resource: newTestTypedResource(&testTypedResource{
+				deleteMethod: func(_ context.Context, req resource.TypedDeleteRequest[testTypedModel], resp *resource.TypedDeleteResponse) {
+					if req.State.ID.ValueString() != "test-id" {
+						resp.Diagnostics.AddError("unexpected req.State value", req.State.ID.String())
+					}
+				},
+			}),
+		},
+		"diagnostics": {
+			resource: newTestTypedResource(&testTypedResource{
+				deleteMethod: func(_ context.Context, _ resource.TypedDeleteRequest[testTypedModel], resp *resource.TypedDeleteResponse) {
+					resp.Diagnostics.AddWarning("warning summary", "warning detail")
+				},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.DeleteRequest{
+				State: tfsdk.State{
+					Raw:    testTypedValue("test-id", "test-name"),
+					Schema: testTypedSchema,
+				},
+			}
+			resp := &resource.DeleteResponse{}
+
+			testCase.resource.Delete(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
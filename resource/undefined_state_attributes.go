@@ -0,0 +1,40 @@
+package resource
+
+// UndefinedStateAttributesBehavior describes how the framework handles
+// attributes in the prior state which are not defined in the schema, such
+// as after manual state modifications or provider downgrades.
+//
+// There is no behavior which preserves undefined attributes in private
+// state. The framework only detects undefined attributes during the
+// UpgradeResourceState RPC, which cannot return private state, and they are
+// already removed from the prior state sent to the ReadResource RPC.
+type UndefinedStateAttributesBehavior uint8
+
+const (
+	// UndefinedStateAttributesBehaviorIgnore is the default value and
+	// silently drops undefined attributes from the prior state.
+	UndefinedStateAttributesBehaviorIgnore UndefinedStateAttributesBehavior = 0
+
+	// UndefinedStateAttributesBehaviorWarn drops undefined attributes from
+	// the prior state and returns a warning diagnostic which describes
+	// them.
+	UndefinedStateAttributesBehaviorWarn UndefinedStateAttributesBehavior = 1
+
+	// UndefinedStateAttributesBehaviorError returns an error diagnostic which
+	// describes the undefined attributes, preventing any further operations
+	// with the prior state.
+	UndefinedStateAttributesBehaviorError UndefinedStateAttributesBehavior = 2
+)
+
+// String returns a lowercase, machine-readable representation of the
+// UndefinedStateAttributesBehavior.
+func (b UndefinedStateAttributesBehavior) String() string {
+	switch b {
+	case UndefinedStateAttributesBehaviorWarn:
+		return "warn"
+	case UndefinedStateAttributesBehaviorError:
+		return "error"
+	default:
+		return "ignore"
+	}
+}
@@ -0,0 +1,6 @@
+// Package displayhint contains display hints for schema attributes, which
+// enable downstream tooling, such as documentation generators and debug
+// renderers, to present large blob attribute values, such as JSON policy
+// documents, readably. Display hints are not sent to Terraform and do not
+// affect plans or state.
+package displayhint
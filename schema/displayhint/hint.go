@@ -0,0 +1,42 @@
+package displayhint
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Hint describes how tooling should present an attribute value.
+type Hint string
+
+const (
+	// None is the zero value, which signals no display preference.
+	None Hint = ""
+
+	// JSON signals that the attribute value is a JSON document, which
+	// should be presented as indented, multiple line JSON and differences
+	// shown line by line.
+	JSON Hint = "json"
+
+	// Multiline signals that the attribute value is multiple line text,
+	// such as a script or certificate, which should be presented as is and
+	// differences shown line by line.
+	Multiline Hint = "multiline"
+)
+
+// Format returns the string value formatted for presentation according to
+// the hint. JSON values are indented with two spaces, unless the value is
+// not valid JSON, in which case the value is returned unmodified. All other
+// values are returned unmodified.
+func Format(hint Hint, value string) string {
+	if hint != JSON {
+		return value
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.Indent(&buf, []byte(value), "", "  "); err != nil {
+		return value
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,54 @@
+package displayhint_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/displayhint"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		hint     displayhint.Hint
+		value    string
+		expected string
+	}{
+		"none": {
+			hint:     displayhint.None,
+			value:    `{"a":1}`,
+			expected: `{"a":1}`,
+		},
+		"json": {
+			hint:     displayhint.JSON,
+			value:    `{"a":1,"b":[true,null]}`,
+			expected: "{\n  \"a\": 1,\n  \"b\": [\n    true,\n    null\n  ]\n}",
+		},
+		"json-invalid": {
+			hint:     displayhint.JSON,
+			value:    `{"a":`,
+			expected: `{"a":`,
+		},
+		"multiline": {
+			hint:     displayhint.Multiline,
+			value:    "line1\nline2",
+			expected: "line1\nline2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := displayhint.Format(testCase.hint, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
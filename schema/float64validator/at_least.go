@@ -0,0 +1,47 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = atLeastValidator{}
+
+// AtLeast returns a validator which ensures that a known float64 value is
+// greater than or equal to the given minimum.
+func AtLeast(minimum float64) validator.Float64 {
+	return atLeastValidator{
+		minimum: minimum,
+	}
+}
+
+// atLeastValidator implements the validator.
+type atLeastValidator struct {
+	minimum float64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", formatValue(v.minimum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation. Null and unknown values are skipped.
+func (v atLeastValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value < v.minimum {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
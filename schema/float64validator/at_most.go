@@ -0,0 +1,47 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = atMostValidator{}
+
+// AtMost returns a validator which ensures that a known float64 value is less
+// than or equal to the given maximum.
+func AtMost(maximum float64) validator.Float64 {
+	return atMostValidator{
+		maximum: maximum,
+	}
+}
+
+// atMostValidator implements the validator.
+type atMostValidator struct {
+	maximum float64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", formatValue(v.maximum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation. Null and unknown values are skipped.
+func (v atMostValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value > v.maximum {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
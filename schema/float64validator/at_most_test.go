@@ -0,0 +1,60 @@
+package float64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtMostValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Float64{
+		float64validator.AtMost(1.5),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.Float64Null(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.Float64Unknown(),
+		},
+		"valid-1-5": {
+			Path:        testPath,
+			ConfigValue: types.Float64Value(1.5),
+		},
+		"invalid-1-75": {
+			Path:        testPath,
+			ConfigValue: types.Float64Value(1.75),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be at most 1.5, got: 1.75",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(1.5),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(1.75),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at most 1.5, got: 1.75",
+				),
+			},
+		},
+	})
+}
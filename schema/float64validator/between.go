@@ -0,0 +1,49 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = betweenValidator{}
+
+// Between returns a validator which ensures that a known float64 value is
+// greater than or equal to minimum and less than or equal to maximum.
+func Between(minimum, maximum float64) validator.Float64 {
+	return betweenValidator{
+		minimum: minimum,
+		maximum: maximum,
+	}
+}
+
+// betweenValidator implements the validator.
+type betweenValidator struct {
+	minimum float64
+	maximum float64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", formatValue(v.minimum), formatValue(v.maximum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation. Null and unknown values are skipped.
+func (v betweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value < v.minimum || value > v.maximum {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
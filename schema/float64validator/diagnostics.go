@@ -0,0 +1,38 @@
+package float64validator
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// invalidValueDiagnostic returns an error diagnostic for the request value,
+// which is redacted if the attribute is sensitive.
+func invalidValueDiagnostic(ctx context.Context, req validator.Float64Request, description string) diag.Diagnostic {
+	return validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		description,
+		validatordiag.AttributeValueString(ctx, req.Config, req.Path, formatValue(req.ConfigValue.ValueFloat64())),
+	)
+}
+
+// formatValue returns the value as a decimal string.
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// formatValues returns the values as a list of decimal strings, such as
+// [1 2 3].
+func formatValues(values []float64) string {
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, formatValue(value))
+	}
+
+	return "[" + strings.Join(result, " ") + "]"
+}
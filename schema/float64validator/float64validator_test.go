@@ -0,0 +1,100 @@
+package float64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSchema contains float64 attributes at the root, nested inside a list of
+// objects, and with Sensitive enabled.
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"test_list_nested": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"test_value": schema.Float64Attribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"test_sensitive": schema.Float64Attribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"test_value": schema.Float64Attribute{
+			Optional: true,
+		},
+	},
+}
+
+var (
+	testPath          = path.Root("test_value")
+	testNestedPath    = path.Root("test_list_nested").AtListIndex(0).AtName("test_value")
+	testSensitivePath = path.Root("test_sensitive")
+)
+
+func TestValidatorComposition(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Float64{
+		float64validator.AtLeast(0.5),
+		float64validator.AtMost(1.5),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Null(),
+		},
+		"unknown": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Unknown(),
+		},
+		"valid-1": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(1),
+		},
+		"invalid-0-25": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(0.25),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at least 0.5, got: 0.25",
+				),
+			},
+		},
+		"invalid-1-75": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(1.75),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at most 1.5, got: 1.75",
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.Float64Value(0.25),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					"Attribute test_sensitive value must be at least 0.5, got: (sensitive value)",
+				),
+			},
+		},
+	})
+}
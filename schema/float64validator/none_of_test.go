@@ -0,0 +1,60 @@
+package float64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNoneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Float64{
+		float64validator.NoneOf(0.1, 0.2, 0.3),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.Float64Null(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.Float64Unknown(),
+		},
+		"valid-0-4": {
+			Path:        testPath,
+			ConfigValue: types.Float64Value(0.4),
+		},
+		"invalid-0-1": {
+			Path:        testPath,
+			ConfigValue: types.Float64Value(0.1),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be none of: [0.1 0.2 0.3], got: 0.1",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(0.4),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Float64Value(0.1),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be none of: [0.1 0.2 0.3], got: 0.1",
+				),
+			},
+		},
+	})
+}
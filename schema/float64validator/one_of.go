@@ -0,0 +1,61 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = oneOfValidator{}
+
+// OneOf returns a validator which ensures that a known float64 value is equal
+// to one of the given values. Configuration values are converted to the
+// nearest float64 before comparison, so the configuration value 0.1 is equal
+// to the float64 value 0.1. Use numbervalidator.OneOf for arbitrary-precision
+// values.
+func OneOf(values ...float64) validator.Float64 {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []float64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation. Null and unknown values are skipped.
+func (v oneOfValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if !containsValue(v.values, value) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
+
+// containsValue returns true if the value is equal to any of the values.
+func containsValue(values []float64, value float64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,47 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = atMostValidator{}
+
+// AtMost returns a validator which ensures that a known int64 value is less
+// than or equal to the given maximum.
+func AtMost(maximum int64) validator.Int64 {
+	return atMostValidator{
+		maximum: maximum,
+	}
+}
+
+// atMostValidator implements the validator.
+type atMostValidator struct {
+	maximum int64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", formatValue(v.maximum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation. Null and unknown values are skipped.
+func (v atMostValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value > v.maximum {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,75 @@
+package int64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetweenValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Int64{
+		int64validator.Between(1, 10),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.Int64Null(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.Int64Unknown(),
+		},
+		"valid-1": {
+			Path:        testPath,
+			ConfigValue: types.Int64Value(1),
+		},
+		"valid-10": {
+			Path:        testPath,
+			ConfigValue: types.Int64Value(10),
+		},
+		"invalid-0": {
+			Path:        testPath,
+			ConfigValue: types.Int64Value(0),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be between 1 and 10, got: 0",
+				),
+			},
+		},
+		"invalid-11": {
+			Path:        testPath,
+			ConfigValue: types.Int64Value(11),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be between 1 and 10, got: 11",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(1),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(0),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be between 1 and 10, got: 0",
+				),
+			},
+		},
+	})
+}
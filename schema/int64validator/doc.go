@@ -0,0 +1,6 @@
+// Package int64validator provides validators for types.Int64 attributes.
+//
+// Validators skip null and unknown values. Error diagnostics include the
+// configured bounds or values and the configuration value, unless the
+// attribute is Sensitive, in which case the value is redacted.
+package int64validator
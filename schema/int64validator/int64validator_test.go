@@ -0,0 +1,100 @@
+package int64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSchema contains int64 attributes at the root, nested inside a list of
+// objects, and with Sensitive enabled.
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"test_list_nested": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"test_value": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"test_sensitive": schema.Int64Attribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"test_value": schema.Int64Attribute{
+			Optional: true,
+		},
+	},
+}
+
+var (
+	testPath          = path.Root("test_value")
+	testNestedPath    = path.Root("test_list_nested").AtListIndex(0).AtName("test_value")
+	testSensitivePath = path.Root("test_sensitive")
+)
+
+func TestValidatorComposition(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Int64{
+		int64validator.AtLeast(1),
+		int64validator.AtMost(10),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Null(),
+		},
+		"unknown": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Unknown(),
+		},
+		"valid-5": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(5),
+		},
+		"invalid-0": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(0),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at least 1, got: 0",
+				),
+			},
+		},
+		"invalid-11": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(11),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at most 10, got: 11",
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.Int64Value(0),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					"Attribute test_sensitive value must be at least 1, got: (sensitive value)",
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = noneOfValidator{}
+
+// NoneOf returns a validator which ensures that a known int64 value is not
+// equal to any of the given values.
+func NoneOf(values ...int64) validator.Int64 {
+	return noneOfValidator{
+		values: values,
+	}
+}
+
+// noneOfValidator implements the validator.
+type noneOfValidator struct {
+	values []int64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation. Null and unknown values are skipped.
+func (v noneOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if containsValue(v.values, value) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
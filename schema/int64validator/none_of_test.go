@@ -0,0 +1,60 @@
+package int64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNoneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Int64{
+		int64validator.NoneOf(1, 2, 3),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.Int64Null(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.Int64Unknown(),
+		},
+		"valid-4": {
+			Path:        testPath,
+			ConfigValue: types.Int64Value(4),
+		},
+		"invalid-2": {
+			Path:        testPath,
+			ConfigValue: types.Int64Value(2),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be none of: [1 2 3], got: 2",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(4),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.Int64Value(2),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be none of: [1 2 3], got: 2",
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = oneOfValidator{}
+
+// OneOf returns a validator which ensures that a known int64 value is equal to
+// one of the given values.
+func OneOf(values ...int64) validator.Int64 {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []int64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation. Null and unknown values are skipped.
+func (v oneOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if !containsValue(v.values, value) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
+
+// containsValue returns true if the value is equal to any of the values.
+func containsValue(values []int64, value int64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeAtLeastValidator(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"test_single_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested_list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_list_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	blockObjectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"test_string": types.StringType,
+		},
+	}
+
+	blockObject := types.ObjectValueMust(
+		blockObjectType.AttrTypes,
+		map[string]attr.Value{
+			"test_string": types.StringValue("test"),
+		},
+	)
+
+	testCases := map[string]struct {
+		path        path.Path
+		configValue attr.Value
+		expected    schematest.ValidatorResponse
+	}{
+		"null": {
+			path:        path.Root("test_list"),
+			configValue: types.ListNull(types.StringType),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"unknown": {
+			path:        path.Root("test_list"),
+			configValue: types.ListUnknown(types.StringType),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"valid-2": {
+			path:        path.Root("test_list"),
+			configValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"valid-3": {
+			path:        path.Root("test_list"),
+			configValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b"), types.StringValue("c")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"invalid-1": {
+			path:        path.Root("test_list"),
+			configValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_list"),
+						"Invalid Attribute Value",
+						"Attribute test_list list must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+		"nested-attribute-valid": {
+			path:        path.Root("test_single_nested").AtName("test_nested_list"),
+			configValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"nested-attribute-invalid": {
+			path:        path.Root("test_single_nested").AtName("test_nested_list"),
+			configValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_single_nested").AtName("test_nested_list"),
+						"Invalid Attribute Value",
+						"Attribute test_single_nested.test_nested_list list must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+		"block-valid": {
+			path:        path.Root("test_list_block"),
+			configValue: types.ListValueMust(blockObjectType, []attr.Value{blockObject, blockObject}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"block-invalid": {
+			path:        path.Root("test_list_block"),
+			configValue: types.ListValueMust(blockObjectType, []attr.Value{blockObject}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_list_block"),
+						"Invalid Attribute Value",
+						"Attribute test_list_block list must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req, diags := schematest.NewAttributeRequest(ctx, testSchema, testCase.configValue, nil, nil, testCase.path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			schematest.AssertValidate(ctx, t, listvalidator.SizeAtLeast(2), req, testCase.expected)
+		})
+	}
+}
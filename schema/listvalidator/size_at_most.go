@@ -0,0 +1,54 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.List = sizeAtMostValidator{}
+
+// SizeAtMost returns a validator which ensures that a known list value contains
+// at most the given number of elements. This is equivalent to the
+// terraform-plugin-sdk/v2 MaxItems schema field.
+func SizeAtMost(maxSize int) validator.List {
+	return sizeAtMostValidator{
+		maxSize: maxSize,
+	}
+}
+
+// sizeAtMostValidator implements the validator.
+type sizeAtMostValidator struct {
+	maxSize int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v sizeAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list must contain at most %d elements", v.maxSize)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v sizeAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation. Null and unknown values are skipped.
+func (v sizeAtMostValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	size := len(req.ConfigValue.Elements())
+
+	if size > v.maxSize {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			strconv.Itoa(size),
+		))
+	}
+}
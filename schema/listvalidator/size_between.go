@@ -0,0 +1,55 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.List = sizeBetweenValidator{}
+
+// SizeBetween returns a validator which ensures that a known list value contains
+// at least minSize and at most maxSize elements.
+func SizeBetween(minSize, maxSize int) validator.List {
+	return sizeBetweenValidator{
+		minSize: minSize,
+		maxSize: maxSize,
+	}
+}
+
+// sizeBetweenValidator implements the validator.
+type sizeBetweenValidator struct {
+	minSize int
+	maxSize int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v sizeBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list must contain at least %d and at most %d elements", v.minSize, v.maxSize)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v sizeBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation. Null and unknown values are skipped.
+func (v sizeBetweenValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	size := len(req.ConfigValue.Elements())
+
+	if size < v.minSize || size > v.maxSize {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			strconv.Itoa(size),
+		))
+	}
+}
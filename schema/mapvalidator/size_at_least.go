@@ -0,0 +1,54 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Map = sizeAtLeastValidator{}
+
+// SizeAtLeast returns a validator which ensures that a known map value contains
+// at least the given number of elements. This is equivalent to the
+// terraform-plugin-sdk/v2 MinItems schema field.
+func SizeAtLeast(minSize int) validator.Map {
+	return sizeAtLeastValidator{
+		minSize: minSize,
+	}
+}
+
+// sizeAtLeastValidator implements the validator.
+type sizeAtLeastValidator struct {
+	minSize int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v sizeAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at least %d elements", v.minSize)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v sizeAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap performs the validation. Null and unknown values are skipped.
+func (v sizeAtLeastValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	size := len(req.ConfigValue.Elements())
+
+	if size < v.minSize {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			strconv.Itoa(size),
+		))
+	}
+}
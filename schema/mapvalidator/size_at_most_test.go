@@ -0,0 +1,144 @@
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeAtMostValidator(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_map": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"test_map_nested": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_map": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_list_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_block_map": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path        path.Path
+		configValue attr.Value
+		expected    schematest.ValidatorResponse
+	}{
+		"null": {
+			path:        path.Root("test_map"),
+			configValue: types.MapNull(types.StringType),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"unknown": {
+			path:        path.Root("test_map"),
+			configValue: types.MapUnknown(types.StringType),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"valid-1": {
+			path:        path.Root("test_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"valid-2": {
+			path:        path.Root("test_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a"), "b": types.StringValue("b")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"invalid-3": {
+			path:        path.Root("test_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a"), "b": types.StringValue("b"), "c": types.StringValue("c")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_map"),
+						"Invalid Attribute Value",
+						"Attribute test_map map must contain at most 2 elements, got: 3",
+					),
+				},
+			},
+		},
+		"nested-attribute-valid": {
+			path:        path.Root("test_map_nested").AtMapKey("key").AtName("test_nested_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"nested-attribute-invalid": {
+			path:        path.Root("test_map_nested").AtMapKey("key").AtName("test_nested_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a"), "b": types.StringValue("b"), "c": types.StringValue("c")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_map_nested").AtMapKey("key").AtName("test_nested_map"),
+						"Invalid Attribute Value",
+						`Attribute test_map_nested["key"].test_nested_map map must contain at most 2 elements, got: 3`,
+					),
+				},
+			},
+		},
+		"block-attribute-valid": {
+			path:        path.Root("test_list_block").AtListIndex(0).AtName("test_block_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"block-attribute-invalid": {
+			path:        path.Root("test_list_block").AtListIndex(0).AtName("test_block_map"),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("a"), "b": types.StringValue("b"), "c": types.StringValue("c")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_list_block").AtListIndex(0).AtName("test_block_map"),
+						"Invalid Attribute Value",
+						"Attribute test_list_block[0].test_block_map map must contain at most 2 elements, got: 3",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req, diags := schematest.NewAttributeRequest(ctx, testSchema, testCase.configValue, nil, nil, testCase.path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			schematest.AssertValidate(ctx, t, mapvalidator.SizeAtMost(2), req, testCase.expected)
+		})
+	}
+}
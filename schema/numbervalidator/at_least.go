@@ -0,0 +1,48 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = atLeastValidator{}
+
+// AtLeast returns a validator which ensures that a known number value is
+// greater than or equal to the given minimum. The bound must not be nil.
+func AtLeast(minimum *big.Float) validator.Number {
+	return atLeastValidator{
+		minimum: minimum,
+	}
+}
+
+// atLeastValidator implements the validator.
+type atLeastValidator struct {
+	minimum *big.Float
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", formatValue(v.minimum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation. Null and unknown values are skipped.
+func (v atLeastValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if compare(value, v.minimum) < 0 {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,64 @@
+package numbervalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtLeastValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Number{
+		numbervalidator.AtLeast(testNumber("123456789012345678901234567890")),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.NumberNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.NumberUnknown(),
+		},
+		"valid-123456789012345678901234567890": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("123456789012345678901234567890")),
+		},
+		"valid-123456789012345678901234567891": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("123456789012345678901234567891")),
+		},
+		"invalid-123456789012345678901234567889": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("123456789012345678901234567889")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be at least 123456789012345678901234567890, got: 123456789012345678901234567889",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("123456789012345678901234567890")),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("123456789012345678901234567889")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at least 123456789012345678901234567890, got: 123456789012345678901234567889",
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = atMostValidator{}
+
+// AtMost returns a validator which ensures that a known number value is less
+// than or equal to the given maximum. The bound must not be nil.
+func AtMost(maximum *big.Float) validator.Number {
+	return atMostValidator{
+		maximum: maximum,
+	}
+}
+
+// atMostValidator implements the validator.
+type atMostValidator struct {
+	maximum *big.Float
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", formatValue(v.maximum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation. Null and unknown values are skipped.
+func (v atMostValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if compare(value, v.maximum) > 0 {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,51 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = betweenValidator{}
+
+// Between returns a validator which ensures that a known number value is
+// greater than or equal to minimum and less than or equal to maximum. The
+// bounds must not be nil.
+func Between(minimum, maximum *big.Float) validator.Number {
+	return betweenValidator{
+		minimum: minimum,
+		maximum: maximum,
+	}
+}
+
+// betweenValidator implements the validator.
+type betweenValidator struct {
+	minimum *big.Float
+	maximum *big.Float
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", formatValue(v.minimum), formatValue(v.maximum))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation. Null and unknown values are skipped.
+func (v betweenValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if compare(value, v.minimum) < 0 || compare(value, v.maximum) > 0 {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
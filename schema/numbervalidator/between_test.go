@@ -0,0 +1,75 @@
+package numbervalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetweenValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Number{
+		numbervalidator.Between(testNumber("0.1"), testNumber("0.3")),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.NumberNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.NumberUnknown(),
+		},
+		"valid-0-1": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("0.1")),
+		},
+		"valid-0-3": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("0.3")),
+		},
+		"invalid-0-05": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("0.05")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be between 0.1 and 0.3, got: 0.05",
+				),
+			},
+		},
+		"invalid-0-30000000000000000001": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("0.30000000000000000001")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be between 0.1 and 0.3, got: 0.30000000000000000001",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("0.1")),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("0.05")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be between 0.1 and 0.3, got: 0.05",
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package numbervalidator
+
+import (
+	"math/big"
+)
+
+// compare returns -1, 0, or +1 depending on whether a is less than, equal
+// to, or greater than b, similar to big.Float.Cmp, except the values are
+// compared at the lower precision of the two values.
+//
+// Terraform configuration numbers have 512 bits of precision, while values
+// created from float64, such as big.NewFloat(0.1), have 53 bits. Comparing
+// those with big.Float.Cmp directly would find the configuration value 0.1
+// to be different from big.NewFloat(0.1), since neither value is exactly
+// 0.1 and each is rounded differently. Rounding the higher precision value
+// to the lower precision first avoids that float equality pitfall, while
+// values of the same precision, such as those created with
+// big.Float.SetString and the same precision, are compared exactly.
+func compare(a, b *big.Float) int {
+	prec := a.Prec()
+
+	if b.Prec() < prec {
+		prec = b.Prec()
+	}
+
+	// Zero values have no precision, so compare those exactly.
+	if prec == 0 || a.Prec() == b.Prec() {
+		return a.Cmp(b)
+	}
+
+	roundedA := new(big.Float).SetPrec(prec).Set(a)
+	roundedB := new(big.Float).SetPrec(prec).Set(b)
+
+	return roundedA.Cmp(roundedB)
+}
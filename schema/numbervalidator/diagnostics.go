@@ -0,0 +1,38 @@
+package numbervalidator
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// invalidValueDiagnostic returns an error diagnostic for the request value,
+// which is redacted if the attribute is sensitive.
+func invalidValueDiagnostic(ctx context.Context, req validator.NumberRequest, description string) diag.Diagnostic {
+	return validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		description,
+		validatordiag.AttributeValueString(ctx, req.Config, req.Path, formatValue(req.ConfigValue.ValueBigFloat())),
+	)
+}
+
+// formatValue returns the value as a decimal string.
+func formatValue(value *big.Float) string {
+	return value.Text('f', -1)
+}
+
+// formatValues returns the values as a list of decimal strings, such as
+// [1 2 3].
+func formatValues(values []*big.Float) string {
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, formatValue(value))
+	}
+
+	return "[" + strings.Join(result, " ") + "]"
+}
@@ -0,0 +1,12 @@
+// Package numbervalidator provides validators for types.Number attributes.
+//
+// Values and bounds are compared as arbitrary-precision *big.Float values,
+// so bounds beyond the range or precision of int64 and float64 work. Values
+// of different precisions are compared at the lower precision, so bounds
+// created from float64, such as big.NewFloat(0.1), match the equivalent
+// configuration value.
+//
+// Validators skip null and unknown values. Error diagnostics include the
+// configured bounds or values and the configuration value, unless the
+// attribute is Sensitive, in which case the value is redacted.
+package numbervalidator
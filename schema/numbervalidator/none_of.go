@@ -0,0 +1,49 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = noneOfValidator{}
+
+// NoneOf returns a validator which ensures that a known number value is not
+// equal to any of the given values. Values are compared the same as OneOf. The
+// values must not be nil.
+func NoneOf(values ...*big.Float) validator.Number {
+	return noneOfValidator{
+		values: values,
+	}
+}
+
+// noneOfValidator implements the validator.
+type noneOfValidator struct {
+	values []*big.Float
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation. Null and unknown values are skipped.
+func (v noneOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if containsValue(v.values, value) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,61 @@
+package numbervalidator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNoneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Number{
+		numbervalidator.NoneOf(big.NewFloat(0.1)),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.NumberNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.NumberUnknown(),
+		},
+		"valid-0-2": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("0.2")),
+		},
+		"invalid-different-precision": {
+			Path:        testPath,
+			ConfigValue: types.NumberValue(testNumber("0.1")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					"Attribute test_value value must be none of: [0.1], got: 0.1",
+				),
+			},
+		},
+		"nested-attribute-valid": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("0.2")),
+		},
+		"nested-attribute-invalid": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("0.1")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be none of: [0.1], got: 0.1",
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package numbervalidator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSchema contains number attributes at the root, nested inside a list of
+// objects, and with Sensitive enabled.
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"test_list_nested": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"test_value": schema.NumberAttribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"test_sensitive": schema.NumberAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"test_value": schema.NumberAttribute{
+			Optional: true,
+		},
+	},
+}
+
+var (
+	testPath          = path.Root("test_value")
+	testNestedPath    = path.Root("test_list_nested").AtListIndex(0).AtName("test_value")
+	testSensitivePath = path.Root("test_sensitive")
+)
+
+// testNumber returns the value parsed with the same precision as Terraform
+// configuration numbers.
+func testNumber(value string) *big.Float {
+	result, _, err := big.ParseFloat(value, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		panic("ParseFloat error: " + err.Error())
+	}
+
+	return result
+}
+
+func TestValidatorComposition(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.Number{
+		numbervalidator.AtLeast(testNumber("0.1")),
+		numbervalidator.AtMost(testNumber("123456789012345678901234567890")),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberNull(),
+		},
+		"unknown": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberUnknown(),
+		},
+		"valid-1": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("1")),
+		},
+		"invalid-0-05": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("0.05")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at least 0.1, got: 0.05",
+				),
+			},
+		},
+		"invalid-123456789012345678901234567891": {
+			Path:        testNestedPath,
+			ConfigValue: types.NumberValue(testNumber("123456789012345678901234567891")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNestedPath,
+					"Invalid Attribute Value",
+					"Attribute test_list_nested[0].test_value value must be at most 123456789012345678901234567890, got: 123456789012345678901234567891",
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.NumberValue(testNumber("0.05")),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					"Attribute test_sensitive value must be at least 0.1, got: (sensitive value)",
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = oneOfValidator{}
+
+// OneOf returns a validator which ensures that a known number value is equal
+// to one of the given values. Values are compared as big.Float values at the
+// lower precision of the two values, rather than with pointer or float64
+// equality, so values beyond the float64 range are compared exactly and
+// values created from float64, such as big.NewFloat(0.1), are equal to the
+// configuration value 0.1. The values must not be nil.
+func OneOf(values ...*big.Float) validator.Number {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	values []*big.Float
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation. Null and unknown values are skipped.
+func (v oneOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if !containsValue(v.values, value) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
+
+// containsValue returns true if the value is equal to any of the values.
+func containsValue(values []*big.Float, value *big.Float) bool {
+	for _, v := range values {
+		if compare(v, value) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,11 @@
+// Package schematest contains helpers for unit testing provider-defined
+// schema plan modifiers and validators, without manually creating
+// configuration, plan, and state data with terraform-plugin-go values.
+//
+// Use NewAttributeRequest to create the request data for an attribute of a
+// schema, including attributes under nested attributes and blocks. Then use
+// PlanModify or Validate to call the plan modifier or validator of any value
+// type, or AssertPlanModify or AssertValidate to also compare the response
+// against an expected response. RunValidatorTestCases runs table-driven
+// validator tests for attributes of a schema.
+package schematest
@@ -0,0 +1,560 @@
+package schematest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// PlanModifierResponse contains the response data of a plan modifier,
+// regardless of value type.
+type PlanModifierResponse struct {
+	// PlanValue is the planned new state for the attribute. Values of custom
+	// types are returned as the base type, such as types.String.
+	PlanValue attr.Value
+
+	// RequiresReplace indicates whether a change in the attribute requires
+	// replacement of the whole resource.
+	RequiresReplace bool
+
+	// Private is the private state resource data following the plan
+	// modification. Only compared by AssertPlanModify if not nil.
+	Private *privatestate.ProviderData
+
+	// Diagnostics report errors or warnings related to the plan
+	// modification.
+	Diagnostics diag.Diagnostics
+}
+
+// PlanModify calls the plan modifier with the request and returns the
+// response. The plan modifier must implement the plan modifier interface of
+// the attribute value type, such as planmodifier.String for types.String
+// values. Otherwise, an error diagnostic is returned.
+func PlanModify(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest) PlanModifierResponse {
+	resp := PlanModifierResponse{
+		PlanValue: req.PlanValue,
+		Private:   req.Private,
+	}
+
+	switch req.PlanValue.(type) {
+	case basetypes.BoolValuable:
+		planModifyBool(ctx, planModifier, req, &resp)
+	case basetypes.Float64Valuable:
+		planModifyFloat64(ctx, planModifier, req, &resp)
+	case basetypes.Int64Valuable:
+		planModifyInt64(ctx, planModifier, req, &resp)
+	case basetypes.ListValuable:
+		planModifyList(ctx, planModifier, req, &resp)
+	case basetypes.MapValuable:
+		planModifyMap(ctx, planModifier, req, &resp)
+	case basetypes.NumberValuable:
+		planModifyNumber(ctx, planModifier, req, &resp)
+	case basetypes.ObjectValuable:
+		planModifyObject(ctx, planModifier, req, &resp)
+	case basetypes.SetValuable:
+		planModifySet(ctx, planModifier, req, &resp)
+	case basetypes.StringValuable:
+		planModifyString(ctx, planModifier, req, &resp)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Plan Modifier Value Type",
+			fmt.Sprintf("The attribute value type %T is not supported for plan modification.", req.PlanValue),
+		)
+	}
+
+	return resp
+}
+
+// AssertPlanModify calls the plan modifier with the request, using
+// PlanModify, and reports any difference between the response and the
+// expected response as a test error. Diagnostics are compared regardless of
+// ordering.
+func AssertPlanModify(ctx context.Context, t testing.TB, planModifier planmodifier.Describer, req AttributeRequest, expected PlanModifierResponse) {
+	t.Helper()
+
+	got := PlanModify(ctx, planModifier, req)
+
+	if expected.Private == nil {
+		got.Private = nil
+	}
+
+	if diff := cmp.Diff(sortedResponseDiagnostics(got), sortedResponseDiagnostics(expected)); diff != "" {
+		t.Errorf("unexpected plan modifier response difference: %s", diff)
+	}
+}
+
+// sortedResponseDiagnostics returns the response with sorted diagnostics.
+func sortedResponseDiagnostics(resp PlanModifierResponse) PlanModifierResponse {
+	resp.Diagnostics = sortedDiagnostics(resp.Diagnostics)
+
+	return resp
+}
+
+// planModifyBool calls the planmodifier.Bool with the request.
+func planModifyBool(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Bool)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Bool"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.BoolRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.BoolValuable.ToBoolValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.BoolValuable.ToBoolValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.BoolValuable.ToBoolValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.BoolResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyBool(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyFloat64 calls the planmodifier.Float64 with the request.
+func planModifyFloat64(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Float64)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Float64"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.Float64Request{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.Float64Valuable.ToFloat64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.Float64Valuable.ToFloat64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.Float64Valuable.ToFloat64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.Float64Response{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyFloat64(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyInt64 calls the planmodifier.Int64 with the request.
+func planModifyInt64(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Int64)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Int64"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.Int64Request{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.Int64Valuable.ToInt64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.Int64Valuable.ToInt64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.Int64Valuable.ToInt64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.Int64Response{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyInt64(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyList calls the planmodifier.List with the request.
+func planModifyList(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.List)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.List"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.ListRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.ListValuable.ToListValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.ListValuable.ToListValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.ListValuable.ToListValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.ListResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyMap calls the planmodifier.Map with the request.
+func planModifyMap(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Map)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Map"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.MapRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.MapValuable.ToMapValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.MapValuable.ToMapValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.MapValuable.ToMapValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.MapResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyMap(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyNumber calls the planmodifier.Number with the request.
+func planModifyNumber(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Number)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Number"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.NumberRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.NumberValuable.ToNumberValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.NumberValuable.ToNumberValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.NumberValuable.ToNumberValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.NumberResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyNumber(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyObject calls the planmodifier.Object with the request.
+func planModifyObject(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Object)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Object"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.ObjectRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.ObjectValuable.ToObjectValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.ObjectValuable.ToObjectValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.ObjectValuable.ToObjectValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.ObjectResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifySet calls the planmodifier.Set with the request.
+func planModifySet(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.Set)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.Set"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.SetRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.SetValuable.ToSetValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.SetValuable.ToSetValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.SetValuable.ToSetValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.SetResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// planModifyString calls the planmodifier.String with the request.
+func planModifyString(ctx context.Context, planModifier planmodifier.Describer, req AttributeRequest, resp *PlanModifierResponse) {
+	typedPlanModifier, ok := planModifier.(planmodifier.String)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidPlanModifierDiag(req, planModifier, "planmodifier.String"))
+
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	planModifyReq := planmodifier.StringRequest{
+		Config:         req.Config,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		Private:        req.Private,
+		State:          req.State,
+	}
+
+	planModifyReq.ConfigValue, diags = toValue(ctx, req.Path, req.ConfigValue, basetypes.StringValuable.ToStringValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.PlanValue, diags = toValue(ctx, req.Path, req.PlanValue, basetypes.StringValuable.ToStringValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	planModifyReq.StateValue, diags = toValue(ctx, req.Path, req.StateValue, basetypes.StringValuable.ToStringValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planModifyResp := &planmodifier.StringResponse{
+		PlanValue: planModifyReq.PlanValue,
+		Private:   planModifyReq.Private,
+	}
+
+	typedPlanModifier.PlanModifyString(ctx, planModifyReq, planModifyResp)
+
+	resp.PlanValue = planModifyResp.PlanValue
+	resp.RequiresReplace = planModifyResp.RequiresReplace
+	resp.Private = planModifyResp.Private
+	resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+}
+
+// invalidPlanModifierDiag returns an error diagnostic for a plan modifier
+// which does not implement the plan modifier interface of the value type.
+func invalidPlanModifierDiag(req AttributeRequest, planModifier planmodifier.Describer, expectedInterface string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		req.Path,
+		"Invalid Plan Modifier",
+		fmt.Sprintf("The plan modifier %T must implement the %s interface for the attribute value type %T.", planModifier, expectedInterface, req.PlanValue),
+	)
+}
@@ -0,0 +1,137 @@
+package schematest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlanModify(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_int64": schema.Int64Attribute{
+				Optional: true,
+			},
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_string": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"test_string": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		configValue  attr.Value
+		stateValue   attr.Value
+		planValue    attr.Value
+		path         path.Path
+		planModifier planmodifier.Describer
+		expected     schematest.PlanModifierResponse
+	}{
+		"int64-requiresreplace": {
+			configValue:  types.Int64Value(2),
+			stateValue:   types.Int64Value(1),
+			planValue:    types.Int64Value(2),
+			path:         path.Root("test_int64"),
+			planModifier: int64planmodifier.RequiresReplace(),
+			expected: schematest.PlanModifierResponse{
+				PlanValue:       types.Int64Value(2),
+				RequiresReplace: true,
+			},
+		},
+		"string-usestateforunknown": {
+			configValue:  types.StringNull(),
+			stateValue:   types.StringValue("test-state"),
+			planValue:    types.StringUnknown(),
+			path:         path.Root("test_string"),
+			planModifier: stringplanmodifier.UseStateForUnknown(),
+			expected: schematest.PlanModifierResponse{
+				PlanValue: types.StringValue("test-state"),
+			},
+		},
+		"string-usestateforunknown-create": {
+			configValue:  types.StringNull(),
+			planValue:    types.StringUnknown(),
+			path:         path.Root("test_string"),
+			planModifier: stringplanmodifier.UseStateForUnknown(),
+			expected: schematest.PlanModifierResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"string-nested-usestateforunknown": {
+			configValue:  types.StringNull(),
+			stateValue:   types.StringValue("test-state"),
+			planValue:    types.StringUnknown(),
+			path:         path.Root("test_list_nested").AtListIndex(0).AtName("test_nested_string"),
+			planModifier: stringplanmodifier.UseStateForUnknown(),
+			expected: schematest.PlanModifierResponse{
+				PlanValue: types.StringValue("test-state"),
+			},
+		},
+		"invalid-plan-modifier": {
+			configValue:  types.StringNull(),
+			stateValue:   types.StringValue("test-state"),
+			planValue:    types.StringUnknown(),
+			path:         path.Root("test_string"),
+			planModifier: int64planmodifier.RequiresReplace(),
+			expected: schematest.PlanModifierResponse{
+				PlanValue: types.StringUnknown(),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_string"),
+						"Invalid Plan Modifier",
+						"The plan modifier int64planmodifier.requiresReplaceIfModifier must implement the planmodifier.String interface for the attribute value type basetypes.StringValue.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req, diags := schematest.NewAttributeRequest(ctx, testSchema, testCase.configValue, testCase.stateValue, testCase.planValue, testCase.path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			got := schematest.PlanModify(ctx, testCase.planModifier, req)
+
+			// Private state data is not modified by these plan modifiers.
+			got.Private = nil
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			schematest.AssertPlanModify(ctx, t, testCase.planModifier, req, testCase.expected)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package schematest
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// AttributeRequest contains the request data for an attribute, regardless of
+// value type. Use NewAttributeRequest to create an AttributeRequest.
+type AttributeRequest struct {
+	// Path contains the path of the attribute.
+	Path path.Path
+
+	// PathExpression contains the expression matching the exact path of the
+	// attribute.
+	PathExpression path.Expression
+
+	// Config contains the entire configuration.
+	Config tfsdk.Config
+
+	// ConfigValue contains the value of the attribute from the configuration.
+	ConfigValue attr.Value
+
+	// Plan contains the entire plan. Only used by plan modifiers.
+	Plan tfsdk.Plan
+
+	// PlanValue contains the value of the attribute from the plan. Only used
+	// by plan modifiers.
+	PlanValue attr.Value
+
+	// State contains the entire prior state. Only used by plan modifiers.
+	State tfsdk.State
+
+	// StateValue contains the value of the attribute from the prior state.
+	// Only used by plan modifiers.
+	StateValue attr.Value
+
+	// Private is provider-defined resource private state data. Only used by
+	// plan modifiers. Defaults to empty data.
+	//
+	// Use the SetKey method to set data before calling the plan modifier.
+	Private *privatestate.ProviderData
+}
+
+// NewAttributeRequest returns an AttributeRequest for the attribute at the
+// given path of the schema, such as a provider, data source, or resource
+// schema. Configuration, plan, and state data is created with the given value
+// at the path and null values otherwise.
+//
+// A nil value represents an entirely null configuration, plan, or state, such
+// as a nil stateValue for resource creation or a nil planValue for resource
+// destruction, and the request value is set to a null value of the attribute
+// type. Use a null value, such as types.StringNull(), to instead only set the
+// attribute to null.
+func NewAttributeRequest(ctx context.Context, schema fwschema.Schema, configValue, stateValue, planValue attr.Value, attributePath path.Path) (AttributeRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	req := AttributeRequest{
+		Path:           attributePath,
+		PathExpression: attributePath.Expression(),
+		Private:        privatestate.EmptyProviderData(ctx),
+	}
+
+	configRaw, configAttrValue, configDiags := newData(ctx, fwschemadata.DataDescriptionConfiguration, schema, attributePath, configValue)
+
+	diags.Append(configDiags...)
+
+	planRaw, planAttrValue, planDiags := newData(ctx, fwschemadata.DataDescriptionPlan, schema, attributePath, planValue)
+
+	diags.Append(planDiags...)
+
+	stateRaw, stateAttrValue, stateDiags := newData(ctx, fwschemadata.DataDescriptionState, schema, attributePath, stateValue)
+
+	diags.Append(stateDiags...)
+
+	if diags.HasError() {
+		return req, diags
+	}
+
+	req.Config = tfsdk.Config{
+		Raw:    configRaw,
+		Schema: schema,
+	}
+	req.ConfigValue = configAttrValue
+	req.Plan = tfsdk.Plan{
+		Raw:    planRaw,
+		Schema: schema,
+	}
+	req.PlanValue = planAttrValue
+	req.State = tfsdk.State{
+		Raw:    stateRaw,
+		Schema: schema,
+	}
+	req.StateValue = stateAttrValue
+
+	return req, diags
+}
+
+// SetOtherValues sets the value of another attribute in the entire
+// configuration, plan, and prior state data, such as a sibling attribute
+// which a plan modifier reads from the request Config, Plan, or State. Each
+// nil value leaves that data unchanged, which preserves an entirely null
+// prior state for resource creation or plan for resource destruction.
+//
+// The attribute path must not be the request path, as the request
+// ConfigValue, PlanValue, and StateValue are not updated.
+func (r *AttributeRequest) SetOtherValues(ctx context.Context, attributePath path.Path, configValue, stateValue, planValue attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if attributePath.Equal(r.Path) {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Path",
+			"SetOtherValues cannot set the value of the request attribute. Use NewAttributeRequest instead.",
+		)
+
+		return diags
+	}
+
+	if configValue != nil {
+		diags.Append(setOtherValue(ctx, fwschemadata.DataDescriptionConfiguration, r.Config.Schema, &r.Config.Raw, attributePath, configValue)...)
+	}
+
+	if stateValue != nil {
+		diags.Append(setOtherValue(ctx, fwschemadata.DataDescriptionState, r.State.Schema, &r.State.Raw, attributePath, stateValue)...)
+	}
+
+	if planValue != nil {
+		diags.Append(setOtherValue(ctx, fwschemadata.DataDescriptionPlan, r.Plan.Schema, &r.Plan.Raw, attributePath, planValue)...)
+	}
+
+	return diags
+}
+
+// setOtherValue sets the value at the path of the entire data, leaving the
+// data unchanged if there are errors.
+func setOtherValue(ctx context.Context, description fwschemadata.DataDescription, schema fwschema.Schema, raw *tftypes.Value, attributePath path.Path, value attr.Value) diag.Diagnostics {
+	data := &fwschemadata.Data{
+		Description:    description,
+		Schema:         schema,
+		TerraformValue: *raw,
+	}
+
+	diags := data.SetAtPath(ctx, attributePath, value)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	*raw = data.TerraformValue
+
+	return diags
+}
+
+// newData returns the entire data of the schema with the given value at the
+// path, and the value at the path with the schema type. If value is nil, the
+// entire data is null and the returned value is a null value of the
+// attribute type.
+func newData(ctx context.Context, description fwschemadata.DataDescription, schema fwschema.Schema, attributePath path.Path, value attr.Value) (tftypes.Value, attr.Value, diag.Diagnostics) {
+	data := &fwschemadata.Data{
+		Description:    description,
+		Schema:         schema,
+		TerraformValue: tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+
+	if value == nil {
+		attrType, diags := schema.TypeAtPath(ctx, attributePath)
+
+		if diags.HasError() {
+			return data.TerraformValue, nil, diags
+		}
+
+		nullValue, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+
+		if err != nil {
+			diags.AddAttributeError(
+				attributePath,
+				"Unable to Create Null Value",
+				"An unexpected error was encountered creating a null value for the attribute: "+err.Error(),
+			)
+		}
+
+		return data.TerraformValue, nullValue, diags
+	}
+
+	diags := data.SetAtPath(ctx, attributePath, value)
+
+	if diags.HasError() {
+		return data.TerraformValue, nil, diags
+	}
+
+	attrValue, valueDiags := data.ValueAtPath(ctx, attributePath)
+
+	diags.Append(valueDiags...)
+
+	return data.TerraformValue, attrValue, diags
+}
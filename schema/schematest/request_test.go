@@ -0,0 +1,278 @@
+package schematest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewAttributeRequest(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"test_string": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list_nested": tftypes.List{ElementType: testNestedObjectType},
+			"test_string":      tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		configValue attr.Value
+		stateValue  attr.Value
+		planValue   attr.Value
+		path        path.Path
+		expected    schematest.AttributeRequest
+		expectError bool
+	}{
+		"root": {
+			configValue: types.StringValue("test-config"),
+			stateValue:  types.StringValue("test-state"),
+			planValue:   types.StringUnknown(),
+			path:        path.Root("test_string"),
+			expected: schematest.AttributeRequest{
+				Path:           path.Root("test_string"),
+				PathExpression: path.MatchRoot("test_string"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, nil),
+						"test_string":      tftypes.NewValue(tftypes.String, "test-config"),
+					}),
+					Schema: testSchema,
+				},
+				ConfigValue: types.StringValue("test-config"),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, nil),
+						"test_string":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testSchema,
+				},
+				PlanValue: types.StringUnknown(),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, nil),
+						"test_string":      tftypes.NewValue(tftypes.String, "test-state"),
+					}),
+					Schema: testSchema,
+				},
+				StateValue: types.StringValue("test-state"),
+				Private:    privatestate.EmptyProviderData(context.Background()),
+			},
+		},
+		"nested": {
+			configValue: types.StringValue("test-config"),
+			planValue:   types.StringValue("test-config"),
+			path:        path.Root("test_list_nested").AtListIndex(0).AtName("test_nested_string"),
+			expected: schematest.AttributeRequest{
+				Path:           path.Root("test_list_nested").AtListIndex(0).AtName("test_nested_string"),
+				PathExpression: path.MatchRoot("test_list_nested").AtListIndex(0).AtName("test_nested_string"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+							tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+								"test_nested_string": tftypes.NewValue(tftypes.String, "test-config"),
+							}),
+						}),
+						"test_string": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ConfigValue: types.StringValue("test-config"),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+							tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+								"test_nested_string": tftypes.NewValue(tftypes.String, "test-config"),
+							}),
+						}),
+						"test_string": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				PlanValue: types.StringValue("test-config"),
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+				StateValue: types.StringNull(),
+				Private:    privatestate.EmptyProviderData(context.Background()),
+			},
+		},
+		"invalid-path": {
+			configValue: types.StringValue("test-config"),
+			path:        path.Root("test_missing"),
+			expected: schematest.AttributeRequest{
+				Path:           path.Root("test_missing"),
+				PathExpression: path.MatchRoot("test_missing"),
+				Private:        privatestate.EmptyProviderData(context.Background()),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schematest.NewAttributeRequest(context.Background(), testSchema, testCase.configValue, testCase.stateValue, testCase.planValue, testCase.path)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error to be %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected request difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeRequestSetOtherValues(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_other": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_string": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_other":  tftypes.String,
+			"test_string": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		stateValue       attr.Value
+		otherConfigValue attr.Value
+		otherStateValue  attr.Value
+		otherPlanValue   attr.Value
+		path             path.Path
+		expectedConfig   tftypes.Value
+		expectedState    tftypes.Value
+		expectedPlan     tftypes.Value
+		expectError      bool
+	}{
+		"values": {
+			stateValue:       types.StringValue("test-state"),
+			otherConfigValue: types.StringValue("other-config"),
+			otherStateValue:  types.StringValue("other-state"),
+			otherPlanValue:   types.StringValue("other-plan"),
+			path:             path.Root("test_other"),
+			expectedConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, "other-config"),
+				"test_string": tftypes.NewValue(tftypes.String, "test-config"),
+			}),
+			expectedState: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, "other-state"),
+				"test_string": tftypes.NewValue(tftypes.String, "test-state"),
+			}),
+			expectedPlan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, "other-plan"),
+				"test_string": tftypes.NewValue(tftypes.String, "test-plan"),
+			}),
+		},
+		"nil-values": {
+			otherConfigValue: types.StringValue("other-config"),
+			otherPlanValue:   types.StringValue("other-plan"),
+			path:             path.Root("test_other"),
+			expectedConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, "other-config"),
+				"test_string": tftypes.NewValue(tftypes.String, "test-config"),
+			}),
+			expectedState: tftypes.NewValue(testType, nil),
+			expectedPlan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, "other-plan"),
+				"test_string": tftypes.NewValue(tftypes.String, "test-plan"),
+			}),
+		},
+		"request-path": {
+			otherConfigValue: types.StringValue("other-config"),
+			path:             path.Root("test_string"),
+			expectedConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, nil),
+				"test_string": tftypes.NewValue(tftypes.String, "test-config"),
+			}),
+			expectedState: tftypes.NewValue(testType, nil),
+			expectedPlan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_other":  tftypes.NewValue(tftypes.String, nil),
+				"test_string": tftypes.NewValue(tftypes.String, "test-plan"),
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, diags := schematest.NewAttributeRequest(context.Background(), testSchema, types.StringValue("test-config"), testCase.stateValue, types.StringValue("test-plan"), path.Root("test_string"))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			diags = req.SetOtherValues(context.Background(), testCase.path, testCase.otherConfigValue, testCase.otherStateValue, testCase.otherPlanValue)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error to be %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+
+			if diff := cmp.Diff(req.Config.Raw, testCase.expectedConfig); diff != "" {
+				t.Errorf("unexpected config difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(req.State.Raw, testCase.expectedState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(req.Plan.Raw, testCase.expectedPlan); diff != "" {
+				t.Errorf("unexpected plan difference: %s", diff)
+			}
+		})
+	}
+}
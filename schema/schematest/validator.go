@@ -0,0 +1,410 @@
+package schematest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValidatorResponse contains the response data of a validator, regardless of
+// value type.
+type ValidatorResponse struct {
+	// Diagnostics report errors or warnings related to the validation.
+	Diagnostics diag.Diagnostics
+}
+
+// Validate calls the validator with the request and returns the response.
+// The validator must implement the validator interface of the attribute
+// value type, such as validator.String for types.String values. Otherwise,
+// an error diagnostic is returned.
+func Validate(ctx context.Context, v validator.Describer, req AttributeRequest) ValidatorResponse {
+	var resp ValidatorResponse
+
+	switch req.ConfigValue.(type) {
+	case basetypes.BoolValuable:
+		validateBool(ctx, v, req, &resp)
+	case basetypes.Float64Valuable:
+		validateFloat64(ctx, v, req, &resp)
+	case basetypes.Int64Valuable:
+		validateInt64(ctx, v, req, &resp)
+	case basetypes.ListValuable:
+		validateList(ctx, v, req, &resp)
+	case basetypes.MapValuable:
+		validateMap(ctx, v, req, &resp)
+	case basetypes.NumberValuable:
+		validateNumber(ctx, v, req, &resp)
+	case basetypes.ObjectValuable:
+		validateObject(ctx, v, req, &resp)
+	case basetypes.SetValuable:
+		validateSet(ctx, v, req, &resp)
+	case basetypes.StringValuable:
+		validateString(ctx, v, req, &resp)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Validator Value Type",
+			fmt.Sprintf("The attribute value type %T is not supported for validation.", req.ConfigValue),
+		)
+	}
+
+	return resp
+}
+
+// AssertValidate calls the validator with the request, using Validate, and
+// reports any difference between the response and the expected response as
+// a test error. Diagnostics are compared regardless of ordering.
+func AssertValidate(ctx context.Context, t testing.TB, v validator.Describer, req AttributeRequest, expected ValidatorResponse) {
+	t.Helper()
+
+	got := Validate(ctx, v, req)
+
+	if diff := cmp.Diff(sortedDiagnostics(got.Diagnostics), sortedDiagnostics(expected.Diagnostics)); diff != "" {
+		t.Errorf("unexpected validator response difference: %s", diff)
+	}
+}
+
+// ValidatorTestCase is a test case of RunValidatorTestCases.
+type ValidatorTestCase struct {
+	// Path is the path of the attribute in the schema.
+	Path path.Path
+
+	// ConfigValue is the configuration value of the attribute.
+	ConfigValue attr.Value
+
+	// Expected is the expected diagnostics of all validators, in order.
+	Expected diag.Diagnostics
+}
+
+// RunValidatorTestCases runs each test case as a parallel subtest. The
+// request is created for the attribute of the schema with NewAttributeRequest
+// and each validator is called in order with Validate. Any difference between
+// the diagnostics of all validators and the expected diagnostics is reported
+// as a test error. Diagnostics are compared in order, so the combined output
+// of multiple validators can be verified.
+func RunValidatorTestCases[V validator.Describer](t *testing.T, schema fwschema.Schema, validators []V, testCases map[string]ValidatorTestCase) {
+	t.Helper()
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req, diags := NewAttributeRequest(ctx, schema, testCase.ConfigValue, nil, nil, testCase.Path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			var got diag.Diagnostics
+
+			for _, v := range validators {
+				got.Append(Validate(ctx, v, req).Diagnostics...)
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+// validateBool calls the validator.Bool with the request.
+func validateBool(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Bool)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Bool"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.BoolValuable.ToBoolValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.BoolRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.BoolResponse{}
+
+	typedValidator.ValidateBool(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateFloat64 calls the validator.Float64 with the request.
+func validateFloat64(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Float64)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Float64"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.Float64Valuable.ToFloat64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.Float64Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.Float64Response{}
+
+	typedValidator.ValidateFloat64(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateInt64 calls the validator.Int64 with the request.
+func validateInt64(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Int64)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Int64"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.Int64Valuable.ToInt64Value)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.Int64Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.Int64Response{}
+
+	typedValidator.ValidateInt64(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateList calls the validator.List with the request.
+func validateList(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.List)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.List"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.ListValuable.ToListValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.ListRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.ListResponse{}
+
+	typedValidator.ValidateList(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateMap calls the validator.Map with the request.
+func validateMap(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Map)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Map"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.MapValuable.ToMapValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.MapRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.MapResponse{}
+
+	typedValidator.ValidateMap(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateNumber calls the validator.Number with the request.
+func validateNumber(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Number)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Number"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.NumberValuable.ToNumberValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.NumberRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.NumberResponse{}
+
+	typedValidator.ValidateNumber(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateObject calls the validator.Object with the request.
+func validateObject(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Object)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Object"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.ObjectValuable.ToObjectValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.ObjectRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.ObjectResponse{}
+
+	typedValidator.ValidateObject(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateSet calls the validator.Set with the request.
+func validateSet(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.Set)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.Set"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.SetValuable.ToSetValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.SetRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.SetResponse{}
+
+	typedValidator.ValidateSet(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// validateString calls the validator.String with the request.
+func validateString(ctx context.Context, v validator.Describer, req AttributeRequest, resp *ValidatorResponse) {
+	typedValidator, ok := v.(validator.String)
+
+	if !ok {
+		resp.Diagnostics.Append(invalidValidatorDiag(req, v, "validator.String"))
+
+		return
+	}
+
+	configValue, diags := toValue(ctx, req.Path, req.ConfigValue, basetypes.StringValuable.ToStringValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReq := validator.StringRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &validator.StringResponse{}
+
+	typedValidator.ValidateString(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// invalidValidatorDiag returns an error diagnostic for a validator which does
+// not implement the validator interface of the value type.
+func invalidValidatorDiag(req AttributeRequest, v validator.Describer, expectedInterface string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		req.Path,
+		"Invalid Validator",
+		fmt.Sprintf("The validator %T must implement the %s interface for the attribute value type %T.", v, expectedInterface, req.ConfigValue),
+	)
+}
@@ -0,0 +1,178 @@
+package schematest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_bool": schema.BoolAttribute{
+				Optional: true,
+			},
+			"test_single_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested_string": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testStringValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.ValueString() == "valid" {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeWarning(req.Path, "second summary", "second detail")
+			resp.Diagnostics.AddAttributeError(req.Path, "first summary", "first detail")
+		},
+	}
+
+	testCases := map[string]struct {
+		configValue attr.Value
+		path        path.Path
+		validator   validator.Describer
+		expected    schematest.ValidatorResponse
+	}{
+		"bool": {
+			configValue: types.BoolValue(true),
+			path:        path.Root("test_bool"),
+			validator: testvalidator.Bool{
+				ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+					if !req.ConfigValue.Equal(types.BoolValue(true)) {
+						resp.Diagnostics.AddError("unexpected req.ConfigValue", req.ConfigValue.String())
+					}
+				},
+			},
+			expected: schematest.ValidatorResponse{},
+		},
+		"string-nested-valid": {
+			configValue: types.StringValue("valid"),
+			path:        path.Root("test_single_nested").AtName("test_nested_string"),
+			validator:   testStringValidator,
+			expected:    schematest.ValidatorResponse{},
+		},
+		"string-nested-invalid": {
+			configValue: types.StringValue("invalid"),
+			path:        path.Root("test_single_nested").AtName("test_nested_string"),
+			validator:   testStringValidator,
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_single_nested").AtName("test_nested_string"),
+						"first summary",
+						"first detail",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test_single_nested").AtName("test_nested_string"),
+						"second summary",
+						"second detail",
+					),
+				},
+			},
+		},
+		"invalid-validator": {
+			configValue: types.BoolValue(true),
+			path:        path.Root("test_bool"),
+			validator:   testStringValidator,
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_bool"),
+						"Invalid Validator",
+						"The validator testvalidator.String must implement the validator.Bool interface for the attribute value type basetypes.BoolValue.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req, diags := schematest.NewAttributeRequest(ctx, testSchema, testCase.configValue, nil, nil, testCase.path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			schematest.AssertValidate(ctx, t, testCase.validator, req, testCase.expected)
+		})
+	}
+}
+
+func TestRunValidatorTestCases(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_single_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested_string": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testPath := path.Root("test_single_nested").AtName("test_nested_string")
+
+	validators := []validator.String{
+		testvalidator.String{
+			ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+				if req.ConfigValue.ValueString() == "invalid" {
+					resp.Diagnostics.AddAttributeError(req.Path, "first summary", "first detail")
+				}
+			},
+		},
+		testvalidator.String{
+			ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+				if req.ConfigValue.ValueString() == "invalid" {
+					resp.Diagnostics.AddAttributeWarning(req.Path, "second summary", "second detail")
+				}
+			},
+		},
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"valid": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("valid"),
+		},
+		"invalid": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("invalid"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(testPath, "first summary", "first detail"),
+				diag.NewAttributeWarningDiagnostic(testPath, "second summary", "second detail"),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package schematest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// toValue converts the attribute value into the framework value type using
+// the given Valuable interface method, such as
+// basetypes.StringValuable.ToStringValue.
+func toValue[V any, T any](ctx context.Context, attributePath path.Path, value attr.Value, to func(V, context.Context) (T, diag.Diagnostics)) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	valuable, ok := value.(V)
+
+	if !ok {
+		var zero T
+
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value Type",
+			fmt.Sprintf("Expected value type %T, got: %T", zero, value),
+		)
+
+		return zero, diags
+	}
+
+	return to(valuable, ctx)
+}
+
+// sortedDiagnostics returns a copy of the diagnostics sorted by severity,
+// summary, and detail, so diagnostics can be compared regardless of
+// ordering.
+func sortedDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	if diags == nil {
+		return nil
+	}
+
+	result := make(diag.Diagnostics, len(diags))
+
+	copy(result, diags)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Severity() != result[j].Severity() {
+			return result[i].Severity() < result[j].Severity()
+		}
+
+		if result[i].Summary() != result[j].Summary() {
+			return result[i].Summary() < result[j].Summary()
+		}
+
+		return result[i].Detail() < result[j].Detail()
+	})
+
+	return result
+}
@@ -0,0 +1,2 @@
+// Package setvalidator provides validators for types.Set attributes and blocks.
+package setvalidator
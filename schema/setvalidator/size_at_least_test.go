@@ -0,0 +1,158 @@
+package setvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeAtLeastValidator(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_set": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_set": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_set_block": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	blockObjectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"test_string": types.StringType,
+		},
+	}
+
+	blockObject := func(value string) attr.Value {
+		return types.ObjectValueMust(
+			blockObjectType.AttrTypes,
+			map[string]attr.Value{
+				"test_string": types.StringValue(value),
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		path        path.Path
+		configValue attr.Value
+		expected    schematest.ValidatorResponse
+	}{
+		"null": {
+			path:        path.Root("test_set"),
+			configValue: types.SetNull(types.StringType),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"unknown": {
+			path:        path.Root("test_set"),
+			configValue: types.SetUnknown(types.StringType),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"valid-2": {
+			path:        path.Root("test_set"),
+			configValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"valid-3": {
+			path:        path.Root("test_set"),
+			configValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b"), types.StringValue("c")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"invalid-1": {
+			path:        path.Root("test_set"),
+			configValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_set"),
+						"Invalid Attribute Value",
+						"Attribute test_set set must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+		"nested-attribute-valid": {
+			path:        path.Root("test_list_nested").AtListIndex(0).AtName("test_nested_set"),
+			configValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"nested-attribute-invalid": {
+			path:        path.Root("test_list_nested").AtListIndex(0).AtName("test_nested_set"),
+			configValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_list_nested").AtListIndex(0).AtName("test_nested_set"),
+						"Invalid Attribute Value",
+						"Attribute test_list_nested[0].test_nested_set set must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+		"block-valid": {
+			path:        path.Root("test_set_block"),
+			configValue: types.SetValueMust(blockObjectType, []attr.Value{blockObject("a"), blockObject("b")}),
+			expected:    schematest.ValidatorResponse{},
+		},
+		"block-invalid": {
+			path:        path.Root("test_set_block"),
+			configValue: types.SetValueMust(blockObjectType, []attr.Value{blockObject("a")}),
+			expected: schematest.ValidatorResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_set_block"),
+						"Invalid Attribute Value",
+						"Attribute test_set_block set must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req, diags := schematest.NewAttributeRequest(ctx, testSchema, testCase.configValue, nil, nil, testCase.path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating request: %v", diags)
+			}
+
+			schematest.AssertValidate(ctx, t, setvalidator.SizeAtLeast(2), req, testCase.expected)
+		})
+	}
+}
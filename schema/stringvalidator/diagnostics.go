@@ -0,0 +1,20 @@
+package stringvalidator
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// invalidValueDiagnostic returns an error diagnostic for the request value,
+// which is redacted if the attribute is sensitive.
+func invalidValueDiagnostic(ctx context.Context, req validator.StringRequest, description string) diag.Diagnostic {
+	return validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		description,
+		validatordiag.AttributeValueString(ctx, req.Config, req.Path, strconv.Quote(req.ConfigValue.ValueString())),
+	)
+}
@@ -0,0 +1,9 @@
+// Package stringvalidator provides validators for types.String attributes.
+//
+// Validators skip null and unknown values. Error diagnostics include the
+// attribute path and the configuration value, unless the attribute is
+// Sensitive, including Sensitive inherited from a parent nested attribute,
+// in which case the value is redacted. Set the attribute NonSensitive field,
+// where available, to include the value of an attribute under a Sensitive
+// nested attribute.
+package stringvalidator
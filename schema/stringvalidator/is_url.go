@@ -0,0 +1,44 @@
+package stringvalidator
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = isURLValidator{}
+
+// IsURL returns a validator which ensures that a known string value is an
+// absolute URL with a scheme and host, such as "https://example.com/path".
+func IsURL() validator.String {
+	return isURLValidator{}
+}
+
+// isURLValidator implements the validator.
+type isURLValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v isURLValidator) Description(_ context.Context) string {
+	return "value must be a URL with a scheme and host"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v isURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v isURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(req.ConfigValue.ValueString())
+
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,78 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsURLValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.IsURL()}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-https-example-com": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("https://example.com"),
+		},
+		"valid-http-example-com-8080-path-query-1": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("http://example.com:8080/path?query=1"),
+		},
+		"invalid-missing-scheme": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("example.com"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be a URL with a scheme and host, got: "example.com"`,
+				),
+			},
+		},
+		"invalid-relative-path": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("/path"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be a URL with a scheme and host, got: "/path"`,
+				),
+			},
+		},
+		"invalid-missing-host": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("https://"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be a URL with a scheme and host, got: "https://"`,
+				),
+			},
+		},
+		"invalid-empty-scheme": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("://example.com"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be a URL with a scheme and host, got: "://example.com"`,
+				),
+			},
+		},
+	})
+}
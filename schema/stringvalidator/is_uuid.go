@@ -0,0 +1,47 @@
+package stringvalidator
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = isUUIDValidator{}
+
+// uuidRegex matches the hexadecimal UUID format, such as
+// "123e4567-e89b-12d3-a456-426614174000", regardless of case.
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// IsUUID returns a validator which ensures that a known string value is a
+// UUID in the hexadecimal format, such as
+// "123e4567-e89b-12d3-a456-426614174000".
+func IsUUID() validator.String {
+	return isUUIDValidator{}
+}
+
+// isUUIDValidator implements the validator.
+type isUUIDValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v isUUIDValidator) Description(_ context.Context) string {
+	return "value must be a UUID"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v isUUIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v isUUIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !uuidRegex.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
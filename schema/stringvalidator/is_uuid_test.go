@@ -0,0 +1,78 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsUUIDValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.IsUUID()}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-lowercase": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("123e4567-e89b-12d3-a456-426614174000"),
+		},
+		"valid-uppercase": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("123E4567-E89B-12D3-A456-426614174000"),
+		},
+		"invalid-missing-hyphens": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("123e4567e89b12d3a456426614174000"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be a UUID, got: "123e4567e89b12d3a456426614174000"`,
+				),
+			},
+		},
+		"invalid-non-hexadecimal": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("123e4567-e89b-12d3-a456-42661417400z"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be a UUID, got: "123e4567-e89b-12d3-a456-42661417400z"`,
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.StringValue("123e4567e89b12d3a456426614174000"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_string value must be a UUID, got: (sensitive value)`,
+				),
+			},
+		},
+		"invalid-non-sensitive": {
+			Path:        testNonSensitivePath,
+			ConfigValue: types.StringValue("123e4567e89b12d3a456426614174000"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNonSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_non_sensitive_string value must be a UUID, got: "123e4567e89b12d3a456426614174000"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthAtLeastValidator{}
+
+// LengthAtLeast returns a validator which ensures that a known string value
+// contains at least the given number of characters.
+func LengthAtLeast(minLength int) validator.String {
+	return lengthAtLeastValidator{
+		minLength: minLength,
+	}
+}
+
+// lengthAtLeastValidator implements the validator.
+type lengthAtLeastValidator struct {
+	minLength int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v lengthAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be at least %d", v.minLength)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v lengthAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v lengthAtLeastValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if utf8.RuneCountInString(req.ConfigValue.ValueString()) < v.minLength {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
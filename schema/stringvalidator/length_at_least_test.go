@@ -0,0 +1,67 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLengthAtLeastValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.LengthAtLeast(3)}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-abc": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abc"),
+		},
+		"valid-multibyte": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("日本語"),
+		},
+		"invalid-ab": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ab"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string string length must be at least 3, got: "ab"`,
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.StringValue("ab"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_string string length must be at least 3, got: (sensitive value)`,
+				),
+			},
+		},
+		"invalid-non-sensitive": {
+			Path:        testNonSensitivePath,
+			ConfigValue: types.StringValue("ab"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNonSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_non_sensitive_string string length must be at least 3, got: "ab"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthAtMostValidator{}
+
+// LengthAtMost returns a validator which ensures that a known string value
+// contains at most the given number of characters.
+func LengthAtMost(maxLength int) validator.String {
+	return lengthAtMostValidator{
+		maxLength: maxLength,
+	}
+}
+
+// lengthAtMostValidator implements the validator.
+type lengthAtMostValidator struct {
+	maxLength int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v lengthAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be at most %d", v.maxLength)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v lengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v lengthAtMostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if utf8.RuneCountInString(req.ConfigValue.ValueString()) > v.maxLength {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
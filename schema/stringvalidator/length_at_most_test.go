@@ -0,0 +1,45 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLengthAtMostValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.LengthAtMost(3)}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-abc": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abc"),
+		},
+		"valid-multibyte": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("日本語"),
+		},
+		"invalid-abcd": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abcd"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string string length must be at most 3, got: "abcd"`,
+				),
+			},
+		},
+	})
+}
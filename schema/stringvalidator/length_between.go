@@ -0,0 +1,51 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthBetweenValidator{}
+
+// LengthBetween returns a validator which ensures that a known string value
+// contains at least minLength and at most maxLength characters.
+func LengthBetween(minLength, maxLength int) validator.String {
+	return lengthBetweenValidator{
+		minLength: minLength,
+		maxLength: maxLength,
+	}
+}
+
+// lengthBetweenValidator implements the validator.
+type lengthBetweenValidator struct {
+	minLength int
+	maxLength int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v lengthBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be at least %d and at most %d", v.minLength, v.maxLength)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v lengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v lengthBetweenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := utf8.RuneCountInString(req.ConfigValue.ValueString())
+
+	if length < v.minLength || length > v.maxLength {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,56 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLengthBetweenValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.LengthBetween(2, 3)}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-ab": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ab"),
+		},
+		"valid-abc": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abc"),
+		},
+		"invalid-a": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("a"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string string length must be at least 2 and at most 3, got: "a"`,
+				),
+			},
+		},
+		"invalid-abcd": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abcd"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string string length must be at least 2 and at most 3, got: "abcd"`,
+				),
+			},
+		},
+	})
+}
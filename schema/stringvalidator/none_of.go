@@ -0,0 +1,60 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = noneOfValidator{}
+
+// NoneOf returns a validator which ensures that a known string value is not
+// equal to any of the given values.
+func NoneOf(values ...string) validator.String {
+	return noneOfValidator{
+		values: values,
+	}
+}
+
+// NoneOfCaseInsensitive returns a validator which ensures that a known
+// string value is not equal to any of the given values, ignoring case.
+func NoneOfCaseInsensitive(values ...string) validator.String {
+	return noneOfValidator{
+		caseInsensitive: true,
+		values:          values,
+	}
+}
+
+// noneOfValidator implements the validator.
+type noneOfValidator struct {
+	caseInsensitive bool
+	values          []string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v noneOfValidator) Description(_ context.Context) string {
+	if v.caseInsensitive {
+		return fmt.Sprintf("value must be none of (case-insensitive): %s", quotedValues(v.values))
+	}
+
+	return fmt.Sprintf("value must be none of: %s", quotedValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v noneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if containsValue(v.values, req.ConfigValue.ValueString(), v.caseInsensitive) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
@@ -0,0 +1,108 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNoneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.NoneOf("one", "two")}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-different-case": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ONE"),
+		},
+		"valid-three": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("three"),
+		},
+		"invalid-one": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("one"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be none of: ["one" "two"], got: "one"`,
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.StringValue("one"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_string value must be none of: ["one" "two"], got: (sensitive value)`,
+				),
+			},
+		},
+		"invalid-non-sensitive": {
+			Path:        testNonSensitivePath,
+			ConfigValue: types.StringValue("one"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNonSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_non_sensitive_string value must be none of: ["one" "two"], got: "one"`,
+				),
+			},
+		},
+	})
+}
+
+func TestNoneOfCaseInsensitiveValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.NoneOfCaseInsensitive("one", "two")}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-three": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("three"),
+		},
+		"invalid-uppercase": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ONE"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be none of (case-insensitive): ["one" "two"], got: "ONE"`,
+				),
+			},
+		},
+		"invalid-two": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("two"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be none of (case-insensitive): ["one" "two"], got: "two"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = oneOfValidator{}
+
+// OneOf returns a validator which ensures that a known string value is equal
+// to one of the given values.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// OneOfCaseInsensitive returns a validator which ensures that a known string
+// value is equal to one of the given values, ignoring case.
+func OneOfCaseInsensitive(values ...string) validator.String {
+	return oneOfValidator{
+		caseInsensitive: true,
+		values:          values,
+	}
+}
+
+// oneOfValidator implements the validator.
+type oneOfValidator struct {
+	caseInsensitive bool
+	values          []string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v oneOfValidator) Description(_ context.Context) string {
+	if v.caseInsensitive {
+		return fmt.Sprintf("value must be one of (case-insensitive): %s", quotedValues(v.values))
+	}
+
+	return fmt.Sprintf("value must be one of: %s", quotedValues(v.values))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !containsValue(v.values, req.ConfigValue.ValueString(), v.caseInsensitive) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
+
+// containsValue returns true if the value is equal to any of the values.
+func containsValue(values []string, value string, caseInsensitive bool) bool {
+	for _, v := range values {
+		if v == value || (caseInsensitive && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// quotedValues returns the values as a list of quoted strings, such as
+// ["one" "two"].
+func quotedValues(values []string) string {
+	return fmt.Sprintf("%q", values)
+}
@@ -0,0 +1,116 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.OneOf("one", "two")}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-one": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("one"),
+		},
+		"valid-two": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("two"),
+		},
+		"invalid-different-case": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ONE"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be one of: ["one" "two"], got: "ONE"`,
+				),
+			},
+		},
+		"invalid-three": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("three"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be one of: ["one" "two"], got: "three"`,
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.StringValue("ONE"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_string value must be one of: ["one" "two"], got: (sensitive value)`,
+				),
+			},
+		},
+		"invalid-non-sensitive": {
+			Path:        testNonSensitivePath,
+			ConfigValue: types.StringValue("ONE"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testNonSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_non_sensitive_string value must be one of: ["one" "two"], got: "ONE"`,
+				),
+			},
+		},
+	})
+}
+
+func TestOneOfCaseInsensitiveValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.OneOfCaseInsensitive("one", "two")}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-one": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("one"),
+		},
+		"valid-uppercase": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ONE"),
+		},
+		"valid-mixed-case": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("Two"),
+		},
+		"invalid-three": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("three"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be one of (case-insensitive): ["one" "two"], got: "three"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexMatchesValidator{}
+
+// RegexMatches returns a validator which ensures that a known string value
+// matches the regular expression. The optional message replaces the default
+// description, such as "must contain only lowercase alphanumeric
+// characters".
+func RegexMatches(regex *regexp.Regexp, message string) validator.String {
+	return regexMatchesValidator{
+		message: message,
+		regex:   regex,
+	}
+}
+
+// regexMatchesValidator implements the validator.
+type regexMatchesValidator struct {
+	message string
+	regex   *regexp.Regexp
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v regexMatchesValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression '%s'", v.regex)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v regexMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation. Null and unknown values are
+// skipped.
+func (v regexMatchesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.regex.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.Append(invalidValueDiagnostic(ctx, req, v.Description(ctx)))
+	}
+}
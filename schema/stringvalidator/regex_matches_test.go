@@ -0,0 +1,72 @@
+package stringvalidator_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegexMatchesValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), "")}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-abc": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abc"),
+		},
+		"invalid-uppercase": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ABC"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must match regular expression '^[a-z]+$', got: "ABC"`,
+				),
+			},
+		},
+	})
+}
+
+func TestRegexMatchesMessageValidator(t *testing.T) {
+	t.Parallel()
+
+	schematest.RunValidatorTestCases(t, testSchema, []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), "value must contain only lowercase letters")}, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid-abc": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abc"),
+		},
+		"invalid-abc1": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("abc1"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must contain only lowercase letters, got: "abc1"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package stringvalidator_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schematest"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSchema contains string attributes nested inside maps of objects,
+// including under a Sensitive nested attribute.
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"test_map_nested": schema.MapNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"test_string": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"test_sensitive_map_nested": schema.MapNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"test_non_sensitive_string": schema.StringAttribute{
+						NonSensitive: true,
+						Optional:     true,
+					},
+					"test_string": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional:  true,
+			Sensitive: true,
+		},
+	},
+}
+
+var (
+	testPath             = path.Root("test_map_nested").AtMapKey("test-key").AtName("test_string")
+	testSensitivePath    = path.Root("test_sensitive_map_nested").AtMapKey("test-key").AtName("test_string")
+	testNonSensitivePath = path.Root("test_sensitive_map_nested").AtMapKey("test-key").AtName("test_non_sensitive_string")
+)
+
+func TestValidatorComposition(t *testing.T) {
+	t.Parallel()
+
+	validators := []validator.String{
+		stringvalidator.LengthBetween(3, 5),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), "value must contain only lowercase letters"),
+		stringvalidator.NoneOfCaseInsensitive("admin"),
+	}
+
+	schematest.RunValidatorTestCases(t, testSchema, validators, map[string]schematest.ValidatorTestCase{
+		"null": {
+			Path:        testPath,
+			ConfigValue: types.StringNull(),
+		},
+		"unknown": {
+			Path:        testPath,
+			ConfigValue: types.StringUnknown(),
+		},
+		"valid": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("test"),
+		},
+		"invalid-one": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("ADMIN"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must contain only lowercase letters, got: "ADMIN"`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must be none of (case-insensitive): ["admin"], got: "ADMIN"`,
+				),
+			},
+		},
+		"invalid-all": {
+			Path:        testPath,
+			ConfigValue: types.StringValue("Administrator"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string string length must be at least 3 and at most 5, got: "Administrator"`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					testPath,
+					"Invalid Attribute Value",
+					`Attribute test_map_nested["test-key"].test_string value must contain only lowercase letters, got: "Administrator"`,
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Path:        testSensitivePath,
+			ConfigValue: types.StringValue("Administrator"),
+			Expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_string string length must be at least 3 and at most 5, got: (sensitive value)`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					testSensitivePath,
+					"Invalid Attribute Value",
+					`Attribute test_sensitive_map_nested["test-key"].test_string value must contain only lowercase letters, got: (sensitive value)`,
+				),
+			},
+		},
+	})
+}
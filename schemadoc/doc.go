@@ -0,0 +1,11 @@
+// Package schemadoc implements machine-readable provider schema documentation
+// export functionality, which enables tooling such as registry documentation
+// generators to describe framework-specific schema behaviors.
+//
+// Unlike the schema information sent to Terraform, the exported Document
+// includes descriptions of attribute defaults, plan modifiers, and
+// validators, sourced from their Description and MarkdownDescription
+// methods. This allows generated documentation to automatically state
+// behaviors such as "forces replacement" when the associated plan modifier
+// describes it.
+package schemadoc
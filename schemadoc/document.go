@@ -0,0 +1,177 @@
+package schemadoc
+
+import (
+	"encoding/json"
+)
+
+// Document is the machine-readable documentation of a provider and all of
+// its data source and resource schemas. It is intended to be encoded as JSON.
+type Document struct {
+	// Provider is the provider schema documentation.
+	Provider *Schema `json:"provider,omitempty"`
+
+	// ProviderMeta is the provider meta schema documentation, if the
+	// provider implements provider.ProviderWithMetaSchema.
+	ProviderMeta *Schema `json:"provider_meta,omitempty"`
+
+	// DataSourceSchemas is the data source schema documentation, keyed by
+	// data source type name.
+	DataSourceSchemas map[string]*Schema `json:"data_source_schemas,omitempty"`
+
+	// ResourceSchemas is the resource schema documentation, keyed by
+	// resource type name.
+	ResourceSchemas map[string]*Schema `json:"resource_schemas,omitempty"`
+}
+
+// Schema is the documentation of a single provider, data source, or resource
+// schema.
+type Schema struct {
+	// Attributes is the documentation of the schema attributes, keyed by
+	// attribute name.
+	Attributes map[string]*Attribute `json:"attributes,omitempty"`
+
+	// Blocks is the documentation of the schema blocks, keyed by block name.
+	Blocks map[string]*Block `json:"blocks,omitempty"`
+
+	// ConfigValidators is the descriptions of the provider, data source, or
+	// resource ConfigValidators, if any.
+	ConfigValidators []Description `json:"config_validators,omitempty"`
+
+	// DeprecationMessage is the deprecation message of the schema, if any.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+
+	// Description is the plaintext description of the schema.
+	Description string `json:"description,omitempty"`
+
+	// MarkdownDescription is the Markdown description of the schema.
+	MarkdownDescription string `json:"markdown_description,omitempty"`
+
+	// Version is the schema version.
+	Version int64 `json:"version"`
+}
+
+// Attribute is the documentation of a single schema attribute.
+type Attribute struct {
+	// Computed is true if the provider can set the attribute value.
+	Computed bool `json:"computed,omitempty"`
+
+	// Default is the description of the attribute default value, if any.
+	Default *Description `json:"default,omitempty"`
+
+	// DeprecationMessage is the deprecation message of the attribute, if
+	// any.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+
+	// Description is the plaintext description of the attribute.
+	Description string `json:"description,omitempty"`
+
+	// DisplayHint is the display hint of the attribute, such as "json", if
+	// any. Tooling should use it to present attribute values readably.
+	DisplayHint string `json:"display_hint,omitempty"`
+
+	// KeyValidators is the descriptions of the map key validators of the
+	// attribute, if any.
+	KeyValidators []Description `json:"key_validators,omitempty"`
+
+	// MarkdownDescription is the Markdown description of the attribute.
+	MarkdownDescription string `json:"markdown_description,omitempty"`
+
+	// NestedType is the documentation of the nested attributes, if the
+	// attribute is a nested attribute.
+	NestedType *NestedAttributeObject `json:"nested_type,omitempty"`
+
+	// Optional is true if practitioners can configure the attribute.
+	Optional bool `json:"optional,omitempty"`
+
+	// PlanModifiers is the descriptions of the plan modifiers of the
+	// attribute, if any.
+	PlanModifiers []Description `json:"plan_modifiers,omitempty"`
+
+	// Required is true if practitioners must configure the attribute.
+	Required bool `json:"required,omitempty"`
+
+	// Sensitive is true if the attribute value is hidden in Terraform
+	// output.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Type is the Terraform type of the attribute, in the same JSON format
+	// as the Terraform CLI providers schema command, if the attribute is not
+	// a nested attribute.
+	Type json.RawMessage `json:"type,omitempty"`
+
+	// Validators is the descriptions of the validators of the attribute, if
+	// any.
+	Validators []Description `json:"validators,omitempty"`
+}
+
+// NestedAttributeObject is the documentation of the attributes underneath a
+// nested attribute.
+type NestedAttributeObject struct {
+	// Attributes is the documentation of the nested attributes, keyed by
+	// attribute name.
+	Attributes map[string]*Attribute `json:"attributes,omitempty"`
+
+	// NestingMode is the nesting mode of the nested attribute, such as list,
+	// map, set, or single.
+	NestingMode string `json:"nesting_mode"`
+
+	// PlanModifiers is the descriptions of the plan modifiers of each nested
+	// object, if any. This is omitted for single nesting mode, where the
+	// plan modifiers are documented on the attribute itself.
+	PlanModifiers []Description `json:"plan_modifiers,omitempty"`
+
+	// Validators is the descriptions of the validators of each nested
+	// object, if any. This is omitted for single nesting mode, where the
+	// validators are documented on the attribute itself.
+	Validators []Description `json:"validators,omitempty"`
+}
+
+// Block is the documentation of a single schema block.
+type Block struct {
+	// Attributes is the documentation of the block attributes, keyed by
+	// attribute name.
+	Attributes map[string]*Attribute `json:"attributes,omitempty"`
+
+	// Blocks is the documentation of the nested blocks, keyed by block name.
+	Blocks map[string]*Block `json:"blocks,omitempty"`
+
+	// DeprecationMessage is the deprecation message of the block, if any.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+
+	// Description is the plaintext description of the block.
+	Description string `json:"description,omitempty"`
+
+	// MarkdownDescription is the Markdown description of the block.
+	MarkdownDescription string `json:"markdown_description,omitempty"`
+
+	// NestingMode is the nesting mode of the block, such as list, set, or
+	// single.
+	NestingMode string `json:"nesting_mode"`
+
+	// ObjectPlanModifiers is the descriptions of the plan modifiers of each
+	// nested object, if any. This is omitted for single nesting mode, where
+	// the plan modifiers are documented on the block itself.
+	ObjectPlanModifiers []Description `json:"object_plan_modifiers,omitempty"`
+
+	// ObjectValidators is the descriptions of the validators of each nested
+	// object, if any. This is omitted for single nesting mode, where the
+	// validators are documented on the block itself.
+	ObjectValidators []Description `json:"object_validators,omitempty"`
+
+	// PlanModifiers is the descriptions of the plan modifiers of the block,
+	// if any.
+	PlanModifiers []Description `json:"plan_modifiers,omitempty"`
+
+	// Validators is the descriptions of the validators of the block, if any.
+	Validators []Description `json:"validators,omitempty"`
+}
+
+// Description is the documentation of a default value, plan modifier, or
+// validator, sourced from its Description and MarkdownDescription methods.
+type Description struct {
+	// Description is the plaintext description.
+	Description string `json:"description,omitempty"`
+
+	// MarkdownDescription is the Markdown description.
+	MarkdownDescription string `json:"markdown_description,omitempty"`
+}
@@ -0,0 +1,395 @@
+package schemadoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Export returns the machine-readable documentation of the given provider
+// and all of its data source and resource schemas. The provider is not
+// configured, similar to Terraform fetching the provider schema.
+func Export(ctx context.Context, p provider.Provider) (*Document, diag.Diagnostics) {
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	schemaResp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, schemaResp)
+
+	diags := schemaResp.Diagnostics
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	document := &Document{
+		Provider:     schemaDocument(ctx, schemaResp.Provider),
+		ProviderMeta: schemaDocument(ctx, schemaResp.ProviderMeta),
+	}
+
+	if providerWithConfigValidators, ok := p.(provider.ProviderWithConfigValidators); ok && document.Provider != nil {
+		document.Provider.ConfigValidators = descriptions(ctx, providerWithConfigValidators.ConfigValidators(ctx))
+	}
+
+	if len(schemaResp.DataSourceSchemas) > 0 {
+		document.DataSourceSchemas = make(map[string]*Schema, len(schemaResp.DataSourceSchemas))
+
+		for typeName, dataSourceSchema := range schemaResp.DataSourceSchemas {
+			document.DataSourceSchemas[typeName] = schemaDocument(ctx, dataSourceSchema)
+
+			dataSource, dataSourceDiags := server.DataSource(ctx, typeName)
+
+			diags.Append(dataSourceDiags...)
+
+			if dataSourceWithConfigValidators, ok := dataSource.(datasource.DataSourceWithConfigValidators); ok {
+				document.DataSourceSchemas[typeName].ConfigValidators = descriptions(ctx, dataSourceWithConfigValidators.ConfigValidators(ctx))
+			}
+		}
+	}
+
+	if len(schemaResp.ResourceSchemas) > 0 {
+		document.ResourceSchemas = make(map[string]*Schema, len(schemaResp.ResourceSchemas))
+
+		for typeName, resourceSchema := range schemaResp.ResourceSchemas {
+			document.ResourceSchemas[typeName] = schemaDocument(ctx, resourceSchema)
+
+			res, resourceDiags := server.Resource(ctx, typeName)
+
+			diags.Append(resourceDiags...)
+
+			if resourceWithConfigValidators, ok := res.(resource.ResourceWithConfigValidators); ok {
+				document.ResourceSchemas[typeName].ConfigValidators = descriptions(ctx, resourceWithConfigValidators.ConfigValidators(ctx))
+			}
+		}
+	}
+
+	return document, diags
+}
+
+// schemaDocument returns the Schema documentation for a fwschema.Schema.
+func schemaDocument(ctx context.Context, s fwschema.Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+
+	return &Schema{
+		Attributes:          attributeDocuments(ctx, s.GetAttributes()),
+		Blocks:              blockDocuments(ctx, s.GetBlocks()),
+		DeprecationMessage:  s.GetDeprecationMessage(),
+		Description:         s.GetDescription(),
+		MarkdownDescription: s.GetMarkdownDescription(),
+		Version:             s.GetVersion(),
+	}
+}
+
+// attributeDocuments returns the Attribute documentation for a map of
+// fwschema.Attribute.
+func attributeDocuments(ctx context.Context, attributes map[string]fwschema.Attribute) map[string]*Attribute {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		result[name] = attributeDocument(ctx, attribute)
+	}
+
+	return result
+}
+
+// attributeDocument returns the Attribute documentation for a
+// fwschema.Attribute.
+func attributeDocument(ctx context.Context, a fwschema.Attribute) *Attribute {
+	result := &Attribute{
+		Computed:            a.IsComputed(),
+		Default:             attributeDefault(ctx, a),
+		DeprecationMessage:  a.GetDeprecationMessage(),
+		Description:         a.GetDescription(),
+		MarkdownDescription: a.GetMarkdownDescription(),
+		Optional:            a.IsOptional(),
+		PlanModifiers:       attributePlanModifiers(ctx, a),
+		Required:            a.IsRequired(),
+		Sensitive:           a.IsSensitive(),
+		Validators:          attributeValidators(ctx, a),
+	}
+
+	if displayHint, ok := a.(fwschema.AttributeWithDisplayHint); ok {
+		result.DisplayHint = string(displayHint.GetDisplayHint())
+	}
+
+	if keyValidators, ok := a.(fwxschema.AttributeWithMapKeyValidators); ok {
+		result.KeyValidators = descriptions(ctx, keyValidators.MapKeyValidators())
+	}
+
+	if nestedAttribute, ok := a.(fwschema.NestedAttribute); ok {
+		nestedObject := nestedAttribute.GetNestedObject()
+
+		result.NestedType = &NestedAttributeObject{
+			Attributes:  attributeDocuments(ctx, nestedObject.GetAttributes()),
+			NestingMode: nestingMode(nestedAttribute.GetNestingMode()),
+		}
+
+		// Single nested attributes pass through their own plan modifiers and
+		// validators to the nested object, so they are already documented.
+		if nestedAttribute.GetNestingMode() == fwschema.NestingModeSingle {
+			return result
+		}
+
+		if o, ok := nestedObject.(fwxschema.NestedAttributeObjectWithPlanModifiers); ok {
+			result.NestedType.PlanModifiers = descriptions(ctx, o.ObjectPlanModifiers())
+		}
+
+		if o, ok := nestedObject.(fwxschema.NestedAttributeObjectWithValidators); ok {
+			result.NestedType.Validators = descriptions(ctx, o.ObjectValidators())
+		}
+
+		return result
+	}
+
+	// Marshalling a tftypes.Type should never return an error, however the
+	// Type is omitted if it does to prevent partial documentation output.
+	if typeJSON, err := json.Marshal(a.GetType().TerraformType(ctx)); err == nil {
+		result.Type = typeJSON
+	}
+
+	return result
+}
+
+// attributeDefault returns the Description of the fwschema.Attribute default
+// value, if any.
+func attributeDefault(ctx context.Context, a fwschema.Attribute) *Description {
+	var d describer
+
+	switch a := a.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		if v := a.BoolDefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithFloat64DefaultValue:
+		if v := a.Float64DefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithInt64DefaultValue:
+		if v := a.Int64DefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithListDefaultValue:
+		if v := a.ListDefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithMapDefaultValue:
+		if v := a.MapDefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithNumberDefaultValue:
+		if v := a.NumberDefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithObjectDefaultValue:
+		if v := a.ObjectDefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithSetDefaultValue:
+		if v := a.SetDefaultValue(); v != nil {
+			d = v
+		}
+	case fwschema.AttributeWithStringDefaultValue:
+		if v := a.StringDefaultValue(); v != nil {
+			d = v
+		}
+	}
+
+	if d == nil {
+		return nil
+	}
+
+	return &Description{
+		Description:         d.Description(ctx),
+		MarkdownDescription: d.MarkdownDescription(ctx),
+	}
+}
+
+// attributePlanModifiers returns the Description of each fwschema.Attribute
+// plan modifier, if any.
+func attributePlanModifiers(ctx context.Context, a fwschema.Attribute) []Description {
+	switch a := a.(type) {
+	case fwxschema.AttributeWithBoolPlanModifiers:
+		return descriptions(ctx, a.BoolPlanModifiers())
+	case fwxschema.AttributeWithFloat64PlanModifiers:
+		return descriptions(ctx, a.Float64PlanModifiers())
+	case fwxschema.AttributeWithInt64PlanModifiers:
+		return descriptions(ctx, a.Int64PlanModifiers())
+	case fwxschema.AttributeWithListPlanModifiers:
+		return descriptions(ctx, a.ListPlanModifiers())
+	case fwxschema.AttributeWithMapPlanModifiers:
+		return descriptions(ctx, a.MapPlanModifiers())
+	case fwxschema.AttributeWithNumberPlanModifiers:
+		return descriptions(ctx, a.NumberPlanModifiers())
+	case fwxschema.AttributeWithObjectPlanModifiers:
+		return descriptions(ctx, a.ObjectPlanModifiers())
+	case fwxschema.AttributeWithSetPlanModifiers:
+		return descriptions(ctx, a.SetPlanModifiers())
+	case fwxschema.AttributeWithStringPlanModifiers:
+		return descriptions(ctx, a.StringPlanModifiers())
+	default:
+		return nil
+	}
+}
+
+// attributeValidators returns the Description of each fwschema.Attribute
+// validator, if any.
+func attributeValidators(ctx context.Context, a fwschema.Attribute) []Description {
+	switch a := a.(type) {
+	case fwxschema.AttributeWithBoolValidators:
+		return descriptions(ctx, a.BoolValidators())
+	case fwxschema.AttributeWithFloat64Validators:
+		return descriptions(ctx, a.Float64Validators())
+	case fwxschema.AttributeWithInt64Validators:
+		return descriptions(ctx, a.Int64Validators())
+	case fwxschema.AttributeWithListValidators:
+		return descriptions(ctx, a.ListValidators())
+	case fwxschema.AttributeWithMapValidators:
+		return descriptions(ctx, a.MapValidators())
+	case fwxschema.AttributeWithNumberValidators:
+		return descriptions(ctx, a.NumberValidators())
+	case fwxschema.AttributeWithObjectValidators:
+		return descriptions(ctx, a.ObjectValidators())
+	case fwxschema.AttributeWithSetValidators:
+		return descriptions(ctx, a.SetValidators())
+	case fwxschema.AttributeWithStringValidators:
+		return descriptions(ctx, a.StringValidators())
+	default:
+		return nil
+	}
+}
+
+// blockDocuments returns the Block documentation for a map of
+// fwschema.Block.
+func blockDocuments(ctx context.Context, blocks map[string]fwschema.Block) map[string]*Block {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*Block, len(blocks))
+
+	for name, block := range blocks {
+		result[name] = blockDocument(ctx, block)
+	}
+
+	return result
+}
+
+// blockDocument returns the Block documentation for a fwschema.Block.
+func blockDocument(ctx context.Context, b fwschema.Block) *Block {
+	nestedObject := b.GetNestedObject()
+
+	result := &Block{
+		Attributes:          attributeDocuments(ctx, nestedObject.GetAttributes()),
+		Blocks:              blockDocuments(ctx, nestedObject.GetBlocks()),
+		DeprecationMessage:  b.GetDeprecationMessage(),
+		Description:         b.GetDescription(),
+		MarkdownDescription: b.GetMarkdownDescription(),
+		NestingMode:         blockNestingMode(b.GetNestingMode()),
+	}
+
+	switch b := b.(type) {
+	case fwxschema.BlockWithListPlanModifiers:
+		result.PlanModifiers = descriptions(ctx, b.ListPlanModifiers())
+	case fwxschema.BlockWithObjectPlanModifiers:
+		result.PlanModifiers = descriptions(ctx, b.ObjectPlanModifiers())
+	case fwxschema.BlockWithSetPlanModifiers:
+		result.PlanModifiers = descriptions(ctx, b.SetPlanModifiers())
+	}
+
+	switch b := b.(type) {
+	case fwxschema.BlockWithListValidators:
+		result.Validators = descriptions(ctx, b.ListValidators())
+	case fwxschema.BlockWithObjectValidators:
+		result.Validators = descriptions(ctx, b.ObjectValidators())
+	case fwxschema.BlockWithSetValidators:
+		result.Validators = descriptions(ctx, b.SetValidators())
+	}
+
+	// Single nested blocks pass through their own plan modifiers and
+	// validators to the nested object, so they are already documented.
+	if b.GetNestingMode() == fwschema.BlockNestingModeSingle {
+		return result
+	}
+
+	if o, ok := nestedObject.(fwxschema.NestedBlockObjectWithPlanModifiers); ok {
+		result.ObjectPlanModifiers = descriptions(ctx, o.ObjectPlanModifiers())
+	}
+
+	if o, ok := nestedObject.(fwxschema.NestedBlockObjectWithValidators); ok {
+		result.ObjectValidators = descriptions(ctx, o.ObjectValidators())
+	}
+
+	return result
+}
+
+// blockNestingMode returns the documentation string for a
+// fwschema.BlockNestingMode.
+func blockNestingMode(mode fwschema.BlockNestingMode) string {
+	switch mode {
+	case fwschema.BlockNestingModeList:
+		return "list"
+	case fwschema.BlockNestingModeSet:
+		return "set"
+	case fwschema.BlockNestingModeSingle:
+		return "single"
+	default:
+		return fmt.Sprintf("unknown (%d)", mode)
+	}
+}
+
+// nestingMode returns the documentation string for a fwschema.NestingMode.
+func nestingMode(mode fwschema.NestingMode) string {
+	switch mode {
+	case fwschema.NestingModeList:
+		return "list"
+	case fwschema.NestingModeMap:
+		return "map"
+	case fwschema.NestingModeSet:
+		return "set"
+	case fwschema.NestingModeSingle:
+		return "single"
+	default:
+		return fmt.Sprintf("unknown (%d)", mode)
+	}
+}
+
+// describer is the common documentation interface of defaults, plan
+// modifiers, and validators.
+type describer interface {
+	Description(context.Context) string
+	MarkdownDescription(context.Context) string
+}
+
+// descriptions returns the Description of each describer.
+func descriptions[T describer](ctx context.Context, describers []T) []Description {
+	if len(describers) == 0 {
+		return nil
+	}
+
+	result := make([]Description, 0, len(describers))
+
+	for _, d := range describers {
+		result = append(result, Description{
+			Description:         d.Description(ctx),
+			MarkdownDescription: d.MarkdownDescription(ctx),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,465 @@
+package schemadoc_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/displayhint"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schemadoc"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.String{
+		DescriptionMethod: func(_ context.Context) string {
+			return "value must be lowercase"
+		},
+		MarkdownDescriptionMethod: func(_ context.Context) string {
+			return "value must be `lowercase`"
+		},
+	}
+
+	testObjectPlanModifier := testplanmodifier.Object{
+		DescriptionMethod: func(_ context.Context) string {
+			return "test object plan modifier"
+		},
+		MarkdownDescriptionMethod: func(_ context.Context) string {
+			return "test `object` plan modifier"
+		},
+	}
+	testObjectPlanModifierDescription := schemadoc.Description{
+		Description:         "test object plan modifier",
+		MarkdownDescription: "test `object` plan modifier",
+	}
+	testObjectValidator := testvalidator.Object{
+		DescriptionMethod: func(_ context.Context) string {
+			return "test object validator"
+		},
+		MarkdownDescriptionMethod: func(_ context.Context) string {
+			return "test `object` validator"
+		},
+	}
+	testObjectValidatorDescription := schemadoc.Description{
+		Description:         "test object validator",
+		MarkdownDescription: "test `object` validator",
+	}
+
+	testCases := map[string]struct {
+		provider            provider.Provider
+		expected            *schemadoc.Document
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"provider": {
+			provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Schema = providerschema.Schema{
+						Attributes: map[string]providerschema.Attribute{
+							"test_attribute": providerschema.StringAttribute{
+								Description: "test description",
+								Optional:    true,
+								Sensitive:   true,
+							},
+						},
+					}
+				},
+			},
+			expected: &schemadoc.Document{
+				Provider: &schemadoc.Schema{
+					Attributes: map[string]*schemadoc.Attribute{
+						"test_attribute": {
+							Description: "test description",
+							Optional:    true,
+							Sensitive:   true,
+							Type:        json.RawMessage(`"string"`),
+						},
+					},
+				},
+			},
+		},
+		"datasource": {
+			provider: &testprovider.Provider{
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSource{
+								MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+									resp.TypeName = "test_data_source"
+								},
+								SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+									resp.Schema = datasourceschema.Schema{
+										Attributes: map[string]datasourceschema.Attribute{
+											"test_attribute": datasourceschema.StringAttribute{
+												Required:   true,
+												Validators: []validator.String{testValidator},
+											},
+											"test_json": datasourceschema.StringAttribute{
+												Computed:    true,
+												DisplayHint: displayhint.JSON,
+											},
+											"test_nested_attribute": datasourceschema.ListNestedAttribute{
+												Computed: true,
+												NestedObject: datasourceschema.NestedAttributeObject{
+													Attributes: map[string]datasourceschema.Attribute{
+														"test_list": datasourceschema.ListAttribute{
+															Computed:    true,
+															ElementType: types.StringType,
+														},
+													},
+												},
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			expected: &schemadoc.Document{
+				Provider: &schemadoc.Schema{},
+				DataSourceSchemas: map[string]*schemadoc.Schema{
+					"test_data_source": {
+						Attributes: map[string]*schemadoc.Attribute{
+							"test_attribute": {
+								Required: true,
+								Type:     json.RawMessage(`"string"`),
+								Validators: []schemadoc.Description{
+									{
+										Description:         "value must be lowercase",
+										MarkdownDescription: "value must be `lowercase`",
+									},
+								},
+							},
+							"test_json": {
+								Computed:    true,
+								DisplayHint: "json",
+								Type:        json.RawMessage(`"string"`),
+							},
+							"test_nested_attribute": {
+								Computed: true,
+								NestedType: &schemadoc.NestedAttributeObject{
+									Attributes: map[string]*schemadoc.Attribute{
+										"test_list": {
+											Computed: true,
+											Type:     json.RawMessage(`["list","string"]`),
+										},
+									},
+									NestingMode: "list",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = resourceschema.Schema{
+										Attributes: map[string]resourceschema.Attribute{
+											"test_attribute": resourceschema.StringAttribute{
+												Computed:           true,
+												Default:            stringdefault.StaticString("test-default"),
+												DeprecationMessage: "test deprecation",
+												Optional:           true,
+												PlanModifiers: []planmodifier.String{
+													stringplanmodifier.RequiresReplace(),
+												},
+											},
+										},
+										Blocks: map[string]resourceschema.Block{
+											"test_block": resourceschema.SetNestedBlock{
+												MarkdownDescription: "test `block`",
+												NestedObject: resourceschema.NestedBlockObject{
+													Attributes: map[string]resourceschema.Attribute{
+														"test_block_attribute": resourceschema.StringAttribute{
+															Optional: true,
+														},
+													},
+												},
+											},
+										},
+										Version: 1,
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			expected: &schemadoc.Document{
+				Provider: &schemadoc.Schema{},
+				ResourceSchemas: map[string]*schemadoc.Schema{
+					"test_resource": {
+						Attributes: map[string]*schemadoc.Attribute{
+							"test_attribute": {
+								Computed: true,
+								Default: &schemadoc.Description{
+									Description:         "value defaults to test-default",
+									MarkdownDescription: "value defaults to `test-default`",
+								},
+								DeprecationMessage: "test deprecation",
+								Optional:           true,
+								PlanModifiers: []schemadoc.Description{
+									{
+										Description:         "If the value of this attribute changes, Terraform will destroy and recreate the resource.",
+										MarkdownDescription: "If the value of this attribute changes, Terraform will destroy and recreate the resource.",
+									},
+								},
+								Type: json.RawMessage(`"string"`),
+							},
+						},
+						Blocks: map[string]*schemadoc.Block{
+							"test_block": {
+								Attributes: map[string]*schemadoc.Attribute{
+									"test_block_attribute": {
+										Optional: true,
+										Type:     json.RawMessage(`"string"`),
+									},
+								},
+								MarkdownDescription: "test `block`",
+								NestingMode:         "set",
+							},
+						},
+						Version: 1,
+					},
+				},
+			},
+		},
+		"provider-config-validators": {
+			provider: &testprovider.ProviderWithConfigValidators{
+				Provider: &testprovider.Provider{},
+				ConfigValidatorsMethod: func(_ context.Context) []provider.ConfigValidator {
+					return []provider.ConfigValidator{
+						&testprovider.ProviderConfigValidator{
+							DescriptionMethod: func(_ context.Context) string {
+								return "test config validator"
+							},
+							MarkdownDescriptionMethod: func(_ context.Context) string {
+								return "test `config` validator"
+							},
+						},
+					}
+				},
+			},
+			expected: &schemadoc.Document{
+				Provider: &schemadoc.Schema{
+					ConfigValidators: []schemadoc.Description{
+						{
+							Description:         "test config validator",
+							MarkdownDescription: "test `config` validator",
+						},
+					},
+				},
+			},
+		},
+		"resource-nested-object-validators": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.ResourceWithConfigValidators{
+								Resource: &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test_nested_attribute": resourceschema.ListNestedAttribute{
+													NestedObject: resourceschema.NestedAttributeObject{
+														Attributes: map[string]resourceschema.Attribute{
+															"test_attribute": resourceschema.StringAttribute{
+																Optional: true,
+															},
+														},
+														PlanModifiers: []planmodifier.Object{testObjectPlanModifier},
+														Validators:    []validator.Object{testObjectValidator},
+													},
+													Optional: true,
+												},
+												"test_single_nested_attribute": resourceschema.SingleNestedAttribute{
+													Attributes: map[string]resourceschema.Attribute{
+														"test_attribute": resourceschema.StringAttribute{
+															Optional: true,
+														},
+													},
+													Optional:      true,
+													PlanModifiers: []planmodifier.Object{testObjectPlanModifier},
+													Validators:    []validator.Object{testObjectValidator},
+												},
+											},
+											Blocks: map[string]resourceschema.Block{
+												"test_list_block": resourceschema.ListNestedBlock{
+													NestedObject: resourceschema.NestedBlockObject{
+														Attributes: map[string]resourceschema.Attribute{
+															"test_attribute": resourceschema.StringAttribute{
+																Optional: true,
+															},
+														},
+														PlanModifiers: []planmodifier.Object{testObjectPlanModifier},
+														Validators:    []validator.Object{testObjectValidator},
+													},
+												},
+												"test_single_block": resourceschema.SingleNestedBlock{
+													Attributes: map[string]resourceschema.Attribute{
+														"test_attribute": resourceschema.StringAttribute{
+															Optional: true,
+														},
+													},
+													PlanModifiers: []planmodifier.Object{testObjectPlanModifier},
+													Validators:    []validator.Object{testObjectValidator},
+												},
+											},
+										}
+									},
+								},
+								ConfigValidatorsMethod: func(_ context.Context) []resource.ConfigValidator {
+									return []resource.ConfigValidator{
+										&testprovider.ResourceConfigValidator{
+											DescriptionMethod: func(_ context.Context) string {
+												return "test config validator"
+											},
+											MarkdownDescriptionMethod: func(_ context.Context) string {
+												return "test `config` validator"
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			expected: &schemadoc.Document{
+				Provider: &schemadoc.Schema{},
+				ResourceSchemas: map[string]*schemadoc.Schema{
+					"test_resource": {
+						Attributes: map[string]*schemadoc.Attribute{
+							"test_nested_attribute": {
+								NestedType: &schemadoc.NestedAttributeObject{
+									Attributes: map[string]*schemadoc.Attribute{
+										"test_attribute": {
+											Optional: true,
+											Type:     json.RawMessage(`"string"`),
+										},
+									},
+									NestingMode:   "list",
+									PlanModifiers: []schemadoc.Description{testObjectPlanModifierDescription},
+									Validators:    []schemadoc.Description{testObjectValidatorDescription},
+								},
+								Optional: true,
+							},
+							"test_single_nested_attribute": {
+								NestedType: &schemadoc.NestedAttributeObject{
+									Attributes: map[string]*schemadoc.Attribute{
+										"test_attribute": {
+											Optional: true,
+											Type:     json.RawMessage(`"string"`),
+										},
+									},
+									NestingMode: "single",
+								},
+								Optional:      true,
+								PlanModifiers: []schemadoc.Description{testObjectPlanModifierDescription},
+								Validators:    []schemadoc.Description{testObjectValidatorDescription},
+							},
+						},
+						Blocks: map[string]*schemadoc.Block{
+							"test_list_block": {
+								Attributes: map[string]*schemadoc.Attribute{
+									"test_attribute": {
+										Optional: true,
+										Type:     json.RawMessage(`"string"`),
+									},
+								},
+								NestingMode:         "list",
+								ObjectPlanModifiers: []schemadoc.Description{testObjectPlanModifierDescription},
+								ObjectValidators:    []schemadoc.Description{testObjectValidatorDescription},
+							},
+							"test_single_block": {
+								Attributes: map[string]*schemadoc.Attribute{
+									"test_attribute": {
+										Optional: true,
+										Type:     json.RawMessage(`"string"`),
+									},
+								},
+								NestingMode:   "single",
+								PlanModifiers: []schemadoc.Description{testObjectPlanModifierDescription},
+								Validators:    []schemadoc.Description{testObjectValidatorDescription},
+							},
+						},
+						ConfigValidators: []schemadoc.Description{
+							{
+								Description:         "test config validator",
+								MarkdownDescription: "test `config` validator",
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-type-name-missing": {
+			provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{}
+						},
+					}
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Type Name Missing",
+					"The *testprovider.Resource Resource returned an empty string from the Metadata method. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schemadoc.Export(context.Background(), testCase.provider)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package sdkv2compat implements runtime shims for terraform-plugin-sdk/v2
+// idioms, such as d.Get("attr") map-style access, backed by framework state
+// and plan data. This enables very large providers to port resources
+// mechanically first, then idiomatically later.
+//
+// A ResourceData is created at the start of each resource CRUD method from
+// the method request and response, such as:
+//
+//	func (r *ThingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+//		d := sdkv2compat.NewCreateResourceData(ctx, req, resp)
+//
+//		name := d.Get("name").(string)
+//		// ... create the remote object ...
+//		d.SetId(id)
+//
+//		resp.Diagnostics.Append(d.Diagnostics()...)
+//	}
+//
+// Deprecated: This package is only intended as a temporary migration aid.
+// Resources should be updated to use the tfsdk.Plan and tfsdk.State type
+// methods, such as Get and SetAttribute, with framework types, then stop
+// using this package. It may be removed in a future major version.
+package sdkv2compat
@@ -0,0 +1,412 @@
+package sdkv2compat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ResourceData provides terraform-plugin-sdk/v2 schema.ResourceData-like
+// access to framework resource data.
+//
+// Keys use the terraform-plugin-sdk/v2 address syntax, where each part is
+// separated by a period. List and set elements are addressed by a zero-based
+// index, such as "rule.0.name", map elements by key, such as "tags.env", and
+// the number of collection elements with a "#" or "%" suffix, such as
+// "rule.#". Set element indexes are the position of the element in the
+// current value.
+//
+// Values are returned with terraform-plugin-sdk/v2 Go types: string, int,
+// float64, bool, []interface{} for lists and sets, and
+// map[string]interface{} for maps and objects. Null and unknown values are
+// returned as the zero value of the Go type.
+//
+// Errors are accumulated and must be added to the response diagnostics via
+// the Diagnostics method.
+//
+// Deprecated: This type is only intended as a temporary migration aid. Use
+// the tfsdk.Plan and tfsdk.State type methods instead.
+type ResourceData struct {
+	// ctx is saved since terraform-plugin-sdk/v2 methods do not accept a
+	// context.Context.
+	ctx context.Context
+
+	diags diag.Diagnostics
+
+	// prior is the prior state, or nil during create.
+	prior *tfsdk.State
+
+	// state is the response state, which is read and written.
+	state *tfsdk.State
+}
+
+// NewCreateResourceData returns a ResourceData for a resource Create method.
+// The response state is populated with the plan, so values are read from the
+// plan and written to the response state.
+//
+// Deprecated: This function is only intended as a temporary migration aid.
+func NewCreateResourceData(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) *ResourceData {
+	resp.State.Raw = req.Plan.Raw.Copy()
+
+	return &ResourceData{
+		ctx:   ctx,
+		state: &resp.State,
+	}
+}
+
+// NewReadResourceData returns a ResourceData for a resource Read method.
+// Values are read from and written to the response state, which is
+// populated with the prior state.
+//
+// Deprecated: This function is only intended as a temporary migration aid.
+func NewReadResourceData(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) *ResourceData {
+	return &ResourceData{
+		ctx:   ctx,
+		prior: &req.State,
+		state: &resp.State,
+	}
+}
+
+// NewUpdateResourceData returns a ResourceData for a resource Update method.
+// The response state is populated with the plan, so values are read from the
+// plan and written to the response state. The prior state is used for the
+// GetChange and HasChange methods.
+//
+// Deprecated: This function is only intended as a temporary migration aid.
+func NewUpdateResourceData(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) *ResourceData {
+	resp.State.Raw = req.Plan.Raw.Copy()
+
+	return &ResourceData{
+		ctx:   ctx,
+		prior: &req.State,
+		state: &resp.State,
+	}
+}
+
+// NewDeleteResourceData returns a ResourceData for a resource Delete method.
+// Values are read from the prior state.
+//
+// Deprecated: This function is only intended as a temporary migration aid.
+func NewDeleteResourceData(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) *ResourceData {
+	resp.State.Raw = req.State.Raw.Copy()
+
+	return &ResourceData{
+		ctx:   ctx,
+		prior: &req.State,
+		state: &resp.State,
+	}
+}
+
+// Diagnostics returns any diagnostics from previous method calls.
+func (d *ResourceData) Diagnostics() diag.Diagnostics {
+	return d.diags
+}
+
+// Get returns the value for the given key. If the key is invalid, nil is
+// returned and an error diagnostic is saved.
+func (d *ResourceData) Get(key string) interface{} {
+	value, _ := d.GetOk(key)
+
+	return value
+}
+
+// GetChange returns the prior state and current values for the given key.
+// During create, the prior value is the zero value.
+func (d *ResourceData) GetChange(key string) (interface{}, interface{}) {
+	current, currentDiags := get(d.ctx, d.state, key)
+
+	d.diags.Append(currentDiags...)
+
+	if d.prior == nil {
+		return zeroValue(d.ctx, d.typeAtKey(key)), current
+	}
+
+	prior, priorDiags := get(d.ctx, d.prior, key)
+
+	d.diags.Append(priorDiags...)
+
+	return prior, current
+}
+
+// GetOk returns the value for the given key and whether it is set to a
+// non-zero value.
+func (d *ResourceData) GetOk(key string) (interface{}, bool) {
+	value, diags := get(d.ctx, d.state, key)
+
+	d.diags.Append(diags...)
+
+	if value == nil {
+		return nil, false
+	}
+
+	return value, !reflect.ValueOf(value).IsZero() && !isEmptyCollection(value)
+}
+
+// HasChange returns true if the prior state and current values for the given
+// key are different.
+func (d *ResourceData) HasChange(key string) bool {
+	prior, current := d.GetChange(key)
+
+	return !reflect.DeepEqual(prior, current)
+}
+
+// HasChanges returns true if any of the given keys have changed.
+func (d *ResourceData) HasChanges(keys ...string) bool {
+	for _, key := range keys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Id returns the value of the "id" attribute.
+func (d *ResourceData) Id() string {
+	id, ok := d.Get("id").(string)
+
+	if !ok {
+		return ""
+	}
+
+	return id
+}
+
+// IsNewResource returns true during create.
+func (d *ResourceData) IsNewResource() bool {
+	return d.prior == nil
+}
+
+// Set saves the given terraform-plugin-sdk/v2 Go value for the given key into
+// the response state. Any returned error is also saved as an error
+// diagnostic.
+func (d *ResourceData) Set(key string, value interface{}) error {
+	diags := set(d.ctx, d.state, key, value)
+
+	d.diags.Append(diags...)
+
+	return diagsError(diags)
+}
+
+// SetId saves the value of the "id" attribute. An empty value removes the
+// resource from the response state, which during read signals to Terraform
+// that the resource no longer exists.
+func (d *ResourceData) SetId(id string) {
+	if id == "" {
+		d.state.RemoveResource(d.ctx)
+
+		return
+	}
+
+	_ = d.Set("id", id)
+}
+
+// typeAtKey returns the framework type for the given key, ignoring errors.
+func (d *ResourceData) typeAtKey(key string) attr.Type {
+	p, count, diags := keyPath(d.ctx, d.state, key)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	if count {
+		return basetypes.Int64Type{}
+	}
+
+	typ, _ := d.state.Schema.TypeAtPath(d.ctx, p)
+
+	return typ
+}
+
+// get returns the terraform-plugin-sdk/v2 Go value for the key.
+func get(ctx context.Context, state *tfsdk.State, key string) (interface{}, diag.Diagnostics) {
+	p, count, diags := keyPath(ctx, state, key)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var value attr.Value
+
+	diags.Append(state.GetAttribute(ctx, p, &value)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if count {
+		return elementCount(ctx, value), diags
+	}
+
+	result, valueDiags := sdkValue(ctx, value)
+
+	diags.Append(valueDiags...)
+
+	return result, diags
+}
+
+// set saves the terraform-plugin-sdk/v2 Go value for the key.
+func set(ctx context.Context, state *tfsdk.State, key string, value interface{}) diag.Diagnostics {
+	p, count, diags := keyPath(ctx, state, key)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if count {
+		diags.AddError(
+			"Invalid ResourceData Key",
+			fmt.Sprintf("The key %q refers to a collection element count, which cannot be set.", key),
+		)
+
+		return diags
+	}
+
+	typ, typDiags := state.Schema.TypeAtPath(ctx, p)
+
+	diags.Append(typDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attrValue, valueDiags := frameworkValue(ctx, typ, value)
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, p, attrValue)...)
+
+	return diags
+}
+
+// keyPath converts a terraform-plugin-sdk/v2 key into a path. The returned
+// boolean is true if the key ends with a collection element count suffix, in
+// which case the path is the collection.
+func keyPath(ctx context.Context, state *tfsdk.State, key string) (path.Path, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parts := strings.Split(key, ".")
+	p := path.Root(parts[0])
+
+	for i, part := range parts[1:] {
+		typ, typDiags := state.Schema.TypeAtPath(ctx, p)
+
+		diags.Append(typDiags...)
+
+		if diags.HasError() {
+			return p, false, diags
+		}
+
+		isLast := i == len(parts)-2
+
+		switch typ.TerraformType(ctx).(type) {
+		case tftypes.List, tftypes.Set, tftypes.Map:
+			if part == "#" || part == "%" {
+				if !isLast {
+					diags.AddError(
+						"Invalid ResourceData Key",
+						fmt.Sprintf("The key %q has an element count suffix which is not the last part.", key),
+					)
+
+					return p, false, diags
+				}
+
+				return p, true, diags
+			}
+		}
+
+		switch typ.TerraformType(ctx).(type) {
+		case tftypes.List:
+			index, err := strconv.Atoi(part)
+
+			if err != nil {
+				diags.AddError(
+					"Invalid ResourceData Key",
+					fmt.Sprintf("The key %q has a list element part %q which is not a valid index: %s", key, part, err),
+				)
+
+				return p, false, diags
+			}
+
+			p = p.AtListIndex(index)
+		case tftypes.Map:
+			p = p.AtMapKey(part)
+		case tftypes.Object:
+			p = p.AtName(part)
+		case tftypes.Set:
+			index, err := strconv.Atoi(part)
+
+			if err != nil {
+				diags.AddError(
+					"Invalid ResourceData Key",
+					fmt.Sprintf("The key %q has a set element part %q which is not a valid index: %s", key, part, err),
+				)
+
+				return p, false, diags
+			}
+
+			var value attr.Value
+
+			diags.Append(state.GetAttribute(ctx, p, &value)...)
+
+			if diags.HasError() {
+				return p, false, diags
+			}
+
+			elements := collectionElements(ctx, value)
+
+			if index < 0 || index >= len(elements) {
+				diags.AddError(
+					"Invalid ResourceData Key",
+					fmt.Sprintf("The key %q has a set element index %d, however the set has %d elements.", key, index, len(elements)),
+				)
+
+				return p, false, diags
+			}
+
+			p = p.AtSetValue(elements[index])
+		default:
+			diags.AddError(
+				"Invalid ResourceData Key",
+				fmt.Sprintf("The key %q has a part %q under %s, which does not have nested values.", key, part, p),
+			)
+
+			return p, false, diags
+		}
+	}
+
+	return p, false, diags
+}
+
+// diagsError returns an error containing the error diagnostics, if any.
+func diagsError(diags diag.Diagnostics) error {
+	errDiags := diags.Errors()
+
+	if len(errDiags) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(errDiags))
+
+	for _, errDiag := range errDiags {
+		messages = append(messages, errDiag.Summary()+": "+errDiag.Detail())
+	}
+
+	return errors.New(strings.Join(messages, "\n"))
+}
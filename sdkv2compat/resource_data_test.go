@@ -0,0 +1,399 @@
+package sdkv2compat_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/sdkv2compat" //nolint:staticcheck // Testing deprecated package
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"count": schema.Int64Attribute{
+			Optional: true,
+		},
+		"enabled": schema.BoolAttribute{
+			Optional: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"tags": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"zones": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"rule": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"port": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	},
+}
+
+var testRuleType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"port": tftypes.Number,
+	},
+}
+
+var testType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"count":   tftypes.Number,
+		"enabled": tftypes.Bool,
+		"id":      tftypes.String,
+		"name":    tftypes.String,
+		"rule":    tftypes.List{ElementType: testRuleType},
+		"tags":    tftypes.Map{ElementType: tftypes.String},
+		"zones":   tftypes.Set{ElementType: tftypes.String},
+	},
+}
+
+func testValue(id any, name string, count any) tftypes.Value {
+	return tftypes.NewValue(testType, map[string]tftypes.Value{
+		"count":   tftypes.NewValue(tftypes.Number, count),
+		"enabled": tftypes.NewValue(tftypes.Bool, nil),
+		"id":      tftypes.NewValue(tftypes.String, id),
+		"name":    tftypes.NewValue(tftypes.String, name),
+		"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+			tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+				"port": tftypes.NewValue(tftypes.Number, 443),
+			}),
+		}),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		"zones": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+			tftypes.NewValue(tftypes.String, "b"),
+		}),
+	})
+}
+
+func TestResourceDataGet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key           string
+		expected      any
+		expectedOk    bool
+		expectedDiags diag.Diagnostics
+	}{
+		"string": {
+			key:        "name",
+			expected:   "test",
+			expectedOk: true,
+		},
+		"int64": {
+			key:        "count",
+			expected:   2,
+			expectedOk: true,
+		},
+		"null-bool": {
+			key:        "enabled",
+			expected:   false,
+			expectedOk: false,
+		},
+		"list-block": {
+			key: "rule",
+			expected: []interface{}{
+				map[string]interface{}{
+					"port": 443,
+				},
+			},
+			expectedOk: true,
+		},
+		"list-block-element-attribute": {
+			key:        "rule.0.port",
+			expected:   443,
+			expectedOk: true,
+		},
+		"list-block-count": {
+			key:        "rule.#",
+			expected:   1,
+			expectedOk: true,
+		},
+		"map": {
+			key: "tags",
+			expected: map[string]interface{}{
+				"env": "test",
+			},
+			expectedOk: true,
+		},
+		"map-element": {
+			key:        "tags.env",
+			expected:   "test",
+			expectedOk: true,
+		},
+		"map-count": {
+			key:        "tags.%",
+			expected:   1,
+			expectedOk: true,
+		},
+		"set": {
+			key:        "zones",
+			expected:   []interface{}{"a", "b"},
+			expectedOk: true,
+		},
+		"set-element": {
+			key:        "zones.1",
+			expected:   "b",
+			expectedOk: true,
+		},
+		"invalid-list-index": {
+			key:        "rule.first",
+			expected:   nil,
+			expectedOk: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ResourceData Key",
+					`The key "rule.first" has a list element part "first" which is not a valid index: strconv.Atoi: parsing "first": invalid syntax`,
+				),
+			},
+		},
+		"invalid-nested-part": {
+			key:        "name.length",
+			expected:   nil,
+			expectedOk: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ResourceData Key",
+					`The key "name.length" has a part "length" under name, which does not have nested values.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    testValue("test-id", "test", 2),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: req.State,
+			}
+
+			d := sdkv2compat.NewReadResourceData(context.Background(), req, resp)
+
+			got, gotOk := d.GetOk(testCase.key)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if gotOk != testCase.expectedOk {
+				t.Errorf("expected ok %t, got %t", testCase.expectedOk, gotOk)
+			}
+
+			if diff := cmp.Diff(d.Diagnostics(), testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceDataSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key           string
+		value         any
+		expected      any
+		expectedError bool
+	}{
+		"string": {
+			key:      "name",
+			value:    "updated",
+			expected: "updated",
+		},
+		"int": {
+			key:      "count",
+			value:    5,
+			expected: 5,
+		},
+		"list-block": {
+			key: "rule",
+			value: []interface{}{
+				map[string]interface{}{
+					"port": 80,
+				},
+				map[string]interface{}{},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"port": 80,
+				},
+				map[string]interface{}{
+					"port": 0,
+				},
+			},
+		},
+		"set": {
+			key:      "zones",
+			value:    []string{"c"},
+			expected: []interface{}{"c"},
+		},
+		"nil": {
+			key:      "tags",
+			value:    nil,
+			expected: map[string]interface{}{},
+		},
+		"wrong-type": {
+			key:           "count",
+			value:         "five",
+			expected:      2,
+			expectedError: true,
+		},
+		"count": {
+			key:           "rule.#",
+			value:         2,
+			expected:      1,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Schema: testSchema,
+					Raw:    testValue("test-id", "test", 2),
+				},
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    testValue("test-id", "test", 2),
+				},
+			}
+			resp := &resource.UpdateResponse{
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    tftypes.NewValue(testType, nil),
+				},
+			}
+
+			d := sdkv2compat.NewUpdateResourceData(context.Background(), req, resp)
+
+			err := d.Set(testCase.key, testCase.value)
+
+			if testCase.expectedError != (err != nil) {
+				t.Fatalf("expected error %t, got: %s", testCase.expectedError, err)
+			}
+
+			if testCase.expectedError != d.Diagnostics().HasError() {
+				t.Fatalf("expected error diagnostics %t, got: %s", testCase.expectedError, d.Diagnostics())
+			}
+
+			if diff := cmp.Diff(d.Get(testCase.key), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if d.HasChange(testCase.key) == testCase.expectedError {
+				t.Errorf("expected change %t", !testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestResourceDataCreate(t *testing.T) {
+	t.Parallel()
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testValue(tftypes.UnknownValue, "test", nil),
+		},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: testSchema,
+			Raw:    tftypes.NewValue(testType, nil),
+		},
+	}
+
+	d := sdkv2compat.NewCreateResourceData(context.Background(), req, resp)
+
+	if !d.IsNewResource() {
+		t.Error("expected new resource")
+	}
+
+	if !d.HasChange("name") {
+		t.Error("expected name change")
+	}
+
+	if d.HasChange("count") {
+		t.Error("expected no count change")
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected empty id, got: %s", d.Id())
+	}
+
+	d.SetId("test-id")
+
+	if d.Id() != "test-id" {
+		t.Errorf("expected id test-id, got: %s", d.Id())
+	}
+
+	if d.Diagnostics().HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", d.Diagnostics())
+	}
+
+	expected := testValue("test-id", "test", nil)
+
+	if diff := cmp.Diff(resp.State.Raw, expected); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
+
+func TestResourceDataSetIdEmpty(t *testing.T) {
+	t.Parallel()
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{
+			Schema: testSchema,
+			Raw:    testValue("test-id", "test", 2),
+		},
+	}
+	resp := &resource.ReadResponse{
+		State: req.State,
+	}
+
+	d := sdkv2compat.NewReadResourceData(context.Background(), req, resp)
+
+	d.SetId("")
+
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected resource removal, got: %s", resp.State.Raw)
+	}
+}
@@ -0,0 +1,321 @@
+package sdkv2compat
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// sdkValue converts a framework value into its terraform-plugin-sdk/v2 Go
+// value. Null and unknown values are converted into the zero value.
+func sdkValue(ctx context.Context, value attr.Value) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return zeroValue(ctx, value.Type(ctx)), diags
+	}
+
+	switch v := value.(type) {
+	case basetypes.BoolValuable:
+		boolValue, boolDiags := v.ToBoolValue(ctx)
+
+		diags.Append(boolDiags...)
+
+		return boolValue.ValueBool(), diags
+	case basetypes.Float64Valuable:
+		float64Value, float64Diags := v.ToFloat64Value(ctx)
+
+		diags.Append(float64Diags...)
+
+		return float64Value.ValueFloat64(), diags
+	case basetypes.Int64Valuable:
+		int64Value, int64Diags := v.ToInt64Value(ctx)
+
+		diags.Append(int64Diags...)
+
+		return int(int64Value.ValueInt64()), diags
+	case basetypes.NumberValuable:
+		numberValue, numberDiags := v.ToNumberValue(ctx)
+
+		diags.Append(numberDiags...)
+
+		result, _ := numberValue.ValueBigFloat().Float64()
+
+		return result, diags
+	case basetypes.StringValuable:
+		stringValue, stringDiags := v.ToStringValue(ctx)
+
+		diags.Append(stringDiags...)
+
+		return stringValue.ValueString(), diags
+	case basetypes.ListValuable, basetypes.SetValuable:
+		elements := collectionElements(ctx, value)
+		result := make([]interface{}, 0, len(elements))
+
+		for _, element := range elements {
+			elementValue, elementDiags := sdkValue(ctx, element)
+
+			diags.Append(elementDiags...)
+
+			result = append(result, elementValue)
+		}
+
+		return result, diags
+	case basetypes.MapValuable:
+		mapValue, mapDiags := v.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		return sdkValueMap(ctx, mapValue.Elements(), diags)
+	case basetypes.ObjectValuable:
+		objectValue, objectDiags := v.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		return sdkValueMap(ctx, objectValue.Attributes(), diags)
+	default:
+		diags.AddError(
+			"Unsupported ResourceData Value Type",
+			fmt.Sprintf("The value type %T cannot be converted into a terraform-plugin-sdk/v2 Go value.", value),
+		)
+
+		return nil, diags
+	}
+}
+
+// sdkValueMap converts framework map elements or object attributes into a
+// terraform-plugin-sdk/v2 Go map.
+func sdkValueMap(ctx context.Context, values map[string]attr.Value, diags diag.Diagnostics) (interface{}, diag.Diagnostics) {
+	result := make(map[string]interface{}, len(values))
+
+	for key, value := range values {
+		elementValue, elementDiags := sdkValue(ctx, value)
+
+		diags.Append(elementDiags...)
+
+		result[key] = elementValue
+	}
+
+	return result, diags
+}
+
+// zeroValue returns the terraform-plugin-sdk/v2 Go zero value for the
+// framework type.
+func zeroValue(_ context.Context, typ attr.Type) interface{} {
+	switch typ.(type) {
+	case basetypes.BoolTypable:
+		return false
+	case basetypes.Float64Typable, basetypes.NumberTypable:
+		return float64(0)
+	case basetypes.Int64Typable:
+		return 0
+	case basetypes.StringTypable:
+		return ""
+	case basetypes.ListTypable, basetypes.SetTypable:
+		return []interface{}{}
+	case basetypes.MapTypable, basetypes.ObjectTypable:
+		return map[string]interface{}{}
+	default:
+		return nil
+	}
+}
+
+// collectionElements returns the elements of a list or set value.
+func collectionElements(ctx context.Context, value attr.Value) []attr.Value {
+	switch v := value.(type) {
+	case basetypes.ListValuable:
+		listValue, _ := v.ToListValue(ctx)
+
+		return listValue.Elements()
+	case basetypes.SetValuable:
+		setValue, _ := v.ToSetValue(ctx)
+
+		return setValue.Elements()
+	default:
+		return nil
+	}
+}
+
+// elementCount returns the number of elements in a list, map, or set value.
+func elementCount(ctx context.Context, value attr.Value) int {
+	if v, ok := value.(basetypes.MapValuable); ok {
+		mapValue, _ := v.ToMapValue(ctx)
+
+		return len(mapValue.Elements())
+	}
+
+	return len(collectionElements(ctx, value))
+}
+
+// isEmptyCollection returns true if the terraform-plugin-sdk/v2 Go value is
+// a slice or map without elements.
+func isEmptyCollection(value interface{}) bool {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+// frameworkValue converts a terraform-plugin-sdk/v2 Go value into a
+// framework value of the given type.
+func frameworkValue(ctx context.Context, typ attr.Type, value interface{}) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfValue, err := terraformValue(typ.TerraformType(ctx), value)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Convert ResourceData Value",
+			fmt.Sprintf("The terraform-plugin-sdk/v2 Go value could not be converted into the framework type %s: %s", typ, err),
+		)
+
+		return nil, diags
+	}
+
+	result, err := typ.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Convert ResourceData Value",
+			fmt.Sprintf("The terraform-plugin-sdk/v2 Go value could not be converted into the framework type %s: %s", typ, err),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// terraformValue converts a terraform-plugin-sdk/v2 Go value into a
+// terraform-plugin-go value of the given type.
+func terraformValue(typ tftypes.Type, value interface{}) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		if v.Kind() != reflect.Bool {
+			return tftypes.Value{}, fmt.Errorf("expected bool, got %T", value)
+		}
+
+		return tftypes.NewValue(typ, v.Bool()), nil
+	case typ.Is(tftypes.Number):
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return tftypes.NewValue(typ, new(big.Float).SetInt64(v.Int())), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return tftypes.NewValue(typ, new(big.Float).SetUint64(v.Uint())), nil
+		case reflect.Float32, reflect.Float64:
+			return tftypes.NewValue(typ, big.NewFloat(v.Float())), nil
+		default:
+			return tftypes.Value{}, fmt.Errorf("expected number, got %T", value)
+		}
+	case typ.Is(tftypes.String):
+		if v.Kind() != reflect.String {
+			return tftypes.Value{}, fmt.Errorf("expected string, got %T", value)
+		}
+
+		return tftypes.NewValue(typ, v.String()), nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.List:
+		elements, err := terraformValueElements(t.ElementType, v)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.Set:
+		elements, err := terraformValueElements(t.ElementType, v)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.Map:
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return tftypes.Value{}, fmt.Errorf("expected map with string keys, got %T", value)
+		}
+
+		elements := make(map[string]tftypes.Value, v.Len())
+
+		for _, key := range v.MapKeys() {
+			element, err := terraformValue(t.ElementType, v.MapIndex(key).Interface())
+
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("map key %q: %w", key.String(), err)
+			}
+
+			elements[key.String()] = element
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.Object:
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return tftypes.Value{}, fmt.Errorf("expected map with string keys, got %T", value)
+		}
+
+		attributes := make(map[string]tftypes.Value, len(t.AttributeTypes))
+
+		for name, attributeType := range t.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		for _, key := range v.MapKeys() {
+			attributeType, ok := t.AttributeTypes[key.String()]
+
+			if !ok {
+				return tftypes.Value{}, fmt.Errorf("unexpected object attribute %q", key.String())
+			}
+
+			attribute, err := terraformValue(attributeType, v.MapIndex(key).Interface())
+
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("object attribute %q: %w", key.String(), err)
+			}
+
+			attributes[key.String()] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// terraformValueElements converts a terraform-plugin-sdk/v2 Go slice into
+// terraform-plugin-go values of the given element type.
+func terraformValueElements(elementType tftypes.Type, v reflect.Value) ([]tftypes.Value, error) {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected slice, got %s", v.Type())
+	}
+
+	elements := make([]tftypes.Value, 0, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		element, err := terraformValue(elementType, v.Index(i).Interface())
+
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
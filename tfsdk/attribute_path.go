@@ -0,0 +1,31 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// FromTerraformPath returns the path.Path equivalent of a
+// *tftypes.AttributePath. The schema is used to determine the attr.Type of
+// each step, so set element values, such as in tftypes.ElementKeyValue steps,
+// are converted into the attr.Value of the schema type at that point. Error
+// diagnostics are returned if a step does not exist in the schema or cannot
+// be converted.
+func FromTerraformPath(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath) (path.Path, diag.Diagnostics) {
+	return fromtftypes.AttributePath(ctx, tfPath, schema)
+}
+
+// ToTerraformPath returns the *tftypes.AttributePath equivalent of a
+// path.Path. Set element values, such as in path.PathStepElementKeyValue
+// steps, are converted with the attr.Value ToTerraformValue method. Error
+// diagnostics are returned if a step cannot be converted.
+func ToTerraformPath(ctx context.Context, p path.Path) (*tftypes.AttributePath, diag.Diagnostics) {
+	return totftypes.AttributePath(ctx, p)
+}
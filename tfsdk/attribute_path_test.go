@@ -0,0 +1,213 @@
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testAttributePathSchema = testschema.Schema{
+	Attributes: map[string]fwschema.Attribute{
+		"list": testschema.Attribute{
+			Type:     types.ListType{ElemType: types.StringType},
+			Optional: true,
+		},
+		"map": testschema.Attribute{
+			Type:     types.MapType{ElemType: types.StringType},
+			Optional: true,
+		},
+		"object": testschema.Attribute{
+			Type: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"nested": types.StringType,
+				},
+			},
+			Optional: true,
+		},
+		"set": testschema.Attribute{
+			Type:     types.SetType{ElemType: types.StringType},
+			Optional: true,
+		},
+		"set_object": testschema.Attribute{
+			Type: types.SetType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"nested": types.StringType,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"string": testschema.Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		},
+	},
+}
+
+func TestFromTerraformPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfPath        *tftypes.AttributePath
+		expected      path.Path
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfPath:   tftypes.NewAttributePath(),
+			expected: path.Empty(),
+		},
+		"AttributeName-missing": {
+			tfPath:   tftypes.NewAttributePath().WithAttributeName("missing"),
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Attribute Path",
+					"An unexpected error occurred while trying to convert an attribute path. "+
+						"This is an error in terraform-plugin-framework used by the provider. "+
+						"Please report the following to the provider developers.\n\n"+
+						"Attribute Path: AttributeName(\"missing\")\n"+
+						"Original Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+		"ElementKeyInt-mismatch": {
+			tfPath:   tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyInt(0),
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Attribute Path",
+					"An unexpected error occurred while trying to convert an attribute path. "+
+						"This is an error in terraform-plugin-framework used by the provider. "+
+						"Please report the following to the provider developers.\n\n"+
+						"Attribute Path: AttributeName(\"map\").ElementKeyInt(0)\n"+
+						"Original Error: ElementKeyInt(0) still remains in the path: cannot apply step tftypes.ElementKeyInt to MapType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.FromTerraformPath(context.Background(), testAttributePathSchema, testCase.tfPath)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTerraformPathRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fwPath path.Path
+		tfPath *tftypes.AttributePath
+	}{
+		"AttributeName": {
+			fwPath: path.Root("string"),
+			tfPath: tftypes.NewAttributePath().WithAttributeName("string"),
+		},
+		"AttributeName-AttributeName": {
+			fwPath: path.Root("object").AtName("nested"),
+			tfPath: tftypes.NewAttributePath().WithAttributeName("object").WithAttributeName("nested"),
+		},
+		"ElementKeyInt": {
+			fwPath: path.Root("list").AtListIndex(1),
+			tfPath: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+		},
+		"ElementKeyString": {
+			fwPath: path.Root("map").AtMapKey("test-key"),
+			tfPath: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("test-key"),
+		},
+		"ElementKeyValue": {
+			fwPath: path.Root("set").AtSetValue(types.StringValue("test-value")),
+			tfPath: tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(
+				tftypes.NewValue(tftypes.String, "test-value"),
+			),
+		},
+		"ElementKeyValue-object-AttributeName": {
+			fwPath: path.Root("set_object").AtSetValue(
+				types.ObjectValueMust(
+					map[string]attr.Type{
+						"nested": types.StringType,
+					},
+					map[string]attr.Value{
+						"nested": types.StringValue("test-value"),
+					},
+				),
+			).AtName("nested"),
+			tfPath: tftypes.NewAttributePath().WithAttributeName("set_object").WithElementKeyValue(
+				tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"nested": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			).WithAttributeName("nested"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			gotTfPath, diags := tfsdk.ToTerraformPath(ctx, testCase.fwPath)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected ToTerraformPath diagnostics: %v", diags)
+			}
+
+			if !gotTfPath.Equal(testCase.tfPath) {
+				t.Errorf("expected ToTerraformPath %s, got: %s", testCase.tfPath, gotTfPath)
+			}
+
+			gotFwPath, diags := tfsdk.FromTerraformPath(ctx, testAttributePathSchema, testCase.tfPath)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected FromTerraformPath diagnostics: %v", diags)
+			}
+
+			if !gotFwPath.Equal(testCase.fwPath) {
+				t.Errorf("expected FromTerraformPath %s, got: %s", testCase.fwPath, gotFwPath)
+			}
+
+			roundTripFwPath, diags := tfsdk.FromTerraformPath(ctx, testAttributePathSchema, gotTfPath)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected round trip diagnostics: %v", diags)
+			}
+
+			if !roundTripFwPath.Equal(testCase.fwPath) {
+				t.Errorf("expected round trip %s, got: %s", testCase.fwPath, roundTripFwPath)
+			}
+		})
+	}
+}
@@ -32,6 +32,18 @@ func (c Config) GetAttribute(ctx context.Context, path path.Path, target interfa
 	return c.data().GetAtPath(ctx, path, target)
 }
 
+// FindAll returns the path and value of all attributes and blocks matching
+// the given path.Expression, which can include steps such as AtAnyListIndex()
+// to search collections. For example, a path.Expression of
+// path.MatchRoot("rule").AtAnyListIndex().AtName("kms_key_id") returns the
+// kms_key_id value of each rule list block element.
+//
+// Unlike PathMatches, only exact matches are returned, so paths under a null
+// or unknown parent value are not included.
+func (c Config) FindAll(ctx context.Context, pathExpr path.Expression) (PathValues, diag.Diagnostics) {
+	return findAll(ctx, c.data(), pathExpr)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -41,6 +53,36 @@ func (c Config) PathMatches(ctx context.Context, pathExpr path.Expression) (path
 	return c.data().PathMatches(ctx, pathExpr)
 }
 
+// HasUnknowns returns true if any value in the configuration is unknown. Refer
+// to UnknownPaths for more information.
+func (c Config) HasUnknowns(ctx context.Context) (bool, diag.Diagnostics) {
+	paths, diags := c.data().UnknownPaths(ctx)
+
+	return len(paths) > 0, diags
+}
+
+// UnknownPaths returns the paths of all unknown values in the configuration,
+// including those nested in collections and objects, sorted by their string
+// representation. Values under an unknown value are not included, since only
+// the unknown parent path exists.
+//
+// The configuration can contain unknown values during planning when it
+// refers to values which are not yet known, such as attributes of other
+// resources which have not been created. For example, a provider Configure
+// method can use this to skip creating an API client until the
+// configuration is known:
+//
+//	unknownPaths, diags := req.Config.UnknownPaths(ctx)
+//
+//	resp.Diagnostics.Append(diags...)
+//
+//	if len(unknownPaths) > 0 {
+//		// Skip client creation or return an error for unknownPaths
+//	}
+func (c Config) UnknownPaths(ctx context.Context) (path.Paths, diag.Diagnostics) {
+	return c.data().UnknownPaths(ctx)
+}
+
 func (c Config) data() fwschemadata.Data {
 	return fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionConfiguration,
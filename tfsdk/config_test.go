@@ -286,3 +286,126 @@ func TestConfigPathMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigHasUnknowns(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type: types.StringType,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		config        tfsdk.Config
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to fwschemadata.TestDataUnknownPaths for more exhaustive
+		// unit testing. These test cases are to ensure Config schema and data
+		// values are passed appropriately to the shared implementation.
+		"known": {
+			config: tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			},
+			expected: false,
+		},
+		"unknown": {
+			config: tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.config.HasUnknowns(context.Background())
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got: %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigUnknownPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        tfsdk.Config
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to fwschemadata.TestDataUnknownPaths for more exhaustive
+		// unit testing. These test cases are to ensure Config schema and data
+		// values are passed appropriately to the shared implementation.
+		"unknown": {
+			config: tfsdk.Config{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: types.MapType{ElemType: types.StringType},
+						},
+					},
+				},
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Map{ElementType: tftypes.String},
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+							"key1": tftypes.NewValue(tftypes.String, "test-value"),
+							"key2": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						}),
+					},
+				),
+			},
+			expected: path.Paths{
+				path.Root("test").AtMapKey("key2"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.config.UnknownPaths(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+)
+
+// Unknown is a model field type which is always converted into an unknown
+// value of the schema type by StateFrom, PlanFrom, and ConfigFrom. This
+// enables forcing individual fields of test data to be unknown, such as:
+//
+//	type testModel struct {
+//	  ID   tfsdk.Unknown `tfsdk:"id"`
+//	  Name string        `tfsdk:"name"`
+//	}
+//
+// Reading data into an Unknown field returns an error diagnostic unless the
+// value is unknown.
+type Unknown struct{}
+
+// GetUnknown always returns true.
+func (u Unknown) GetUnknown(_ context.Context) bool {
+	return true
+}
+
+// GetValue always returns nil.
+func (u Unknown) GetValue(_ context.Context) interface{} {
+	return nil
+}
+
+// SetUnknown returns an error if the value is not unknown.
+func (u Unknown) SetUnknown(_ context.Context, unknown bool) error {
+	if !unknown {
+		return fmt.Errorf("tfsdk.Unknown can only be set to unknown values")
+	}
+
+	return nil
+}
+
+// SetValue always returns an error, as only unknown values are supported.
+func (u Unknown) SetValue(_ context.Context, _ interface{}) error {
+	return fmt.Errorf("tfsdk.Unknown can only be set to unknown values")
+}
+
+// ConfigFrom returns a Config of the schema with all data from the given
+// model, such as a struct with tfsdk field tags, using the same conversion
+// rules as State.Set. This is intended for creating test data without
+// manually creating terraform-plugin-go values.
+func ConfigFrom(ctx context.Context, schema fwschema.Schema, model interface{}) (Config, diag.Diagnostics) {
+	data := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         schema,
+		TerraformValue: tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+
+	diags := data.Set(ctx, model)
+
+	return Config{
+		Raw:    data.TerraformValue,
+		Schema: schema,
+	}, diags
+}
+
+// PlanFrom returns a Plan of the schema with all data from the given model,
+// such as a struct with tfsdk field tags, using the same conversion rules as
+// Plan.Set. This is intended for creating test data without manually
+// creating terraform-plugin-go values.
+func PlanFrom(ctx context.Context, schema fwschema.Schema, model interface{}) (Plan, diag.Diagnostics) {
+	plan := Plan{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+
+	diags := plan.Set(ctx, model)
+
+	return plan, diags
+}
+
+// StateFrom returns a State of the schema with all data from the given
+// model, such as a struct with tfsdk field tags, using the same conversion
+// rules as State.Set. This is intended for creating test data without
+// manually creating terraform-plugin-go values.
+func StateFrom(ctx context.Context, schema fwschema.Schema, model interface{}) (State, diag.Diagnostics) {
+	state := State{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+
+	diags := state.Set(ctx, model)
+
+	return state, diags
+}
@@ -0,0 +1,218 @@
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testFromNestedModel struct {
+	Name string `tfsdk:"name"`
+}
+
+type testFromModel struct {
+	Bool       bool                  `tfsdk:"bool"`
+	Int64      int64                 `tfsdk:"int64"`
+	List       []string              `tfsdk:"list"`
+	ListNested []testFromNestedModel `tfsdk:"list_nested"`
+	Map        map[string]string     `tfsdk:"map"`
+	Object     testFromNestedModel   `tfsdk:"object"`
+	Pointer    *string               `tfsdk:"pointer"`
+	String     string                `tfsdk:"string"`
+}
+
+var testFromSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"bool": schema.BoolAttribute{
+			Optional: true,
+		},
+		"int64": schema.Int64Attribute{
+			Optional: true,
+		},
+		"list": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"list_nested": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"map": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"object": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+			Optional: true,
+		},
+		"pointer": schema.StringAttribute{
+			Optional: true,
+		},
+		"string": schema.StringAttribute{
+			Optional: true,
+		},
+	},
+}
+
+var testFromModelValue = testFromModel{
+	Bool:  true,
+	Int64: 123,
+	List:  []string{"one", "two"},
+	ListNested: []testFromNestedModel{
+		{Name: "first"},
+		{Name: "second"},
+	},
+	Map: map[string]string{
+		"key": "value",
+	},
+	Object: testFromNestedModel{
+		Name: "nested",
+	},
+	String: "test",
+}
+
+func TestConfigFrom(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	config, diags := tfsdk.ConfigFrom(ctx, testFromSchema, testFromModelValue)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var got testFromModel
+
+	diags = config.Get(ctx, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Get error: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, testFromModelValue); diff != "" {
+		t.Errorf("unexpected round-trip difference: %s", diff)
+	}
+}
+
+func TestPlanFrom(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	plan, diags := tfsdk.PlanFrom(ctx, testFromSchema, testFromModelValue)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var got testFromModel
+
+	diags = plan.Get(ctx, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Get error: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, testFromModelValue); diff != "" {
+		t.Errorf("unexpected round-trip difference: %s", diff)
+	}
+}
+
+func TestStateFrom(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	state, diags := tfsdk.StateFrom(ctx, testFromSchema, testFromModelValue)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var got testFromModel
+
+	diags = state.Get(ctx, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Get error: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, testFromModelValue); diff != "" {
+		t.Errorf("unexpected round-trip difference: %s", diff)
+	}
+}
+
+func TestStateFrom_Unknown(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	type testModel struct {
+		ID   tfsdk.Unknown `tfsdk:"id"`
+		Name string        `tfsdk:"name"`
+	}
+
+	state, diags := tfsdk.StateFrom(ctx, testSchema, testModel{Name: "test"})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id":   tftypes.String,
+				"name": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name": tftypes.NewValue(tftypes.String, "test"),
+		},
+	)
+
+	if diff := cmp.Diff(state.Raw, expected); diff != "" {
+		t.Errorf("unexpected Raw difference: %s", diff)
+	}
+
+	var got testModel
+
+	diags = state.Get(ctx, &got)
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("unexpected Get diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got, testModel{Name: "test"}); diff != "" {
+		t.Errorf("unexpected round-trip difference: %s", diff)
+	}
+}
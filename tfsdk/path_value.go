@@ -0,0 +1,75 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// PathValue is an attribute or block path and its value, such as returned by
+// the Config, Plan, and State type FindAll methods.
+type PathValue struct {
+	// Path is the exact path of the value.
+	Path path.Path
+
+	// Value is the value at Path. Consumers should assert the type of the
+	// value with the desired attr.Value type, such as types.String.
+	Value attr.Value
+}
+
+// PathValues is a collection of PathValue.
+type PathValues []PathValue
+
+// Paths returns the Path of each PathValue.
+func (v PathValues) Paths() path.Paths {
+	if v == nil {
+		return nil
+	}
+
+	result := make(path.Paths, 0, len(v))
+
+	for _, pathValue := range v {
+		result = append(result, pathValue.Path)
+	}
+
+	return result
+}
+
+// findAll returns the PathValue for all paths in the data which exactly match
+// the path expression, in the order found when walking the data.
+func findAll(ctx context.Context, data fwschemadata.Data, pathExpr path.Expression) (PathValues, diag.Diagnostics) {
+	var result PathValues
+
+	matches, diags := data.PathMatches(ctx, pathExpr)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, match := range matches {
+		// PathMatches returns parent paths with null or unknown values to
+		// prevent false positives, however those values do not contain any
+		// value matching the expression.
+		if !pathExpr.Matches(match) {
+			continue
+		}
+
+		value, valueDiags := data.ValueAtPath(ctx, match)
+
+		diags.Append(valueDiags...)
+
+		if valueDiags.HasError() {
+			continue
+		}
+
+		result = append(result, PathValue{
+			Path:  match,
+			Value: value,
+		})
+	}
+
+	return result, diags
+}
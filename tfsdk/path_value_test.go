@@ -0,0 +1,58 @@
+package tfsdk_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPathValuesPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pathValues tfsdk.PathValues
+		expected   path.Paths
+	}{
+		"nil": {
+			pathValues: nil,
+			expected:   nil,
+		},
+		"empty": {
+			pathValues: tfsdk.PathValues{},
+			expected:   path.Paths{},
+		},
+		"values": {
+			pathValues: tfsdk.PathValues{
+				{
+					Path:  path.Root("test1"),
+					Value: types.StringValue("test-value1"),
+				},
+				{
+					Path:  path.Root("test2"),
+					Value: types.StringValue("test-value2"),
+				},
+			},
+			expected: path.Paths{
+				path.Root("test1"),
+				path.Root("test2"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.pathValues.Paths()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -32,6 +32,18 @@ func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface
 	return p.data().GetAtPath(ctx, path, target)
 }
 
+// FindAll returns the path and value of all attributes and blocks matching
+// the given path.Expression, which can include steps such as AtAnyListIndex()
+// to search collections. For example, a path.Expression of
+// path.MatchRoot("rule").AtAnyListIndex().AtName("kms_key_id") returns the
+// kms_key_id value of each rule list block element.
+//
+// Unlike PathMatches, only exact matches are returned, so paths under a null
+// or unknown parent value are not included.
+func (p Plan) FindAll(ctx context.Context, pathExpr path.Expression) (PathValues, diag.Diagnostics) {
+	return findAll(ctx, *p.data(), pathExpr)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
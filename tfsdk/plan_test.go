@@ -197,6 +197,67 @@ func TestPlanGetAttribute(t *testing.T) {
 	}
 }
 
+func TestPlanFindAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		plan          tfsdk.Plan
+		expression    path.Expression
+		expected      tfsdk.PathValues
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to TestStateFindAll for more exhaustive unit testing.
+		// These test cases are to ensure Plan schema and data values are
+		// passed appropriately to the shared implementation.
+		"AttributeNameExact-match": {
+			plan: tfsdk.Plan{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: types.StringType,
+						},
+					},
+				},
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+			expression: path.MatchRoot("test"),
+			expected: tfsdk.PathValues{
+				{
+					Path:  path.Root("test"),
+					Value: types.StringValue("test-value"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.plan.FindAll(context.Background(), testCase.expression)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestPlanPathMatches(t *testing.T) {
 	t.Parallel()
 
@@ -34,6 +34,18 @@ func (s State) GetAttribute(ctx context.Context, path path.Path, target interfac
 	return s.data().GetAtPath(ctx, path, target)
 }
 
+// FindAll returns the path and value of all attributes and blocks matching
+// the given path.Expression, which can include steps such as AtAnyListIndex()
+// to search collections. For example, a path.Expression of
+// path.MatchRoot("rule").AtAnyListIndex().AtName("kms_key_id") returns the
+// kms_key_id value of each rule list block element.
+//
+// Unlike PathMatches, only exact matches are returned, so paths under a null
+// or unknown parent value are not included.
+func (s State) FindAll(ctx context.Context, pathExpr path.Expression) (PathValues, diag.Diagnostics) {
+	return findAll(ctx, s.data(), pathExpr)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
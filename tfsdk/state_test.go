@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -197,6 +198,169 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 }
 
+func TestStateFindAll(t *testing.T) {
+	t.Parallel()
+
+	testRuleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"kms_key_id": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"rule": tftypes.List{ElementType: testRuleType},
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"rule": testschema.Attribute{
+				Type: types.ListType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"kms_key_id": types.StringType,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		expression    path.Expression
+		expected      tfsdk.PathValues
+		expectedDiags diag.Diagnostics
+	}{
+		"AttributeNameExact-match": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-value"),
+					"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+				}),
+			},
+			expression: path.MatchRoot("name"),
+			expected: tfsdk.PathValues{
+				{
+					Path:  path.Root("name"),
+					Value: types.StringValue("test-value"),
+				},
+			},
+		},
+		"AttributeNameExact-null": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, nil),
+					"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+				}),
+			},
+			expression: path.MatchRoot("name"),
+			expected: tfsdk.PathValues{
+				{
+					Path:  path.Root("name"),
+					Value: types.StringNull(),
+				},
+			},
+		},
+		"AttributeNameExact-mismatch": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-value"),
+					"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+				}),
+			},
+			expression: path.MatchRoot("not-test"),
+			expected:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: not-test",
+				),
+			},
+		},
+		"ElementKeyIntAny-match": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-value"),
+					"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, []tftypes.Value{
+						tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+							"kms_key_id": tftypes.NewValue(tftypes.String, "test-key-1"),
+						}),
+						tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+							"kms_key_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						}),
+					}),
+				}),
+			},
+			expression: path.MatchRoot("rule").AtAnyListIndex().AtName("kms_key_id"),
+			expected: tfsdk.PathValues{
+				{
+					Path:  path.Root("rule").AtListIndex(0).AtName("kms_key_id"),
+					Value: types.StringValue("test-key-1"),
+				},
+				{
+					Path:  path.Root("rule").AtListIndex(1).AtName("kms_key_id"),
+					Value: types.StringUnknown(),
+				},
+			},
+		},
+		"ElementKeyIntAny-parent-null": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-value"),
+					"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, nil),
+				}),
+			},
+			expression: path.MatchRoot("rule").AtAnyListIndex().AtName("kms_key_id"),
+			expected:   nil,
+		},
+		"ElementKeyIntAny-parent-unknown": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-value"),
+					"rule": tftypes.NewValue(tftypes.List{ElementType: testRuleType}, tftypes.UnknownValue),
+				}),
+			},
+			expression: path.MatchRoot("rule").AtAnyListIndex().AtName("kms_key_id"),
+			expected:   nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.state.FindAll(context.Background(), testCase.expression)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateSet(t *testing.T) {
 	t.Parallel()
 
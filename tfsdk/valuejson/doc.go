@@ -0,0 +1,25 @@
+// Package valuejson implements encoding of framework values as JSON, which
+// is compatible with the Terraform JSON output of values, such as the
+// "terraform show -json" command. This enables tooling, such as policy
+// review of planned values, to snapshot values outside of Terraform.
+//
+// Null values are encoded as JSON null, numbers are encoded with their full
+// precision, objects and maps are encoded as JSON objects, and lists, sets,
+// and tuples are encoded as JSON arrays. Set elements are sorted by their
+// JSON encoding, so the output is deterministic. Custom types are encoded
+// using their underlying Terraform value.
+//
+// Unknown values cannot be represented in JSON, so Marshal returns an error
+// diagnostic for them by default. Set the MarshalOptions type
+// UnknownAsSentinel field to instead encode them as the UnknownSentinel
+// string.
+//
+// Plan, state, and configuration data can be encoded by first converting
+// the data into a value with the schema type, such as:
+//
+//	planValue, err := req.Plan.Schema.Type().ValueFromTerraform(ctx, req.Plan.Raw)
+//
+//	// ... error handling ...
+//
+//	planJSON, diags := valuejson.Marshal(ctx, planValue)
+package valuejson
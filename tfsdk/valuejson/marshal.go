@@ -0,0 +1,244 @@
+package valuejson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// UnknownSentinel is the JSON string which unknown values are encoded as,
+// when the MarshalOptions type UnknownAsSentinel field is enabled.
+const UnknownSentinel = "<unknown>"
+
+// MarshalOptions configures the encoding of values as JSON.
+type MarshalOptions struct {
+	// UnknownAsSentinel encodes unknown values as the UnknownSentinel
+	// string. By default, unknown values return an error diagnostic.
+	UnknownAsSentinel bool
+}
+
+// Marshal returns the JSON encoding of the value, with the default
+// MarshalOptions.
+func Marshal(ctx context.Context, value attr.Value) ([]byte, diag.Diagnostics) {
+	return MarshalOptions{}.Marshal(ctx, value)
+}
+
+// Marshal returns the JSON encoding of the value.
+func (o MarshalOptions) Marshal(ctx context.Context, value attr.Value) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		diags.AddError(
+			"Unable to Marshal Value to JSON",
+			"The value is missing. This is always an issue in the caller and should be reported to the tooling developers.",
+		)
+
+		return nil, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Marshal Value to JSON",
+			"An unexpected error occurred while converting the value to its Terraform value: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	jsonValue, err := o.jsonValue(tftypes.NewAttributePath(), tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Marshal Value to JSON",
+			"The value cannot be encoded as JSON: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	result, err := encode(jsonValue)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Marshal Value to JSON",
+			"An unexpected error occurred while encoding the value: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// jsonValue returns the Terraform value as a Go type suitable for JSON
+// encoding.
+func (o MarshalOptions) jsonValue(tfPath *tftypes.AttributePath, value tftypes.Value) (interface{}, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	if !value.IsKnown() {
+		if o.UnknownAsSentinel {
+			return UnknownSentinel, nil
+		}
+
+		return nil, fmt.Errorf("value at %s is unknown, which cannot be represented in JSON", pathString(tfPath))
+	}
+
+	valueType := value.Type()
+
+	switch {
+	case valueType.Is(tftypes.Bool):
+		var b bool
+
+		err := value.As(&b)
+
+		return b, err
+	case valueType.Is(tftypes.Number):
+		var n big.Float
+
+		err := value.As(&n)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Number(n.Text('f', -1)), nil
+	case valueType.Is(tftypes.String):
+		var s string
+
+		err := value.As(&s)
+
+		return s, err
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make([]interface{}, 0, len(elements))
+
+		for index, element := range elements {
+			jsonElement, err := o.jsonValue(tfPath.WithElementKeyInt(index), element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, jsonElement)
+		}
+
+		return result, nil
+	case valueType.Is(tftypes.Set{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make([]json.RawMessage, 0, len(elements))
+
+		for _, element := range elements {
+			jsonElement, err := o.jsonValue(tfPath.WithElementKeyValue(element), element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			jsonBytes, err := encode(jsonElement)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, jsonBytes)
+		}
+
+		// Sort set elements by their encoding for deterministic output.
+		sort.Slice(result, func(i, j int) bool {
+			return bytes.Compare(result[i], result[j]) < 0
+		})
+
+		return result, nil
+	case valueType.Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]interface{}, len(elements))
+
+		for key, element := range elements {
+			jsonElement, err := o.jsonValue(tfPath.WithElementKeyString(key), element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = jsonElement
+		}
+
+		return result, nil
+	case valueType.Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]interface{}, len(attributes))
+
+		for name, attribute := range attributes {
+			jsonAttribute, err := o.jsonValue(tfPath.WithAttributeName(name), attribute)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[name] = jsonAttribute
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("type %s at %s is not supported", valueType, pathString(tfPath))
+	}
+}
+
+// encode returns the JSON encoding of the Go value. Unlike json.Marshal,
+// characters such as < and > are not escaped, so the UnknownSentinel string
+// remains readable.
+func encode(jsonValue interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(jsonValue); err != nil {
+		return nil, err
+	}
+
+	// Encode always appends a newline.
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// pathString returns a human-readable representation of the path for error
+// messages.
+func pathString(tfPath *tftypes.AttributePath) string {
+	if len(tfPath.Steps()) == 0 {
+		return "the root"
+	}
+
+	return tfPath.String()
+}
@@ -0,0 +1,261 @@
+package valuejson_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk/valuejson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"nested": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"enabled": types.BoolType,
+					"ports":   types.ListType{ElemType: types.Int64Type},
+				},
+			},
+		},
+	}
+	nestedType := objectType.AttrTypes["nested"].(types.ObjectType) //nolint:forcetypeassert // Test setup
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: `true`,
+		},
+		"bool-null": {
+			value:    types.BoolNull(),
+			expected: `null`,
+		},
+		"float64": {
+			value:    types.Float64Value(1.1),
+			expected: `1.1`,
+		},
+		"float64-null": {
+			value:    types.Float64Null(),
+			expected: `null`,
+		},
+		"int64": {
+			value:    types.Int64Value(-9007199254740993),
+			expected: `-9007199254740993`,
+		},
+		"int64-null": {
+			value:    types.Int64Null(),
+			expected: `null`,
+		},
+		"number": {
+			value:    types.NumberValue(big.NewFloat(1.5)),
+			expected: `1.5`,
+		},
+		"number-large": {
+			value:    types.NumberValue(new(big.Float).SetPrec(512).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil))),
+			expected: `1000000000000000000000000000000`,
+		},
+		"number-null": {
+			value:    types.NumberNull(),
+			expected: `null`,
+		},
+		"string": {
+			value:    types.StringValue("test \"value\""),
+			expected: `"test \"value\""`,
+		},
+		"string-null": {
+			value:    types.StringNull(),
+			expected: `null`,
+		},
+		"list": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("b"),
+				types.StringValue("a"),
+			}),
+			expected: `["b","a"]`,
+		},
+		"list-empty": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{}),
+			expected: `[]`,
+		},
+		"list-null": {
+			value:    types.ListNull(types.StringType),
+			expected: `null`,
+		},
+		"map": {
+			value: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+				"b": types.Int64Value(2),
+				"a": types.Int64Value(1),
+			}),
+			expected: `{"a":1,"b":2}`,
+		},
+		"map-null": {
+			value:    types.MapNull(types.Int64Type),
+			expected: `null`,
+		},
+		"set": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("c"),
+				types.StringValue("a"),
+				types.StringValue("b"),
+			}),
+			expected: `["a","b","c"]`,
+		},
+		"set-objects": {
+			value: types.SetValueMust(nestedType, []attr.Value{
+				types.ObjectValueMust(nestedType.AttrTypes, map[string]attr.Value{
+					"enabled": types.BoolValue(true),
+					"ports":   types.ListNull(types.Int64Type),
+				}),
+				types.ObjectValueMust(nestedType.AttrTypes, map[string]attr.Value{
+					"enabled": types.BoolValue(false),
+					"ports":   types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(443)}),
+				}),
+			}),
+			expected: `[{"enabled":false,"ports":[443]},{"enabled":true,"ports":null}]`,
+		},
+		"set-null": {
+			value:    types.SetNull(types.StringType),
+			expected: `null`,
+		},
+		"object": {
+			value: types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+				"name": types.StringValue("test"),
+				"nested": types.ObjectValueMust(nestedType.AttrTypes, map[string]attr.Value{
+					"enabled": types.BoolValue(true),
+					"ports": types.ListValueMust(types.Int64Type, []attr.Value{
+						types.Int64Value(80),
+						types.Int64Value(443),
+					}),
+				}),
+			}),
+			expected: `{"name":"test","nested":{"enabled":true,"ports":[80,443]}}`,
+		},
+		"object-null-attributes": {
+			value: types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+				"name":   types.StringNull(),
+				"nested": types.ObjectNull(nestedType.AttrTypes),
+			}),
+			expected: `{"name":null,"nested":null}`,
+		},
+		"object-null": {
+			value:    types.ObjectNull(objectType.AttrTypes),
+			expected: `null`,
+		},
+		"custom-type": {
+			value: testtypes.String{
+				InternalString: types.StringValue("test"),
+				CreatedBy:      testtypes.StringType{},
+			},
+			expected: `"test"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			got, diags := valuejson.Marshal(ctx, testCase.value)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected marshal error: %s", diags)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected JSON difference: %s", diff)
+			}
+
+			roundTrip, diags := valuejson.Unmarshal(ctx, got, testCase.value.Type(ctx))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected unmarshal error: %s", diags)
+			}
+
+			if !roundTrip.Equal(testCase.value) {
+				t.Errorf("expected round trip value %s, got: %s", testCase.value, roundTrip)
+			}
+		})
+	}
+}
+
+func TestMarshalUnknown(t *testing.T) {
+	t.Parallel()
+
+	objectType := map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+	value := types.ObjectValueMust(objectType, map[string]attr.Value{
+		"id":   types.StringUnknown(),
+		"name": types.StringValue("test"),
+	})
+
+	testCases := map[string]struct {
+		options       valuejson.MarshalOptions
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"default": {
+			options: valuejson.MarshalOptions{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Marshal Value to JSON",
+					`The value cannot be encoded as JSON: value at AttributeName("id") is unknown, which cannot be represented in JSON`,
+				),
+			},
+		},
+		"sentinel": {
+			options: valuejson.MarshalOptions{
+				UnknownAsSentinel: true,
+			},
+			expected: `{"id":"<unknown>","name":"test"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.options.Marshal(context.Background(), value)
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected JSON difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !testCase.options.UnknownAsSentinel {
+				return
+			}
+
+			roundTrip, diags := valuejson.UnmarshalOptions{UnknownAsSentinel: true}.Unmarshal(context.Background(), got, value.Type(context.Background()))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected unmarshal error: %s", diags)
+			}
+
+			if !roundTrip.Equal(value) {
+				t.Errorf("expected round trip value %s, got: %s", value, roundTrip)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package valuejson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// UnmarshalOptions configures the decoding of JSON into values.
+type UnmarshalOptions struct {
+	// UnknownAsSentinel decodes the UnknownSentinel string as an unknown
+	// value, for any type. By default, the UnknownSentinel string is only
+	// decoded as a string.
+	UnknownAsSentinel bool
+}
+
+// Unmarshal returns the value of the given type decoded from JSON, with the
+// default UnmarshalOptions.
+func Unmarshal(ctx context.Context, data []byte, typ attr.Type) (attr.Value, diag.Diagnostics) {
+	return UnmarshalOptions{}.Unmarshal(ctx, data, typ)
+}
+
+// Unmarshal returns the value of the given type decoded from JSON. JSON
+// object properties which are missing for object types are decoded as null
+// values. Any type validation is performed on the decoded value.
+func (o UnmarshalOptions) Unmarshal(ctx context.Context, data []byte, typ attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ == nil {
+		diags.AddError(
+			"Unable to Unmarshal JSON to Value",
+			"The type is missing. This is always an issue in the caller and should be reported to the tooling developers.",
+		)
+
+		return nil, diags
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var jsonValue interface{}
+
+	err := decoder.Decode(&jsonValue)
+
+	if err == nil {
+		if _, tokenErr := decoder.Token(); !errors.Is(tokenErr, io.EOF) {
+			err = errors.New("unexpected data after the JSON value")
+		}
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Unmarshal JSON to Value",
+			"The data is not valid JSON: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	tfValue, err := o.terraformValue(tftypes.NewAttributePath(), typ.TerraformType(ctx), jsonValue)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Unmarshal JSON to Value",
+			"The JSON cannot be decoded as the type: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, tfValue, path.Empty())...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	value, err := typ.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Unmarshal JSON to Value",
+			"An unexpected error occurred while converting the Terraform value to the type: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return value, diags
+}
+
+// terraformValue returns the Terraform value of the given type for the
+// decoded JSON value.
+func (o UnmarshalOptions) terraformValue(tfPath *tftypes.AttributePath, typ tftypes.Type, jsonValue interface{}) (tftypes.Value, error) {
+	if jsonValue == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if s, ok := jsonValue.(string); ok && o.UnknownAsSentinel && s == UnknownSentinel {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		b, ok := jsonValue.(bool)
+
+		if !ok {
+			return tftypes.Value{}, unexpectedJSONError(tfPath, "boolean", jsonValue)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.Number):
+		n, ok := jsonValue.(json.Number)
+
+		if !ok {
+			return tftypes.Value{}, unexpectedJSONError(tfPath, "number", jsonValue)
+		}
+
+		f, _, err := big.ParseFloat(n.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("JSON number at %s is invalid: %w", pathString(tfPath), err)
+		}
+
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.String):
+		s, ok := jsonValue.(string)
+
+		if !ok {
+			return tftypes.Value{}, unexpectedJSONError(tfPath, "string", jsonValue)
+		}
+
+		return tftypes.NewValue(typ, s), nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.List:
+		return o.terraformValueElements(tfPath, typ, jsonValue, func(_ int) tftypes.Type { return t.ElementType })
+	case tftypes.Set:
+		return o.terraformValueElements(tfPath, typ, jsonValue, func(_ int) tftypes.Type { return t.ElementType })
+	case tftypes.Tuple:
+		jsonElements, ok := jsonValue.([]interface{})
+
+		if ok && len(jsonElements) != len(t.ElementTypes) {
+			return tftypes.Value{}, fmt.Errorf("JSON array at %s has %d elements, however the tuple type has %d elements", pathString(tfPath), len(jsonElements), len(t.ElementTypes))
+		}
+
+		return o.terraformValueElements(tfPath, typ, jsonValue, func(index int) tftypes.Type { return t.ElementTypes[index] })
+	case tftypes.Map:
+		jsonElements, ok := jsonValue.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, unexpectedJSONError(tfPath, "object", jsonValue)
+		}
+
+		elements := make(map[string]tftypes.Value, len(jsonElements))
+
+		for key, jsonElement := range jsonElements {
+			element, err := o.terraformValue(tfPath.WithElementKeyString(key), t.ElementType, jsonElement)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements[key] = element
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.Object:
+		jsonAttributes, ok := jsonValue.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, unexpectedJSONError(tfPath, "object", jsonValue)
+		}
+
+		for name := range jsonAttributes {
+			if _, ok := t.AttributeTypes[name]; !ok {
+				return tftypes.Value{}, fmt.Errorf("JSON object at %s has the property %q, which is not an attribute of the object type", pathString(tfPath), name)
+			}
+		}
+
+		attributes := make(map[string]tftypes.Value, len(t.AttributeTypes))
+
+		for name, attributeType := range t.AttributeTypes {
+			attribute, err := o.terraformValue(tfPath.WithAttributeName(name), attributeType, jsonAttributes[name])
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("type %s at %s is not supported", typ, pathString(tfPath))
+	}
+}
+
+// terraformValueElements returns the Terraform list, set, or tuple value of
+// the given type for the decoded JSON array. The elementType function
+// returns the type of the element at each index.
+func (o UnmarshalOptions) terraformValueElements(tfPath *tftypes.AttributePath, typ tftypes.Type, jsonValue interface{}, elementType func(int) tftypes.Type) (tftypes.Value, error) {
+	jsonElements, ok := jsonValue.([]interface{})
+
+	if !ok {
+		return tftypes.Value{}, unexpectedJSONError(tfPath, "array", jsonValue)
+	}
+
+	elements := make([]tftypes.Value, 0, len(jsonElements))
+
+	for index, jsonElement := range jsonElements {
+		element, err := o.terraformValue(tfPath.WithElementKeyInt(index), elementType(index), jsonElement)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	if err := tftypes.ValidateValue(typ, elements); err != nil {
+		return tftypes.Value{}, fmt.Errorf("JSON array at %s is invalid: %w", pathString(tfPath), err)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// unexpectedJSONError returns an error for a decoded JSON value which does
+// not match the expected JSON type.
+func unexpectedJSONError(tfPath *tftypes.AttributePath, expected string, jsonValue interface{}) error {
+	return fmt.Errorf("JSON value at %s must be %s %s, got %s", pathString(tfPath), article(expected), expected, jsonTypeName(jsonValue))
+}
+
+// article returns the indefinite article for the JSON type name.
+func article(name string) string {
+	if name == "array" || name == "object" {
+		return "an"
+	}
+
+	return "a"
+}
+
+// jsonTypeName returns the JSON type name of the decoded JSON value.
+func jsonTypeName(jsonValue interface{}) string {
+	switch jsonValue.(type) {
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", jsonValue)
+	}
+}
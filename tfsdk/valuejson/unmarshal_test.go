@@ -0,0 +1,140 @@
+package valuejson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk/valuejson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	objectAttrTypes := map[string]attr.Type{
+		"name": types.StringType,
+		"tags": types.MapType{ElemType: types.StringType},
+	}
+
+	testCases := map[string]struct {
+		options       valuejson.UnmarshalOptions
+		data          string
+		typ           attr.Type
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"object-missing-attribute": {
+			data: `{"name":"test"}`,
+			typ:  types.ObjectType{AttrTypes: objectAttrTypes},
+			expected: types.ObjectValueMust(objectAttrTypes, map[string]attr.Value{
+				"name": types.StringValue("test"),
+				"tags": types.MapNull(types.StringType),
+			}),
+		},
+		"object-unexpected-attribute": {
+			data: `{"name":"test","other":true}`,
+			typ:  types.ObjectType{AttrTypes: objectAttrTypes},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Unmarshal JSON to Value",
+					`The JSON cannot be decoded as the type: JSON object at the root has the property "other", which is not an attribute of the object type`,
+				),
+			},
+		},
+		"nested-wrong-type": {
+			data: `{"name":"test","tags":{"key":1}}`,
+			typ:  types.ObjectType{AttrTypes: objectAttrTypes},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Unmarshal JSON to Value",
+					`The JSON cannot be decoded as the type: JSON value at AttributeName("tags").ElementKeyString("key") must be a string, got number`,
+				),
+			},
+		},
+		"list-wrong-type": {
+			data: `{"key":"value"}`,
+			typ:  types.ListType{ElemType: types.StringType},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Unmarshal JSON to Value",
+					"The JSON cannot be decoded as the type: JSON value at the root must be an array, got object",
+				),
+			},
+		},
+		"int64-fractional": {
+			data: `1.5`,
+			typ:  types.Int64Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Int64 Type Validation Error",
+					"Value 1.5 is not an integer.",
+				),
+			},
+		},
+		"unknown-sentinel-default": {
+			data:     `"<unknown>"`,
+			typ:      types.StringType,
+			expected: types.StringValue("<unknown>"),
+		},
+		"unknown-sentinel": {
+			options: valuejson.UnmarshalOptions{
+				UnknownAsSentinel: true,
+			},
+			data:     `"<unknown>"`,
+			typ:      types.Int64Type,
+			expected: types.Int64Unknown(),
+		},
+		"invalid-json": {
+			data: `{"name":`,
+			typ:  types.ObjectType{AttrTypes: objectAttrTypes},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Unmarshal JSON to Value",
+					"The data is not valid JSON: unexpected EOF",
+				),
+			},
+		},
+		"trailing-data": {
+			data: `true false`,
+			typ:  types.BoolType,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Unmarshal JSON to Value",
+					"The data is not valid JSON: unexpected data after the JSON value",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.options.Unmarshal(context.Background(), []byte(testCase.data), testCase.typ)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testCase.expected == nil {
+				if got != nil {
+					t.Errorf("expected no value, got: %s", got)
+				}
+
+				return
+			}
+
+			if got == nil || !got.Equal(testCase.expected) {
+				t.Errorf("expected value %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
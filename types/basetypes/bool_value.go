@@ -141,10 +141,11 @@ func (b BoolValue) ValueBool() bool {
 	return b.value
 }
 
-// ValueBoolPointer returns a pointer to the known bool value, nil for a null
-// value, or a pointer to false for an unknown value.
+// ValueBoolPointer returns a pointer to the known bool value, or nil for a
+// null or unknown value. Use the IsUnknown method to differentiate an unknown
+// value from a null value.
 func (b BoolValue) ValueBoolPointer() *bool {
-	if b.IsNull() {
+	if b.IsNull() || b.IsUnknown() {
 		return nil
 	}
 
@@ -337,7 +337,7 @@ func TestBoolValueValueBoolPointer(t *testing.T) {
 		},
 		"unknown": {
 			input:    NewBoolUnknown(),
-			expected: pointer(false),
+			expected: nil,
 		},
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwnumber"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -95,7 +96,8 @@ func (t Float64Type) Validate(ctx context.Context, in tftypes.Value, path path.P
 		diags.AddAttributeError(
 			path,
 			"Float64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value),
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point. ", value.Text('g', 17))+
+				"The provider developer can use a Number attribute to support arbitrary precision.",
 		)
 		return diags
 	}
@@ -106,7 +108,18 @@ func (t Float64Type) Validate(ctx context.Context, in tftypes.Value, path path.P
 		diags.AddAttributeError(
 			path,
 			"Float64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value),
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point. ", value.Text('g', 17))+
+				"The provider developer can use a Number attribute to support arbitrary precision.",
+		)
+		return diags
+	}
+
+	if fwnumber.Float64PrecisionLoss(value, float64Value) {
+		diags.AddAttributeError(
+			path,
+			"Float64 Type Validation Error",
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point without losing precision. ", value.Text('g', 17))+
+				"The provider developer can use a Number attribute to support arbitrary precision.",
 		)
 		return diags
 	}
@@ -138,21 +151,17 @@ func (t Float64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (
 		return nil, err
 	}
 
-	f, accuracy := bigF.Float64()
+	f, err := fwnumber.Float64(bigF)
 
-	// Underflow
-	// Reference: https://pkg.go.dev/math/big#Float.Float64
-	if f == 0 && accuracy != big.Exact {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF)
-	}
-
-	// Overflow
-	// Reference: https://pkg.go.dev/math/big#Float.Float64
-	if math.IsInf(f, 0) {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF)
+	if err != nil {
+		return nil, err
 	}
 
-	return NewFloat64Value(f), nil
+	return Float64Value{
+		state:    attr.ValueStateKnown,
+		value:    f,
+		bigValue: bigF,
+	}, nil
 }
 
 // ValueType returns the Value type.
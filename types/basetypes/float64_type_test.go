@@ -66,7 +66,7 @@ func TestFloat64TypeValidate(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
 					"Float64 Type Validation Error",
-					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("4.9406564584124654417656879286822137236505980e-325")),
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point. The provider developer can use a Number attribute to support arbitrary precision.", testMustParseFloat("4.9406564584124654417656879286822137236505980e-325").Text('g', 17)),
 				),
 			},
 		},
@@ -82,7 +82,46 @@ func TestFloat64TypeValidate(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
 					"Float64 Type Validation Error",
-					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1.79769313486231570814527423731704356798070e+309")),
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point. The provider developer can use a Number attribute to support arbitrary precision.", testMustParseFloat("1.79769313486231570814527423731704356798070e+309").Text('g', 17)),
+				),
+			},
+		},
+		"negative-exponent-underflow": {
+			in: tftypes.NewValue(tftypes.Number, testMustParseFloat("1e-400")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Float64 Type Validation Error",
+					"Value 1e-400 cannot be represented as a 64-bit floating point."+
+						" The provider developer can use a Number attribute to support arbitrary precision.",
+				),
+			},
+		},
+		"decimal-string-float": {
+			in:       tftypes.NewValue(tftypes.Number, testMustParseFloat("0.1")),
+			expected: nil,
+		},
+		// HCL arithmetic results are sent with more precision than a float64.
+		"decimal-division": {
+			in:       tftypes.NewValue(tftypes.Number, new(big.Float).SetPrec(512).Quo(big.NewFloat(1), big.NewFloat(3))),
+			expected: nil,
+		},
+		"decimal-sum": {
+			in:       tftypes.NewValue(tftypes.Number, new(big.Float).SetPrec(512).Add(testMustParseFloat("0.1"), testMustParseFloat("0.2"))),
+			expected: nil,
+		},
+		"max-safe-integer": {
+			in:       tftypes.NewValue(tftypes.Number, testMustParseFloat("9007199254740992")),
+			expected: nil,
+		},
+		"max-safe-integer-above": {
+			in: tftypes.NewValue(tftypes.Number, testMustParseFloat("9007199254740993")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Float64 Type Validation Error",
+					"Value 9007199254740993 cannot be represented as a 64-bit floating point without losing precision."+
+						" The provider developer can use a Number attribute to support arbitrary precision.",
 				),
 			},
 		},
@@ -153,7 +192,7 @@ func TestFloat64TypeValueFromTerraform(t *testing.T) {
 		},
 		"SmallestNonzeroFloat64-below": {
 			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("4.9406564584124654417656879286822137236505980e-325")),
-			expectedErr: fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("4.9406564584124654417656879286822137236505980e-325")),
+			expectedErr: fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("4.9406564584124654417656879286822137236505980e-325").Text('g', 17)),
 		},
 		// Reference: https://pkg.go.dev/math/big#Float.Float64
 		// Reference: https://pkg.go.dev/math#pkg-constants
@@ -163,7 +202,32 @@ func TestFloat64TypeValueFromTerraform(t *testing.T) {
 		},
 		"MaxFloat64-above": {
 			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("1.79769313486231570814527423731704356798070e+309")),
-			expectedErr: fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1.79769313486231570814527423731704356798070e+309")),
+			expectedErr: fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1.79769313486231570814527423731704356798070e+309").Text('g', 17)),
+		},
+		"negative-exponent-underflow": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("1e-400")),
+			expectedErr: "Value 1e-400 cannot be represented as a 64-bit floating point.",
+		},
+		"decimal-string-float": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("0.1")),
+			expectation: NewFloat64Value(0.1),
+		},
+		// HCL arithmetic results are sent with more precision than a float64.
+		"decimal-division": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetPrec(512).Quo(big.NewFloat(1), big.NewFloat(3))),
+			expectation: NewFloat64Value(1.0 / 3.0),
+		},
+		"decimal-sum": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetPrec(512).Add(testMustParseFloat("0.1"), testMustParseFloat("0.2"))),
+			expectation: NewFloat64Value(0.3),
+		},
+		"max-safe-integer": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("9007199254740992")),
+			expectation: NewFloat64Value(9007199254740992),
+		},
+		"max-safe-integer-above": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("9007199254740993")),
+			expectedErr: "Value 9007199254740993 cannot be represented as a 64-bit floating point without losing precision.",
 		},
 	}
 	for name, test := range tests {
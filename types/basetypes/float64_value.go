@@ -3,6 +3,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -23,6 +24,29 @@ type Float64Valuable interface {
 	ToFloat64Value(ctx context.Context) (Float64Value, diag.Diagnostics)
 }
 
+// Float64ValuableWithSemanticEquals extends Float64Valuable with semantic
+// equality logic, such as a tolerance for values which a remote system
+// computes or rounds differently than the configuration.
+//
+// When a resource is read or applied, the framework calls the
+// Float64SemanticEquals method of the new value with the prior value, which
+// is the prior state during read and the planned state during apply. If the
+// values are semantically equal, the prior value is kept to prevent
+// differences.
+type Float64ValuableWithSemanticEquals interface {
+	Float64Valuable
+
+	// Float64SemanticEquals should return true if the given value is
+	// semantically equal to the current value. This logic is used to
+	// prevent Terraform data consistency errors and resource drift where a
+	// value change may have inconsequential differences, such as
+	// floating-point rounding.
+	//
+	// Only known values are compared with this method as changing a value's
+	// state implicitly represents a different value.
+	Float64SemanticEquals(context.Context, Float64Valuable) (bool, diag.Diagnostics)
+}
+
 // Float64Null creates a Float64 with a null value. Determine whether the value is
 // null via the Float64 type IsNull method.
 func NewFloat64Null() Float64Value {
@@ -72,9 +96,18 @@ type Float64Value struct {
 
 	// value contains the known value, if not null or unknown.
 	value float64
+
+	// bigValue contains the original Terraform number representation of a
+	// known value, if created from a Terraform value. This is used when
+	// returning the value to Terraform so representation differences which
+	// are the same float64, such as 0.3 in configuration, do not cause
+	// Terraform to detect a changed value.
+	bigValue *big.Float
 }
 
 // Equal returns true if `other` is a Float64 and has the same value as `f`.
+// Values are compared as float64, so different Terraform number
+// representations of the same float64, such as 0.3 and 3e-1, are equal.
 func (f Float64Value) Equal(other attr.Value) bool {
 	o, ok := other.(Float64Value)
 
@@ -101,6 +134,10 @@ func (f Float64Value) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
 		}
 
+		if f.bigValue != nil {
+			return tftypes.NewValue(tftypes.Number, new(big.Float).Copy(f.bigValue)), nil
+		}
+
 		return tftypes.NewValue(tftypes.Number, f.value), nil
 	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.Number, nil), nil
@@ -147,10 +184,11 @@ func (f Float64Value) ValueFloat64() float64 {
 	return f.value
 }
 
-// ValueFloat64Pointer returns a pointer to the known float64 value, nil for a
-// null value, or a pointer to 0.0 for an unknown value.
+// ValueFloat64Pointer returns a pointer to the known float64 value, or nil for a
+// null or unknown value. Use the IsUnknown method to differentiate an unknown
+// value from a null value.
 func (f Float64Value) ValueFloat64Pointer() *float64 {
-	if f.IsNull() {
+	if f.IsNull() || f.IsUnknown() {
 		return nil
 	}
 
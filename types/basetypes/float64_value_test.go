@@ -26,6 +26,25 @@ func testMustParseFloat(s string) *big.Float {
 	return f
 }
 
+// testMustFloat64ValueFromTerraform converts a string into a Float64Value
+// via a Terraform number, similar to configuration values, or panics on any
+// error.
+func testMustFloat64ValueFromTerraform(s string) Float64Value {
+	v, err := Float64Type{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.Number, testMustParseFloat(s)))
+
+	if err != nil {
+		panic(err)
+	}
+
+	f, ok := v.(Float64Value)
+
+	if !ok {
+		panic("unexpected value type")
+	}
+
+	return f
+}
+
 func TestFloat64ValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +61,14 @@ func TestFloat64ValueToTerraformValue(t *testing.T) {
 			input:       NewFloat64Value(123.456),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.456)),
 		},
+		"known-terraform-decimal": {
+			input:       testMustFloat64ValueFromTerraform("0.3"),
+			expectation: tftypes.NewValue(tftypes.Number, testMustParseFloat("0.3")),
+		},
+		"known-terraform-scientific": {
+			input:       testMustFloat64ValueFromTerraform("3e-1"),
+			expectation: tftypes.NewValue(tftypes.Number, testMustParseFloat("3e-1")),
+		},
 		"unknown": {
 			input:       NewFloat64Unknown(),
 			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
@@ -104,6 +131,21 @@ func TestFloat64ValueEqual(t *testing.T) {
 			candidate:   NewFloat64Value(456),
 			expectation: false,
 		},
+		"known-known-float64-representation": {
+			input:       testMustFloat64ValueFromTerraform("0.3"),
+			candidate:   NewFloat64Value(0.3),
+			expectation: true,
+		},
+		"known-known-scientific-representation": {
+			input:       testMustFloat64ValueFromTerraform("0.3"),
+			candidate:   testMustFloat64ValueFromTerraform("3e-1"),
+			expectation: true,
+		},
+		"known-known-rounding-diff": {
+			input:       NewFloat64Value(0.30000000000000004),
+			candidate:   NewFloat64Value(0.3),
+			expectation: false,
+		},
 		"known-unknown": {
 			input:       NewFloat64Value(123),
 			candidate:   NewFloat64Unknown(),
@@ -342,7 +384,7 @@ func TestFloat64ValueValueFloat64Pointer(t *testing.T) {
 		},
 		"unknown": {
 			input:    NewFloat64Unknown(),
-			expected: pointer(0.0),
+			expected: nil,
 		},
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwnumber"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -90,7 +91,7 @@ func (t Int64Type) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			fmt.Sprintf("Value %s is not an integer.", value),
+			fmt.Sprintf("Value %s is not an integer.", diag.FormatNumber(value)),
 		)
 		return diags
 	}
@@ -101,7 +102,8 @@ func (t Int64Type) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", value),
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer. ", diag.FormatNumber(value))+
+				"The provider developer can use a Number attribute to support arbitrary precision.",
 		)
 		return diags
 	}
@@ -133,14 +135,10 @@ func (t Int64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 		return nil, err
 	}
 
-	if !bigF.IsInt() {
-		return nil, fmt.Errorf("Value %s is not an integer.", bigF)
-	}
-
-	i, accuracy := bigF.Int64()
+	i, err := fwnumber.Int64(bigF)
 
-	if accuracy != 0 {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit integer.", bigF)
+	if err != nil {
+		return nil, err
 	}
 
 	return NewInt64Value(i), nil
@@ -2,12 +2,85 @@ package basetypes
 
 import (
 	"context"
+	"math"
+	"math/big"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestInt64TypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"zero": {
+			in:       tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+			expected: nil,
+		},
+		"float": {
+			in: tftypes.NewValue(tftypes.Number, testMustParseFloat("1.5")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"Value 1.5 is not an integer.",
+				),
+			},
+		},
+		"MaxInt64": {
+			in:       tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(math.MaxInt64)),
+			expected: nil,
+		},
+		"MaxInt64-above": {
+			in: tftypes.NewValue(tftypes.Number, testMustParseFloat("9223372036854775808")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"Value 9223372036854775808 cannot be represented as a 64-bit integer."+
+						" The provider developer can use a Number attribute to support arbitrary precision.",
+				),
+			},
+		},
+		"MinInt64": {
+			in:       tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(math.MinInt64)),
+			expected: nil,
+		},
+		"MinInt64-below": {
+			in: tftypes.NewValue(tftypes.Number, testMustParseFloat("-9223372036854775809")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"Value -9223372036854775809 cannot be represented as a 64-bit integer."+
+						" The provider developer can use a Number attribute to support arbitrary precision.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Int64Type{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64TypeValueFromTerraform(t *testing.T) {
 	t.Parallel()
 
@@ -33,6 +106,26 @@ func TestInt64TypeValueFromTerraform(t *testing.T) {
 			input:       tftypes.NewValue(tftypes.String, "oops"),
 			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
 		},
+		"float": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("1.5")),
+			expectedErr: "Value 1.5 is not an integer.",
+		},
+		"MaxInt64": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(math.MaxInt64)),
+			expectation: NewInt64Value(math.MaxInt64),
+		},
+		"MaxInt64-above": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("9223372036854775808")),
+			expectedErr: "Value 9223372036854775808 cannot be represented as a 64-bit integer.",
+		},
+		"MinInt64": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(math.MinInt64)),
+			expectation: NewInt64Value(math.MinInt64),
+		},
+		"MinInt64-below": {
+			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("-9223372036854775809")),
+			expectedErr: "Value -9223372036854775809 cannot be represented as a 64-bit integer.",
+		},
 	}
 	for name, test := range tests {
 		name, test := name, test
@@ -141,10 +141,11 @@ func (i Int64Value) ValueInt64() int64 {
 	return i.value
 }
 
-// ValueInt64Pointer returns a pointer to the known int64 value, nil for a
-// null value, or a pointer to 0 for an unknown value.
+// ValueInt64Pointer returns a pointer to the known int64 value, or nil for a
+// null or unknown value. Use the IsUnknown method to differentiate an unknown
+// value from a null value.
 func (i Int64Value) ValueInt64Pointer() *int64 {
-	if i.IsNull() {
+	if i.IsNull() || i.IsUnknown() {
 		return nil
 	}
 
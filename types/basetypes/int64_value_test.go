@@ -299,7 +299,7 @@ func TestInt64ValueValueInt64Pointer(t *testing.T) {
 		},
 		"unknown": {
 			input:    NewInt64Unknown(),
-			expected: pointer(int64(0)),
+			expected: nil,
 		},
 	}
 
@@ -77,6 +77,9 @@ func NewListValue(elementType attr.Type, elements []attr.Value) (ListValue, diag
 // NewListValueFrom creates a List with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the List type Elements or ElementsAs methods.
+//
+// A nil slice creates a null List, while an empty slice creates a known List
+// with zero elements.
 func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any) (ListValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
@@ -103,6 +106,34 @@ func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any)
 	return list, diags
 }
 
+// NewListValueFromMust creates a List with a known value, using reflection
+// rules, converting any diagnostics into a panic at runtime. Access the value
+// via the List type Elements or ElementsAs methods.
+//
+// This creation function is only recommended to create List values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func NewListValueFromMust(ctx context.Context, elementType attr.Type, elements any) ListValue {
+	list, diags := NewListValueFrom(ctx, elementType, elements)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewListValueFromMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return list
+}
+
 // NewListValueMust creates a List with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the List
 // type Elements or ElementsAs methods.
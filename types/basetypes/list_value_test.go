@@ -105,6 +105,49 @@ func TestNewListValueFrom(t *testing.T) {
 				[]attr.Value{},
 			),
 		},
+		"valid-StringType{}-[]string-nil": {
+			elementType: StringType{},
+			elements:    []string(nil),
+			expected:    NewListNull(StringType{}),
+		},
+		"valid-ObjectType{}-[]struct": {
+			elementType: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": StringType{},
+				},
+			},
+			elements: []struct {
+				String string `tfsdk:"string"`
+			}{
+				{String: "test1"},
+				{String: "test2"},
+			},
+			expected: NewListValueMust(
+				ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"string": StringType{},
+					},
+				},
+				[]attr.Value{
+					NewObjectValueMust(
+						map[string]attr.Type{
+							"string": StringType{},
+						},
+						map[string]attr.Value{
+							"string": NewStringValue("test1"),
+						},
+					),
+					NewObjectValueMust(
+						map[string]attr.Type{
+							"string": StringType{},
+						},
+						map[string]attr.Value{
+							"string": NewStringValue("test2"),
+						},
+					),
+				},
+			),
+		},
 		"valid-StringType{}-[]types.String": {
 			elementType: StringType{},
 			elements: []StringValue{
@@ -198,6 +241,36 @@ func TestNewListValueFrom(t *testing.T) {
 	}
 }
 
+func TestNewListValueFromMust(t *testing.T) {
+	t.Parallel()
+
+	expected := NewListValueMust(
+		StringType{},
+		[]attr.Value{
+			NewStringValue("test1"),
+			NewStringValue("test2"),
+		},
+	)
+
+	got := NewListValueFromMust(context.Background(), StringType{}, []string{"test1", "test2"})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestNewListValueFromMust_panic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+
+	NewListValueFromMust(context.Background(), StringType{}, []bool{true})
+}
+
 func TestListElementsAs_stringSlice(t *testing.T) {
 	t.Parallel()
 
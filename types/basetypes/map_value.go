@@ -79,6 +79,9 @@ func NewMapValue(elementType attr.Type, elements map[string]attr.Value) (MapValu
 // The elements must be a map of string keys to values which can convert into
 // the given element type. Access the value via the Map type Elements or
 // ElementsAs methods.
+//
+// A nil map creates a null Map, while an empty map creates a known Map
+// with zero elements.
 func NewMapValueFrom(ctx context.Context, elementType attr.Type, elements any) (MapValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
@@ -105,6 +108,34 @@ func NewMapValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 	return m, diags
 }
 
+// NewMapValueFromMust creates a Map with a known value, using reflection
+// rules, converting any diagnostics into a panic at runtime. Access the value
+// via the Map type Elements or ElementsAs methods.
+//
+// This creation function is only recommended to create Map values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func NewMapValueFromMust(ctx context.Context, elementType attr.Type, elements any) MapValue {
+	m, diags := NewMapValueFrom(ctx, elementType, elements)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewMapValueFromMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return m
+}
+
 // NewMapValueMust creates a Map with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Map
 // type Elements or ElementsAs methods.
@@ -159,6 +190,32 @@ func (m MapValue) Elements() map[string]attr.Value {
 	return result
 }
 
+// ElementKeys returns the keys of the elements for the Map, sorted in
+// ascending order. This enables deterministic iteration over the elements,
+// such as when creating API request payloads or log output.
+func (m MapValue) ElementKeys() []string {
+	result := make([]string, 0, len(m.elements))
+
+	for key := range m.elements {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// ForEachElement calls the given function with each element key and value
+// for the Map, in ascending key order. Iteration stops if the function
+// returns false.
+func (m MapValue) ForEachElement(f func(key string, value attr.Value) bool) {
+	for _, key := range m.ElementKeys() {
+		if !f(key, m.elements[key]) {
+			return
+		}
+	}
+}
+
 // ElementsAs populates `target` with the elements of the MapValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
@@ -284,21 +341,15 @@ func (m MapValue) String() string {
 		return attr.NullValueString
 	}
 
-	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(m.Elements()))
-	for k := range m.Elements() {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
 	var res strings.Builder
 
+	// We want the output to be consistent, so we sort the output by key
 	res.WriteString("{")
-	for i, k := range keys {
+	for i, k := range m.ElementKeys() {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf("%q:%s", k, m.Elements()[k].String()))
+		res.WriteString(fmt.Sprintf("%q:%s", k, m.elements[k].String()))
 	}
 	res.WriteString("}")
 
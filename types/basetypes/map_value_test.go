@@ -381,6 +381,89 @@ func TestMapValueElements_immutable(t *testing.T) {
 	}
 }
 
+func TestMapValueElementKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected []string
+	}{
+		"known": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"c": NewStringValue("test-value-c"),
+				"a": NewStringValue("test-value-a"),
+				"b": NewStringValue("test-value-b"),
+			}),
+			expected: []string{"a", "b", "c"},
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: []string{},
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ElementKeys()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueForEachElement(t *testing.T) {
+	t.Parallel()
+
+	input := NewMapValueMust(StringType{}, map[string]attr.Value{
+		"c": NewStringValue("test-value-c"),
+		"a": NewStringValue("test-value-a"),
+		"b": NewStringValue("test-value-b"),
+	})
+
+	testCases := map[string]struct {
+		stopKey  string
+		expected []string
+	}{
+		"all": {
+			expected: []string{"a:test-value-a", "b:test-value-b", "c:test-value-c"},
+		},
+		"stop": {
+			stopKey:  "b",
+			expected: []string{"a:test-value-a", "b:test-value-b"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			input.ForEachElement(func(key string, value attr.Value) bool {
+				got = append(got, key+":"+value.(StringValue).ValueString())
+
+				return key != testCase.stopKey
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapValueElementType(t *testing.T) {
 	t.Parallel()
 
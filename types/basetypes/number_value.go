@@ -3,12 +3,14 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwnumber"
 )
 
 var (
@@ -53,6 +55,30 @@ func NewNumberValue(value *big.Float) NumberValue {
 	}
 }
 
+// NewNumberValueFromInt64 creates a Number with a known value from an int64.
+// Access the value via the Number type ValueInt64 method.
+func NewNumberValueFromInt64(value int64) NumberValue {
+	return NumberValue{
+		state: attr.ValueStateKnown,
+		value: new(big.Float).SetInt64(value),
+	}
+}
+
+// NewNumberValueFromFloat64 creates a Number with a known value from a
+// float64. Access the value via the Number type ValueFloat64 method. If the
+// given value is NaN, which cannot be represented as a Terraform number, a
+// null Number is created.
+func NewNumberValueFromFloat64(value float64) NumberValue {
+	if math.IsNaN(value) {
+		return NewNumberNull()
+	}
+
+	return NumberValue{
+		state: attr.ValueStateKnown,
+		value: new(big.Float).SetFloat64(value),
+	}
+}
+
 // NumberValue represents a number value, exposed as a *big.Float. Numbers can be
 // floats or integers.
 type NumberValue struct {
@@ -92,6 +118,7 @@ func (n NumberValue) ToTerraformValue(_ context.Context) (tftypes.Value, error)
 }
 
 // Equal returns true if `other` is a Number and has the same value as `n`.
+// Negative and positive zero are considered the same value.
 func (n NumberValue) Equal(other attr.Value) bool {
 	o, ok := other.(NumberValue)
 
@@ -135,12 +162,65 @@ func (n NumberValue) String() string {
 	return n.value.String()
 }
 
-// ValueBigFloat returns the known *big.Float value. If Number is null or unknown, returns
-// 0.0.
+// ValueBigFloat returns the known *big.Float value. If Number is null or
+// unknown, returns nil. Use the IsUnknown method to differentiate an unknown
+// value from a null value.
 func (n NumberValue) ValueBigFloat() *big.Float {
 	return n.value
 }
 
+// ValueInt64 returns the known value as an int64. An error diagnostic is
+// returned if the value is not an integer or is outside the int64 range,
+// rather than truncating it. If Number is null or unknown, returns 0.
+func (n NumberValue) ValueInt64(_ context.Context) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.state != attr.ValueStateKnown || n.value == nil {
+		return 0, diags
+	}
+
+	i, err := fwnumber.Int64(n.value)
+
+	if err != nil {
+		diags.AddError(
+			diag.SummaryValueConversionError,
+			"An unexpected error was encountered trying to convert the Number value to int64. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return i, diags
+}
+
+// ValueFloat64 returns the known value as a float64. An error diagnostic is
+// returned if the value overflows, underflows, or loses precision as a
+// float64, rather than rounding it. Decimal values without an exact binary
+// representation, such as 0.1, are returned as the nearest float64. If Number
+// is null or unknown, returns 0.0.
+func (n NumberValue) ValueFloat64(_ context.Context) (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.state != attr.ValueStateKnown || n.value == nil {
+		return 0.0, diags
+	}
+
+	f, err := fwnumber.Float64(n.value)
+
+	if err != nil {
+		diags.AddError(
+			diag.SummaryValueConversionError,
+			"An unexpected error was encountered trying to convert the Number value to float64. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return 0.0, diags
+	}
+
+	return f, diags
+}
+
 // ToNumberValue returns Number.
 func (n NumberValue) ToNumberValue(context.Context) (NumberValue, diag.Diagnostics) {
 	return n, nil
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -97,6 +98,16 @@ func TestNumberValueEqual(t *testing.T) {
 			candidate:   NewNumberNull(),
 			expectation: false,
 		},
+		"known-known-zero-negative-zero": {
+			input:       NewNumberValue(big.NewFloat(0)),
+			candidate:   NewNumberValue(big.NewFloat(math.Copysign(0, -1))),
+			expectation: true,
+		},
+		"known-known-from-int64-from-float64": {
+			input:       NewNumberValueFromInt64(123),
+			candidate:   NewNumberValueFromFloat64(123),
+			expectation: true,
+		},
 		"known-wrong-type": {
 			input:       NewNumberValue(big.NewFloat(123)),
 			candidate:   NewFloat64Value(123),
@@ -352,3 +363,240 @@ func TestNumberValueValueBigFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNewNumberValueFromInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    int64
+		expected NumberValue
+	}{
+		"zero": {
+			input:    0,
+			expected: NewNumberValue(big.NewFloat(0)),
+		},
+		"max": {
+			input:    math.MaxInt64,
+			expected: NewNumberValue(testMustParseFloat("9223372036854775807")),
+		},
+		"min": {
+			input:    math.MinInt64,
+			expected: NewNumberValue(testMustParseFloat("-9223372036854775808")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewNumberValueFromInt64(testCase.input)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewNumberValueFromFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    float64
+		expected NumberValue
+	}{
+		"zero": {
+			input:    0,
+			expected: NewNumberValue(big.NewFloat(0)),
+		},
+		"negative-zero": {
+			input:    math.Copysign(0, -1),
+			expected: NewNumberValue(big.NewFloat(0)),
+		},
+		"fractional": {
+			input:    1.5,
+			expected: NewNumberValue(big.NewFloat(1.5)),
+		},
+		"nan": {
+			input:    math.NaN(),
+			expected: NewNumberNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewNumberValueFromFloat64(testCase.input)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      int64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(123)),
+			expected: 123,
+		},
+		"known-max": {
+			input:    NewNumberValue(testMustParseFloat("9223372036854775807")),
+			expected: math.MaxInt64,
+		},
+		"known-2^63": {
+			input: NewNumberValue(testMustParseFloat("9223372036854775808")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Number value to int64. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value 9223372036854775808 cannot be represented as a 64-bit integer.",
+				),
+			},
+		},
+		"known-1e100": {
+			input: NewNumberValue(testMustParseFloat("1e100")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Number value to int64. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value 1e+100 cannot be represented as a 64-bit integer.",
+				),
+			},
+		},
+		"known-fractional": {
+			input: NewNumberValue(testMustParseFloat("1.5")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Number value to int64. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value 1.5 is not an integer.",
+				),
+			},
+		},
+		"known-nil": {
+			input:    NewNumberValue(nil),
+			expected: 0,
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueInt64(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      float64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(1.5)),
+			expected: 1.5,
+		},
+		"known-decimal": {
+			input:    NewNumberValue(testMustParseFloat("0.1")),
+			expected: 0.1,
+		},
+		"known-1e100": {
+			input:    NewNumberValue(testMustParseFloat("1e100")),
+			expected: 1e100,
+		},
+		"known-2^63": {
+			input:    NewNumberValue(testMustParseFloat("9223372036854775808")),
+			expected: 9223372036854775808,
+		},
+		"known-precision-loss": {
+			input: NewNumberValue(testMustParseFloat("9007199254740993")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Number value to float64. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value 9007199254740993 cannot be represented as a 64-bit floating point without losing precision.",
+				),
+			},
+		},
+		"known-overflow": {
+			input: NewNumberValue(testMustParseFloat("1e400")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Number value to float64. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value 1e+400 cannot be represented as a 64-bit floating point.",
+				),
+			},
+		},
+		"known-nil": {
+			input:    NewNumberValue(nil),
+			expected: 0,
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueFloat64(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
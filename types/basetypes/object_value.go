@@ -133,6 +133,34 @@ func NewObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type
 	return m, diags
 }
 
+// NewObjectValueFromMust creates a Object with a known value, using reflection
+// rules, converting any diagnostics into a panic at runtime. Access the value
+// via the Object type Attributes or As methods.
+//
+// This creation function is only recommended to create Object values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func NewObjectValueFromMust(ctx context.Context, attributeTypes map[string]attr.Type, attributes any) ObjectValue {
+	object, diags := NewObjectValueFrom(ctx, attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewObjectValueFromMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
 // NewObjectValueMust creates a Object with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Object
 // type Elements or ElementsAs methods.
@@ -223,6 +251,32 @@ func (o ObjectValue) Attributes() map[string]attr.Value {
 	return result
 }
 
+// AttributeNames returns the names of the known attribute values for the
+// Object, sorted in ascending order. This enables deterministic iteration over
+// the attributes, such as when creating API request payloads or log output.
+func (o ObjectValue) AttributeNames() []string {
+	result := make([]string, 0, len(o.attributes))
+
+	for name := range o.attributes {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// ForEachAttribute calls the given function with each known attribute name
+// and value for the Object, in ascending name order. Iteration stops if the
+// function returns false.
+func (o ObjectValue) ForEachAttribute(f func(name string, value attr.Value) bool) {
+	for _, name := range o.AttributeNames() {
+		if !f(name, o.attributes[name]) {
+			return
+		}
+	}
+}
+
 // AttributeTypes returns a copy of the mapping of attribute types for the Object.
 func (o ObjectValue) AttributeTypes(_ context.Context) map[string]attr.Type {
 	// Ensure callers cannot mutate the internal attribute types
@@ -352,21 +406,15 @@ func (o ObjectValue) String() string {
 		return attr.NullValueString
 	}
 
-	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(o.Attributes()))
-	for k := range o.Attributes() {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
 	var res strings.Builder
 
+	// We want the output to be consistent, so we sort the output by key
 	res.WriteString("{")
-	for i, k := range keys {
+	for i, k := range o.AttributeNames() {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf(`"%s":%s`, k, o.Attributes()[k].String()))
+		res.WriteString(fmt.Sprintf(`"%s":%s`, k, o.attributes[k].String()))
 	}
 	res.WriteString("}")
 
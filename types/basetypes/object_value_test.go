@@ -214,8 +214,7 @@ func TestNewObjectValueFrom(t *testing.T) {
 				),
 			},
 		},
-		// This likely should be valid, however it is not currently.
-		"invalid-map[string]attr.Value": {
+		"valid-map[string]attr.Value": {
 			attributeTypes: map[string]attr.Type{
 				"bool":   BoolType{},
 				"string": StringType{},
@@ -224,20 +223,49 @@ func TestNewObjectValueFrom(t *testing.T) {
 				"bool":   NewBoolNull(),
 				"string": NewStringNull(),
 			},
-			expected: NewObjectUnknown(
+			expected: NewObjectValueMust(
 				map[string]attr.Type{
 					"bool":   BoolType{},
 					"string": StringType{},
 				},
+				map[string]attr.Value{
+					"bool":   NewBoolNull(),
+					"string": NewStringNull(),
+				},
 			),
-			expectedDiags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(
-					path.Empty(),
-					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"cannot use type map[string]attr.Value as schema type basetypes.ObjectType; basetypes.ObjectType must be an attr.TypeWithElementType to hold map[string]attr.Value",
-				),
+		},
+		"valid-map[string]any": {
+			attributeTypes: map[string]attr.Type{
+				"bool":   BoolType{},
+				"string": StringType{},
+			},
+			attributes: map[string]any{
+				"bool":   true,
+				"string": "test",
 			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"bool":   BoolType{},
+					"string": StringType{},
+				},
+				map[string]attr.Value{
+					"bool":   NewBoolValue(true),
+					"string": NewStringValue("test"),
+				},
+			),
+		},
+		"valid-map[string]attr.Value-nil": {
+			attributeTypes: map[string]attr.Type{
+				"bool":   BoolType{},
+				"string": StringType{},
+			},
+			attributes: map[string]attr.Value(nil),
+			expected: NewObjectNull(
+				map[string]attr.Type{
+					"bool":   BoolType{},
+					"string": StringType{},
+				},
+			),
 		},
 		"invalid-not-struct": {
 			attributeTypes: map[string]attr.Type{
@@ -272,8 +300,20 @@ func TestNewObjectValueFrom(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty(),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"cannot use type map[string]bool as schema type basetypes.ObjectType; basetypes.ObjectType must be an attr.TypeWithElementType to hold map[string]bool",
+					"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"map key \"key1\" does not match any attribute in supplied attr.Type basetypes.ObjectType",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"map is missing key \"bool\" for attribute in supplied attr.Type basetypes.ObjectType",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"map is missing key \"string\" for attribute in supplied attr.Type basetypes.ObjectType",
 				),
 			},
 		},
@@ -601,6 +641,105 @@ func TestObjectValueAttributes(t *testing.T) {
 	}
 }
 
+func TestObjectValueAttributeNames(t *testing.T) {
+	t.Parallel()
+
+	testAttributeTypes := map[string]attr.Type{
+		"c": StringType{},
+		"a": StringType{},
+		"b": StringType{},
+	}
+
+	testCases := map[string]struct {
+		input    ObjectValue
+		expected []string
+	}{
+		"known": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"c": NewStringValue("test-value-c"),
+					"a": NewStringValue("test-value-a"),
+					"b": NewStringValue("test-value-b"),
+				},
+			),
+			expected: []string{"a", "b", "c"},
+		},
+		"null": {
+			input:    NewObjectNull(testAttributeTypes),
+			expected: []string{},
+		},
+		"unknown": {
+			input:    NewObjectUnknown(testAttributeTypes),
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.AttributeNames()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValueForEachAttribute(t *testing.T) {
+	t.Parallel()
+
+	input := NewObjectValueMust(
+		map[string]attr.Type{
+			"c": StringType{},
+			"a": StringType{},
+			"b": StringType{},
+		},
+		map[string]attr.Value{
+			"c": NewStringValue("test-value-c"),
+			"a": NewStringValue("test-value-a"),
+			"b": NewStringValue("test-value-b"),
+		},
+	)
+
+	testCases := map[string]struct {
+		stopName string
+		expected []string
+	}{
+		"all": {
+			expected: []string{"a:test-value-a", "b:test-value-b", "c:test-value-c"},
+		},
+		"stop": {
+			stopName: "b",
+			expected: []string{"a:test-value-a", "b:test-value-b"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			input.ForEachAttribute(func(name string, value attr.Value) bool {
+				got = append(got, name+":"+value.(StringValue).ValueString())
+
+				return name != testCase.stopName
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestObjectValueAttributes_immutable(t *testing.T) {
 	t.Parallel()
 
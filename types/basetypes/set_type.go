@@ -186,7 +186,7 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 			// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/172
 			diags.AddAttributeError(
 				path,
-				"Duplicate Set Element",
+				diag.SummaryDuplicateSetElement,
 				fmt.Sprintf("This attribute contains duplicate values of: %s", elemInner),
 			)
 		}
@@ -77,6 +77,9 @@ func NewSetValue(elementType attr.Type, elements []attr.Value) (SetValue, diag.D
 // NewSetValueFrom creates a Set with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
+//
+// A nil slice creates a null Set, while an empty slice creates a known Set
+// with zero elements.
 func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (SetValue, diag.Diagnostics) {
 	attrValue, diags := reflect.FromValue(
 		ctx,
@@ -103,6 +106,34 @@ func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 	return set, diags
 }
 
+// NewSetValueFromMust creates a Set with a known value, using reflection
+// rules, converting any diagnostics into a panic at runtime. Access the value
+// via the Set type Elements or ElementsAs methods.
+//
+// This creation function is only recommended to create Set values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func NewSetValueFromMust(ctx context.Context, elementType attr.Type, elements any) SetValue {
+	set, diags := NewSetValueFrom(ctx, elementType, elements)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSetValueFromMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return set
+}
+
 // NewSetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.
@@ -152,10 +152,11 @@ func (s StringValue) ValueString() string {
 	return s.value
 }
 
-// ValueStringPointer returns a pointer to the known string value, nil for a
-// null value, or a pointer to "" for an unknown value.
+// ValueStringPointer returns a pointer to the known string value, or nil for a
+// null or unknown value. Use the IsUnknown method to differentiate an unknown
+// value from a null value.
 func (s StringValue) ValueStringPointer() *string {
-	if s.IsNull() {
+	if s.IsNull() || s.IsUnknown() {
 		return nil
 	}
 
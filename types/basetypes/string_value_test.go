@@ -293,7 +293,7 @@ func TestStringValueValueStringPointer(t *testing.T) {
 		},
 		"unknown": {
 			input:    NewStringUnknown(),
-			expected: pointer(""),
+			expected: nil,
 		},
 	}
 
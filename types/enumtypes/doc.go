@@ -0,0 +1,9 @@
+// Package enumtypes contains custom types for Go enum types, which are Go
+// types with an underlying string type and a fixed set of allowed values,
+// such as those typically found in remote system API clients.
+//
+// Create a type with NewStringType and set it as the CustomType of a string
+// attribute. The type includes validation of the allowed values and
+// descriptions which list them for documentation. Use the associated
+// StringValue type in data models, which converts to the Go enum type.
+package enumtypes
@@ -0,0 +1,5 @@
+package enumtypes_test
+
+func pointer[T any](value T) *T {
+	return &value
+}
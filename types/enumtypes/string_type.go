@@ -0,0 +1,215 @@
+package enumtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = StringType[string]{}
+	_ xattr.TypeWithValidate  = StringType[string]{}
+)
+
+// StringType is a custom string type which only allows the values of the Go
+// enum type T. StringValue is the associated value type.
+//
+// Use NewStringType to create the type, rather than the zero value, which
+// has no allowed values. For example:
+//
+//	var colorType = enumtypes.NewStringType(api.ColorBlue, api.ColorRed)
+//
+//	"color": schema.StringAttribute{
+//		CustomType:          colorType,
+//		MarkdownDescription: "Color of the widget. " + colorType.MarkdownDescription(),
+//		Required:            true,
+//	},
+type StringType[T ~string] struct {
+	basetypes.StringType
+
+	values []T
+}
+
+// NewStringType returns a StringType which allows the given values.
+func NewStringType[T ~string](values ...T) StringType[T] {
+	return StringType[T]{
+		values: append([]T(nil), values...),
+	}
+}
+
+// AllowedValues returns a copy of the allowed values.
+func (t StringType[T]) AllowedValues() []T {
+	return append([]T(nil), t.values...)
+}
+
+// Description returns a plain text description of the allowed values, which
+// is intended to be added to attribute descriptions.
+func (t StringType[T]) Description() string {
+	return "Allowed values: " + t.quotedValues(`"`) + "."
+}
+
+// Equal returns true if the given type is a StringType of the same Go enum
+// type with the same allowed values.
+func (t StringType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(StringType[T])
+
+	if !ok {
+		return false
+	}
+
+	if len(t.values) != len(other.values) {
+		return false
+	}
+
+	for i, value := range t.values {
+		if other.values[i] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarkdownDescription returns a Markdown description of the allowed values,
+// which is intended to be added to attribute Markdown descriptions.
+func (t StringType[T]) MarkdownDescription() string {
+	return "Allowed values: " + t.quotedValues("`") + "."
+}
+
+// NullValue returns a null StringValue of this type.
+func (t StringType[T]) NullValue() StringValue[T] {
+	return StringValue[T]{
+		StringValue: basetypes.NewStringNull(),
+		values:      t.values,
+	}
+}
+
+// PointerValue returns a StringValue of this type, which is null if the
+// given pointer is nil.
+func (t StringType[T]) PointerValue(value *T) StringValue[T] {
+	if value == nil {
+		return t.NullValue()
+	}
+
+	return t.Value(*value)
+}
+
+// String returns a human readable string of the type name.
+func (t StringType[T]) String() string {
+	var zero T
+
+	return fmt.Sprintf("enumtypes.StringType[%T]", zero)
+}
+
+// UnknownValue returns an unknown StringValue of this type.
+func (t StringType[T]) UnknownValue() StringValue[T] {
+	return StringValue[T]{
+		StringValue: basetypes.NewStringUnknown(),
+		values:      t.values,
+	}
+}
+
+// Validate returns an error diagnostic if a known value is not one of the
+// allowed values.
+func (t StringType[T]) Validate(ctx context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Enum Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	for _, allowedValue := range t.values {
+		if string(allowedValue) == value {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		valuePath,
+		"Invalid Attribute Value Match",
+		fmt.Sprintf("Attribute %s value must be one of: %s, got: %q", valuePath, t.quotedValues(`"`), value),
+	)
+
+	return diags
+}
+
+// Value returns a known StringValue of this type.
+func (t StringType[T]) Value(value T) StringValue[T] {
+	return StringValue[T]{
+		StringValue: basetypes.NewStringValue(string(value)),
+		values:      t.values,
+	}
+}
+
+// ValueFromString returns a StringValue of this type given a
+// basetypes.StringValue.
+func (t StringType[T]) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return StringValue[T]{
+		StringValue: in,
+		values:      t.values,
+	}, nil
+}
+
+// ValueFromTerraform returns a StringValue of this type given a
+// tftypes.Value.
+func (t StringType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns the StringValue type.
+func (t StringType[T]) ValueType(_ context.Context) attr.Value {
+	return StringValue[T]{
+		values: t.values,
+	}
+}
+
+// quotedValues returns the allowed values, each surrounded by the quote
+// string, separated by commas.
+func (t StringType[T]) quotedValues(quote string) string {
+	quoted := make([]string, 0, len(t.values))
+
+	for _, value := range t.values {
+		quoted = append(quoted, quote+string(value)+quote)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,212 @@
+package enumtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/enumtypes"
+)
+
+type testColor string
+
+const (
+	testColorBlue testColor = "blue"
+	testColorRed  testColor = "red"
+)
+
+type testSize string
+
+var testColorType = enumtypes.NewStringType(testColorBlue, testColorRed)
+
+func TestStringTypeDescription(t *testing.T) {
+	t.Parallel()
+
+	expected := `Allowed values: "blue", "red".`
+
+	if got := testColorType.Description(); got != expected {
+		t.Errorf("expected %q, got: %q", expected, got)
+	}
+
+	expectedMarkdown := "Allowed values: `blue`, `red`."
+
+	if got := testColorType.MarkdownDescription(); got != expectedMarkdown {
+		t.Errorf("expected %q, got: %q", expectedMarkdown, got)
+	}
+}
+
+func TestStringTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			other:    enumtypes.NewStringType(testColorBlue, testColorRed),
+			expected: true,
+		},
+		"different-values": {
+			other:    enumtypes.NewStringType(testColorBlue),
+			expected: false,
+		},
+		"different-values-order": {
+			other:    enumtypes.NewStringType(testColorRed, testColorBlue),
+			expected: false,
+		},
+		"different-enum-type": {
+			other:    enumtypes.NewStringType[testSize]("blue", "red"),
+			expected: false,
+		},
+		"StringType": {
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testColorType.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestStringTypeString(t *testing.T) {
+	t.Parallel()
+
+	expected := "enumtypes.StringType[enumtypes_test.testColor]"
+
+	if got := testColorType.String(); got != expected {
+		t.Errorf("expected %q, got: %q", expected, got)
+	}
+}
+
+func TestStringTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"allowed": {
+			in: tftypes.NewValue(tftypes.String, "red"),
+		},
+		"not-allowed": {
+			in: tftypes.NewValue(tftypes.String, "green"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: "blue", "red", got: "green"`,
+				),
+			},
+		},
+		"wrong-type": {
+			in: tftypes.NewValue(tftypes.Number, 1),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Enum Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testColorType.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in:       tftypes.NewValue(tftypes.String, "red"),
+			expected: testColorType.Value(testColorRed),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: testColorType.NullValue(),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: testColorType.UnknownValue(),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.Number, 1),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testColorType.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got: %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !got.Type(context.Background()).Equal(testColorType) {
+				t.Errorf("expected value type to equal %s, got: %s", testColorType, got.Type(context.Background()))
+			}
+		})
+	}
+}
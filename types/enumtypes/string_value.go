@@ -0,0 +1,59 @@
+package enumtypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable = StringValue[string]{}
+)
+
+// StringValue is the value type of StringType, which converts to the Go enum
+// type T. Create values with the StringType Value, PointerValue, NullValue,
+// and UnknownValue methods.
+type StringValue[T ~string] struct {
+	basetypes.StringValue
+
+	values []T
+}
+
+// Equal returns true if the given value is a StringValue of the same Go
+// enum type with the same value state and value.
+func (v StringValue[T]) Equal(o attr.Value) bool {
+	other, ok := o.(StringValue[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the StringType with the allowed values of this value.
+func (v StringValue[T]) Type(_ context.Context) attr.Type {
+	return StringType[T]{
+		values: v.values,
+	}
+}
+
+// ValueEnum returns the known value as the Go enum type. If the value is
+// null or unknown, returns the zero value of the Go enum type.
+func (v StringValue[T]) ValueEnum() T {
+	return T(v.ValueString())
+}
+
+// ValueEnumPointer returns a pointer to the known value as the Go enum type,
+// or nil for a null or unknown value. Use the IsUnknown method to
+// differentiate an unknown value from a null value.
+func (v StringValue[T]) ValueEnumPointer() *T {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	value := v.ValueEnum()
+
+	return &value
+}
@@ -0,0 +1,170 @@
+package enumtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/enumtypes"
+)
+
+func TestStringValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    enumtypes.StringValue[testColor]
+		other    attr.Value
+		expected bool
+	}{
+		"known-equal": {
+			value:    testColorType.Value(testColorRed),
+			other:    testColorType.Value(testColorRed),
+			expected: true,
+		},
+		"known-different": {
+			value:    testColorType.Value(testColorRed),
+			other:    testColorType.Value(testColorBlue),
+			expected: false,
+		},
+		"known-null": {
+			value:    testColorType.Value(testColorRed),
+			other:    testColorType.NullValue(),
+			expected: false,
+		},
+		"null-null": {
+			value:    testColorType.NullValue(),
+			other:    testColorType.NullValue(),
+			expected: true,
+		},
+		"StringValue": {
+			value:    testColorType.Value(testColorRed),
+			other:    types.StringValue("red"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestStringValueValueEnum(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value           enumtypes.StringValue[testColor]
+		expected        testColor
+		expectedPointer *testColor
+	}{
+		"known": {
+			value:           testColorType.Value(testColorRed),
+			expected:        testColorRed,
+			expectedPointer: pointer(testColorRed),
+		},
+		"null": {
+			value:    testColorType.NullValue(),
+			expected: "",
+		},
+		"pointer-nil": {
+			value:    testColorType.PointerValue(nil),
+			expected: "",
+		},
+		"pointer": {
+			value:           testColorType.PointerValue(pointer(testColorBlue)),
+			expected:        testColorBlue,
+			expectedPointer: pointer(testColorBlue),
+		},
+		"unknown": {
+			value:    testColorType.UnknownValue(),
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.value.ValueEnum(); got != testCase.expected {
+				t.Errorf("expected %q, got: %q", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(testCase.value.ValueEnumPointer(), testCase.expectedPointer); diff != "" {
+				t.Errorf("unexpected pointer difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValueDataModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type testModel struct {
+		Color enumtypes.StringValue[testColor] `tfsdk:"color"`
+	}
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"color": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"color": tftypes.NewValue(tftypes.String, "blue"),
+		}),
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"color": schema.StringAttribute{
+					CustomType: testColorType,
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	var data testModel
+
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected Get diagnostics: %v", diags)
+	}
+
+	if got := data.Color.ValueEnum(); got != testColorBlue {
+		t.Errorf("expected %q, got: %q", testColorBlue, got)
+	}
+
+	data.Color = testColorType.Value(testColorRed)
+
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected Set diagnostics: %v", diags)
+	}
+
+	expected := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"color": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"color": tftypes.NewValue(tftypes.String, "red"),
+	})
+
+	if diff := cmp.Diff(state.Raw, expected); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
@@ -31,10 +31,24 @@ func ListValue(elementType attr.Type, elements []attr.Value) (basetypes.ListValu
 // ListValueFrom creates a List with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the List type Elements or ElementsAs methods.
+//
+// A nil slice creates a null List, while an empty slice creates a known List
+// with zero elements.
 func ListValueFrom(ctx context.Context, elementType attr.Type, elements any) (basetypes.ListValue, diag.Diagnostics) {
 	return basetypes.NewListValueFrom(ctx, elementType, elements)
 }
 
+// ListValueFromMust creates a List with a known value, using reflection rules,
+// converting any diagnostics into a panic at runtime. Access the value via the
+// List type Elements or ElementsAs methods.
+//
+// This creation function is only recommended to create List values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func ListValueFromMust(ctx context.Context, elementType attr.Type, elements any) basetypes.ListValue {
+	return basetypes.NewListValueFromMust(ctx, elementType, elements)
+}
+
 // ListValueMust creates a List with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the List
 // type Elements or ElementsAs methods.
@@ -31,10 +31,24 @@ func MapValue(elementType attr.Type, elements map[string]attr.Value) (basetypes.
 // MapValueFrom creates a Map with a known value, using reflection rules.
 // The elements must be a map which can convert into the given element type.
 // Access the value via the Map type Elements or ElementsAs methods.
+//
+// A nil map creates a null Map, while an empty map creates a known Map
+// with zero elements.
 func MapValueFrom(ctx context.Context, elementType attr.Type, elements any) (basetypes.MapValue, diag.Diagnostics) {
 	return basetypes.NewMapValueFrom(ctx, elementType, elements)
 }
 
+// MapValueFromMust creates a Map with a known value, using reflection rules,
+// converting any diagnostics into a panic at runtime. Access the value via the
+// Map type Elements or ElementsAs methods.
+//
+// This creation function is only recommended to create Map values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func MapValueFromMust(ctx context.Context, elementType attr.Type, elements any) basetypes.MapValue {
+	return basetypes.NewMapValueFromMust(ctx, elementType, elements)
+}
+
 // MapValueMust creates a Map with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Map
 // type Elements or ElementsAs methods.
@@ -25,3 +25,16 @@ func NumberUnknown() basetypes.NumberValue {
 func NumberValue(value *big.Float) basetypes.NumberValue {
 	return basetypes.NewNumberValue(value)
 }
+
+// NumberValueFromInt64 creates a Number with a known value from an int64.
+// Access the value via the Number type ValueInt64 method.
+func NumberValueFromInt64(value int64) basetypes.NumberValue {
+	return basetypes.NewNumberValueFromInt64(value)
+}
+
+// NumberValueFromFloat64 creates a Number with a known value from a float64.
+// Access the value via the Number type ValueFloat64 method. If the given
+// value is NaN, a null Number is created.
+func NumberValueFromFloat64(value float64) basetypes.NumberValue {
+	return basetypes.NewNumberValueFromFloat64(value)
+}
@@ -29,12 +29,24 @@ func ObjectValue(attributeTypes map[string]attr.Type, attributes map[string]attr
 }
 
 // ObjectValueFrom creates a Object with a known value, using reflection rules.
-// The attributes must be a struct which can convert into the given attribute types.
+// The attributes must be a struct with tfsdk field tags or a map of string
+// attribute names to values which can convert into the given attribute types.
 // Access the value via the Object type Attributes or As methods.
 func ObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, attributes any) (basetypes.ObjectValue, diag.Diagnostics) {
 	return basetypes.NewObjectValueFrom(ctx, attributeTypes, attributes)
 }
 
+// ObjectValueFromMust creates a Object with a known value, using reflection rules,
+// converting any diagnostics into a panic at runtime. Access the value via the
+// Object type Attributes or As methods.
+//
+// This creation function is only recommended to create Object values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func ObjectValueFromMust(ctx context.Context, attributeTypes map[string]attr.Type, attributes any) basetypes.ObjectValue {
+	return basetypes.NewObjectValueFromMust(ctx, attributeTypes, attributes)
+}
+
 // ObjectValueMust creates a Object with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Object
 // type Attributes or As methods.
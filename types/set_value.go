@@ -31,10 +31,24 @@ func SetValue(elementType attr.Type, elements []attr.Value) (basetypes.SetValue,
 // SetValueFrom creates a Set with a known value, using reflection rules.
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
+//
+// A nil slice creates a null Set, while an empty slice creates a known Set
+// with zero elements.
 func SetValueFrom(ctx context.Context, elementType attr.Type, elements any) (basetypes.SetValue, diag.Diagnostics) {
 	return basetypes.NewSetValueFrom(ctx, elementType, elements)
 }
 
+// SetValueFromMust creates a Set with a known value, using reflection rules,
+// converting any diagnostics into a panic at runtime. Access the value via the
+// Set type Elements or ElementsAs methods.
+//
+// This creation function is only recommended to create Set values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func SetValueFromMust(ctx context.Context, elementType attr.Type, elements any) basetypes.SetValue {
+	return basetypes.NewSetValueFromMust(ctx, elementType, elements)
+}
+
 // SetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.